@@ -0,0 +1,239 @@
+// Package apilogging logs the requests the clients of the provider make to
+// the Kubernetes API.
+package apilogging
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogSubsystem is the tflog subsystem of the API requests made by the
+// clients.
+const apiLogSubsystem = "kubernetes.api"
+
+// apiLogBodyLimit is the maximum number of bytes of a body which are logged.
+const apiLogBodyLimit = 64 * 1024
+
+const redactedValue = "(redacted)"
+
+// apiRequestInfo describes the Kubernetes object an API request is made for,
+// as far as it can be told from the path of the request.
+type apiRequestInfo struct {
+	Group       string
+	Version     string
+	Resource    string
+	Namespace   string
+	Name        string
+	Subresource string
+}
+
+// parseAPIRequestPath parses paths such as /api/v1/namespaces/default/pods/web
+// and /apis/apps/v1/namespaces/default/deployments/web/scale.
+func parseAPIRequestPath(path string) apiRequestInfo {
+	info := apiRequestInfo{}
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case len(parts) >= 2 && parts[0] == "api":
+		info.Version = parts[1]
+		parts = parts[2:]
+	case len(parts) >= 3 && parts[0] == "apis":
+		info.Group = parts[1]
+		info.Version = parts[2]
+		parts = parts[3:]
+	default:
+		return info
+	}
+	if len(parts) >= 2 && parts[0] == "namespaces" {
+		info.Namespace = parts[1]
+		parts = parts[2:]
+		if len(parts) == 0 {
+			// The namespace itself, e.g. /api/v1/namespaces/default.
+			info.Resource = "namespaces"
+			info.Name = info.Namespace
+			info.Namespace = ""
+			return info
+		}
+	}
+	if len(parts) > 0 {
+		info.Resource = parts[0]
+	}
+	if len(parts) > 1 {
+		info.Name = parts[1]
+	}
+	if len(parts) > 2 {
+		info.Subresource = parts[2]
+	}
+	return info
+}
+
+func (i apiRequestInfo) groupVersionResource() string {
+	if i.Group == "" {
+		return fmt.Sprintf("%s/%s", i.Version, i.Resource)
+	}
+	return fmt.Sprintf("%s/%s/%s", i.Group, i.Version, i.Resource)
+}
+
+// isSensitive reports whether the bodies of the request may contain secret
+// data which has to be redacted.
+func (i apiRequestInfo) isSensitive() bool {
+	return (i.Group == "" && i.Resource == "secrets") || i.Subresource == "token" || i.Resource == "tokenrequests"
+}
+
+// isStreaming reports whether the response of the request is a stream which
+// must not be read ahead of the client.
+func (i apiRequestInfo) isStreaming(req *http.Request) bool {
+	switch i.Subresource {
+	case "log", "exec", "attach", "portforward", "proxy":
+		return true
+	}
+	w := req.URL.Query().Get("watch")
+	return w == "true" || w == "1"
+}
+
+// apiLoggingTransport logs one line per API request to the kubernetes.api
+// subsystem and, when logBodies is set, the bodies of the request and the
+// response with secret data redacted.
+type apiLoggingTransport struct {
+	rt        http.RoundTripper
+	logBodies bool
+}
+
+// NewTransport returns a function wrapping a transport with an
+// apiLoggingTransport, to be passed to rest.Config.Wrap.
+func NewTransport(logBodies bool) func(http.RoundTripper) http.RoundTripper {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return &apiLoggingTransport{rt: rt, logBodies: logBodies}
+	}
+}
+
+func (t *apiLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), apiLogSubsystem)
+	info := parseAPIRequestPath(req.URL.Path)
+	fields := []interface{}{
+		"method", req.Method,
+		"path", req.URL.Path,
+		"resource", info.groupVersionResource(),
+		"namespace", info.Namespace,
+		"name", info.Name,
+	}
+	if info.Subresource != "" {
+		fields = append(fields, "subresource", info.Subresource)
+	}
+
+	if t.logBodies && req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			data, _ := io.ReadAll(io.LimitReader(body, apiLogBodyLimit))
+			body.Close()
+			tflog.SubsystemTrace(ctx, apiLogSubsystem, "Kubernetes API request body", append(fields,
+				"body", string(redactAPIBody(data, req.Header.Get("Content-Type"), info.isSensitive())))...)
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.rt.RoundTrip(req)
+	fields = append(fields, "duration_ms", time.Since(start).Milliseconds())
+	if err != nil {
+		tflog.SubsystemDebug(ctx, apiLogSubsystem, "Kubernetes API request failed", append(fields, "error", err.Error())...)
+		return resp, err
+	}
+	fields = append(fields, "status_code", resp.StatusCode)
+	tflog.SubsystemDebug(ctx, apiLogSubsystem, "Kubernetes API request", fields...)
+
+	if t.logBodies && resp.Body != nil && !info.isStreaming(req) {
+		data, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(data))
+		if err != nil {
+			return resp, err
+		}
+		if len(data) > apiLogBodyLimit {
+			data = data[:apiLogBodyLimit]
+		}
+		tflog.SubsystemTrace(ctx, apiLogSubsystem, "Kubernetes API response body", append(fields,
+			"body", string(redactAPIBody(data, resp.Header.Get("Content-Type"), info.isSensitive())))...)
+	}
+	return resp, nil
+}
+
+// redactAPIBody returns the body to log. Secret data is redacted from JSON
+// bodies. Sensitive bodies which cannot be parsed, such as patches of
+// secrets, and bodies which are not JSON are left out entirely.
+func redactAPIBody(data []byte, contentType string, sensitive bool) []byte {
+	if len(data) == 0 {
+		return data
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	if mediaType != "" && mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json") {
+		return []byte(fmt.Sprintf("(%d bytes of %s)", len(data), mediaType))
+	}
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		if sensitive {
+			return []byte(redactedValue)
+		}
+		return data
+	}
+	if !redactAPIObject(obj) {
+		if sensitive {
+			return []byte(redactedValue)
+		}
+		return data
+	}
+	out, err := json.Marshal(obj)
+	if err != nil {
+		return []byte(redactedValue)
+	}
+	return out
+}
+
+// redactAPIObject redacts the secret data of Secrets, lists of Secrets and
+// token requests. It reports whether the object was recognized.
+func redactAPIObject(obj interface{}) bool {
+	m, ok := obj.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	switch m["kind"] {
+	case "Secret":
+		redactAPIFields(m, "data", "stringData")
+		return true
+	case "SecretList":
+		items, _ := m["items"].([]interface{})
+		for _, item := range items {
+			if im, ok := item.(map[string]interface{}); ok {
+				redactAPIFields(im, "data", "stringData")
+			}
+		}
+		return true
+	case "TokenRequest":
+		if status, ok := m["status"].(map[string]interface{}); ok {
+			redactAPIFields(status, "token")
+		}
+		return true
+	case "Status":
+		return true
+	}
+	return false
+}
+
+func redactAPIFields(m map[string]interface{}, keys ...string) {
+	for _, k := range keys {
+		switch v := m[k].(type) {
+		case map[string]interface{}:
+			for kk := range v {
+				v[kk] = redactedValue
+			}
+		case nil:
+		default:
+			m[k] = redactedValue
+		}
+	}
+}
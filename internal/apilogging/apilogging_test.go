@@ -0,0 +1,152 @@
+package apilogging
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseAPIRequestPath(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected apiRequestInfo
+	}{
+		{
+			path:     "/api/v1/namespaces/default/pods/web",
+			expected: apiRequestInfo{Version: "v1", Resource: "pods", Namespace: "default", Name: "web"},
+		},
+		{
+			path:     "/api/v1/namespaces/default",
+			expected: apiRequestInfo{Version: "v1", Resource: "namespaces", Name: "default"},
+		},
+		{
+			path:     "/api/v1/namespaces",
+			expected: apiRequestInfo{Version: "v1", Resource: "namespaces"},
+		},
+		{
+			path:     "/apis/apps/v1/namespaces/default/deployments/web/scale",
+			expected: apiRequestInfo{Group: "apps", Version: "v1", Resource: "deployments", Namespace: "default", Name: "web", Subresource: "scale"},
+		},
+		{
+			path:     "/apis/rbac.authorization.k8s.io/v1/clusterroles/admin",
+			expected: apiRequestInfo{Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterroles", Name: "admin"},
+		},
+		{
+			path:     "/apis/apps/v1",
+			expected: apiRequestInfo{Group: "apps", Version: "v1"},
+		},
+		{
+			path:     "/version",
+			expected: apiRequestInfo{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, parseAPIRequestPath(tc.path)); diff != "" {
+				t.Fatalf("unexpected request info (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRedactAPIBody(t *testing.T) {
+	testCases := []struct {
+		name        string
+		body        string
+		contentType string
+		sensitive   bool
+		expected    string
+	}{
+		{
+			name:        "secret",
+			body:        `{"kind":"Secret","metadata":{"name":"s"},"data":{"password":"c2VjcmV0"},"stringData":{"user":"admin"}}`,
+			contentType: "application/json",
+			sensitive:   true,
+			expected:    `{"data":{"password":"(redacted)"},"kind":"Secret","metadata":{"name":"s"},"stringData":{"user":"(redacted)"}}`,
+		},
+		{
+			name:        "secret list",
+			body:        `{"kind":"SecretList","items":[{"metadata":{"name":"s"},"data":{"password":"c2VjcmV0"}}]}`,
+			contentType: "application/json",
+			sensitive:   true,
+			expected:    `{"items":[{"data":{"password":"(redacted)"},"metadata":{"name":"s"}}],"kind":"SecretList"}`,
+		},
+		{
+			name:        "token request",
+			body:        `{"kind":"TokenRequest","status":{"token":"abc","expirationTimestamp":"2023-01-01T00:00:00Z"}}`,
+			contentType: "application/json",
+			sensitive:   true,
+			expected:    `{"kind":"TokenRequest","status":{"expirationTimestamp":"2023-01-01T00:00:00Z","token":"(redacted)"}}`,
+		},
+		{
+			name:        "secret patch",
+			body:        `[{"op":"replace","path":"/data","value":{"password":"c2VjcmV0"}}]`,
+			contentType: "application/json-patch+json",
+			sensitive:   true,
+			expected:    `(redacted)`,
+		},
+		{
+			name:        "config map",
+			body:        `{"kind":"ConfigMap","data":{"key":"value"}}`,
+			contentType: "application/json",
+			expected:    `{"kind":"ConfigMap","data":{"key":"value"}}`,
+		},
+		{
+			name:        "embedded secret",
+			body:        `{"kind":"Secret","data":{"password":"c2VjcmV0"}}`,
+			contentType: "application/json",
+			expected:    `{"data":{"password":"(redacted)"},"kind":"Secret"}`,
+		},
+		{
+			name:        "protobuf",
+			body:        "k8s\x00",
+			contentType: "application/vnd.kubernetes.protobuf",
+			sensitive:   true,
+			expected:    `(4 bytes of application/vnd.kubernetes.protobuf)`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := string(redactAPIBody([]byte(tc.body), tc.contentType, tc.sensitive))
+			if got != tc.expected {
+				t.Fatalf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestAPILoggingTransport(t *testing.T) {
+	body := `{"kind":"Secret","data":{"password":"c2VjcmV0"}}`
+	rt := NewTransport(true)(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}))
+
+	req, err := http.NewRequest(http.MethodGet, "https://127.0.0.1/api/v1/namespaces/default/secrets/s", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != body {
+		t.Fatalf("expected the response body to be passed on unchanged, got %s", got)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
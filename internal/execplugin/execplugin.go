@@ -0,0 +1,133 @@
+// Package execplugin reports what exec credential plugins print to their
+// standard error when they fail.
+//
+// client-go forwards the standard error of the plugins to the one of the
+// provider, which Terraform only writes to its logs, so a failed request
+// merely says that the plugin exited with an error. The clients built here
+// run the plugin once more after such a failure to capture its standard error
+// and add it to the error of the request.
+package execplugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/pkg/apis/clientauthentication"
+	"k8s.io/client-go/pkg/apis/clientauthentication/install"
+	"k8s.io/client-go/rest"
+)
+
+const execInfoEnv = "KUBERNETES_EXEC_INFO"
+
+// stderrLimit is the maximum number of bytes of the standard error of a
+// plugin which are added to an error.
+const stderrLimit = 4 * 1024
+
+// runTimeout bounds the run of a plugin made to capture its standard error.
+const runTimeout = time.Minute
+
+var scheme = runtime.NewScheme()
+var codecs = serializer.NewCodecFactory(scheme)
+
+func init() {
+	install.Install(scheme)
+}
+
+// HTTPClientFor returns the HTTP client of rest.HTTPClientFor. When the
+// config uses an exec credential plugin, requests failing because the plugin
+// exited with an error report the standard error of the plugin.
+func HTTPClientFor(config *rest.Config) (*http.Client, error) {
+	c := *config
+	if c.UserAgent == "" {
+		c.UserAgent = rest.DefaultKubernetesUserAgent()
+	}
+	client, err := rest.HTTPClientFor(&c)
+	if err != nil {
+		return nil, err
+	}
+	if c.ExecProvider != nil {
+		client.Transport = &stderrRoundTripper{rt: client.Transport, config: &c}
+	}
+	return client, nil
+}
+
+type stderrRoundTripper struct {
+	rt     http.RoundTripper
+	config *rest.Config
+}
+
+func (t *stderrRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.rt.RoundTrip(req)
+	if err == nil || !isPluginExitError(err) {
+		return resp, err
+	}
+	stderr := pluginStderr(t.config)
+	if stderr == "" {
+		return resp, err
+	}
+	return resp, fmt.Errorf("%w, the plugin printed:\n%s", err, stderr)
+}
+
+func (t *stderrRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return t.rt
+}
+
+// isPluginExitError reports whether err is the error client-go returns when
+// the credential plugin ran but exited with an error. It is only available as
+// a message.
+func isPluginExitError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "exec: executable ") && strings.Contains(msg, " failed with exit code ")
+}
+
+// pluginStderr runs the credential plugin of config the way client-go does,
+// without standard input, and returns what it printed to its standard error.
+func pluginStderr(config *rest.Config) string {
+	ec := config.ExecProvider
+	cred := &clientauthentication.ExecCredential{}
+	if ec.ProvideClusterInfo {
+		cluster, err := rest.ConfigToExecCluster(config)
+		if err != nil {
+			return ""
+		}
+		cred.Spec.Cluster = cluster
+	}
+	gv, err := schema.ParseGroupVersion(ec.APIVersion)
+	if err != nil {
+		return ""
+	}
+	data, err := runtime.Encode(codecs.LegacyCodec(gv), cred)
+	if err != nil {
+		return ""
+	}
+
+	// The context of the request is not used, the request may have failed
+	// because it ended.
+	ctx, cancel := context.WithTimeout(context.Background(), runTimeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, ec.Command, ec.Args...)
+	cmd.Env = os.Environ()
+	for _, e := range ec.Env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", e.Name, e.Value))
+	}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", execInfoEnv, data))
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	// The exit status does not matter, the plugin failed for client-go.
+	_ = cmd.Run()
+
+	out := strings.TrimSpace(stderr.String())
+	if len(out) > stderrLimit {
+		out = out[:stderrLimit] + "..."
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package execplugin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func writePlugin(t *testing.T, script string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("The stub credential plugin is a shell script")
+	}
+	plugin := filepath.Join(t.TempDir(), "credential-plugin")
+	if err := os.WriteFile(plugin, []byte("#!/bin/sh\n"+script), 0700); err != nil {
+		t.Fatal(err)
+	}
+	return plugin
+}
+
+func TestHTTPClientFor_pluginStderr(t *testing.T) {
+	plugin := writePlugin(t, `echo "the session of $STUB_USER has expired, run login" >&2
+exit 1
+`)
+	client, err := HTTPClientFor(&rest.Config{
+		Host: "https://127.0.0.1:1",
+		ExecProvider: &clientcmdapi.ExecConfig{
+			APIVersion:      "client.authentication.k8s.io/v1",
+			Command:         plugin,
+			Env:             []clientcmdapi.ExecEnvVar{{Name: "STUB_USER", Value: "jane"}},
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Get("https://127.0.0.1:1/api")
+	if err == nil {
+		t.Fatal("Expected the request to fail")
+	}
+	if !strings.Contains(err.Error(), "failed with exit code 1") {
+		t.Errorf("Expected the exit code of the plugin, got %q", err)
+	}
+	if !strings.Contains(err.Error(), "the session of jane has expired, run login") {
+		t.Errorf("Expected the standard error of the plugin, got %q", err)
+	}
+}
+
+func TestHTTPClientFor_pluginSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer stub" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	plugin := writePlugin(t, `echo "warnings are not errors" >&2
+printf '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"stub"}}'
+`)
+	client, err := HTTPClientFor(&rest.Config{
+		Host: server.URL,
+		ExecProvider: &clientcmdapi.ExecConfig{
+			APIVersion:      "client.authentication.k8s.io/v1",
+			Command:         plugin,
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.Get(server.URL + "/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+}
@@ -0,0 +1,53 @@
+// Package ssa parses the conflicts the Kubernetes API reports for server-side
+// apply requests, for both the kubernetes and the manifest providers.
+package ssa
+
+import (
+	"fmt"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// managerRegexp extracts the name of the conflicting manager from the message
+// of a FieldManagerConflict cause, e.g. `conflict with "kubectl" using v1`.
+var managerRegexp = regexp.MustCompile(`^conflict with "([^"]*)"`)
+
+// FieldConflict is a field of an apply request owned by another field manager.
+type FieldConflict struct {
+	// Field is the path of the field, e.g. `.data.foo`.
+	Field string
+	// Manager is the name of the field manager owning the field, empty when
+	// the message of the cause does not name it.
+	Manager string
+}
+
+// Owner describes the field manager owning the field, e.g.
+// `field manager "kubectl"`.
+func (c FieldConflict) Owner() string {
+	if c.Manager == "" {
+		return "another field manager"
+	}
+	return fmt.Sprintf("field manager %q", c.Manager)
+}
+
+// FieldConflicts returns the field manager conflicts in the causes of the
+// status of a failed apply request. It is empty for any other status,
+// including the conflict returned for a stale resourceVersion.
+func FieldConflicts(s metav1.Status) []FieldConflict {
+	if s.Details == nil {
+		return nil
+	}
+	var conflicts []FieldConflict
+	for _, c := range s.Details.Causes {
+		if c.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		fc := FieldConflict{Field: c.Field}
+		if m := managerRegexp.FindStringSubmatch(c.Message); m != nil {
+			fc.Manager = m[1]
+		}
+		conflicts = append(conflicts, fc)
+	}
+	return conflicts
+}
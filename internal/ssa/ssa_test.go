@@ -0,0 +1,70 @@
+package ssa
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFieldConflicts(t *testing.T) {
+	cases := map[string]struct {
+		status   metav1.Status
+		expected []FieldConflict
+	}{
+		"no details": {
+			status: metav1.Status{Reason: metav1.StatusReasonConflict},
+		},
+		"resourceVersion conflict": {
+			status: metav1.Status{
+				Reason:  metav1.StatusReasonConflict,
+				Details: &metav1.StatusDetails{Name: "test"},
+			},
+		},
+		"field manager conflicts": {
+			status: metav1.Status{
+				Reason: metav1.StatusReasonConflict,
+				Details: &metav1.StatusDetails{
+					Causes: []metav1.StatusCause{
+						{
+							Type:    metav1.CauseTypeFieldManagerConflict,
+							Message: `conflict with "kubectl" using v1`,
+							Field:   ".data.foo",
+						},
+						{
+							Type:    metav1.CauseTypeFieldValueInvalid,
+							Message: "invalid",
+							Field:   ".data.bar",
+						},
+						{
+							Type:    metav1.CauseTypeFieldManagerConflict,
+							Message: "conflict",
+							Field:   ".data.baz",
+						},
+					},
+				},
+			},
+			expected: []FieldConflict{
+				{Field: ".data.foo", Manager: "kubectl"},
+				{Field: ".data.baz"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conflicts := FieldConflicts(tc.status)
+			if !reflect.DeepEqual(conflicts, tc.expected) {
+				t.Errorf("Expected %#v, got %#v", tc.expected, conflicts)
+			}
+		})
+	}
+}
+
+func TestFieldConflictOwner(t *testing.T) {
+	if owner := (FieldConflict{Manager: "kubectl"}).Owner(); owner != `field manager "kubectl"` {
+		t.Errorf("Unexpected owner %q", owner)
+	}
+	if owner := (FieldConflict{}).Owner(); owner != "another field manager" {
+		t.Errorf("Unexpected owner %q", owner)
+	}
+}
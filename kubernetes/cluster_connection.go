@@ -0,0 +1,255 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/apilogging"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// clusterConnectionSchema returns the schema of the cluster_connection block,
+// which points a single resource at another cluster than the provider. The
+// object lives in the cluster, so changing the host of the block replaces the
+// resource, see forceNewClusterConnectionHost. The rest of the block can be
+// updated in place, unless the resource cannot be updated.
+func clusterConnectionSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "Manage the resource in another cluster than the one the provider is configured for.",
+		Optional:    true,
+		ForceNew:    forceNew,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"host": {
+					Type:        schema.TypeString,
+					Description: "The hostname (in form of URI) of the Kubernetes API.",
+					Required:    true,
+					ForceNew:    forceNew,
+				},
+				"token": {
+					Type:        schema.TypeString,
+					Description: "Token to authenticate a service account.",
+					Optional:    true,
+					ForceNew:    forceNew,
+					Sensitive:   true,
+				},
+				"cluster_ca_certificate": {
+					Type:        schema.TypeString,
+					Description: "PEM-encoded root certificates bundle for TLS authentication.",
+					Optional:    true,
+					ForceNew:    forceNew,
+				},
+				"insecure": {
+					Type:        schema.TypeBool,
+					Description: "Whether the server should be accessed without verifying the TLS certificate.",
+					Optional:    true,
+					ForceNew:    forceNew,
+				},
+				"exec": {
+					Type:        schema.TypeList,
+					Description: "Configuration of an exec-based credential plugin.",
+					Optional:    true,
+					ForceNew:    forceNew,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"api_version": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     forceNew,
+								ValidateFunc: validation.StringInSlice([]string{"client.authentication.k8s.io/v1", "client.authentication.k8s.io/v1beta1"}, false),
+							},
+							"command": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: forceNew,
+							},
+							"args": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: forceNew,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"env": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: forceNew,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// clusterConnectionCache holds the clientsets of the cluster_connection blocks
+// seen during a run, keyed by a hash of the block, so that resources sharing
+// a connection share their clients and discovery results.
+type clusterConnectionCache struct {
+	mu          sync.Mutex
+	connections map[string]kubeClientsets
+}
+
+// withClusterConnection adds the cluster_connection block to the resource and
+// wraps its functions to hand them the clientsets of the connection.
+func withClusterConnection(r *schema.Resource) {
+	if _, ok := r.Schema["cluster_connection"]; ok {
+		return
+	}
+	forceNew := r.UpdateContext == nil
+	r.Schema["cluster_connection"] = clusterConnectionSchema(forceNew)
+
+	wrap := func(f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if f == nil {
+			return nil
+		}
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			m, err := clusterConnectionMeta(d.Get("cluster_connection").([]interface{}), meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return f(ctx, d, m)
+		}
+	}
+	r.CreateContext = wrap(r.CreateContext)
+	r.ReadContext = wrap(r.ReadContext)
+	r.UpdateContext = wrap(r.UpdateContext)
+	r.DeleteContext = wrap(r.DeleteContext)
+
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			if !forceNew {
+				if err := forceNewClusterConnectionHost(d); err != nil {
+					return err
+				}
+			}
+			if !d.NewValueKnown("cluster_connection") {
+				k, ok := meta.(kubeClientsets)
+				if !ok {
+					return f(ctx, d, meta)
+				}
+				// The clients cannot be built yet, so the checks against the
+				// API server are skipped like for an incomplete provider.
+				k.configErr = &errIncompleteConfiguration{err: fmt.Errorf("cluster_connection is not known until apply")}
+				return f(ctx, d, k)
+			}
+			m, err := clusterConnectionMeta(d.Get("cluster_connection").([]interface{}), meta)
+			if err != nil {
+				return err
+			}
+			return f(ctx, d, m)
+		}
+	} else if !forceNew {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return forceNewClusterConnectionHost(d)
+		}
+	}
+}
+
+// forceNewClusterConnectionHost replaces the resource when the host of its
+// cluster_connection block changes, as its object lives in the cluster the
+// old host points to. Adding the block, e.g. to an imported resource, only
+// updates the resource, the object is expected in the cluster of the block.
+func forceNewClusterConnectionHost(d *schema.ResourceDiff) error {
+	const key = "cluster_connection.0.host"
+	o, n := d.GetChange(key)
+	if o.(string) == "" || o.(string) == n.(string) {
+		return nil
+	}
+	return d.ForceNew(key)
+}
+
+// clusterConnectionMeta returns the clientsets for the given cluster_connection
+// block, or meta itself when the block is not set.
+func clusterConnectionMeta(l []interface{}, meta interface{}) (interface{}, error) {
+	if len(l) == 0 || l[0] == nil {
+		return meta, nil
+	}
+	k, ok := meta.(kubeClientsets)
+	if !ok || k.connections == nil {
+		return meta, nil
+	}
+	in := l[0].(map[string]interface{})
+
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return nil, err
+	}
+	key := fmt.Sprintf("%x", sha256.Sum256(raw))
+
+	k.connections.mu.Lock()
+	defer k.connections.mu.Unlock()
+	if c, ok := k.connections.connections[key]; ok {
+		return c, nil
+	}
+
+	cfg, err := expandClusterConnection(in, k.config)
+	if err != nil {
+		return nil, err
+	}
+	cfg.Wrap(apilogging.NewTransport(k.logAPIRequests))
+	log.Printf("[DEBUG] Using cluster connection to %s", cfg.Host)
+	c := k
+	c.config = cfg
+	c.configErr = nil
+	c.clients = &clientsetsCache{}
+	c.serverVersion = &serverVersionCache{}
+	k.connections.connections[key] = c
+	return c, nil
+}
+
+// expandClusterConnection builds the client configuration of a connection.
+// Only the transport settings are taken from the provider configuration, so
+// the credentials of the provider are never sent to another cluster.
+func expandClusterConnection(in map[string]interface{}, base *restclient.Config) (*restclient.Config, error) {
+	caData := []byte(in["cluster_ca_certificate"].(string))
+	insecure := in["insecure"].(bool)
+	host, _, err := restclient.DefaultServerURL(in["host"].(string), "", apimachineryschema.GroupVersion{}, len(caData) > 0 || insecure)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse cluster_connection host: %s", err)
+	}
+
+	cfg := &restclient.Config{
+		Host:        host.String(),
+		BearerToken: in["token"].(string),
+		TLSClientConfig: restclient.TLSClientConfig{
+			Insecure: insecure,
+			CAData:   caData,
+		},
+	}
+	if base != nil {
+		cfg.UserAgent = base.UserAgent
+		cfg.QPS = base.QPS
+		cfg.Burst = base.Burst
+		cfg.Timeout = base.Timeout
+		cfg.Proxy = base.Proxy
+	}
+
+	if l := in["exec"].([]interface{}); len(l) > 0 && l[0] != nil {
+		spec := l[0].(map[string]interface{})
+		exec := &clientcmdapi.ExecConfig{
+			APIVersion:      spec["api_version"].(string),
+			Command:         spec["command"].(string),
+			Args:            expandStringSlice(spec["args"].([]interface{})),
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+		for k, v := range spec["env"].(map[string]interface{}) {
+			exec.Env = append(exec.Env, clientcmdapi.ExecEnvVar{Name: k, Value: v.(string)})
+		}
+		cfg.ExecProvider = exec
+	}
+	return cfg, nil
+}
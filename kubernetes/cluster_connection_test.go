@@ -0,0 +1,245 @@
+package kubernetes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	restclient "k8s.io/client-go/rest"
+)
+
+func testClusterConnectionProviderMeta() kubeClientsets {
+	return kubeClientsets{
+		config: &restclient.Config{
+			Host:        "https://provider.example.com",
+			BearerToken: "provider-token",
+			UserAgent:   "HashiCorp/1.0 Terraform/1.5.0",
+			QPS:         50,
+			Burst:       100,
+		},
+		clients:       &clientsetsCache{},
+		serverVersion: &serverVersionCache{},
+		connections:   &clusterConnectionCache{connections: map[string]kubeClientsets{}},
+	}
+}
+
+func TestClusterConnectionMeta(t *testing.T) {
+	meta := testClusterConnectionProviderMeta()
+
+	out, err := clusterConnectionMeta([]interface{}{}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.(kubeClientsets).config != meta.config {
+		t.Fatal("Expected the provider clientsets without a cluster_connection block")
+	}
+
+	block := map[string]interface{}{
+		"host":                   "10.0.0.1:6443",
+		"token":                  "connection-token",
+		"cluster_ca_certificate": "ca",
+		"insecure":               false,
+		"exec":                   []interface{}{},
+	}
+	out, err = clusterConnectionMeta([]interface{}{block}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := out.(kubeClientsets)
+	if c.config.Host != "https://10.0.0.1:6443" {
+		t.Errorf("Expected host %q, got %q", "https://10.0.0.1:6443", c.config.Host)
+	}
+	if c.config.BearerToken != "connection-token" {
+		t.Errorf("Expected the token of the connection, got %q", c.config.BearerToken)
+	}
+	if string(c.config.TLSClientConfig.CAData) != "ca" {
+		t.Errorf("Expected the CA of the connection, got %q", string(c.config.TLSClientConfig.CAData))
+	}
+	if c.config.UserAgent != meta.config.UserAgent || c.config.QPS != meta.config.QPS || c.config.Burst != meta.config.Burst {
+		t.Errorf("Expected the transport settings of the provider, got %#v", c.config)
+	}
+	if c.clients == meta.clients || c.serverVersion == meta.serverVersion {
+		t.Error("Expected the connection not to share the clients of the provider")
+	}
+
+	again, err := clusterConnectionMeta([]interface{}{block}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.(kubeClientsets).clients != c.clients {
+		t.Error("Expected the same connection to reuse its clients")
+	}
+
+	other := map[string]interface{}{}
+	for k, v := range block {
+		other[k] = v
+	}
+	other["token"] = "other-token"
+	out, err = clusterConnectionMeta([]interface{}{other}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.(kubeClientsets).clients == c.clients {
+		t.Error("Expected another connection to get its own clients")
+	}
+}
+
+func TestClusterConnectionMeta_noProviderCredentials(t *testing.T) {
+	meta := testClusterConnectionProviderMeta()
+	block := map[string]interface{}{
+		"host":                   "https://10.0.0.1",
+		"token":                  "",
+		"cluster_ca_certificate": "",
+		"insecure":               true,
+		"exec": []interface{}{
+			map[string]interface{}{
+				"api_version": "client.authentication.k8s.io/v1beta1",
+				"command":     "aws",
+				"args":        []interface{}{"eks", "get-token"},
+				"env":         map[string]interface{}{},
+			},
+		},
+	}
+	out, err := clusterConnectionMeta([]interface{}{block}, meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := out.(kubeClientsets)
+	if c.config.BearerToken != "" {
+		t.Errorf("Expected the token of the provider not to be used, got %q", c.config.BearerToken)
+	}
+	if c.config.ExecProvider == nil || c.config.ExecProvider.Command != "aws" {
+		t.Errorf("Expected the exec plugin of the connection, got %#v", c.config.ExecProvider)
+	}
+}
+
+func TestWithClusterConnection(t *testing.T) {
+	var got interface{}
+	read := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		got = meta
+		return nil
+	}
+	r := &schema.Resource{
+		CreateContext: read,
+		ReadContext:   read,
+		DeleteContext: read,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+	withClusterConnection(r)
+
+	if s := r.Schema["cluster_connection"]; s == nil || !s.ForceNew {
+		t.Fatal("Expected a cluster_connection block which forces a new resource without an update function")
+	}
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatal(err)
+	}
+
+	meta := testClusterConnectionProviderMeta()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name": "test",
+		"cluster_connection": []interface{}{
+			map[string]interface{}{
+				"host":  "https://10.0.0.1",
+				"token": "connection-token",
+			},
+		},
+	})
+	if diags := r.ReadContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if host := got.(kubeClientsets).config.Host; host != "https://10.0.0.1" {
+		t.Errorf("Expected the read function to get the clientsets of the connection, got host %q", host)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "test"})
+	if diags := r.ReadContext(context.TODO(), d, meta); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if host := got.(kubeClientsets).config.Host; host != meta.config.Host {
+		t.Errorf("Expected the read function to get the clientsets of the provider, got host %q", host)
+	}
+}
+
+func TestWithClusterConnection_forceNew(t *testing.T) {
+	noop := func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return nil
+	}
+	r := &schema.Resource{
+		CreateContext: noop,
+		ReadContext:   noop,
+		UpdateContext: noop,
+		DeleteContext: noop,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+	withClusterConnection(r)
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatal(err)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"id":                         "test",
+			"name":                       "test",
+			"cluster_connection.#":       "1",
+			"cluster_connection.0.host":  "https://10.0.0.1",
+			"cluster_connection.0.token": "old-token",
+			"cluster_connection.0.cluster_ca_certificate": "old-ca",
+			"cluster_connection.0.insecure":               "false",
+			"cluster_connection.0.exec.#":                 "0",
+		},
+	}
+	connection := func(host, token, ca string, insecure bool) map[string]interface{} {
+		return map[string]interface{}{
+			"name": "test",
+			"cluster_connection": []interface{}{map[string]interface{}{
+				"host":                   host,
+				"token":                  token,
+				"cluster_ca_certificate": ca,
+				"insecure":               insecure,
+			}},
+		}
+	}
+	withoutConnection := &terraform.InstanceState{
+		ID:         "test",
+		Attributes: map[string]string{"id": "test", "name": "test"},
+	}
+
+	cases := map[string]struct {
+		state       *terraform.InstanceState
+		raw         map[string]interface{}
+		requiresNew bool
+	}{
+		"token rotated":    {state, connection("https://10.0.0.1", "new-token", "old-ca", false), false},
+		"host changed":     {state, connection("https://10.0.0.2", "old-token", "old-ca", false), true},
+		"ca rotated":       {state, connection("https://10.0.0.1", "old-token", "new-ca", false), false},
+		"insecure toggled": {state, connection("https://10.0.0.1", "old-token", "old-ca", true), false},
+		"block removed":    {state, map[string]interface{}{"name": "test"}, true},
+		"block added":      {withoutConnection, connection("https://10.0.0.1", "old-token", "old-ca", false), false},
+		"unchanged":        {state, connection("https://10.0.0.1", "old-token", "old-ca", false), false},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diff, err := r.Diff(context.Background(), tc.state, terraform.NewResourceConfigRaw(tc.raw), testClusterConnectionProviderMeta())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := diff != nil && diff.RequiresNew(); got != tc.requiresNew {
+				t.Errorf("Expected the diff to require a new resource to be %t, got %t", tc.requiresNew, got)
+			}
+		})
+	}
+}
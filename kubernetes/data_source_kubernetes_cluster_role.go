@@ -0,0 +1,149 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesClusterRole() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesClusterRoleRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("clusterRole", false),
+			"rule": {
+				Type:        schema.TypeList,
+				Description: "The effective PolicyRules of this ClusterRole, including the rules aggregated by the controller.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_groups": {
+							Type:        schema.TypeList,
+							Description: "APIGroups is the name of the APIGroup that contains the resources.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"non_resource_urls": {
+							Type:        schema.TypeList,
+							Description: "NonResourceURLs is a set of partial urls that a user should have access to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"resource_names": {
+							Type:        schema.TypeList,
+							Description: "ResourceNames is an optional white list of names that the rule applies to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"resources": {
+							Type:        schema.TypeList,
+							Description: "Resources is a list of resources this rule applies to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"verbs": {
+							Type:        schema.TypeList,
+							Description: "Verbs is a list of Verbs that apply to ALL the ResourceKinds contained in this rule.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"aggregation_rule": {
+				Type:        schema.TypeList,
+				Description: "Describes how the Rules of this ClusterRole are built by the controller.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cluster_role_selectors": {
+							Type:        schema.TypeList,
+							Description: "A list of selectors which are used to find ClusterRoles and create the rules.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"match_expressions": {
+										Type:        schema.TypeList,
+										Description: "A list of label selector requirements. The requirements are ANDed.",
+										Computed:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:        schema.TypeString,
+													Description: "The label key that the selector applies to.",
+													Computed:    true,
+												},
+												"operator": {
+													Type:        schema.TypeString,
+													Description: "A key's relationship to a set of values.",
+													Computed:    true,
+												},
+												"values": {
+													Type:        schema.TypeSet,
+													Description: "An array of string values.",
+													Computed:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+													Set:         schema.HashString,
+												},
+											},
+										},
+									},
+									"match_labels": {
+										Type:        schema.TypeMap,
+										Description: "A map of {key,value} pairs. The requirements are ANDed.",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("metadata.0.name").(string)
+	log.Printf("[INFO] Reading cluster role %s", name)
+	cRole, err := conn.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return diag.Errorf("ClusterRole %q not found", name)
+		}
+		return diag.Errorf("Unable to fetch cluster role from Kubernetes: %s", err)
+	}
+	log.Printf("[INFO] Received cluster role: %#v", cRole)
+
+	d.SetId(cRole.Name)
+
+	err = d.Set("metadata", flattenMetadata(cRole.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("rule", flattenClusterRoleRules(cRole.Rules))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	aggregationRule := []interface{}{}
+	if cRole.AggregationRule != nil {
+		aggregationRule = flattenClusterRoleAggregationRule(cRole.AggregationRule)
+	}
+	err = d.Set("aggregation_rule", aggregationRule)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
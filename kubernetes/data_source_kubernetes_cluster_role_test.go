@@ -0,0 +1,125 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceClusterRole_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	dataSourceName := "data.kubernetes_cluster_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resources. The second apply reads the aggregated role using a data source.
+				Config: testAccKubernetesDataSourceClusterRoleConfig_basic(name),
+			},
+			{
+				Config: testAccKubernetesDataSourceClusterRoleConfig_basic(name) +
+					testAccKubernetesDataSourceClusterRoleConfig_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.0.cluster_role_selectors.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.0.cluster_role_selectors.0.match_labels.rbac.example.com/aggregate-to-audit", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.resources.0", "secrets"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.verbs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rule.0.verbs.0", "get"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceClusterRole_builtIn(t *testing.T) {
+	dataSourceName := "data.kubernetes_cluster_role.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "kubernetes_cluster_role" "test" {
+  metadata {
+    name = "view"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", "view"),
+					resource.TestCheckResourceAttr(dataSourceName, "aggregation_rule.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rule.0.verbs.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceClusterRole_notFound(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`data "kubernetes_cluster_role" "test" {
+  metadata {
+    name = %q
+  }
+}
+`, name),
+				ExpectError: regexp.MustCompile(`not found`),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceClusterRoleConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_role" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  aggregation_rule {
+    cluster_role_selectors {
+      match_labels = {
+        "rbac.example.com/aggregate-to-audit" = "true"
+      }
+    }
+  }
+}
+
+resource "kubernetes_cluster_role" "audit" {
+  metadata {
+    labels = {
+      "rbac.example.com/aggregate-to-audit" = "true"
+    }
+    name = "%[1]s-audit"
+  }
+
+  rule {
+    api_groups = [""]
+    resources  = ["secrets"]
+    verbs      = ["get"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceClusterRoleConfig_read() string {
+	return `data "kubernetes_cluster_role" "test" {
+  metadata {
+    name = "${kubernetes_cluster_role.test.metadata.0.name}"
+  }
+}
+`
+}
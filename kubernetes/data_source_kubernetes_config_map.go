@@ -23,6 +23,11 @@ func dataSourceKubernetesConfigMap() *schema.Resource {
 				Description: "A map of the config map binary data.",
 				Computed:    true,
 			},
+			"immutable": {
+				Type:        schema.TypeBool,
+				Description: "Whether the data stored in the config map cannot be updated.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -0,0 +1,168 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	discovery "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+func dataSourceKubernetesEndpointSlicesV1() *schema.Resource {
+	endpointDoc := discovery.Endpoint{}.SwaggerDoc()
+	conditionsDoc := discovery.EndpointConditions{}.SwaggerDoc()
+	portDoc := discovery.EndpointPort{}.SwaggerDoc()
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesEndpointSlicesV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the service to list the endpoint slices of.",
+				Required:     true,
+				ValidateFunc: validateName,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The namespace of the service.",
+				Optional:    true,
+				Default:     "default",
+			},
+			"include_not_ready": {
+				Type:        schema.TypeBool,
+				Description: "Include endpoints which are not ready, e.g. terminating endpoints while they are drained.",
+				Optional:    true,
+				Default:     false,
+			},
+			"endpoint": {
+				Type:        schema.TypeList,
+				Description: "The endpoints of all the endpoint slices of the service, sorted by address.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"addresses": {
+							Type:        schema.TypeList,
+							Description: endpointDoc["addresses"],
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"address_type": {
+							Type:        schema.TypeString,
+							Description: "The type of the addresses, `IPv4`, `IPv6` or `FQDN`.",
+							Computed:    true,
+						},
+						"ready": {
+							Type:        schema.TypeBool,
+							Description: conditionsDoc["ready"],
+							Computed:    true,
+						},
+						"serving": {
+							Type:        schema.TypeBool,
+							Description: conditionsDoc["serving"],
+							Computed:    true,
+						},
+						"terminating": {
+							Type:        schema.TypeBool,
+							Description: conditionsDoc["terminating"],
+							Computed:    true,
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: endpointDoc["hostname"],
+							Computed:    true,
+						},
+						"node_name": {
+							Type:        schema.TypeString,
+							Description: endpointDoc["nodeName"],
+							Computed:    true,
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Description: endpointDoc["zone"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"port": {
+				Type:        schema.TypeList,
+				Description: "The ports of all the endpoint slices of the service, sorted by name and number.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: portDoc["name"],
+							Computed:    true,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Description: portDoc["port"],
+							Computed:    true,
+						},
+						"protocol": {
+							Type:        schema.TypeString,
+							Description: portDoc["protocol"],
+							Computed:    true,
+						},
+						"app_protocol": {
+							Type:        schema.TypeString,
+							Description: portDoc["appProtocol"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Description: "The addresses of all the returned endpoints, sorted.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesEndpointSlicesV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	om := metav1.ObjectMeta{
+		Namespace: d.Get("namespace").(string),
+		Name:      d.Get("service_name").(string),
+	}
+	selector := labels.Set{discovery.LabelServiceName: om.Name}.String()
+
+	log.Printf("[INFO] Listing endpoint slices of service %s", buildId(om))
+	slices, err := conn.DiscoveryV1().EndpointSlices(om.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector,
+	})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to list endpoint slices of service %s because: %s", buildId(om), err)
+	}
+	log.Printf("[INFO] Received %d endpoint slices", len(slices.Items))
+
+	endpoints, ports, addresses := flattenServiceEndpointSlicesV1(slices.Items, d.Get("include_not_ready").(bool))
+	err = d.Set("endpoint", endpoints)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("port", ports)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("addresses", addresses)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildId(om))
+	return nil
+}
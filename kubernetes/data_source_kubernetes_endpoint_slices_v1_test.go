@@ -0,0 +1,108 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceEndpointSlicesV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.21.0") },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the slices. The second apply reads them using the data source.
+				Config: testAccKubernetesDataSourceEndpointSlicesV1Config_basic(name),
+			},
+			{
+				Config: testAccKubernetesDataSourceEndpointSlicesV1Config_basic(name) +
+					testAccKubernetesDataSourceEndpointSlicesV1Config_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "endpoint.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "endpoint.0.addresses.0", "10.0.0.4"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "endpoint.0.zone", "zone-a"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "endpoint.1.addresses.0", "10.0.0.6"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "endpoint.1.zone", "zone-b"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "addresses.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "port.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "port.0.name", "http"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.ready", "port.0.port", "80"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.all", "endpoint.#", "3"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.all", "endpoint.1.addresses.0", "10.0.0.5"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.all", "endpoint.1.ready", "false"),
+					resource.TestCheckResourceAttr("data.kubernetes_endpoint_slices_v1.all", "endpoint.1.terminating", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceEndpointSlicesV1Config_basic(name string) string {
+	return testAccKubernetesEndpointSliceV1Config_service(name) + fmt.Sprintf(`
+resource "kubernetes_endpoint_slice_v1" "a" {
+  metadata {
+    name = "%s-a"
+    labels = {
+      "kubernetes.io/service-name" = kubernetes_service_v1.test.metadata.0.name
+    }
+  }
+
+  address_type = "IPv4"
+
+  endpoint {
+    addresses = ["10.0.0.4"]
+    zone      = "zone-a"
+  }
+
+  endpoint {
+    addresses = ["10.0.0.5"]
+    condition {
+      ready       = false
+      terminating = true
+    }
+  }
+
+  port {
+    name = "http"
+    port = 80
+  }
+}
+
+resource "kubernetes_endpoint_slice_v1" "b" {
+  metadata {
+    name = "%s-b"
+    labels = {
+      "kubernetes.io/service-name" = kubernetes_service_v1.test.metadata.0.name
+    }
+  }
+
+  address_type = "IPv4"
+
+  endpoint {
+    addresses = ["10.0.0.6"]
+    zone      = "zone-b"
+  }
+
+  port {
+    name = "http"
+    port = 80
+  }
+}
+`, name, name)
+}
+
+func testAccKubernetesDataSourceEndpointSlicesV1Config_read() string {
+	return `data "kubernetes_endpoint_slices_v1" "ready" {
+  service_name = kubernetes_service_v1.test.metadata.0.name
+}
+
+data "kubernetes_endpoint_slices_v1" "all" {
+  service_name      = kubernetes_service_v1.test.metadata.0.name
+  include_not_ready = true
+}
+`
+}
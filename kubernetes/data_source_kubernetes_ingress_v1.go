@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -20,8 +21,17 @@ func dataSourceKubernetesIngressV1() *schema.Resource {
 
 	return &schema.Resource{
 		ReadContext: dataSourceKubernetesIngressV1Read,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("ingress", false),
+			"wait_for_load_balancer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Terraform will wait for the ingress controller to report at least 1 load balancer endpoint in the status before reading the ingress.",
+			},
 			"spec": {
 				Type:        schema.TypeList,
 				Description: docIngress["spec"],
@@ -151,5 +161,16 @@ func dataSourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceDa
 	}
 	d.SetId(buildId(om))
 
+	if d.Get("wait_for_load_balancer").(bool) {
+		conn, err := meta.(KubeClientsets).MainClientset()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		err = waitForIngressV1LoadBalancer(ctx, conn, om, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesIngressV1Read(ctx, d, meta)
 }
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -65,6 +66,30 @@ func TestAccKubernetesDataSourceIngressV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourceIngressV1_waitForLoadBalancerTimeout(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.22.0")
+			skipIfNotRunningInKind(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceIngressV1Config_basic(name),
+			},
+			{
+				// Without an ingress controller the load balancer status is never populated.
+				Config: testAccKubernetesDataSourceIngressV1Config_basic(name) +
+					testAccKubernetesDataSourceIngressV1Config_waitForLoadBalancer(),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Load Balancer of Ingress default/%s is not ready yet", name)),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDataSourceIngressV1_regression(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
@@ -144,6 +169,21 @@ func testAccKubernetesDataSourceIngressV1Config_read() string {
 `)
 }
 
+func testAccKubernetesDataSourceIngressV1Config_waitForLoadBalancer() string {
+	return `data "kubernetes_ingress_v1" "test" {
+  metadata {
+    name      = kubernetes_ingress_v1.test.metadata.0.name
+    namespace = kubernetes_ingress_v1.test.metadata.0.namespace
+  }
+  wait_for_load_balancer = true
+
+  timeouts {
+    read = "10s"
+  }
+}
+`
+}
+
 // Note: this test uses a unique namespace in order to avoid name collisions in AWS.
 // This ensures a unique TargetGroup for each test run.
 func testAccKubernetesDataSourceIngressV1Config_regression(provider, name string) string {
@@ -0,0 +1,110 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// namespacesListPageSize is the number of namespaces requested per page when
+// listing namespaces.
+const namespacesListPageSize = 500
+
+func dataSourceKubernetesNamespaces() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesNamespacesRead,
+		Schema: map[string]*schema.Schema{
+			"label_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned namespaces by their labels.",
+				Optional:    true,
+			},
+			"field_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned namespaces by their fields.",
+				Optional:    true,
+			},
+			"namespaces": {
+				Type:        schema.TypeList,
+				Description: "The list of namespaces matching the selectors, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the namespace.",
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: "Map of string keys and values set on the namespace.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"annotations": {
+							Type:        schema.TypeMap,
+							Description: "An unstructured key value map stored with the namespace.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"phase": {
+							Type:        schema.TypeString,
+							Description: "The current lifecycle phase of the namespace, `Active` or `Terminating`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+		Limit:         namespacesListPageSize,
+	}
+
+	log.Printf("[INFO] Listing namespaces with %#v", opts)
+	var namespaces []api.Namespace
+	for {
+		nsRaw, err := conn.CoreV1().Namespaces().List(ctx, opts)
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return diag.FromErr(err)
+		}
+		namespaces = append(namespaces, nsRaw.Items...)
+		opts.Continue = nsRaw.Continue
+		if opts.Continue == "" {
+			break
+		}
+	}
+	log.Printf("[INFO] Received %d namespaces", len(namespaces))
+
+	err = d.Set("namespaces", flattenNamespaces(namespaces))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idsum := sha256.New()
+	for _, v := range []string{opts.LabelSelector, opts.FieldSelector} {
+		_, err := idsum.Write([]byte(v + "\n"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(fmt.Sprintf("%x", idsum.Sum(nil)))
+	return nil
+}
@@ -0,0 +1,59 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNamespaces_basic(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf-acc-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNamespacesConfig_basic(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.team", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.team", "namespaces.0.name", prefix+"-a"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.team", "namespaces.0.labels.team", prefix),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.team", "namespaces.0.annotations.owner", "a"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.team", "namespaces.0.phase", "Active"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.team", "namespaces.1.name", prefix+"-b"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.by_name", "namespaces.#", "1"),
+					resource.TestCheckResourceAttr("data.kubernetes_namespaces.by_name", "namespaces.0.name", prefix+"-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNamespacesConfig_basic(prefix string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  for_each = toset(["b", "a"])
+
+  metadata {
+    name = "%s-${each.key}"
+    labels = {
+      team = "%s"
+    }
+    annotations = {
+      owner = each.key
+    }
+  }
+}
+
+data "kubernetes_namespaces" "team" {
+  label_selector = "team=%s"
+
+  depends_on = [kubernetes_namespace.test]
+}
+
+data "kubernetes_namespaces" "by_name" {
+  field_selector = "metadata.name=${kubernetes_namespace.test["b"].metadata.0.name}"
+}
+`, prefix, prefix, prefix)
+}
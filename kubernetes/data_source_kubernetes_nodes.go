@@ -0,0 +1,199 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesNodes() *schema.Resource {
+	nodeDoc := api.Node{}.SwaggerDoc()
+	statusDoc := api.NodeStatus{}.SwaggerDoc()
+	conditionDoc := api.NodeCondition{}.SwaggerDoc()
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesNodesRead,
+		Schema: map[string]*schema.Schema{
+			"label_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned nodes by their labels.",
+				Optional:    true,
+			},
+			"field_selector": {
+				Type:        schema.TypeString,
+				Description: "A selector to restrict the list of returned nodes by their fields.",
+				Optional:    true,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Description: "The list of nodes matching the selectors, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": {
+							Type:        schema.TypeList,
+							Description: nodeDoc["metadata"],
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "Name of the node.",
+										Computed:    true,
+									},
+									"uid": {
+										Type:        schema.TypeString,
+										Description: "The unique in time and space value for this node.",
+										Computed:    true,
+									},
+									"resource_version": {
+										Type:        schema.TypeString,
+										Description: "An opaque value that represents the internal version of this node.",
+										Computed:    true,
+									},
+									"labels": {
+										Type:        schema.TypeMap,
+										Description: "Map of string keys and values set on the node.",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+									"annotations": {
+										Type:        schema.TypeMap,
+										Description: "An unstructured key value map stored with the node.",
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"taint": {
+							Type:        schema.TypeList,
+							Description: "The taints of the node.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Description: "The taint key.",
+										Computed:    true,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Description: "The taint value.",
+										Computed:    true,
+									},
+									"effect": {
+										Type:        schema.TypeString,
+										Description: "The taint effect.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"address": {
+							Type:        schema.TypeList,
+							Description: statusDoc["addresses"],
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "The type of the address, such as `InternalIP`, `ExternalIP` or `Hostname`.",
+										Computed:    true,
+									},
+									"address": {
+										Type:        schema.TypeString,
+										Description: "The address.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"allocatable": {
+							Type:        schema.TypeMap,
+							Description: statusDoc["allocatable"],
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"capacity": {
+							Type:        schema.TypeMap,
+							Description: statusDoc["capacity"],
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"condition": {
+							Type:        schema.TypeList,
+							Description: statusDoc["conditions"],
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: conditionDoc["type"],
+										Computed:    true,
+									},
+									"status": {
+										Type:        schema.TypeString,
+										Description: conditionDoc["status"],
+										Computed:    true,
+									},
+									"reason": {
+										Type:        schema.TypeString,
+										Description: conditionDoc["reason"],
+										Computed:    true,
+									},
+									"message": {
+										Type:        schema.TypeString,
+										Description: conditionDoc["message"],
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesNodesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	opts := metav1.ListOptions{
+		LabelSelector: d.Get("label_selector").(string),
+		FieldSelector: d.Get("field_selector").(string),
+	}
+
+	log.Printf("[INFO] Listing nodes with %#v", opts)
+	nodes, err := conn.CoreV1().Nodes().List(ctx, opts)
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received %d nodes", len(nodes.Items))
+
+	err = d.Set("nodes", flattenNodes(nodes.Items))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	idsum := sha256.New()
+	for _, v := range []string{opts.LabelSelector, opts.FieldSelector} {
+		_, err := idsum.Write([]byte(v + "\n"))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(fmt.Sprintf("%x", idsum.Sum(nil)))
+	return nil
+}
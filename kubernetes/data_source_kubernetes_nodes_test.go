@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceNodes_basic(t *testing.T) {
+	rxPosNum := regexp.MustCompile("^[1-9][0-9]*$")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceNodesConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.kubernetes_nodes.all", "nodes.#", rxPosNum),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.all", "nodes.0.metadata.0.name"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.all", "nodes.0.metadata.0.uid"),
+					resource.TestMatchResourceAttr("data.kubernetes_nodes.all", "nodes.0.address.#", rxPosNum),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.all", "nodes.0.capacity.cpu"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_nodes.all", "nodes.0.allocatable.memory"),
+					resource.TestMatchResourceAttr("data.kubernetes_nodes.all", "nodes.0.condition.#", rxPosNum),
+					resource.TestCheckResourceAttr("data.kubernetes_nodes.by_label", "nodes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.kubernetes_nodes.by_label", "nodes.0.metadata.0.name", "data.kubernetes_nodes.all", "nodes.0.metadata.0.name"),
+					resource.TestCheckResourceAttr("data.kubernetes_nodes.by_field", "nodes.#", "1"),
+					resource.TestCheckResourceAttrPair("data.kubernetes_nodes.by_field", "nodes.0.metadata.0.name", "data.kubernetes_nodes.all", "nodes.0.metadata.0.name"),
+					resource.TestCheckResourceAttr("data.kubernetes_nodes.none", "nodes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceNodesConfig_basic() string {
+	return `
+data "kubernetes_nodes" "all" {}
+
+data "kubernetes_nodes" "by_label" {
+  label_selector = "kubernetes.io/hostname=${data.kubernetes_nodes.all.nodes.0.metadata.0.labels["kubernetes.io/hostname"]}"
+}
+
+data "kubernetes_nodes" "by_field" {
+  field_selector = "metadata.name=${data.kubernetes_nodes.all.nodes.0.metadata.0.name}"
+}
+
+data "kubernetes_nodes" "none" {
+  label_selector = "terraform-provider-kubernetes/no-such-label=true"
+}
+`
+}
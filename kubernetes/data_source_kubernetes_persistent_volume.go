@@ -0,0 +1,179 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesPersistentVolume() *schema.Resource {
+	statusDoc := api.PersistentVolumeStatus{}.SwaggerDoc()
+
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesPersistentVolumeRead,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("persistent volume", false),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec of the persistent volume owned by the cluster",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_modes": {
+							Type:        schema.TypeSet,
+							Description: "Contains all ways the volume can be mounted. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#access-modes",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+						},
+						"capacity": {
+							Type:        schema.TypeMap,
+							Description: "A description of the persistent volume's resources and capacity. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#capacity",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"persistent_volume_reclaim_policy": {
+							Type:        schema.TypeString,
+							Description: "What happens to a persistent volume when released from its claim. More info: http://kubernetes.io/docs/user-guide/persistent-volumes#recycling-policy",
+							Computed:    true,
+						},
+						"claim_ref": {
+							Type:        schema.TypeList,
+							Description: "A reference to the persistent volume claim bound to the persistent volume. More Info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#binding",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "The namespace of the PersistentVolumeClaim.",
+										Computed:    true,
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the PersistentVolumeClaim",
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"persistent_volume_source": {
+							Type:        schema.TypeList,
+							Description: "The specification of a persistent volume.",
+							Computed:    true,
+							Elem:        persistentVolumeSourceSchema(),
+						},
+						"storage_class_name": {
+							Type:        schema.TypeString,
+							Description: "A description of the persistent volume's class. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#class",
+							Computed:    true,
+						},
+						"node_affinity": {
+							Type:        schema.TypeList,
+							Description: "A description of the persistent volume's node affinity. More info: https://kubernetes.io/docs/concepts/storage/volumes/#local",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"required": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"node_selector_term": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: nodeSelectorTermFields(),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"mount_options": {
+							Type:        schema.TypeSet,
+							Description: "A list of mount options, e.g. [\"ro\", \"soft\"].",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+						},
+						"volume_mode": {
+							Type:        schema.TypeString,
+							Description: "Defines if a volume is intended to be used with a formatted filesystem. or to remain in raw block state.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The current status of the persistent volume.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phase": {
+							Type:        schema.TypeString,
+							Description: statusDoc["phase"],
+							Computed:    true,
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Description: statusDoc["reason"],
+							Computed:    true,
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Description: statusDoc["message"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesPersistentVolumeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("metadata.0.name").(string)
+	d.SetId(name)
+
+	log.Printf("[INFO] Reading persistent volume %s", name)
+	volume, err := conn.CoreV1().PersistentVolumes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Persistent volume %s not found", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received persistent volume: %#v", volume)
+
+	err = d.Set("metadata", flattenMetadata(volume.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenPersistentVolumeSpec(volume.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenPersistentVolumeStatus(volume.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package kubernetes
 
 import (
 	"context"
+	"log"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -70,6 +73,49 @@ func dataSourceKubernetesPersistentVolumeClaim() *schema.Resource {
 							Optional:    true,
 							Computed:    true,
 						},
+						"data_source": {
+							Type:        schema.TypeList,
+							Description: "The source the volume was populated from.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: persistentVolumeClaimDataSourceFields(false),
+							},
+						},
+						"data_source_ref": {
+							Type:        schema.TypeList,
+							Description: "The object the volume was populated from.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: persistentVolumeClaimDataSourceFields(true),
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The current status of the persistent volume claim.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"phase": {
+							Type:        schema.TypeString,
+							Description: "The current phase of the persistent volume claim, `Pending`, `Bound` or `Lost`.",
+							Computed:    true,
+						},
+						"access_modes": {
+							Type:        schema.TypeSet,
+							Description: "The access modes of the volume backing the claim.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+						},
+						"capacity": {
+							Type:        schema.TypeMap,
+							Description: "The actual resources of the volume backing the claim.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
 					},
 				},
 			},
@@ -86,5 +132,36 @@ func dataSourceKubernetesPersistentVolumeClaimRead(ctx context.Context, d *schem
 	}
 	d.SetId(buildId(om))
 
-	return resourceKubernetesPersistentVolumeClaimRead(ctx, d, meta)
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading persistent volume claim %s", buildId(om))
+	claim, err := conn.CoreV1().PersistentVolumeClaims(om.Namespace).Get(ctx, om.Name, meta_v1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Persistent volume claim %s not found", buildId(om))
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
+
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenPersistentVolumeClaimSpec(claim.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenPersistentVolumeClaimStatus(claim.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
 }
@@ -43,6 +43,8 @@ func TestAccKubernetesDataSourcePVC_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "spec.0.resources.#", "1"),
 					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.%", "1"),
 					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "5Gi"),
+					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "status.0.phase", "Pending"),
+					resource.TestCheckResourceAttr("data.kubernetes_persistent_volume_claim.test", "status.0.capacity.%", "0"),
 				),
 			},
 		},
@@ -0,0 +1,80 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourcePersistentVolume_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	dataSourceName := "data.kubernetes_persistent_volume_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{ // The first apply creates the resource. The second apply reads the resource using the data source.
+				Config: testAccKubernetesDataSourcePersistentVolumeConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_v1.test", "metadata.0.name", name),
+				),
+			},
+			{
+				Config: testAccKubernetesDataSourcePersistentVolumeConfig_basic(name) +
+					testAccKubernetesDataSourcePersistentVolumeConfig_read(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.labels.TestLabelOne", "one"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.capacity.storage", "1Gi"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.access_modes.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.persistent_volume_reclaim_policy", "Retain"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.storage_class_name", "standard"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.volume_mode", "Filesystem"),
+					resource.TestCheckResourceAttr(dataSourceName, "spec.0.persistent_volume_source.0.host_path.0.path", "/custom/testing/path"),
+					resource.TestCheckResourceAttr(dataSourceName, "status.0.phase", "Available"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourcePersistentVolumeConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_v1" "test" {
+  metadata {
+    name = "%s"
+    labels = {
+      TestLabelOne = "one"
+    }
+  }
+
+  spec {
+    capacity = {
+      storage = "1024Mi"
+    }
+
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = "standard"
+
+    persistent_volume_source {
+      host_path {
+        path = "/custom/testing/path"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourcePersistentVolumeConfig_read() string {
+	return `data "kubernetes_persistent_volume_v1" "test" {
+  metadata {
+    name = kubernetes_persistent_volume_v1.test.metadata.0.name
+  }
+}
+`
+}
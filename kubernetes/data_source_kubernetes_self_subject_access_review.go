@@ -0,0 +1,45 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesSelfSubjectAccessReview() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesSelfSubjectAccessReviewRead,
+		Schema:      accessReviewSchema(),
+	}
+}
+
+func dataSourceKubernetesSelfSubjectAccessReviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	review := &authv1.SelfSubjectAccessReview{
+		Spec: authv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes:    expandResourceAttributes(d.Get("resource_attributes").([]interface{})),
+			NonResourceAttributes: expandNonResourceAttributes(d.Get("non_resource_attributes").([]interface{})),
+		},
+	}
+	log.Printf("[INFO] Creating new self subject access review: %#v", review.Spec)
+	out, err := conn.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create self subject access review: %s", err)
+	}
+	log.Printf("[INFO] Received self subject access review status: %#v", out.Status)
+
+	id, err := accessReviewId(review.Spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	return setAccessReviewStatus(d, out.Status)
+}
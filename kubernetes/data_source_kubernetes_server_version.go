@@ -0,0 +1,73 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceKubernetesServerVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesServerVersionRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Description: "The version of the API server without the `v` prefix and any pre-release or build suffix, e.g. `1.27.3`.",
+				Computed:    true,
+			},
+			"git_version": {
+				Type:        schema.TypeString,
+				Description: "The full version reported by the API server, e.g. `v1.27.3-eks-a5565ad`.",
+				Computed:    true,
+			},
+			"major": {
+				Type:        schema.TypeString,
+				Description: "The major version reported by the API server.",
+				Computed:    true,
+			},
+			"minor": {
+				Type:        schema.TypeString,
+				Description: "The minor version reported by the API server. Managed clusters may add a suffix, e.g. `27+`.",
+				Computed:    true,
+			},
+			"git_commit": {
+				Type:        schema.TypeString,
+				Description: "The commit the API server was built from.",
+				Computed:    true,
+			},
+			"build_date": {
+				Type:        schema.TypeString,
+				Description: "The date the API server was built.",
+				Computed:    true,
+			},
+			"platform": {
+				Type:        schema.TypeString,
+				Description: "The platform of the API server, e.g. `linux/amd64`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceKubernetesServerVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	info, err := meta.(KubeClientsets).ServerVersionInfo()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	v, err := meta.(KubeClientsets).ServerVersion()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(info.GitVersion)
+	d.Set("version", v.Core().String())
+	d.Set("git_version", info.GitVersion)
+	d.Set("major", info.Major)
+	d.Set("minor", info.Minor)
+	d.Set("git_commit", info.GitCommit)
+	d.Set("build_date", info.BuildDate)
+	d.Set("platform", info.Platform)
+
+	return nil
+}
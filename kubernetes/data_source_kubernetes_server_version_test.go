@@ -0,0 +1,54 @@
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceServerVersion_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServerVersionConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.kubernetes_server_version.test", "version", regexp.MustCompile(`^1\.\d+\.\d+$`)),
+					resource.TestMatchResourceAttr("data.kubernetes_server_version.test", "git_version", regexp.MustCompile(`^v1\.\d+\.\d+`)),
+					resource.TestCheckResourceAttr("data.kubernetes_server_version.test", "major", "1"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_version.test", "minor"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_server_version.test", "platform"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceServerVersion_expectVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourceServerVersionConfig_expectVersion(),
+				ExpectError: regexp.MustCompile(`Cluster reports v1\.\d+\.\d+\S* which does not satisfy < 1\.0`),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceServerVersionConfig_basic() string {
+	return `data "kubernetes_server_version" "test" {}
+`
+}
+
+func testAccKubernetesDataSourceServerVersionConfig_expectVersion() string {
+	return `provider "kubernetes" {
+  expect_kubernetes_version = "< 1.0"
+}
+
+data "kubernetes_all_namespaces" "test" {}
+`
+}
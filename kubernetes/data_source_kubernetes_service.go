@@ -20,6 +20,11 @@ func dataSourceKubernetesService() *schema.Resource {
 				Computed:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"allocate_load_balancer_node_ports": {
+							Type:        schema.TypeBool,
+							Description: "Defines if `NodePorts` will be automatically allocated for services with type `LoadBalancer`. It may be set to `false` if the cluster load-balancer does not rely on `NodePorts`. If the caller requests specific `NodePorts` (by specifying a value), those requests will be respected, regardless of this field. This field may only be set for services with type `LoadBalancer`. Default is `true`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-nodeport-allocation",
+							Computed:    true,
+						},
 						"cluster_ip": {
 							Type:        schema.TypeString,
 							Description: "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required. Ignored if type is `ExternalName`. More info: http://kubernetes.io/docs/user-guide/services#virtual-ips-and-service-proxies",
@@ -44,6 +49,27 @@ func dataSourceKubernetesService() *schema.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice([]string{"Local", "Cluster"}, false),
 						},
+						"internal_traffic_policy": {
+							Type:        schema.TypeString,
+							Description: "Specifies if the cluster internal traffic should be routed to all endpoints or node-local endpoints only. `Cluster` routes internal traffic to a Service to all endpoints. `Local` routes traffic to node-local endpoints only, traffic is dropped if no node-local endpoints are ready. The default value is `Cluster`. More info: https://kubernetes.io/docs/concepts/services-networking/service-traffic-policy/",
+							Computed:    true,
+						},
+						"ip_families": {
+							Type:        schema.TypeList,
+							Description: "IPFamilies is a list of IP families (e.g. `IPv4`, `IPv6`) assigned to this service. This field is usually assigned automatically based on cluster configuration and the `ip_family_policy` field. If this field is specified manually, the requested family is available in the cluster, and `ip_family_policy` allows it, it will be used; otherwise creation of the service will fail. This field is conditionally mutable: it allows for adding or removing a secondary IP family, but it does not allow changing the primary IP family of the service. More info: https://kubernetes.io/docs/concepts/services-networking/dual-stack/",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+						},
+						"ip_family_policy": {
+							Type:        schema.TypeString,
+							Description: "IPFamilyPolicy represents the dual-stack-ness requested or required by this Service. If there is no value provided, then this field will be set to `SingleStack`. Services can be `SingleStack` (a single IP family), `PreferDualStack` (two IP families on dual-stack configured clusters or a single IP family on single-stack clusters), or `RequireDualStack` (two IP families on dual-stack configured clusters, otherwise fail). More info: https://kubernetes.io/docs/concepts/services-networking/dual-stack/",
+							Computed:    true,
+						},
+						"load_balancer_class": {
+							Type:        schema.TypeString,
+							Description: "The class of the load balancer implementation this Service belongs to. If specified, the value of this field must be a label-style identifier, with an optional prefix. This field can only be set when the Service type is `LoadBalancer`. If not set, the default load balancer implementation is used. This field can only be set when creating or updating a Service to type `LoadBalancer`. Once set, it can not be changed. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-class",
+							Computed:    true,
+						},
 						"load_balancer_ip": {
 							Type:        schema.TypeString,
 							Description: "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -62,6 +88,11 @@ func dataSourceKubernetesService() *schema.Resource {
 							Computed:    true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
+									"app_protocol": {
+										Type:        schema.TypeString,
+										Description: "The application protocol for this port. This is used as a hint for implementations to offer richer behavior for protocols that they understand. This field follows standard Kubernetes label syntax. Valid values are either un-prefixed protocol names (e.g. `http`, `h2c`) or domain prefixed names such as `mycompany.com/my-custom-protocol`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#application-protocol",
+										Computed:    true,
+									},
 									"name": {
 										Type:        schema.TypeString,
 										Description: "The name of this port within the service. All ports within the service must have unique names. Optional if only one ServicePort is defined on this service.",
@@ -142,6 +173,26 @@ func dataSourceKubernetesService() *schema.Resource {
 													Type:     schema.TypeString,
 													Computed: true,
 												},
+												"ports": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"port": {
+																Type:     schema.TypeInt,
+																Computed: true,
+															},
+															"protocol": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+															"error": {
+																Type:     schema.TypeString,
+																Computed: true,
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -2,9 +2,14 @@ package kubernetes
 
 import (
 	"context"
+	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -48,8 +53,39 @@ func dataSourceKubernetesServiceAccount() *schema.Resource {
 				Computed:    true,
 			},
 			"default_secret_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "Name of the token secret generated for the service account. Always empty on Kubernetes 1.24 and later, which no longer generate token secrets.",
+				Computed:    true,
+				Deprecated:  "Kubernetes 1.24 and later no longer generate token secrets for service accounts. Use the kubernetes_service_account_token resource to request a token instead.",
+			},
+			"fetch_token": {
+				Type:        schema.TypeBool,
+				Description: "Request a short-lived token for the service account through the TokenRequest API. A new token is requested every time the data source is read.",
+				Optional:    true,
+				Default:     false,
+			},
+			"token_audiences": {
+				Type:        schema.TypeList,
+				Description: "The intended audiences of the requested token. When omitted the audiences of the API server are used. Only used when `fetch_token` is true.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"token_expiration_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "The requested duration of validity of the token. The API server may return a token with a shorter validity. When omitted the API server default of one hour is used. Only used when `fetch_token` is true.",
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(600),
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "The requested service account token. Empty unless `fetch_token` is true.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_expiration": {
+				Type:        schema.TypeString,
+				Description: "The time the requested token expires, in RFC3339 format. Empty unless `fetch_token` is true.",
+				Computed:    true,
 			},
 		},
 	}
@@ -64,16 +100,49 @@ func dataSourceKubernetesServiceAccountRead(ctx context.Context, d *schema.Resou
 	metadata := expandMetadata(d.Get("metadata").([]interface{}))
 	sa, err := conn.CoreV1().ServiceAccounts(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
 	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return diag.Errorf("Service account %q not found in namespace %q", metadata.Name, metadata.Namespace)
+		}
 		return diag.Errorf("Unable to fetch service account from Kubernetes: %s", err)
 	}
 
-	defaultSecret, diagMsg := findDefaultServiceAccount(ctx, sa, conn)
+	v, err := meta.(KubeClientsets).ServerVersion()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var defaultSecret string
+	var diagMsg diag.Diagnostics
+	if serviceAccountTokenSecretsAutoGenerated(v) {
+		defaultSecret, diagMsg = findDefaultServiceAccount(ctx, sa, conn)
+	}
 
 	err = d.Set("default_secret_name", defaultSecret)
 	if err != nil {
 		return diag.Errorf("Unable to set default_secret_name: %s", err)
 	}
 
+	var token, tokenExpiration string
+	if d.Get("fetch_token").(bool) {
+		req := &authv1.TokenRequest{
+			Spec: authv1.TokenRequestSpec{
+				Audiences: expandStringSlice(d.Get("token_audiences").([]interface{})),
+			},
+		}
+		if v, ok := d.GetOk("token_expiration_seconds"); ok {
+			req.Spec.ExpirationSeconds = ptrToInt64(int64(v.(int)))
+		}
+		log.Printf("[INFO] Requesting token for service account %s/%s", sa.Namespace, sa.Name)
+		out, err := conn.CoreV1().ServiceAccounts(sa.Namespace).CreateToken(ctx, sa.Name, req, metav1.CreateOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to request token for service account %s/%s: %s", sa.Namespace, sa.Name, err)
+		}
+		log.Printf("[INFO] Received token for service account %s/%s expiring at %s", sa.Namespace, sa.Name, out.Status.ExpirationTimestamp)
+		token = out.Status.Token
+		tokenExpiration = out.Status.ExpirationTimestamp.UTC().Format(time.RFC3339)
+	}
+	d.Set("token", token)
+	d.Set("token_expiration", tokenExpiration)
+
 	d.SetId(buildId(sa.ObjectMeta))
 
 	diagMsg = append(diagMsg, resourceKubernetesServiceAccountRead(ctx, d, meta)...)
@@ -2,7 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
-	// "regexp"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,7 +13,10 @@ func TestAccKubernetesDataSourceServiceAccount_basic(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -49,7 +52,10 @@ func TestAccKubernetesDataSourceServiceAccount_default_secret(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		ProviderFactories: testAccProviderFactories,
 		Steps: []resource.TestStep{
 			{
@@ -72,6 +78,46 @@ func TestAccKubernetesDataSourceServiceAccount_default_secret(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDataSourceServiceAccount_fetchToken(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	dataSourceName := "data.kubernetes_service_account_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceServiceAccountConfig_fetchToken(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token_expiration"),
+					resource.TestCheckResourceAttr("data.kubernetes_service_account_v1.no_token", "token", ""),
+					resource.TestCheckResourceAttr("data.kubernetes_service_account_v1.no_token", "token_expiration", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceServiceAccount_notFound(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourceServiceAccountConfig_default_secret_read(name),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`Service account "%s" not found in namespace "default"`, name)),
+			},
+		},
+	})
+}
+
 func testAccKubernetesDataSourceServiceAccountConfig_basic(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_service_account" "test" {
   metadata {
@@ -159,3 +205,26 @@ data "kubernetes_service_account" "test" {
 }
 `, name)
 }
+
+func testAccKubernetesDataSourceServiceAccountConfig_fetchToken(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_account_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+data "kubernetes_service_account_v1" "test" {
+  metadata {
+    name = kubernetes_service_account_v1.test.metadata.0.name
+  }
+  fetch_token              = true
+  token_expiration_seconds = 600
+}
+
+data "kubernetes_service_account_v1" "no_token" {
+  metadata {
+    name = kubernetes_service_account_v1.test.metadata.0.name
+  }
+}
+`, name)
+}
@@ -50,9 +50,8 @@ func dataSourceKubernetesStorageClass() *schema.Resource {
 			},
 			"allowed_topologies": {
 				Type:        schema.TypeList,
-				Description: "Restrict the node topologies where volumes can be dynamically provisioned.",
+				Description: "Restrict the node topologies where volumes can be dynamically provisioned. Each block is a topology selector term, the terms are ORed.",
 				Optional:    true,
-				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"match_label_expressions": {
@@ -0,0 +1,223 @@
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	authv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func dataSourceKubernetesSubjectAccessReview() *schema.Resource {
+	specDoc := authv1.SubjectAccessReviewSpec{}.SwaggerDoc()
+
+	s := accessReviewSchema()
+	s["user"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  specDoc["user"] + " Service accounts are named `system:serviceaccount:<namespace>:<name>`.",
+		Optional:     true,
+		AtLeastOneOf: []string{"user", "groups"},
+	}
+	s["groups"] = &schema.Schema{
+		Type:         schema.TypeList,
+		Description:  specDoc["groups"],
+		Optional:     true,
+		Elem:         &schema.Schema{Type: schema.TypeString},
+		AtLeastOneOf: []string{"user", "groups"},
+	}
+	s["uid"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: specDoc["uid"],
+		Optional:    true,
+	}
+	return &schema.Resource{
+		ReadContext: dataSourceKubernetesSubjectAccessReviewRead,
+		Schema:      s,
+	}
+}
+
+// accessReviewSchema returns the attributes shared by the subject access
+// review data sources: the action to check and the decision of the API server.
+func accessReviewSchema() map[string]*schema.Schema {
+	resourceDoc := authv1.ResourceAttributes{}.SwaggerDoc()
+	nonResourceDoc := authv1.NonResourceAttributes{}.SwaggerDoc()
+	statusDoc := authv1.SubjectAccessReviewStatus{}.SwaggerDoc()
+
+	return map[string]*schema.Schema{
+		"resource_attributes": {
+			Type:         schema.TypeList,
+			Description:  "The request to check against a resource of the API.",
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"resource_attributes", "non_resource_attributes"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"verb": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["verb"],
+						Required:    true,
+					},
+					"group": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["group"],
+						Optional:    true,
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["version"],
+						Optional:    true,
+					},
+					"resource": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["resource"],
+						Optional:    true,
+					},
+					"subresource": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["subresource"],
+						Optional:    true,
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["namespace"],
+						Optional:    true,
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Description: resourceDoc["name"],
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"non_resource_attributes": {
+			Type:         schema.TypeList,
+			Description:  "The request to check against a path of the API server which is not a resource, such as `/healthz`.",
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"resource_attributes", "non_resource_attributes"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path": {
+						Type:        schema.TypeString,
+						Description: nonResourceDoc["path"],
+						Required:    true,
+					},
+					"verb": {
+						Type:        schema.TypeString,
+						Description: nonResourceDoc["verb"],
+						Required:    true,
+					},
+				},
+			},
+		},
+		"allowed": {
+			Type:        schema.TypeBool,
+			Description: statusDoc["allowed"],
+			Computed:    true,
+		},
+		"denied": {
+			Type:        schema.TypeBool,
+			Description: statusDoc["denied"],
+			Computed:    true,
+		},
+		"reason": {
+			Type:        schema.TypeString,
+			Description: statusDoc["reason"],
+			Computed:    true,
+		},
+		"evaluation_error": {
+			Type:        schema.TypeString,
+			Description: statusDoc["evaluationError"],
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceKubernetesSubjectAccessReviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	review := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			ResourceAttributes:    expandResourceAttributes(d.Get("resource_attributes").([]interface{})),
+			NonResourceAttributes: expandNonResourceAttributes(d.Get("non_resource_attributes").([]interface{})),
+			User:                  d.Get("user").(string),
+			Groups:                expandStringSlice(d.Get("groups").([]interface{})),
+			UID:                   d.Get("uid").(string),
+		},
+	}
+	log.Printf("[INFO] Creating new subject access review: %#v", review.Spec)
+	out, err := conn.AuthorizationV1().SubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create subject access review: %s", err)
+	}
+	log.Printf("[INFO] Received subject access review status: %#v", out.Status)
+
+	id, err := accessReviewId(review.Spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+	return setAccessReviewStatus(d, out.Status)
+}
+
+// accessReviewId returns a hash of the spec of the review, so the ID changes
+// with the question asked.
+func accessReviewId(spec interface{}) (string, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(raw)), nil
+}
+
+func setAccessReviewStatus(d *schema.ResourceData, status authv1.SubjectAccessReviewStatus) diag.Diagnostics {
+	if err := d.Set("allowed", status.Allowed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("denied", status.Denied); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("reason", status.Reason); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("evaluation_error", status.EvaluationError); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandResourceAttributes(l []interface{}) *authv1.ResourceAttributes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	return &authv1.ResourceAttributes{
+		Verb:        in["verb"].(string),
+		Group:       in["group"].(string),
+		Version:     in["version"].(string),
+		Resource:    in["resource"].(string),
+		Subresource: in["subresource"].(string),
+		Namespace:   in["namespace"].(string),
+		Name:        in["name"].(string),
+	}
+}
+
+func expandNonResourceAttributes(l []interface{}) *authv1.NonResourceAttributes {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	return &authv1.NonResourceAttributes{
+		Path: in["path"].(string),
+		Verb: in["verb"].(string),
+	}
+}
@@ -0,0 +1,142 @@
+package kubernetes
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccKubernetesDataSourceSubjectAccessReview_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceSubjectAccessReviewConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_subject_access_review.allowed", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.kubernetes_subject_access_review.allowed", "denied", "false"),
+					resource.TestCheckResourceAttr("data.kubernetes_subject_access_review.not_allowed", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.kubernetes_subject_access_review.not_allowed", "evaluation_error", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceSubjectAccessReview_invalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesDataSourceSubjectAccessReviewConfig_noSubject(),
+				ExpectError: regexp.MustCompile(`one of .groups,user. must be specified`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDataSourceSelfSubjectAccessReview_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDataSourceSelfSubjectAccessReviewConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_self_subject_access_review.test", "allowed", "true"),
+					resource.TestCheckResourceAttrSet("data.kubernetes_self_subject_access_review.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDataSourceSubjectAccessReviewConfig_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+}
+
+resource "kubernetes_service_account_v1" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = kubernetes_namespace_v1.test.metadata.0.name
+  }
+}
+
+resource "kubernetes_role_v1" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = kubernetes_namespace_v1.test.metadata.0.name
+  }
+  rule {
+    api_groups = [""]
+    resources  = ["configmaps"]
+    verbs      = ["get", "list"]
+  }
+}
+
+resource "kubernetes_role_binding_v1" "test" {
+  metadata {
+    name      = "%[1]s"
+    namespace = kubernetes_namespace_v1.test.metadata.0.name
+  }
+  role_ref {
+    api_group = "rbac.authorization.k8s.io"
+    kind      = "Role"
+    name      = kubernetes_role_v1.test.metadata.0.name
+  }
+  subject {
+    kind      = "ServiceAccount"
+    name      = kubernetes_service_account_v1.test.metadata.0.name
+    namespace = kubernetes_namespace_v1.test.metadata.0.name
+  }
+}
+
+data "kubernetes_subject_access_review" "allowed" {
+  user = "system:serviceaccount:${kubernetes_namespace_v1.test.metadata.0.name}:${kubernetes_service_account_v1.test.metadata.0.name}"
+  resource_attributes {
+    verb      = "list"
+    resource  = "configmaps"
+    namespace = kubernetes_role_binding_v1.test.metadata.0.namespace
+  }
+}
+
+data "kubernetes_subject_access_review" "not_allowed" {
+  user = "system:serviceaccount:${kubernetes_namespace_v1.test.metadata.0.name}:${kubernetes_service_account_v1.test.metadata.0.name}"
+  resource_attributes {
+    verb      = "get"
+    resource  = "secrets"
+    namespace = kubernetes_role_binding_v1.test.metadata.0.namespace
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDataSourceSubjectAccessReviewConfig_noSubject() string {
+	return `data "kubernetes_subject_access_review" "test" {
+  non_resource_attributes {
+    path = "/healthz"
+    verb = "get"
+  }
+}
+`
+}
+
+func testAccKubernetesDataSourceSelfSubjectAccessReviewConfig_basic() string {
+	return `data "kubernetes_self_subject_access_review" "test" {
+  resource_attributes {
+    verb     = "list"
+    resource = "namespaces"
+  }
+}
+`
+}
@@ -1,18 +1,204 @@
 package kubernetes
 
 import (
+	"reflect"
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+// suppressEquivalentResourceQuantity hides the difference between quantities
+// the API server stores in canonical form, such as "1024Mi" and "1Gi" or
+// "0.5" and "500m".
 func suppressEquivalentResourceQuantity(k, old, new string, d *schema.ResourceData) bool {
-	oldQ, err := resource.ParseQuantity(old)
+	return equivalentResourceQuantities(old, new)
+}
+
+// equivalentResourceQuantities reports whether both values describe the same
+// quantity. An empty value is only equivalent to another empty value, so
+// adding or removing a quantity is never hidden.
+func equivalentResourceQuantities(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if a == "" || b == "" {
+		return false
+	}
+	aQ, err := resource.ParseQuantity(a)
 	if err != nil {
 		return false
 	}
-	newQ, err := resource.ParseQuantity(new)
+	bQ, err := resource.ParseQuantity(b)
+	if err != nil {
+		return false
+	}
+	return aQ.Cmp(bQ) == 0
+}
+
+// suppressEquivalentIntOrString hides the difference between int-or-string
+// values which describe the same amount, such as "05" and "5" or "0%" and "0".
+func suppressEquivalentIntOrString(k, old, new string, d *schema.ResourceData) bool {
+	return equivalentIntOrStrings(old, new)
+}
+
+// equivalentIntOrStrings reports whether both values describe the same number
+// or percentage. As with quantities, an empty value is only equivalent to
+// another empty value.
+func equivalentIntOrStrings(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if a == "" || b == "" {
+		return false
+	}
+	return canonicalIntOrString(a) == canonicalIntOrString(b)
+}
+
+func canonicalIntOrString(v string) string {
+	if p := strings.TrimSuffix(v, "%"); p != v {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return v
+		}
+		// zero percent of any number of pods is zero pods
+		if n == 0 {
+			return "0"
+		}
+		return strconv.Itoa(n) + "%"
+	}
+	n, err := strconv.Atoi(v)
 	if err != nil {
+		return v
+	}
+	return strconv.Itoa(n)
+}
+
+// suppressRollingUpdateForRecreate hides the rolling update parameters of a
+// deployment using the Recreate strategy, which the API server drops.
+func suppressRollingUpdateForRecreate(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.Index(k, "rolling_update")]
+	return d.Get(prefix+"type").(string) == "Recreate"
+}
+
+// suppressReorderedContainerEnv hides a change of the order of the environment
+// variables of a container when the variables themselves are unchanged. The
+// order of the configuration is still sent on other changes, see
+// orderContainerEnvAsConfigured. As the order matters for $(VAR_NAME)
+// references, a change of the order of variables using them is not hidden.
+func suppressReorderedContainerEnv(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	i := strings.LastIndex(k, "env.")
+	if i < 0 {
 		return false
 	}
-	return oldQ.Cmp(newQ) == 0
+	o, n := d.GetChange(k[:i+len("env")])
+	ol, nl := o.([]interface{}), n.([]interface{})
+	if referencesEnvVar(ol) || referencesEnvVar(nl) {
+		return false
+	}
+	return equivalentUnorderedLists(ol, nl)
+}
+
+// referencesEnvVar reports whether the value of any of the environment
+// variables contains a $(VAR_NAME) reference.
+func referencesEnvVar(env []interface{}) bool {
+	for _, e := range env {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := m["value"].(string); ok && strings.Contains(v, "$(") {
+			return true
+		}
+	}
+	return false
+}
+
+// equivalentUnorderedLists reports whether both lists have the same elements,
+// regardless of their order.
+func equivalentUnorderedLists(a, b []interface{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	matched := make([]bool, len(b))
+	for _, av := range a {
+		found := false
+		for j, bv := range b {
+			if !matched[j] && reflect.DeepEqual(av, bv) {
+				matched[j] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// suppressDroppedInitContainerRestartPolicy hides the restart policy of an
+// existing init container when the cluster predates native sidecars and
+// dropped the field.
+func suppressDroppedInitContainerRestartPolicy(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" || old != "" {
+		return false
+	}
+	prefix := strings.TrimSuffix(k, "restart_policy")
+	o, n := d.GetChange(prefix + "name")
+	return o.(string) != "" && o.(string) == n.(string)
+}
+
+// suppressServerAddedIPFamily hides the secondary IP family the API server
+// adds to a `PreferDualStack` or `RequireDualStack` service when only the
+// primary family was configured.
+func suppressServerAddedIPFamily(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	key := k[:strings.LastIndex(k, ".")]
+	o, n := d.GetChange(key)
+	oldFamilies := o.([]interface{})
+	newFamilies := n.([]interface{})
+	if len(newFamilies) == 0 || len(newFamilies) >= len(oldFamilies) {
+		return false
+	}
+	for i, f := range newFamilies {
+		if oldFamilies[i] != f {
+			return false
+		}
+	}
+	return true
+}
+
+// suppressInferredNetworkPolicyTypes hides the policy types the API server
+// infers for a network policy when none were configured.
+func suppressInferredNetworkPolicyTypes(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	key := k[:strings.LastIndex(k, ".")]
+	o, n := d.GetChange(key)
+	if len(n.([]interface{})) > 0 {
+		return false
+	}
+	egress, err := expandNetworkPolicyEgress(d.Get(strings.TrimSuffix(key, "policy_types") + "egress").([]interface{}))
+	if err != nil {
+		return false
+	}
+	inferred := inferNetworkPolicyTypes(*egress)
+	oldTypes := o.([]interface{})
+	if len(oldTypes) != len(inferred) {
+		return false
+	}
+	for i, t := range inferred {
+		if oldTypes[i] != string(t) {
+			return false
+		}
+	}
+	return true
 }
@@ -0,0 +1,168 @@
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSuppressEquivalentResourceQuantity(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{"500m", "0.5", true},
+		{"1", "1000m", true},
+		{"1e3", "1k", true},
+		{"1e3", "1000", true},
+		{"1Gi", "1024Mi", true},
+		{"1Gi", "1073741824", true},
+		{"1Gi", "1G", false},
+		{"1Ki", "1k", false},
+		{"2", "2000m", true},
+		{"2", "2001m", false},
+		{"", "", true},
+		{"", "0", false},
+		{"1Gi", "", false},
+		{"", "1Gi", false},
+		{"invalid", "invalid", true},
+		{"invalid", "1Gi", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Old+"/"+tc.New, func(t *testing.T) {
+			got := suppressEquivalentResourceQuantity("requests.memory", tc.Old, tc.New, nil)
+			if got != tc.Expected {
+				t.Fatalf("Expected %q and %q equivalence to be %t, got %t", tc.Old, tc.New, tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentIntOrString(t *testing.T) {
+	cases := []struct {
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{"25%", "25%", true},
+		{"25%", "025%", true},
+		{"25%", "25", false},
+		{"0", "0", true},
+		{"0", "00", true},
+		{"0", "0%", true},
+		{"0%", "0", true},
+		{"1", "1%", false},
+		{"", "", true},
+		{"", "25%", false},
+		{"25%", "", false},
+		{"", "0", false},
+		{"0", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Old+"/"+tc.New, func(t *testing.T) {
+			got := suppressEquivalentIntOrString("max_surge", tc.Old, tc.New, nil)
+			if got != tc.Expected {
+				t.Fatalf("Expected %q and %q equivalence to be %t, got %t", tc.Old, tc.New, tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestEquivalentUnorderedLists(t *testing.T) {
+	env := func(name, value string) interface{} {
+		return map[string]interface{}{"name": name, "value": value, "value_from": []interface{}{}}
+	}
+	cases := []struct {
+		Name     string
+		Old      []interface{}
+		New      []interface{}
+		Expected bool
+	}{
+		{"same order", []interface{}{env("A", "1"), env("B", "2")}, []interface{}{env("A", "1"), env("B", "2")}, true},
+		{"reordered", []interface{}{env("B", "2"), env("A", "1")}, []interface{}{env("A", "1"), env("B", "2")}, true},
+		{"changed value", []interface{}{env("B", "3"), env("A", "1")}, []interface{}{env("A", "1"), env("B", "2")}, false},
+		{"added", []interface{}{env("A", "1")}, []interface{}{env("A", "1"), env("B", "2")}, false},
+		{"duplicates", []interface{}{env("A", "1"), env("A", "1")}, []interface{}{env("A", "1"), env("B", "2")}, false},
+		{"empty", []interface{}{}, []interface{}{}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := equivalentUnorderedLists(tc.Old, tc.New)
+			if got != tc.Expected {
+				t.Fatalf("Expected lists equivalence to be %t, got %t", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestSuppressReorderedContainerEnv(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"container": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem:     &schema.Resource{Schema: containerFields(true)},
+		},
+	}}
+	cases := map[string]struct {
+		first    map[string]interface{}
+		second   map[string]interface{}
+		expected bool
+	}{
+		"reordered": {
+			first:    map[string]interface{}{"name": "A", "value": "y"},
+			second:   map[string]interface{}{"name": "B", "value": "x"},
+			expected: false,
+		},
+		"reordered with a reference": {
+			first:    map[string]interface{}{"name": "A", "value": "$(B)"},
+			second:   map[string]interface{}{"name": "B", "value": "x"},
+			expected: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// State holds the variables in the order of the server, the
+			// configuration moves B before A.
+			state := &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"container.#":             "1",
+					"container.0.name":        "app",
+					"container.0.image":       "app:1",
+					"container.0.env.#":       "2",
+					"container.0.env.0.name":  tc.first["name"].(string),
+					"container.0.env.0.value": tc.first["value"].(string),
+					"container.0.env.1.name":  tc.second["name"].(string),
+					"container.0.env.1.value": tc.second["value"].(string),
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"container": []interface{}{map[string]interface{}{
+					"name":  "app",
+					"image": "app:1",
+					"env":   []interface{}{tc.second, tc.first},
+				}},
+			})
+			diff, err := r.Diff(context.Background(), state, config, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			changed := false
+			if diff != nil {
+				for k := range diff.Attributes {
+					changed = changed || strings.HasPrefix(k, "container.0.env.")
+				}
+			}
+			if changed != tc.expected {
+				t.Errorf("Expected a diff of env: %t, got %v", tc.expected, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,313 @@
+package kubernetes
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The Gateway API is not part of the Kubernetes API and its Go types live in
+// a separate module, so the resources are managed through the dynamic client
+// using the minimal types below.
+const gatewayAPIV1GroupVersion = "gateway.networking.k8s.io/v1"
+
+var (
+	gatewayV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1",
+		Resource: "gateways",
+	}
+	httpRouteV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "gateway.networking.k8s.io",
+		Version:  "v1",
+		Resource: "httproutes",
+	}
+)
+
+// errGatewayAPINotInstalled is returned when the cluster does not serve the
+// Gateway API resource a configuration refers to.
+type errGatewayAPINotInstalled struct {
+	resource string
+}
+
+func (e *errGatewayAPINotInstalled) Error() string {
+	return fmt.Sprintf("Gateway API not installed: the cluster does not serve %s in %s. Install the Gateway API CRDs (https://gateway-api.sigs.k8s.io/guides/#installing-gateway-api) before creating this resource.", e.resource, gatewayAPIV1GroupVersion)
+}
+
+func checkGatewayAPIV1Installed(conn *kubernetes.Clientset, resource string) error {
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion(gatewayAPIV1GroupVersion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &errGatewayAPINotInstalled{resource: resource}
+		}
+		return err
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == resource {
+			return nil
+		}
+	}
+	return &errGatewayAPINotInstalled{resource: resource}
+}
+
+// customizeDiffGatewayAPIV1 fails the plan when the Gateway API CRDs are not
+// installed. Other discovery errors are only logged, the cluster may not be
+// reachable yet during plan.
+func customizeDiffGatewayAPIV1(meta interface{}, resource string) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		log.Printf("[WARN] Skipping Gateway API discovery for %s: %s", resource, err)
+		return nil
+	}
+	err = checkGatewayAPIV1Installed(conn, resource)
+	if _, ok := err.(*errGatewayAPINotInstalled); ok {
+		return err
+	}
+	if err != nil {
+		log.Printf("[WARN] Skipping Gateway API discovery for %s: %s", resource, err)
+	}
+	return nil
+}
+
+// gatewayAPIV1ConditionTrue reports whether the condition of the given type
+// is true for the current generation of the object.
+func gatewayAPIV1ConditionTrue(conditions []metav1.Condition, conditionType string, generation int64) bool {
+	c := meta.FindStatusCondition(conditions, conditionType)
+	if c == nil {
+		return false
+	}
+	return c.Status == metav1.ConditionTrue && c.ObservedGeneration >= generation
+}
+
+func flattenGatewayAPIV1Conditions(in []metav1.Condition) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, c := range in {
+		att[i] = map[string]interface{}{
+			"type":    c.Type,
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		}
+	}
+	return att
+}
+
+func gatewayAPIV1ConditionMessage(conditions []metav1.Condition, conditionType string) string {
+	c := meta.FindStatusCondition(conditions, conditionType)
+	if c == nil {
+		return "condition not reported yet"
+	}
+	return fmt.Sprintf("%s: %s", c.Reason, c.Message)
+}
+
+type gatewayV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   gatewayV1Spec   `json:"spec"`
+	Status gatewayV1Status `json:"status,omitempty"`
+}
+
+type gatewayV1Spec struct {
+	GatewayClassName string              `json:"gatewayClassName"`
+	Listeners        []gatewayV1Listener `json:"listeners"`
+	Addresses        []gatewayV1Address  `json:"addresses,omitempty"`
+}
+
+type gatewayV1Listener struct {
+	Name          string                  `json:"name"`
+	Hostname      *string                 `json:"hostname,omitempty"`
+	Port          int32                   `json:"port"`
+	Protocol      string                  `json:"protocol"`
+	TLS           *gatewayV1TLSConfig     `json:"tls,omitempty"`
+	AllowedRoutes *gatewayV1AllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+type gatewayV1TLSConfig struct {
+	Mode            *string                          `json:"mode,omitempty"`
+	CertificateRefs []gatewayV1SecretObjectReference `json:"certificateRefs,omitempty"`
+	Options         map[string]string                `json:"options,omitempty"`
+}
+
+type gatewayV1SecretObjectReference struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+type gatewayV1AllowedRoutes struct {
+	Namespaces *gatewayV1RouteNamespaces `json:"namespaces,omitempty"`
+	Kinds      []gatewayV1RouteGroupKind `json:"kinds,omitempty"`
+}
+
+type gatewayV1RouteNamespaces struct {
+	From     *string               `json:"from,omitempty"`
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+type gatewayV1RouteGroupKind struct {
+	Group *string `json:"group,omitempty"`
+	Kind  string  `json:"kind"`
+}
+
+type gatewayV1Address struct {
+	Type  *string `json:"type,omitempty"`
+	Value string  `json:"value"`
+}
+
+type gatewayV1Status struct {
+	Addresses  []gatewayV1Address        `json:"addresses,omitempty"`
+	Conditions []metav1.Condition        `json:"conditions,omitempty"`
+	Listeners  []gatewayV1ListenerStatus `json:"listeners,omitempty"`
+}
+
+type gatewayV1ListenerStatus struct {
+	Name           string             `json:"name"`
+	AttachedRoutes int32              `json:"attachedRoutes"`
+	Conditions     []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type httpRouteV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   httpRouteV1Spec   `json:"spec"`
+	Status httpRouteV1Status `json:"status,omitempty"`
+}
+
+type httpRouteV1Spec struct {
+	ParentRefs []gatewayV1ParentReference `json:"parentRefs,omitempty"`
+	Hostnames  []string                   `json:"hostnames,omitempty"`
+	Rules      []httpRouteV1Rule          `json:"rules,omitempty"`
+}
+
+type gatewayV1ParentReference struct {
+	Group       *string `json:"group,omitempty"`
+	Kind        *string `json:"kind,omitempty"`
+	Namespace   *string `json:"namespace,omitempty"`
+	Name        string  `json:"name"`
+	SectionName *string `json:"sectionName,omitempty"`
+	Port        *int32  `json:"port,omitempty"`
+}
+
+type httpRouteV1Rule struct {
+	Matches     []httpRouteV1Match      `json:"matches,omitempty"`
+	Filters     []httpRouteV1Filter     `json:"filters,omitempty"`
+	BackendRefs []httpRouteV1BackendRef `json:"backendRefs,omitempty"`
+}
+
+type httpRouteV1Match struct {
+	Path        *httpRouteV1PathMatch   `json:"path,omitempty"`
+	Headers     []httpRouteV1ValueMatch `json:"headers,omitempty"`
+	QueryParams []httpRouteV1ValueMatch `json:"queryParams,omitempty"`
+	Method      *string                 `json:"method,omitempty"`
+}
+
+type httpRouteV1PathMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Value *string `json:"value,omitempty"`
+}
+
+// httpRouteV1ValueMatch matches a header or a query parameter.
+type httpRouteV1ValueMatch struct {
+	Type  *string `json:"type,omitempty"`
+	Name  string  `json:"name"`
+	Value string  `json:"value"`
+}
+
+type httpRouteV1Filter struct {
+	Type                   string                      `json:"type"`
+	RequestHeaderModifier  *httpRouteV1HeaderFilter    `json:"requestHeaderModifier,omitempty"`
+	ResponseHeaderModifier *httpRouteV1HeaderFilter    `json:"responseHeaderModifier,omitempty"`
+	RequestRedirect        *httpRouteV1RequestRedirect `json:"requestRedirect,omitempty"`
+	URLRewrite             *httpRouteV1URLRewrite      `json:"urlRewrite,omitempty"`
+	RequestMirror          *httpRouteV1RequestMirror   `json:"requestMirror,omitempty"`
+}
+
+type httpRouteV1HeaderFilter struct {
+	Set    []httpRouteV1Header `json:"set,omitempty"`
+	Add    []httpRouteV1Header `json:"add,omitempty"`
+	Remove []string            `json:"remove,omitempty"`
+}
+
+type httpRouteV1Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type httpRouteV1RequestRedirect struct {
+	Scheme     *string                  `json:"scheme,omitempty"`
+	Hostname   *string                  `json:"hostname,omitempty"`
+	Path       *httpRouteV1PathModifier `json:"path,omitempty"`
+	Port       *int32                   `json:"port,omitempty"`
+	StatusCode *int32                   `json:"statusCode,omitempty"`
+}
+
+type httpRouteV1URLRewrite struct {
+	Hostname *string                  `json:"hostname,omitempty"`
+	Path     *httpRouteV1PathModifier `json:"path,omitempty"`
+}
+
+type httpRouteV1PathModifier struct {
+	Type               string  `json:"type"`
+	ReplaceFullPath    *string `json:"replaceFullPath,omitempty"`
+	ReplacePrefixMatch *string `json:"replacePrefixMatch,omitempty"`
+}
+
+type httpRouteV1RequestMirror struct {
+	BackendRef httpRouteV1BackendRef `json:"backendRef"`
+}
+
+type httpRouteV1BackendRef struct {
+	Group     *string `json:"group,omitempty"`
+	Kind      *string `json:"kind,omitempty"`
+	Name      string  `json:"name"`
+	Namespace *string `json:"namespace,omitempty"`
+	Port      *int32  `json:"port,omitempty"`
+	Weight    *int32  `json:"weight,omitempty"`
+}
+
+type httpRouteV1Status struct {
+	Parents []httpRouteV1ParentStatus `json:"parents,omitempty"`
+}
+
+type httpRouteV1ParentStatus struct {
+	ParentRef      gatewayV1ParentReference `json:"parentRef"`
+	ControllerName string                   `json:"controllerName"`
+	Conditions     []metav1.Condition       `json:"conditions,omitempty"`
+}
+
+func gatewayAPIV1ConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The conditions reported by the controller.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"status": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"reason": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,83 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGatewayAPIV1UnstructuredRoundTrip(t *testing.T) {
+	gw := gatewayV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayAPIV1GroupVersion,
+			Kind:       "Gateway",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example",
+			Namespace: "default",
+		},
+		Spec: gatewayV1Spec{
+			GatewayClassName: "example",
+			Listeners: []gatewayV1Listener{
+				{Name: "http", Port: 80, Protocol: "HTTP"},
+			},
+		},
+		Status: gatewayV1Status{
+			Conditions: []metav1.Condition{{Type: "Programmed", Status: metav1.ConditionTrue}},
+		},
+	}
+
+	u, err := toUnstructuredObject(&gw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := u.Object["status"]; ok {
+		t.Fatal("Expected the status to be dropped from the object sent to the API server")
+	}
+	if u.GetKind() != "Gateway" || u.GetName() != "example" {
+		t.Fatalf("Unexpected object identity: %s %s", u.GetKind(), u.GetName())
+	}
+
+	out := gatewayV1{}
+	if err := fromUnstructuredObject(u, &out); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(gw.Spec, out.Spec); diff != "" {
+		t.Fatalf("Unexpected gateway spec round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestHTTPRouteV1Accepted(t *testing.T) {
+	condition := func(status metav1.ConditionStatus, generation int64) []metav1.Condition {
+		return []metav1.Condition{{Type: "Accepted", Status: status, ObservedGeneration: generation, Reason: "Test"}}
+	}
+	route := func(parents ...[]metav1.Condition) *httpRouteV1 {
+		r := &httpRouteV1{}
+		r.Generation = 2
+		r.Spec.ParentRefs = []gatewayV1ParentReference{{Name: "a"}, {Name: "b"}}
+		for _, c := range parents {
+			r.Status.Parents = append(r.Status.Parents, httpRouteV1ParentStatus{Conditions: c})
+		}
+		return r
+	}
+
+	cases := map[string]struct {
+		route    *httpRouteV1
+		expected bool
+	}{
+		"no status":           {route(), false},
+		"missing parent":      {route(condition(metav1.ConditionTrue, 2)), false},
+		"not accepted":        {route(condition(metav1.ConditionTrue, 2), condition(metav1.ConditionFalse, 2)), false},
+		"previous generation": {route(condition(metav1.ConditionTrue, 2), condition(metav1.ConditionTrue, 1)), false},
+		"accepted":            {route(condition(metav1.ConditionTrue, 2), condition(metav1.ConditionTrue, 2)), true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if done, _ := httpRouteV1Accepted(tc.route); done != tc.expected {
+				t.Errorf("Expected accepted to be %t, got %t", tc.expected, done)
+			}
+		})
+	}
+}
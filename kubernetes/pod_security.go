@@ -0,0 +1,112 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	psaapi "k8s.io/pod-security-admission/api"
+	"k8s.io/pod-security-admission/policy"
+)
+
+var podSecurityEvaluator = mustNewPodSecurityEvaluator()
+
+func mustNewPodSecurityEvaluator() policy.Evaluator {
+	e, err := policy.NewEvaluator(policy.DefaultChecks())
+	if err != nil {
+		panic(fmt.Sprintf("Failed to build the pod security evaluator: %s", err))
+	}
+	return e
+}
+
+// validatePodSecurityDiff checks the pod spec at key, such as
+// "spec.0.template.0.spec", against the Pod Security Standard enforced on the
+// namespace of the resource, when validate_pod_security is enabled. Specs
+// which are not known until apply are skipped, and so are namespaces which
+// cannot be read, e.g. because the cluster cannot be reached.
+func validatePodSecurityDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, key string) error {
+	if k, ok := meta.(kubeClientsets); !ok || !k.validatePodSecurity {
+		return nil
+	}
+	metaKey := strings.TrimSuffix(key, "spec") + "metadata"
+	if !d.NewValueKnown("metadata.0.namespace") || !isWhollyKnownAt(d.GetRawConfig(), key) || !isWhollyKnownAt(d.GetRawConfig(), metaKey) {
+		log.Printf("[DEBUG] Skipping pod security validation of %s: the pod spec is not known until apply", key)
+		return nil
+	}
+	namespace := d.Get("metadata.0.namespace").(string)
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		log.Printf("[WARN] Skipping pod security validation of %s: %s", key, err)
+		return nil
+	}
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[WARN] Skipping pod security validation of %s: %s", key, err)
+		return nil
+	}
+	spec, err := expandPodSpec(d.Get(key).([]interface{}))
+	if err != nil {
+		return err
+	}
+	podMeta := expandMetadata(d.Get(metaKey).([]interface{}))
+	if err := evaluatePodSecurity(ns.Labels, &podMeta, spec); err != nil {
+		return fmt.Errorf("%s: pod of namespace %q %s", key, namespace, err)
+	}
+	return nil
+}
+
+// evaluatePodSecurity checks a pod against the Pod Security Standard enforced
+// by the labels of its namespace. The error names the violating fields the
+// way the admission controller would.
+func evaluatePodSecurity(labels map[string]string, podMeta *metav1.ObjectMeta, spec *v1.PodSpec) error {
+	p, errs := psaapi.PolicyToEvaluate(labels, psaapi.Policy{
+		Enforce: psaapi.LevelVersion{Level: psaapi.LevelPrivileged, Version: psaapi.LatestVersion()},
+	})
+	if len(errs) > 0 {
+		log.Printf("[WARN] Invalid pod security labels: %s", errs.ToAggregate())
+	}
+	if p.Enforce.Level == psaapi.LevelPrivileged {
+		return nil
+	}
+	result := policy.AggregateCheckResults(podSecurityEvaluator.EvaluatePod(p.Enforce, podMeta, spec))
+	if result.Allowed {
+		return nil
+	}
+	return fmt.Errorf("violates PodSecurity %q: %s", p.Enforce.String(), result.ForbiddenDetail())
+}
+
+// isWhollyKnownAt reports whether the value at the given key of a raw
+// configuration, such as "spec.0.template.0.spec", is known, including all
+// the values nested within. Values missing from the configuration are known.
+func isWhollyKnownAt(v cty.Value, key string) bool {
+	for _, p := range strings.Split(key, ".") {
+		if !v.IsKnown() {
+			return false
+		}
+		if v.IsNull() {
+			return true
+		}
+		if i, err := strconv.Atoi(p); err == nil {
+			if !v.Type().IsListType() || v.LengthInt() <= i {
+				return true
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+			continue
+		}
+		if !v.Type().IsObjectType() || !v.Type().HasAttribute(p) {
+			return true
+		}
+		v = v.GetAttr(p)
+	}
+	return v.IsWhollyKnown()
+}
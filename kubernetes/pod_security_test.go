@@ -0,0 +1,129 @@
+package kubernetes
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestEvaluatePodSecurity(t *testing.T) {
+	restrictedSpec := &v1.PodSpec{
+		SecurityContext: &v1.PodSecurityContext{
+			RunAsNonRoot:   ptrToBool(true),
+			SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+		},
+		Containers: []v1.Container{
+			{
+				Name:  "web",
+				Image: "nginx",
+				SecurityContext: &v1.SecurityContext{
+					AllowPrivilegeEscalation: ptrToBool(false),
+					Capabilities:             &v1.Capabilities{Drop: []v1.Capability{"ALL"}},
+				},
+			},
+		},
+	}
+	privilegedSpec := &v1.PodSpec{
+		HostNetwork: true,
+		Containers: []v1.Container{
+			{
+				Name:            "web",
+				Image:           "nginx",
+				SecurityContext: &v1.SecurityContext{Privileged: ptrToBool(true)},
+			},
+		},
+	}
+	defaultSpec := &v1.PodSpec{
+		Containers: []v1.Container{{Name: "web", Image: "nginx"}},
+	}
+
+	testCases := []struct {
+		name     string
+		labels   map[string]string
+		spec     *v1.PodSpec
+		expected *regexp.Regexp
+	}{
+		{
+			name: "no labels",
+			spec: privilegedSpec,
+		},
+		{
+			name:   "privileged namespace",
+			labels: map[string]string{"pod-security.kubernetes.io/enforce": "privileged"},
+			spec:   privilegedSpec,
+		},
+		{
+			name:     "privileged pod in baseline namespace",
+			labels:   map[string]string{"pod-security.kubernetes.io/enforce": "baseline"},
+			spec:     privilegedSpec,
+			expected: regexp.MustCompile(`violates PodSecurity "baseline:latest": host namespaces \(hostNetwork=true\), privileged \(container "web" must not set securityContext.privileged=true\)`),
+		},
+		{
+			name:   "default pod in baseline namespace",
+			labels: map[string]string{"pod-security.kubernetes.io/enforce": "baseline"},
+			spec:   defaultSpec,
+		},
+		{
+			name:     "default pod in restricted namespace",
+			labels:   map[string]string{"pod-security.kubernetes.io/enforce": "restricted", "pod-security.kubernetes.io/enforce-version": "v1.28"},
+			spec:     defaultSpec,
+			expected: regexp.MustCompile(`violates PodSecurity "restricted:v1.28": allowPrivilegeEscalation != false`),
+		},
+		{
+			name:   "restricted pod in restricted namespace",
+			labels: map[string]string{"pod-security.kubernetes.io/enforce": "restricted"},
+			spec:   restrictedSpec,
+		},
+		{
+			name:   "warn only",
+			labels: map[string]string{"pod-security.kubernetes.io/warn": "restricted"},
+			spec:   defaultSpec,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := evaluatePodSecurity(tc.labels, &metav1.ObjectMeta{}, tc.spec)
+			if tc.expected == nil {
+				if err != nil {
+					t.Fatalf("expected the pod to be allowed, got %s", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error matching %s", tc.expected)
+			}
+			if !tc.expected.MatchString(err.Error()) {
+				t.Fatalf("expected an error matching %s, got %s", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestIsWhollyKnownAt(t *testing.T) {
+	spec := func(image cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"spec": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"template": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"spec": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"image": image,
+					})}),
+				})}),
+			})}),
+		})
+	}
+	if !isWhollyKnownAt(spec(cty.StringVal("nginx")), "spec.0.template.0.spec") {
+		t.Fatal("expected a known spec")
+	}
+	if isWhollyKnownAt(spec(cty.UnknownVal(cty.String)), "spec.0.template.0.spec") {
+		t.Fatal("expected a spec with an unknown image to be unknown")
+	}
+	if !isWhollyKnownAt(spec(cty.UnknownVal(cty.String)), "spec.0.template.0.metadata") {
+		t.Fatal("expected missing metadata to be known")
+	}
+	if isWhollyKnownAt(cty.UnknownVal(cty.DynamicPseudoType), "spec") {
+		t.Fatal("expected an unknown configuration to be unknown")
+	}
+}
@@ -6,24 +6,36 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/mitchellh/go-homedir"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/apilogging"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/execplugin"
 
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
 	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sversion "k8s.io/apimachinery/pkg/version"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	restclient "k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/transport"
 	aggregator "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 )
 
@@ -81,7 +93,6 @@ func Provider() *schema.Provider {
 			"config_path": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				DefaultFunc:   schema.EnvDefaultFunc("KUBE_CONFIG_PATH", nil),
 				Description:   "Path to the kube config file. Can be set with KUBE_CONFIG_PATH.",
 				ConflictsWith: []string{"config_paths"},
 			},
@@ -108,12 +119,101 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("KUBE_TOKEN", ""),
 				Description: "Token to authenticate an service account",
 			},
+			"token_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("KUBE_TOKEN_FILE", ""),
+				Description:   "Path to a file containing the token to authenticate a service account. The file is read again when it changes, e.g. for a rotated projected service account token.",
+				ConflictsWith: []string{"token"},
+			},
 			"proxy_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Description: "URL to the proxy to be used for all API requests",
 				DefaultFunc: schema.EnvDefaultFunc("KUBE_PROXY_URL", ""),
 			},
+			"tls_server_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_TLS_SERVER_NAME", ""),
+				Description: "Server name to use for SNI and to verify the TLS certificate of the Kubernetes API, instead of the hostname of host.",
+			},
+			"timeout": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_TIMEOUT", ""),
+				Description: "Timeout of a single request to the Kubernetes API, as a duration such as `30s`. Defaults to no timeout.",
+			},
+			"qps": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_QPS", 50.0),
+				Description:  "Maximum number of requests per second sent to the Kubernetes API by the client. Defaults to 50.",
+				ValidateFunc: validation.FloatAtLeast(0.1),
+			},
+			"burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_BURST", 100),
+				Description:  "Maximum burst of requests sent to the Kubernetes API by the client above qps. Defaults to 100.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"disable_client_rate_limiting": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_DISABLE_CLIENT_RATE_LIMITING", false),
+				Description: "Do not throttle requests on the client side and ignore qps and burst, e.g. for clusters protected by API Priority and Fairness.",
+			},
+			"log_api_requests": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("KUBE_LOG_API_REQUESTS", false),
+				Description: "Log the bodies of API requests and responses at TRACE level, with the data of secrets redacted.",
+			},
+			"impersonate": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Make all requests as the given user instead of the authenticated one. Can be set with the KUBE_AS, KUBE_AS_GROUPS and KUBE_AS_UID environment variables.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The user name to impersonate.",
+						},
+						"groups": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The groups to impersonate.",
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The UID to impersonate.",
+						},
+						"extra": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Extra information to impersonate.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"exec": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -121,8 +221,10 @@ func Provider() *schema.Provider {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"api_version": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "API version of the ExecCredential exchanged with the plugin. Valid values are `client.authentication.k8s.io/v1` and `client.authentication.k8s.io/v1beta1`.",
+							ValidateFunc: validation.StringInSlice([]string{"client.authentication.k8s.io/v1", "client.authentication.k8s.io/v1beta1"}, false),
 						},
 						"command": {
 							Type:     schema.TypeString,
@@ -138,10 +240,56 @@ func Provider() *schema.Provider {
 							Optional: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
+						"interactive_mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      string(clientcmdapi.IfAvailableExecInteractiveMode),
+							Description:  "Whether the plugin uses standard input. Valid values are `Never`, `IfAvailable` and `Always`.",
+							ValidateFunc: validation.StringInSlice([]string{string(clientcmdapi.NeverExecInteractiveMode), string(clientcmdapi.IfAvailableExecInteractiveMode), string(clientcmdapi.AlwaysExecInteractiveMode)}, false),
+						},
+						"install_hint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Help text shown when the command cannot be found.",
+						},
+						"provide_cluster_info": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Pass the information about the cluster to the plugin in the KUBERNETES_EXEC_INFO environment variable.",
+						},
 					},
 				},
 				Description: "",
 			},
+			"ignore_annotations": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of Kubernetes metadata annotations to ignore across all resources handled by this provider for situations where external systems are managing certain resource annotations. Each item is a regular expression.",
+			},
+			"ignore_labels": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
+			},
+			"server_side_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use server-side apply for the resources which support it, instead of updating them with JSON patches. Can be overridden per resource.",
+			},
+			"validate_pod_security": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Check during plan that the pods of workload resources satisfy the Pod Security Standard enforced on their namespace by the `pod-security.kubernetes.io/enforce` label. The check is skipped when the cluster cannot be reached.",
+			},
+			"expect_kubernetes_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("KUBE_EXPECT_KUBERNETES_VERSION", ""),
+				Description:  "A version constraint, such as `>= 1.26`, the API server has to satisfy. It is checked before the first request to the cluster.",
+				ValidateFunc: validateVersionConstraint,
+			},
 			"experiments": {
 				Type:        schema.TypeList,
 				MaxItems:    1,
@@ -176,6 +324,9 @@ func Provider() *schema.Provider {
 			"kubernetes_namespace":                  dataSourceKubernetesNamespace(),
 			"kubernetes_namespace_v1":               dataSourceKubernetesNamespace(),
 			"kubernetes_all_namespaces":             dataSourceKubernetesAllNamespaces(),
+			"kubernetes_namespaces":                 dataSourceKubernetesNamespaces(),
+			"kubernetes_nodes":                      dataSourceKubernetesNodes(),
+			"kubernetes_server_version":             dataSourceKubernetesServerVersion(),
 			"kubernetes_secret":                     dataSourceKubernetesSecret(),
 			"kubernetes_secret_v1":                  dataSourceKubernetesSecret(),
 			"kubernetes_service":                    dataSourceKubernetesService(),
@@ -186,10 +337,14 @@ func Provider() *schema.Provider {
 			"kubernetes_service_account_v1":         dataSourceKubernetesServiceAccount(),
 			"kubernetes_persistent_volume_claim":    dataSourceKubernetesPersistentVolumeClaim(),
 			"kubernetes_persistent_volume_claim_v1": dataSourceKubernetesPersistentVolumeClaim(),
+			"kubernetes_persistent_volume":          dataSourceKubernetesPersistentVolume(),
+			"kubernetes_persistent_volume_v1":       dataSourceKubernetesPersistentVolume(),
 
 			// networking
-			"kubernetes_ingress":    dataSourceKubernetesIngress(),
-			"kubernetes_ingress_v1": dataSourceKubernetesIngressV1(),
+			"kubernetes_ingress":            dataSourceKubernetesIngress(),
+			"kubernetes_ingress_v1":         dataSourceKubernetesIngressV1(),
+			"kubernetes_endpoint_slices":    dataSourceKubernetesEndpointSlicesV1(),
+			"kubernetes_endpoint_slices_v1": dataSourceKubernetesEndpointSlicesV1(),
 
 			// storage
 			"kubernetes_storage_class":    dataSourceKubernetesStorageClass(),
@@ -197,24 +352,37 @@ func Provider() *schema.Provider {
 
 			// admission control
 			"kubernetes_mutating_webhook_configuration_v1": dataSourceKubernetesMutatingWebhookConfiguration(),
+
+			// rbac
+			"kubernetes_cluster_role":    dataSourceKubernetesClusterRole(),
+			"kubernetes_cluster_role_v1": dataSourceKubernetesClusterRole(),
+
+			// authorization
+			"kubernetes_subject_access_review":      dataSourceKubernetesSubjectAccessReview(),
+			"kubernetes_self_subject_access_review": dataSourceKubernetesSelfSubjectAccessReview(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			// core
 			"kubernetes_namespace":                  resourceKubernetesNamespace(),
 			"kubernetes_namespace_v1":               resourceKubernetesNamespace(),
+			"kubernetes_default_namespace":          resourceKubernetesDefaultNamespace(),
 			"kubernetes_service":                    resourceKubernetesService(),
 			"kubernetes_service_v1":                 resourceKubernetesService(),
 			"kubernetes_service_account":            resourceKubernetesServiceAccount(),
 			"kubernetes_service_account_v1":         resourceKubernetesServiceAccount(),
 			"kubernetes_default_service_account":    resourceKubernetesDefaultServiceAccount(),
 			"kubernetes_default_service_account_v1": resourceKubernetesDefaultServiceAccount(),
+			"kubernetes_service_account_token":      resourceKubernetesServiceAccountToken(),
 			"kubernetes_config_map":                 resourceKubernetesConfigMap(),
 			"kubernetes_config_map_v1":              resourceKubernetesConfigMap(),
+			"kubernetes_config_map_v1_data":         resourceKubernetesConfigMapV1Data(),
 			"kubernetes_secret":                     resourceKubernetesSecret(),
 			"kubernetes_secret_v1":                  resourceKubernetesSecret(),
+			"kubernetes_secret_v1_data":             resourceKubernetesSecretV1Data(),
 			"kubernetes_pod":                        resourceKubernetesPod(),
 			"kubernetes_pod_v1":                     resourceKubernetesPod(),
+			"kubernetes_pod_ephemeral_containers":   resourceKubernetesPodEphemeralContainers(),
 			"kubernetes_endpoints":                  resourceKubernetesEndpoints(),
 			"kubernetes_endpoints_v1":               resourceKubernetesEndpoints(),
 			"kubernetes_limit_range":                resourceKubernetesLimitRange(),
@@ -227,6 +395,7 @@ func Provider() *schema.Provider {
 			"kubernetes_replication_controller_v1":  resourceKubernetesReplicationController(),
 			"kubernetes_resource_quota":             resourceKubernetesResourceQuota(),
 			"kubernetes_resource_quota_v1":          resourceKubernetesResourceQuota(),
+			"kubernetes_node_taint":                 resourceKubernetesNodeTaint(),
 
 			// api registration
 			"kubernetes_api_service":    resourceKubernetesAPIService(),
@@ -239,6 +408,7 @@ func Provider() *schema.Provider {
 			"kubernetes_daemon_set_v1":   resourceKubernetesDaemonSet(),
 			"kubernetes_stateful_set":    resourceKubernetesStatefulSet(),
 			"kubernetes_stateful_set_v1": resourceKubernetesStatefulSet(),
+			"kubernetes_replica_set_v1":  resourceKubernetesReplicaSetV1(),
 
 			// batch
 			"kubernetes_job":         resourceKubernetesJob(),
@@ -249,6 +419,7 @@ func Provider() *schema.Provider {
 			// autoscaling
 			"kubernetes_horizontal_pod_autoscaler":         resourceKubernetesHorizontalPodAutoscaler(),
 			"kubernetes_horizontal_pod_autoscaler_v1":      resourceKubernetesHorizontalPodAutoscalerV1(),
+			"kubernetes_horizontal_pod_autoscaler_v2":      resourceKubernetesHorizontalPodAutoscalerV2(),
 			"kubernetes_horizontal_pod_autoscaler_v2beta2": resourceKubernetesHorizontalPodAutoscalerV2Beta2(),
 
 			// certificates
@@ -273,6 +444,13 @@ func Provider() *schema.Provider {
 			"kubernetes_network_policy":    resourceKubernetesNetworkPolicy(),
 			"kubernetes_network_policy_v1": resourceKubernetesNetworkPolicy(),
 
+			// discovery
+			"kubernetes_endpoint_slice_v1": resourceKubernetesEndpointSliceV1(),
+
+			// gateway
+			"kubernetes_gateway_v1":    resourceKubernetesGatewayV1(),
+			"kubernetes_http_route_v1": resourceKubernetesHTTPRouteV1(),
+
 			// policy
 			"kubernetes_pod_disruption_budget":       resourceKubernetesPodDisruptionBudget(),
 			"kubernetes_pod_disruption_budget_v1":    resourceKubernetesPodDisruptionBudgetV1(),
@@ -283,20 +461,43 @@ func Provider() *schema.Provider {
 			"kubernetes_priority_class":    resourceKubernetesPriorityClass(),
 			"kubernetes_priority_class_v1": resourceKubernetesPriorityClass(),
 
+			// node
+			"kubernetes_runtime_class_v1": resourceKubernetesRuntimeClassV1(),
+
+			// flow control
+			"kubernetes_flow_schema_v1":                  resourceKubernetesFlowSchemaV1(),
+			"kubernetes_priority_level_configuration_v1": resourceKubernetesPriorityLevelConfigurationV1(),
+
 			// admission control
-			"kubernetes_validating_webhook_configuration":    resourceKubernetesValidatingWebhookConfiguration(),
-			"kubernetes_validating_webhook_configuration_v1": resourceKubernetesValidatingWebhookConfigurationV1(),
-			"kubernetes_mutating_webhook_configuration":      resourceKubernetesMutatingWebhookConfiguration(),
-			"kubernetes_mutating_webhook_configuration_v1":   resourceKubernetesMutatingWebhookConfigurationV1(),
+			"kubernetes_validating_webhook_configuration":       resourceKubernetesValidatingWebhookConfiguration(),
+			"kubernetes_validating_webhook_configuration_v1":    resourceKubernetesValidatingWebhookConfigurationV1(),
+			"kubernetes_mutating_webhook_configuration":         resourceKubernetesMutatingWebhookConfiguration(),
+			"kubernetes_mutating_webhook_configuration_v1":      resourceKubernetesMutatingWebhookConfigurationV1(),
+			"kubernetes_validating_admission_policy_v1":         resourceKubernetesValidatingAdmissionPolicyV1(),
+			"kubernetes_validating_admission_policy_binding_v1": resourceKubernetesValidatingAdmissionPolicyBindingV1(),
 
 			// storage
-			"kubernetes_storage_class":    resourceKubernetesStorageClass(),
-			"kubernetes_storage_class_v1": resourceKubernetesStorageClass(),
-			"kubernetes_csi_driver":       resourceKubernetesCSIDriver(),
-			"kubernetes_csi_driver_v1":    resourceKubernetesCSIDriverV1(),
+			"kubernetes_storage_class":        resourceKubernetesStorageClass(),
+			"kubernetes_storage_class_v1":     resourceKubernetesStorageClass(),
+			"kubernetes_csi_driver":           resourceKubernetesCSIDriver(),
+			"kubernetes_csi_driver_v1":        resourceKubernetesCSIDriverV1(),
+			"kubernetes_csi_storage_capacity": resourceKubernetesCSIStorageCapacity(),
+
+			// snapshot
+			"kubernetes_volume_snapshot_class":   resourceKubernetesVolumeSnapshotClass(),
+			"kubernetes_volume_snapshot":         resourceKubernetesVolumeSnapshot(),
+			"kubernetes_volume_snapshot_content": resourceKubernetesVolumeSnapshotContent(),
+
+			// manifests
+			"kubernetes_manifests":    resourceKubernetesManifests(),
+			"kubernetes_resource_set": resourceKubernetesResourceSet(),
 		},
 	}
 
+	for _, r := range p.ResourcesMap {
+		withClusterConnection(r)
+	}
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return providerConfigure(ctx, d, p.TerraformVersion)
 	}
@@ -307,94 +508,376 @@ func Provider() *schema.Provider {
 type KubeClientsets interface {
 	MainClientset() (*kubernetes.Clientset, error)
 	AggregatorClientset() (*aggregator.Clientset, error)
+	DynamicClient() (dynamic.Interface, error)
+	ServerVersion() (*gversion.Version, error)
+	ServerVersionInfo() (*k8sversion.Info, error)
 }
 
 type kubeClientsets struct {
-	config              *restclient.Config
+	config *restclient.Config
+
+	// configErr is set when no client configuration could be built. It is
+	// returned when a client is first used instead of failing Configure.
+	configErr error
+
+	// clients caches the clients built from config. It is a pointer so the
+	// cache is shared between the copies of kubeClientsets handed to resources.
+	clients *clientsetsCache
+
+	configData *schema.ResourceData
+
+	// ignoreAnnotations and ignoreLabels hold the compiled provider-level
+	// ignore_annotations and ignore_labels patterns.
+	ignoreAnnotations []*regexp.Regexp
+	ignoreLabels      []*regexp.Regexp
+
+	// serverSideApply is the provider-level server_side_apply setting.
+	serverSideApply bool
+
+	// logAPIRequests is the provider-level log_api_requests setting.
+	logAPIRequests bool
+
+	// validatePodSecurity is the provider-level validate_pod_security setting.
+	validatePodSecurity bool
+
+	// serverVersion caches the API server version. It is a pointer so the
+	// cache is shared between the copies of kubeClientsets handed to resources.
+	serverVersion *serverVersionCache
+
+	// expectedVersion is the expect_kubernetes_version constraint, if any.
+	expectedVersion gversion.Constraints
+
+	// connections caches the clientsets of the cluster_connection blocks of
+	// resources. It is shared with the clientsets of the connections.
+	connections *clusterConnectionCache
+}
+
+type clientsetsCache struct {
+	mu                  sync.Mutex
+	httpClient          *http.Client
 	mainClientset       *kubernetes.Clientset
 	aggregatorClientset *aggregator.Clientset
+	dynamicClient       dynamic.Interface
+}
 
-	configData *schema.ResourceData
+type serverVersionCache struct {
+	mu      sync.Mutex
+	info    *k8sversion.Info
+	version *gversion.Version
+
+	// check and checkErr hold the outcome of the expect_kubernetes_version
+	// check, which is done once per run.
+	check    sync.Once
+	checkErr error
+}
+
+// errIncompleteConfiguration is returned by the clients when the provider
+// configuration could not be turned into a client configuration, typically
+// because it refers to a cluster which is only created during apply.
+type errIncompleteConfiguration struct {
+	err error
+}
+
+func (e *errIncompleteConfiguration) Error() string {
+	return fmt.Sprintf("Failed to configure client: the provider configuration is incomplete or invalid, or depends on values which are not known until apply: %s", e.err)
+}
+
+func (k kubeClientsets) clientsets() (*clientsetsCache, error) {
+	if k.configErr != nil {
+		return nil, k.configErr
+	}
+	if k.clients == nil || k.config == nil {
+		return nil, fmt.Errorf("Failed to configure client: the provider is not configured")
+	}
+	return k.clients, nil
+}
+
+// httpClientLocked returns the HTTP client shared by the clients. It must be
+// called while holding the mutex of the cache.
+func (k kubeClientsets) httpClientLocked(c *clientsetsCache) (*http.Client, error) {
+	if c.httpClient == nil {
+		hc, err := execplugin.HTTPClientFor(k.config)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to configure client: %s", err)
+		}
+		c.httpClient = hc
+	}
+	return c.httpClient, nil
 }
 
 func (k kubeClientsets) MainClientset() (*kubernetes.Clientset, error) {
-	if k.mainClientset != nil {
-		return k.mainClientset, nil
+	if err := k.checkServerVersion(); err != nil {
+		return nil, err
 	}
+	return k.mainClientset()
+}
 
-	if k.config != nil {
-		kc, err := kubernetes.NewForConfig(k.config)
+func (k kubeClientsets) mainClientset() (*kubernetes.Clientset, error) {
+	c, err := k.clientsets()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.mainClientset == nil {
+		hc, err := k.httpClientLocked(c)
+		if err != nil {
+			return nil, err
+		}
+		kc, err := kubernetes.NewForConfigAndClient(k.config, hc)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to configure client: %s", err)
 		}
-		k.mainClientset = kc
+		c.mainClientset = kc
 	}
-	return k.mainClientset, nil
+	return c.mainClientset, nil
 }
 
 func (k kubeClientsets) AggregatorClientset() (*aggregator.Clientset, error) {
-	if k.aggregatorClientset != nil {
-		return k.aggregatorClientset, nil
+	if err := k.checkServerVersion(); err != nil {
+		return nil, err
+	}
+	c, err := k.clientsets()
+	if err != nil {
+		return nil, err
 	}
-	if k.config != nil {
-		ac, err := aggregator.NewForConfig(k.config)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aggregatorClientset == nil {
+		hc, err := k.httpClientLocked(c)
+		if err != nil {
+			return nil, err
+		}
+		ac, err := aggregator.NewForConfigAndClient(k.config, hc)
 		if err != nil {
 			return nil, fmt.Errorf("Failed to configure client: %s", err)
 		}
-		k.aggregatorClientset = ac
+		c.aggregatorClientset = ac
 	}
-	return k.aggregatorClientset, nil
+	return c.aggregatorClientset, nil
+}
+
+func (k kubeClientsets) DynamicClient() (dynamic.Interface, error) {
+	if err := k.checkServerVersion(); err != nil {
+		return nil, err
+	}
+	c, err := k.clientsets()
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dynamicClient == nil {
+		hc, err := k.httpClientLocked(c)
+		if err != nil {
+			return nil, err
+		}
+		dc, err := dynamic.NewForConfigAndClient(k.config, hc)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to configure client: %s", err)
+		}
+		c.dynamicClient = dc
+	}
+	return c.dynamicClient, nil
+}
+
+// ServerVersionInfo returns the version information of the API server. It is
+// looked up once through the discovery client, failed lookups are retried on
+// the next call.
+func (k kubeClientsets) ServerVersionInfo() (*k8sversion.Info, error) {
+	if k.serverVersion == nil {
+		return nil, fmt.Errorf("Server version cache is not configured")
+	}
+	k.serverVersion.mu.Lock()
+	defer k.serverVersion.mu.Unlock()
+	if k.serverVersion.info != nil {
+		return k.serverVersion.info, nil
+	}
+
+	conn, err := k.mainClientset()
+	if err != nil {
+		return nil, err
+	}
+	info, err := conn.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get server version: %s", err)
+	}
+	v, err := gversion.NewVersion(info.GitVersion)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse server version %q: %s", info.GitVersion, err)
+	}
+	log.Printf("[DEBUG] Kubernetes server version is %s", v)
+	k.serverVersion.info = info
+	k.serverVersion.version = v
+	return info, nil
+}
+
+// ServerVersion returns the parsed version of the API server.
+func (k kubeClientsets) ServerVersion() (*gversion.Version, error) {
+	if _, err := k.ServerVersionInfo(); err != nil {
+		return nil, err
+	}
+	return k.serverVersion.version, nil
+}
+
+// checkServerVersion fails when the API server does not satisfy the
+// expect_kubernetes_version constraint. The server version is only looked up
+// when a constraint is set. A failed lookup does not fail the check, so the
+// error of the request which needed the client is reported instead.
+func (k kubeClientsets) checkServerVersion() error {
+	if len(k.expectedVersion) == 0 || k.serverVersion == nil {
+		return nil
+	}
+	k.serverVersion.check.Do(func() {
+		info, err := k.ServerVersionInfo()
+		if err != nil {
+			if _, ok := err.(*errIncompleteConfiguration); !ok {
+				log.Printf("[WARN] Skipping the expect_kubernetes_version check: %s", err)
+			}
+			return
+		}
+		k.serverVersion.checkErr = checkServerVersionConstraint(info.GitVersion, k.serverVersion.version, k.expectedVersion)
+	})
+	return k.serverVersion.checkErr
+}
+
+func checkServerVersionConstraint(gitVersion string, v *gversion.Version, c gversion.Constraints) error {
+	// Versions of managed clusters carry a pre-release suffix, e.g.
+	// v1.27.3-eks-a5565ad, which never satisfies a constraint without one.
+	if !c.Check(v.Core()) {
+		return fmt.Errorf("Cluster reports %s which does not satisfy %s, see expect_kubernetes_version", gitVersion, c)
+	}
+	return nil
+}
+
+func validateVersionConstraint(value interface{}, key string) (ws []string, es []error) {
+	if _, err := gversion.NewConstraint(value.(string)); err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid version constraint: %s", key, value, err))
+	}
+	return
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData, terraformVersion string) (interface{}, diag.Diagnostics) {
 	// Config initialization
 	cfg, err := initializeConfiguration(d)
-	if err != nil {
-		return nil, diag.FromErr(err)
-	}
-	if cfg == nil {
-		// This is a TEMPORARY measure to work around https://github.com/hashicorp/terraform/issues/24055
-		// IMPORTANT: this will NOT enable a workaround of issue: https://github.com/hashicorp/terraform/issues/4149
-		// IMPORTANT: if the supplied configuration is incomplete or invalid
-		///IMPORTANT: provider operations will fail or attempt to connect to localhost endpoints
+	var configErr error
+	if _, ok := err.(*errIncompleteConfiguration); ok {
+		// The host and credentials may only be known after apply, e.g. when the
+		// cluster is created in the same run. Clients are built on first use, so
+		// the error is only reported by the operations which need a client.
+		log.Printf("[WARN] %s", err)
+		configErr = err
 		cfg = &restclient.Config{}
+	} else if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	cfg.UserAgent = fmt.Sprintf("HashiCorp/1.0 Terraform/%s", terraformVersion)
+	configureRateLimiting(cfg, d)
+	logAPIRequests := d.Get("log_api_requests").(bool)
+	cfg.Wrap(apilogging.NewTransport(logAPIRequests))
 
 	if logging.IsDebugOrHigher() {
 		log.Printf("[DEBUG] Enabling HTTP requests/responses tracing")
-		cfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		cfg.Wrap(func(rt http.RoundTripper) http.RoundTripper {
 			return logging.NewTransport("Kubernetes", rt)
+		})
+	}
+
+	ignoreAnnotations, err := expandIgnorePatterns(d.Get("ignore_annotations").([]interface{}))
+	if err != nil {
+		return nil, diag.Errorf("Invalid ignore_annotations: %s", err)
+	}
+	ignoreLabels, err := expandIgnorePatterns(d.Get("ignore_labels").([]interface{}))
+	if err != nil {
+		return nil, diag.Errorf("Invalid ignore_labels: %s", err)
+	}
+
+	var expectedVersion gversion.Constraints
+	if v := d.Get("expect_kubernetes_version").(string); v != "" {
+		expectedVersion, err = gversion.NewConstraint(v)
+		if err != nil {
+			return nil, diag.Errorf("Invalid expect_kubernetes_version: %s", err)
 		}
 	}
 
 	m := kubeClientsets{
 		config:              cfg,
-		mainClientset:       nil,
-		aggregatorClientset: nil,
+		configErr:           configErr,
+		clients:             &clientsetsCache{},
 		configData:          d,
+		ignoreAnnotations:   ignoreAnnotations,
+		ignoreLabels:        ignoreLabels,
+		serverSideApply:     d.Get("server_side_apply").(bool),
+		logAPIRequests:      logAPIRequests,
+		validatePodSecurity: d.Get("validate_pod_security").(bool),
+		serverVersion:       &serverVersionCache{},
+		expectedVersion:     expectedVersion,
+		connections:         &clusterConnectionCache{connections: map[string]kubeClientsets{}},
 	}
 	return m, diag.Diagnostics{}
 }
 
-func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error) {
-	overrides := &clientcmd.ConfigOverrides{}
-	loader := &clientcmd.ClientConfigLoadingRules{}
+// kubeconfigPaths returns the kube config files to load. Arguments set in the
+// provider block take precedence over the environment, so config_paths wins
+// over KUBE_CONFIG_PATH. config_path has no DefaultFunc for that reason: the
+// raw configuration is not available when the provider is configured, so the
+// value of KUBE_CONFIG_PATH could not be told apart from the same path set in
+// the provider block.
+func kubeconfigPaths(d *schema.ResourceData) []string {
+	configPath := d.Get("config_path").(string)
+	configPaths := expandStringSlice(d.Get("config_paths").([]interface{}))
 
-	configPaths := []string{}
-
-	if v, ok := d.Get("config_path").(string); ok && v != "" {
-		configPaths = []string{v}
-	} else if v, ok := d.Get("config_paths").([]interface{}); ok && len(v) > 0 {
-		for _, p := range v {
-			configPaths = append(configPaths, p.(string))
-		}
-	} else if v := os.Getenv("KUBE_CONFIG_PATHS"); v != "" {
+	switch {
+	case configPath != "":
+		return []string{configPath}
+	case len(configPaths) > 0:
+		return configPaths
+	case os.Getenv("KUBE_CONFIG_PATH") != "":
+		return []string{os.Getenv("KUBE_CONFIG_PATH")}
+	case os.Getenv("KUBE_CONFIG_PATHS") != "":
 		// NOTE we have to do this here because the schema
 		// does not yet allow you to set a default for a TypeList
-		configPaths = filepath.SplitList(v)
+		return filepath.SplitList(os.Getenv("KUBE_CONFIG_PATHS"))
 	}
+	return []string{}
+}
+
+// configureRateLimiting sets the client-side rate limits shared by all
+// clientsets. A negative QPS keeps client-go from creating a rate limiter.
+func configureRateLimiting(cfg *restclient.Config, d *schema.ResourceData) {
+	if d.Get("disable_client_rate_limiting").(bool) {
+		cfg.QPS = -1
+		cfg.Burst = 0
+		log.Printf("[TRACE] Client-side rate limiting of Kubernetes API requests is disabled")
+		return
+	}
+	cfg.QPS = float32(d.Get("qps").(float64))
+	cfg.Burst = d.Get("burst").(int)
+	log.Printf("[TRACE] Rate limiting Kubernetes API requests to %v QPS with a burst of %d", cfg.QPS, cfg.Burst)
+}
+
+func expandIgnorePatterns(in []interface{}) ([]*regexp.Regexp, error) {
+	patterns := make([]*regexp.Regexp, 0, len(in))
+	for _, p := range in {
+		s, ok := p.(string)
+		if !ok {
+			continue
+		}
+		r, err := regexp.Compile(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile %q: %s", s, err)
+		}
+		patterns = append(patterns, r)
+	}
+	return patterns, nil
+}
+
+func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error) {
+	overrides := &clientcmd.ConfigOverrides{}
+	loader := &clientcmd.ClientConfigLoadingRules{}
+
+	configPaths := kubeconfigPaths(d)
 
 	if len(configPaths) > 0 {
 		expandedPaths := []string{}
@@ -477,14 +960,23 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 	if v, ok := d.GetOk("token"); ok {
 		overrides.AuthInfo.Token = v.(string)
 	}
+	if v, ok := d.GetOk("token_file"); ok {
+		path, err := homedir.Expand(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		overrides.AuthInfo.TokenFile = path
+	}
 
 	if v, ok := d.GetOk("exec"); ok {
 		exec := &clientcmdapi.ExecConfig{}
 		if spec, ok := v.([]interface{})[0].(map[string]interface{}); ok {
-			exec.InteractiveMode = clientcmdapi.IfAvailableExecInteractiveMode
+			exec.InteractiveMode = clientcmdapi.ExecInteractiveMode(spec["interactive_mode"].(string))
 			exec.APIVersion = spec["api_version"].(string)
 			exec.Command = spec["command"].(string)
 			exec.Args = expandStringSlice(spec["args"].([]interface{}))
+			exec.InstallHint = spec["install_hint"].(string)
+			exec.ProvideClusterInfo = spec["provide_cluster_info"].(bool)
 			for kk, vv := range spec["env"].(map[string]interface{}) {
 				exec.Env = append(exec.Env, clientcmdapi.ExecEnvVar{Name: kk, Value: vv.(string)})
 			}
@@ -494,20 +986,112 @@ func initializeConfiguration(d *schema.ResourceData) (*restclient.Config, error)
 		overrides.AuthInfo.Exec = exec
 	}
 
+	var proxyURL *url.URL
 	if v, ok := d.GetOk("proxy_url"); ok {
+		u, err := parseProxyURL(v.(string))
+		if err != nil {
+			return nil, err
+		}
+		proxyURL = u
 		overrides.ClusterDefaults.ProxyURL = v.(string)
 	}
+	if v, ok := d.GetOk("tls_server_name"); ok {
+		overrides.ClusterInfo.TLSServerName = v.(string)
+	}
+	var timeout time.Duration
+	if v, ok := d.GetOk("timeout"); ok {
+		t, err := time.ParseDuration(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse timeout %q: %s", v, err)
+		}
+		if t < 0 {
+			return nil, fmt.Errorf("Failed to parse timeout %q: must not be negative", v)
+		}
+		timeout = t
+	}
 
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
 	cfg, err := cc.ClientConfig()
 	if err != nil {
-		log.Printf("[WARN] Invalid provider configuration was supplied. Provider operations likely to fail: %v", err)
-		return nil, nil
+		return nil, &errIncompleteConfiguration{err: err}
+	}
+
+	// The in-cluster configuration only takes the host, token and CA from the
+	// overrides, so the transport settings and the impersonation are applied
+	// to the result as well.
+	if imp := expandImpersonationConfig(d.Get("impersonate").([]interface{})); imp.UserName != "" {
+		cfg.Impersonate = imp
+	}
+	if proxyURL != nil {
+		cfg.Proxy = http.ProxyURL(proxyURL)
+	}
+	if overrides.ClusterInfo.TLSServerName != "" {
+		cfg.TLSClientConfig.ServerName = overrides.ClusterInfo.TLSServerName
+	}
+	if timeout > 0 {
+		cfg.Timeout = timeout
+	}
+	if cfg.BearerTokenFile != "" {
+		// client-go only reloads a token file once a minute. Reading it through
+		// a resettable token source also reloads it after an unauthorized
+		// response, so applies outliving a rotated token recover immediately.
+		ts := transport.NewCachedFileTokenSource(cfg.BearerTokenFile)
+		cfg.Wrap(transport.ResettableTokenSourceWrapTransport(ts))
+		cfg.BearerToken = ""
+		cfg.BearerTokenFile = ""
 	}
 
 	return cfg, nil
 }
 
+// expandImpersonationConfig falls back to the KUBE_AS environment variables
+// when the impersonate block is not set, since a block cannot have a default.
+func expandImpersonationConfig(l []interface{}) restclient.ImpersonationConfig {
+	if len(l) == 0 || l[0] == nil {
+		c := restclient.ImpersonationConfig{
+			UserName: os.Getenv("KUBE_AS"),
+			UID:      os.Getenv("KUBE_AS_UID"),
+		}
+		if v := os.Getenv("KUBE_AS_GROUPS"); v != "" {
+			c.Groups = strings.Split(v, ",")
+		}
+		return c
+	}
+	in := l[0].(map[string]interface{})
+	c := restclient.ImpersonationConfig{
+		UserName: in["user_name"].(string),
+		UID:      in["uid"].(string),
+		Groups:   expandStringSlice(in["groups"].([]interface{})),
+	}
+	for _, e := range in["extra"].([]interface{}) {
+		extra := e.(map[string]interface{})
+		if c.Extra == nil {
+			c.Extra = map[string][]string{}
+		}
+		key := extra["key"].(string)
+		c.Extra[key] = append(c.Extra[key], expandStringSlice(extra["values"].([]interface{}))...)
+	}
+	return c
+}
+
+// parseProxyURL parses the proxy_url argument, so that an invalid value fails
+// the provider configuration instead of being logged and ignored by client-go.
+func parseProxyURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse proxy_url %q: %s", s, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("Failed to parse proxy_url %q: unsupported scheme %q, must be one of http, https or socks5", s, u.Scheme)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("Failed to parse proxy_url %q: missing host", s)
+	}
+	return u, nil
+}
+
 var useadmissionregistrationv1beta1 *bool
 
 func useAdmissionregistrationV1beta1(conn *kubernetes.Clientset) (bool, error) {
@@ -4,18 +4,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 // Global constants for testing images (reduces the number of docker pulls).
@@ -109,6 +117,493 @@ func TestProvider_configure_paths(t *testing.T) {
 	}
 }
 
+func TestProvider_configure_ignore_invalid(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"ignore_labels": []interface{}{"^linkerd\\.io/", "(unclosed"},
+	})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if !diags.HasError() {
+		t.Fatal("Expected an invalid ignore_labels pattern to fail provider configuration")
+	}
+	if !strings.Contains(diags[0].Summary, "ignore_labels") {
+		t.Fatalf("Expected the error to name ignore_labels, got %q", diags[0].Summary)
+	}
+}
+
+func TestProvider_configure_unknown_host(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	// Terraform configures the provider with unknown values during plan when
+	// the cluster is created in the same run.
+	p := Provider()
+	block := schema.InternalMap(p.Schema).CoreConfigSchema()
+	attrs := map[string]cty.Value{}
+	for name, ty := range block.ImpliedType().AttributeTypes() {
+		attrs[name] = cty.NullVal(ty)
+	}
+	attrs["host"] = cty.UnknownVal(cty.String)
+	attrs["cluster_ca_certificate"] = cty.UnknownVal(cty.String)
+	attrs["token"] = cty.UnknownVal(cty.String)
+
+	diags := p.Configure(ctx, terraform.NewResourceConfigShimmed(cty.ObjectVal(attrs), block))
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	meta := p.Meta().(KubeClientsets)
+	if _, err := meta.MainClientset(); !isErrIncompleteConfiguration(err) {
+		t.Errorf("Expected the main clientset to report the incomplete configuration, got %v", err)
+	}
+	if _, err := meta.DynamicClient(); !isErrIncompleteConfiguration(err) {
+		t.Errorf("Expected the dynamic client to report the incomplete configuration, got %v", err)
+	}
+	if _, err := meta.AggregatorClientset(); !isErrIncompleteConfiguration(err) {
+		t.Errorf("Expected the aggregator clientset to report the incomplete configuration, got %v", err)
+	}
+	if _, err := meta.ServerVersion(); !isErrIncompleteConfiguration(err) {
+		t.Errorf("Expected the server version lookup to report the incomplete configuration, got %v", err)
+	}
+	if err := customizeDiffGatewayAPIV1(meta, gatewayV1Resource.Resource); err != nil {
+		t.Errorf("Expected the Gateway API discovery to be skipped, got %s", err)
+	}
+}
+
+func isErrIncompleteConfiguration(err error) bool {
+	_, ok := err.(*errIncompleteConfiguration)
+	return ok
+}
+
+func TestProvider_configure_proxy_url(t *testing.T) {
+	ctx := context.TODO()
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_CONFIG_PATH", "test-fixtures/kube-config.yaml")
+	os.Setenv("KUBE_CTX", "gcp")
+	os.Setenv("KUBE_PROXY_URL", "ftp://proxy.example.com:3128")
+
+	rc := terraform.NewResourceConfigRaw(map[string]interface{}{})
+	p := Provider()
+	diags := p.Configure(ctx, rc)
+	if !diags.HasError() {
+		t.Fatal("Expected a proxy_url with an unsupported scheme to fail provider configuration")
+	}
+	if !strings.Contains(diags[0].Summary, "proxy_url") {
+		t.Fatalf("Expected the error to name proxy_url, got %q", diags[0].Summary)
+	}
+}
+
+func TestProvider_configure_transport(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"config_path":     "test-fixtures/kube-config.yaml",
+		"config_context":  "gcp",
+		"proxy_url":       "socks5://proxy.example.com:1080",
+		"tls_server_name": "kubernetes.internal",
+		"timeout":         "30s",
+	})
+	cfg, err := initializeConfiguration(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg == nil {
+		t.Fatal("Expected a client configuration")
+	}
+	if cfg.TLSClientConfig.ServerName != "kubernetes.internal" {
+		t.Errorf("Expected server name %q, got %q", "kubernetes.internal", cfg.TLSClientConfig.ServerName)
+	}
+	if cfg.Timeout != 30*time.Second {
+		t.Errorf("Expected timeout %s, got %s", 30*time.Second, cfg.Timeout)
+	}
+	if cfg.Proxy == nil {
+		t.Fatal("Expected a proxy function")
+	}
+	u, err := cfg.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.String() != "socks5://proxy.example.com:1080" {
+		t.Errorf("Expected proxy %q, got %q", "socks5://proxy.example.com:1080", u)
+	}
+}
+
+func TestInitializeConfiguration_precedence(t *testing.T) {
+	cases := []struct {
+		name        string
+		env         map[string]string
+		raw         map[string]interface{}
+		host        string
+		bearerToken string
+		certData    string
+	}{
+		{
+			name: "kubeconfig from env",
+			env:  map[string]string{"KUBE_CONFIG_PATH": "test-fixtures/kube-config-other.yaml"},
+			raw:  map[string]interface{}{},
+			host: "https://10.0.0.1:6443", bearerToken: "other-token",
+		},
+		{
+			name: "config_paths over KUBE_CONFIG_PATH",
+			env:  map[string]string{"KUBE_CONFIG_PATH": "test-fixtures/kube-config.yaml", "KUBE_CTX": "gcp"},
+			raw: map[string]interface{}{
+				"config_paths":   []interface{}{"test-fixtures/kube-config.yaml", "test-fixtures/kube-config-other.yaml"},
+				"config_context": "other",
+			},
+			host: "https://10.0.0.1:6443", bearerToken: "other-token",
+		},
+		{
+			name: "config_path equal to KUBE_CONFIG_PATH over config_paths",
+			env:  map[string]string{"KUBE_CONFIG_PATH": "test-fixtures/kube-config-other.yaml"},
+			raw: map[string]interface{}{
+				"config_path":  "test-fixtures/kube-config-other.yaml",
+				"config_paths": []interface{}{"test-fixtures/kube-config.yaml"},
+			},
+			host: "https://10.0.0.1:6443", bearerToken: "other-token",
+		},
+		{
+			name: "config_path over KUBE_CONFIG_PATHS",
+			env:  map[string]string{"KUBE_CONFIG_PATHS": "test-fixtures/kube-config.yaml"},
+			raw:  map[string]interface{}{"config_path": "test-fixtures/kube-config-other.yaml"},
+			host: "https://10.0.0.1:6443", bearerToken: "other-token",
+		},
+		{
+			name: "config_context over KUBE_CTX",
+			env:  map[string]string{"KUBE_CTX": "gcp"},
+			raw: map[string]interface{}{
+				"config_paths":   []interface{}{"test-fixtures/kube-config.yaml", "test-fixtures/kube-config-other.yaml"},
+				"config_context": "other",
+			},
+			host: "https://10.0.0.1:6443", bearerToken: "other-token",
+		},
+		{
+			name: "host and token over kubeconfig",
+			env:  map[string]string{"KUBE_TOKEN": "env-token"},
+			raw: map[string]interface{}{
+				"config_path": "test-fixtures/kube-config-other.yaml",
+				"host":        "https://192.168.0.1",
+				"token":       "argument-token",
+			},
+			host: "https://192.168.0.1", bearerToken: "argument-token",
+		},
+		{
+			name: "client certificate over kubeconfig",
+			env:  map[string]string{"KUBE_HOST": "https://192.168.0.2"},
+			raw: map[string]interface{}{
+				"config_path":        "test-fixtures/kube-config.yaml",
+				"config_context":     "gcp",
+				"client_certificate": "certificate",
+				"client_key":         "key",
+			},
+			host: "https://192.168.0.2", certData: "certificate",
+		},
+		{
+			name: "static configuration without kubeconfig",
+			env:  map[string]string{"KUBE_HOST": "https://192.168.0.3", "KUBE_TOKEN": "env-token"},
+			raw:  map[string]interface{}{"cluster_ca_certificate": "ca"},
+			host: "https://192.168.0.3", bearerToken: "env-token",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			resetEnv := unsetEnv(t)
+			defer resetEnv()
+			for k, v := range tc.env {
+				os.Setenv(k, v)
+			}
+
+			d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+			cfg, err := initializeConfiguration(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg == nil {
+				t.Fatal("Expected a client configuration")
+			}
+			if cfg.Host != tc.host {
+				t.Errorf("Expected host %q, got %q", tc.host, cfg.Host)
+			}
+			if cfg.BearerToken != tc.bearerToken {
+				t.Errorf("Expected bearer token %q, got %q", tc.bearerToken, cfg.BearerToken)
+			}
+			if string(cfg.TLSClientConfig.CertData) != tc.certData {
+				t.Errorf("Expected client certificate %q, got %q", tc.certData, string(cfg.TLSClientConfig.CertData))
+			}
+		})
+	}
+}
+
+type recordingRoundTripper struct {
+	authorization []string
+}
+
+func (rt *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.authorization = append(rt.authorization, req.Header.Get("Authorization"))
+	return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody, Request: req}, nil
+}
+
+func TestInitializeConfiguration_tokenFileRotation(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"host":       "https://127.0.0.1",
+		"insecure":   true,
+		"token_file": tokenFile,
+	})
+	cfg, err := initializeConfiguration(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg == nil {
+		t.Fatal("Expected a client configuration")
+	}
+
+	recorder := &recordingRoundTripper{}
+	rt, err := restclient.HTTPWrappersForConfig(cfg, recorder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://127.0.0.1/api", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An unauthorized response to a request made after the token was read
+	// drops the cached token, so the next request reads the rewritten file.
+	for i := 0; i < 2; i++ {
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(tokenFile, []byte("second-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Bearer first-token", "Bearer first-token", "Bearer second-token"}
+	if diff := cmp.Diff(expected, recorder.authorization); diff != "" {
+		t.Fatalf("Unexpected Authorization headers (-want +got):\n%s", diff)
+	}
+}
+
+func TestInitializeConfiguration_exec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("The stub credential plugin is a shell script")
+	}
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	// The stub prints a token built from its environment and arguments, and
+	// whether it received the cluster information.
+	plugin := filepath.Join(t.TempDir(), "credential-plugin")
+	script := `#!/bin/sh
+cluster=no
+case "$KUBERNETES_EXEC_INFO" in *'"server":"https://127.0.0.1"'*) cluster=yes ;; esac
+printf '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"%s-%s-%s"}}' "$STUB_TOKEN" "$1" "$cluster"
+`
+	if err := os.WriteFile(plugin, []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	d := schema.TestResourceDataRaw(t, Provider().Schema, map[string]interface{}{
+		"host":     "https://127.0.0.1",
+		"insecure": true,
+		"exec": []interface{}{
+			map[string]interface{}{
+				"api_version":          "client.authentication.k8s.io/v1",
+				"command":              plugin,
+				"args":                 []interface{}{"argument"},
+				"env":                  map[string]interface{}{"STUB_TOKEN": "stub"},
+				"interactive_mode":     "Never",
+				"install_hint":         "Install the stub plugin",
+				"provide_cluster_info": true,
+			},
+		},
+	})
+	cfg, err := initializeConfiguration(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg == nil {
+		t.Fatal("Expected a client configuration")
+	}
+	if cfg.ExecProvider == nil {
+		t.Fatal("Expected an exec provider")
+	}
+	if cfg.ExecProvider.InteractiveMode != clientcmdapi.NeverExecInteractiveMode {
+		t.Errorf("Expected interactive mode %q, got %q", clientcmdapi.NeverExecInteractiveMode, cfg.ExecProvider.InteractiveMode)
+	}
+	if cfg.ExecProvider.InstallHint != "Install the stub plugin" {
+		t.Errorf("Expected install hint %q, got %q", "Install the stub plugin", cfg.ExecProvider.InstallHint)
+	}
+
+	recorder := &recordingRoundTripper{}
+	rt, err := restclient.HTTPWrappersForConfig(cfg, recorder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://127.0.0.1/api", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Bearer stub-argument-yes"}
+	if diff := cmp.Diff(expected, recorder.authorization); diff != "" {
+		t.Fatalf("Unexpected Authorization headers (-want +got):\n%s", diff)
+	}
+}
+
+func TestCheckServerVersionConstraint(t *testing.T) {
+	cases := []struct {
+		gitVersion string
+		constraint string
+		satisfied  bool
+	}{
+		{"v1.27.3", ">= 1.26", true},
+		{"v1.27.3-eks-a5565ad", ">= 1.26", true},
+		{"v1.26.0-gke.1000", ">= 1.26, < 1.28", true},
+		{"v1.23.17", ">= 1.26", false},
+		{"v1.28.1+k3s1", "~> 1.27.0", false},
+	}
+	for _, tc := range cases {
+		v, err := gversion.NewVersion(tc.gitVersion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := gversion.NewConstraint(tc.constraint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = checkServerVersionConstraint(tc.gitVersion, v, c)
+		if tc.satisfied && err != nil {
+			t.Errorf("Expected %s to satisfy %q, got %s", tc.gitVersion, tc.constraint, err)
+		}
+		if !tc.satisfied && err == nil {
+			t.Errorf("Expected %s not to satisfy %q", tc.gitVersion, tc.constraint)
+		}
+	}
+
+	v, _ := gversion.NewVersion("v1.23.17")
+	c, _ := gversion.NewConstraint(">=1.26")
+	err := checkServerVersionConstraint("v1.23.17", v, c)
+	if err == nil || err.Error() != "Cluster reports v1.23.17 which does not satisfy >=1.26, see expect_kubernetes_version" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestConfigureRateLimiting(t *testing.T) {
+	cases := []struct {
+		raw   map[string]interface{}
+		qps   float32
+		burst int
+	}{
+		{map[string]interface{}{}, 50, 100},
+		{map[string]interface{}{"qps": 20.5, "burst": 40}, 20.5, 40},
+		{map[string]interface{}{"qps": 20.5, "disable_client_rate_limiting": true}, -1, 0},
+	}
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, Provider().Schema, tc.raw)
+		cfg := &restclient.Config{}
+		configureRateLimiting(cfg, d)
+		if cfg.QPS != tc.qps || cfg.Burst != tc.burst {
+			t.Errorf("Expected %v QPS with a burst of %d for %v, got %v and %d", tc.qps, tc.burst, tc.raw, cfg.QPS, cfg.Burst)
+		}
+	}
+}
+
+func TestExpandImpersonationConfig(t *testing.T) {
+	resetEnv := unsetEnv(t)
+	defer resetEnv()
+
+	os.Setenv("KUBE_AS", "env-user")
+	os.Setenv("KUBE_AS_GROUPS", "devs,ops")
+
+	cases := []struct {
+		in       []interface{}
+		expected restclient.ImpersonationConfig
+	}{
+		{
+			[]interface{}{},
+			restclient.ImpersonationConfig{
+				UserName: "env-user",
+				Groups:   []string{"devs", "ops"},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"user_name": "terraform",
+					"uid":       "1234",
+					"groups":    []interface{}{"deployers"},
+					"extra": []interface{}{
+						map[string]interface{}{
+							"key":    "scopes",
+							"values": []interface{}{"view", "edit"},
+						},
+					},
+				},
+			},
+			restclient.ImpersonationConfig{
+				UserName: "terraform",
+				UID:      "1234",
+				Groups:   []string{"deployers"},
+				Extra:    map[string][]string{"scopes": {"view", "edit"}},
+			},
+		},
+	}
+	for _, tc := range cases {
+		out := expandImpersonationConfig(tc.in)
+		if diff := cmp.Diff(tc.expected, out); diff != "" {
+			t.Errorf("Unexpected impersonation config (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestParseProxyURL(t *testing.T) {
+	cases := []struct {
+		in    string
+		valid bool
+	}{
+		{"http://proxy.example.com:3128", true},
+		{"https://proxy.example.com", true},
+		{"socks5://127.0.0.1:1080", true},
+		{"ftp://proxy.example.com", false},
+		{"proxy.example.com:3128", false},
+		{"http://", false},
+	}
+	for _, tc := range cases {
+		_, err := parseProxyURL(tc.in)
+		if tc.valid && err != nil {
+			t.Errorf("Expected %q to be valid, got %s", tc.in, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("Expected %q to be invalid", tc.in)
+		}
+	}
+}
+
 func unsetEnv(t *testing.T) func() {
 	e := getEnv()
 
@@ -126,6 +621,13 @@ func unsetEnv(t *testing.T) func() {
 		"KUBE_CLUSTER_CA_CERT_DATA": e.ClusterCACertData,
 		"KUBE_INSECURE":             e.Insecure,
 		"KUBE_TOKEN":                e.Token,
+		"KUBE_TOKEN_FILE":           e.TokenFile,
+		"KUBE_PROXY_URL":            e.ProxyURL,
+		"KUBE_TLS_SERVER_NAME":      e.TLSServerName,
+		"KUBE_TIMEOUT":              e.Timeout,
+		"KUBE_AS":                   e.As,
+		"KUBE_AS_GROUPS":            e.AsGroups,
+		"KUBE_AS_UID":               e.AsUID,
 	}
 
 	for k, _ := range envVars {
@@ -156,6 +658,13 @@ func getEnv() *currentEnv {
 		ClusterCACertData: os.Getenv("KUBE_CLUSTER_CA_CERT_DATA"),
 		Insecure:          os.Getenv("KUBE_INSECURE"),
 		Token:             os.Getenv("KUBE_TOKEN"),
+		TokenFile:         os.Getenv("KUBE_TOKEN_FILE"),
+		ProxyURL:          os.Getenv("KUBE_PROXY_URL"),
+		TLSServerName:     os.Getenv("KUBE_TLS_SERVER_NAME"),
+		Timeout:           os.Getenv("KUBE_TIMEOUT"),
+		As:                os.Getenv("KUBE_AS"),
+		AsGroups:          os.Getenv("KUBE_AS_GROUPS"),
+		AsUID:             os.Getenv("KUBE_AS_UID"),
 	}
 	if v := os.Getenv("KUBE_CONFIG_PATH"); v != "" {
 		e.ConfigPath = v
@@ -300,6 +809,16 @@ func skipIfNotRunningInMinikube(t *testing.T) {
 	}
 }
 
+func skipIfNotRunningInKind(t *testing.T) {
+	isInKind, err := isRunningInKind()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isInKind {
+		t.Skip("The Kubernetes endpoint must come from kind for this test to run - skipping")
+	}
+}
+
 func skipIfRunningInMinikube(t *testing.T) {
 	isInMinikube, err := isRunningInMinikube()
 	if err != nil {
@@ -310,6 +829,70 @@ func skipIfRunningInMinikube(t *testing.T) {
 	}
 }
 
+func skipIfNotDualStack(t *testing.T) {
+	node, err := getFirstNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(node.Spec.PodCIDRs) < 2 {
+		t.Skip("The Kubernetes cluster must be configured for IPv4/IPv6 dual-stack networking for this test to run - skipping")
+	}
+}
+
+func gatewayAPIInstalled(t *testing.T) bool {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkGatewayAPIV1Installed(conn, httpRouteV1Resource.Resource)
+	if _, ok := err.(*errGatewayAPINotInstalled); ok {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return true
+}
+
+func skipIfNoGatewayAPI(t *testing.T) {
+	if !gatewayAPIInstalled(t) {
+		t.Skip("The Kubernetes cluster must have the Gateway API CRDs installed for this test to run - skipping")
+	}
+}
+
+func skipIfGatewayAPI(t *testing.T) {
+	if gatewayAPIInstalled(t) {
+		t.Skip("The Kubernetes cluster must not have the Gateway API CRDs installed for this test to run - skipping")
+	}
+}
+
+func volumeSnapshotAPIInstalled(t *testing.T) bool {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkVolumeSnapshotV1Installed(conn, volumeSnapshotV1Resource.Resource)
+	if _, ok := err.(*errVolumeSnapshotAPINotInstalled); ok {
+		return false
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return true
+}
+
+func skipIfNoVolumeSnapshotAPI(t *testing.T) {
+	if !volumeSnapshotAPIInstalled(t) {
+		t.Skip("The Kubernetes cluster must have the volume snapshot CRDs installed for this test to run - skipping")
+	}
+}
+
+func skipIfVolumeSnapshotAPI(t *testing.T) {
+	if volumeSnapshotAPIInstalled(t) {
+		t.Skip("The Kubernetes cluster must not have the volume snapshot CRDs installed for this test to run - skipping")
+	}
+}
+
 func skipIfUnsupportedSecurityContextRunAsGroup(t *testing.T) {
 	skipIfClusterVersionLessThan(t, "1.14.0")
 }
@@ -327,6 +910,15 @@ func isRunningInMinikube() (bool, error) {
 	return false, nil
 }
 
+func isRunningInKind() (bool, error) {
+	node, err := getFirstNode()
+	if err != nil {
+		return false, err
+	}
+
+	return strings.HasPrefix(node.Spec.ProviderID, "kind://"), nil
+}
+
 func isRunningInGke() (bool, error) {
 	node, err := getFirstNode()
 	if err != nil {
@@ -435,6 +1027,13 @@ type currentEnv struct {
 	ClusterCACertData string
 	Insecure          string
 	Token             string
+	TokenFile         string
+	ProxyURL          string
+	TLSServerName     string
+	Timeout           string
+	As                string
+	AsGroups          string
+	AsUID             string
 }
 
 func requiredProviders() string {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
 )
 
@@ -220,6 +221,53 @@ func TestAccKubernetesPod_with_pod_anti_affinity_with_preferred_during_schedulin
 	})
 }
 
+func TestAccKubernetesPod_with_pod_anti_affinity_with_namespace_selector(t *testing.T) {
+	var conf api.Pod
+	podName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := nginxImageVersion
+	keyName := "spec.0.affinity.0.pod_anti_affinity.0.preferred_during_scheduling_ignored_during_execution"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithPodAntiAffinityWithNamespaceSelector(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists("kubernetes_pod.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.#", keyName), "2"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.0.pod_affinity_term.0.namespace_selector.#", keyName), "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.0.pod_affinity_term.0.namespace_selector.0.match_labels.%%", keyName), "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.0.pod_affinity_term.0.namespace_selector.0.match_labels.team", keyName), "web"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.1.pod_affinity_term.0.namespace_selector.#", keyName), "1"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.1.pod_affinity_term.0.namespace_selector.0.match_labels.%%", keyName), "0"),
+					resource.TestCheckResourceAttr("kubernetes_pod.test", fmt.Sprintf("%s.1.pod_affinity_term.0.namespace_selector.0.match_expressions.#", keyName), "0"),
+					func(s *terraform.State) error {
+						terms := conf.Spec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution
+						if terms[0].PodAffinityTerm.LabelSelector == nil {
+							return fmt.Errorf("Expected the label selector of the first term to be set")
+						}
+						if terms[1].PodAffinityTerm.LabelSelector != nil {
+							return fmt.Errorf("Expected no label selector in the second term, got %#v", terms[1].PodAffinityTerm.LabelSelector)
+						}
+						if terms[1].PodAffinityTerm.NamespaceSelector == nil {
+							return fmt.Errorf("Expected an empty namespace selector in the second term")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "kubernetes_pod.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func testAccKubernetesPodConfigWithNodeAffinityWithRequiredDuringSchedulingIgnoredDuringExecution(podName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
   metadata {
@@ -427,3 +475,48 @@ func testAccKubernetesPodConfigWithPodAntiAffinityWithPreferredDuringSchedulingI
 }
     `, podName, imageName)
 }
+
+func testAccKubernetesPodConfigWithPodAntiAffinityWithNamespaceSelector(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    labels = {
+      app = "pod_label"
+    }
+    name = "%s"
+  }
+  spec {
+    affinity {
+      pod_anti_affinity {
+        preferred_during_scheduling_ignored_during_execution {
+          weight = 100
+          pod_affinity_term {
+            label_selector {
+              match_labels = {
+                app = "pod_label"
+              }
+            }
+            namespace_selector {
+              match_labels = {
+                team = "web"
+              }
+            }
+            topology_key = "kubernetes.io/hostname"
+          }
+        }
+        preferred_during_scheduling_ignored_during_execution {
+          weight = 10
+          pod_affinity_term {
+            namespace_selector {}
+            topology_key = "kubernetes.io/hostname"
+          }
+        }
+      }
+    }
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, podName, imageName)
+}
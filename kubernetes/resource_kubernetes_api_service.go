@@ -2,16 +2,19 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"k8s.io/apimachinery/pkg/api/errors"
 	meta_v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
 	v1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+	aggregator "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
 )
 
 func resourceKubernetesAPIService() *schema.Resource {
@@ -23,6 +26,10 @@ func resourceKubernetesAPIService() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("api_service", true),
@@ -35,7 +42,7 @@ func resourceKubernetesAPIService() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"ca_bundle": {
 							Type:        schema.TypeString,
-							Description: "CABundle is a PEM encoded CA bundle which will be used to validate an API server's serving certificate. If unspecified, system trust roots on the apiserver are used.",
+							Description: "CABundle is a PEM encoded CA bundle which will be used to validate an API server's serving certificate. If unspecified, system trust roots on the apiserver are used. The PEM data is given as is, not base64 encoded.",
 							Optional:    true,
 						},
 						"group": {
@@ -97,6 +104,12 @@ func resourceKubernetesAPIService() *schema.Resource {
 					},
 				},
 			},
+			"wait_for_available": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the API service to report the `Available` condition after it is created or updated.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 	}
 }
@@ -121,6 +134,13 @@ func resourceKubernetesAPIServiceCreate(ctx context.Context, d *schema.ResourceD
 	log.Printf("[INFO] Submitted new API service: %#v", out)
 	d.SetId(out.ObjectMeta.Name)
 
+	if d.Get("wait_for_available").(bool) {
+		err = waitForAPIServiceAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesAPIServiceRead(ctx, d, meta)
 }
 
@@ -146,7 +166,7 @@ func resourceKubernetesAPIServiceRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received API service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -187,6 +207,13 @@ func resourceKubernetesAPIServiceUpdate(ctx context.Context, d *schema.ResourceD
 	log.Printf("[INFO] Submitted updated API service: %#v", out)
 	d.SetId(out.ObjectMeta.Name)
 
+	if d.Get("wait_for_available").(bool) {
+		err = waitForAPIServiceAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesAPIServiceRead(ctx, d, meta)
 }
 
@@ -228,3 +255,35 @@ func resourceKubernetesAPIServiceExists(ctx context.Context, d *schema.ResourceD
 	}
 	return true, err
 }
+
+// waitForAPIServiceAvailable waits for the Available condition of the API
+// service to become true. The reason and message of the condition are kept in
+// the error, so a timeout tells why the aggregated API server is unreachable.
+func waitForAPIServiceAvailable(ctx context.Context, conn *aggregator.Clientset, name string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		svc, err := conn.ApiregistrationV1().APIServices().Get(ctx, name, meta_v1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if err := apiServiceAvailable(svc.Status); err != nil {
+			log.Printf("[DEBUG] API service %s: %s", name, err)
+			return resource.RetryableError(fmt.Errorf("API service %s is not available: %s", name, err))
+		}
+		return nil
+	})
+}
+
+// apiServiceAvailable returns an error describing the Available condition of
+// the API service unless it is true.
+func apiServiceAvailable(status v1.APIServiceStatus) error {
+	for _, c := range status.Conditions {
+		if c.Type != v1.Available {
+			continue
+		}
+		if c.Status == v1.ConditionTrue {
+			return nil
+		}
+		return fmt.Errorf("condition %s is %s: %s: %s", c.Type, c.Status, c.Reason, c.Message)
+	}
+	return fmt.Errorf("condition %s is not reported yet", v1.Available)
+}
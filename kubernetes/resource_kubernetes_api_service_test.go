@@ -3,18 +3,21 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 )
 
 func TestAccKubernetesAPIService_basic(t *testing.T) {
 	group := fmt.Sprintf("tf-acc-test-%s.k8s.io", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	version := "v1beta1"
 	name := fmt.Sprintf("%s.%s", version, group)
+	var conf v1.APIService
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
@@ -25,7 +28,7 @@ func TestAccKubernetesAPIService_basic(t *testing.T) {
 			{
 				Config: testAccKubernetesAPIServiceConfig_basic(name, group, version),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test"),
+					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test", &conf),
 					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.name", name),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.generation"),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.resource_version"),
@@ -46,7 +49,7 @@ func TestAccKubernetesAPIService_basic(t *testing.T) {
 			{
 				Config: testAccKubernetesAPIServiceConfig_modified(name, group, version),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test"),
+					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test", &conf),
 					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.name", name),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.generation"),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.resource_version"),
@@ -67,7 +70,7 @@ func TestAccKubernetesAPIService_basic(t *testing.T) {
 			{
 				Config: testAccKubernetesAPIServiceConfig_modified_local_service(name, group, version),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test"),
+					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test", &conf),
 					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.name", name),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.generation"),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.resource_version"),
@@ -85,7 +88,7 @@ func TestAccKubernetesAPIService_basic(t *testing.T) {
 			{
 				Config: testAccKubernetesAPIServiceConfig_basic(name, group, version),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test"),
+					testAccCheckKubernetesAPIServiceExists("kubernetes_api_service.test", &conf),
 					resource.TestCheckResourceAttr("kubernetes_api_service.test", "metadata.0.name", name),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.generation"),
 					resource.TestCheckResourceAttrSet("kubernetes_api_service.test", "metadata.0.resource_version"),
@@ -107,6 +110,109 @@ func TestAccKubernetesAPIService_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesAPIServiceV1_caBundleUpdate(t *testing.T) {
+	group := fmt.Sprintf("tf-acc-test-%s.k8s.io", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	version := "v1beta1"
+	name := fmt.Sprintf("%s.%s", version, group)
+	resourceName := "kubernetes_api_service_v1.test"
+	var conf1, conf2 v1.APIService
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesAPIServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesAPIServiceV1Config_caBundle(name, group, version, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesAPIServiceExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ca_bundle", "first"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_available", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_available"},
+			},
+			{
+				Config: testAccKubernetesAPIServiceV1Config_caBundle(name, group, version, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesAPIServiceExists(resourceName, &conf2),
+					testAccCheckKubernetesAPIServiceNotRecreated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ca_bundle", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesAPIServiceV1_waitForAvailableTimeout(t *testing.T) {
+	group := fmt.Sprintf("tf-acc-test-%s.k8s.io", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	version := "v1beta1"
+	name := fmt.Sprintf("%s.%s", version, group)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesAPIServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesAPIServiceV1Config_waitForAvailable(name, group, version),
+				ExpectError: regexp.MustCompile(`API service .+ is not available: condition Available is False`),
+			},
+		},
+	})
+}
+
+func TestAPIServiceAvailable(t *testing.T) {
+	cases := map[string]struct {
+		Status        v1.APIServiceStatus
+		ExpectedError string
+	}{
+		"available": {
+			Status: v1.APIServiceStatus{Conditions: []v1.APIServiceCondition{
+				{Type: v1.Available, Status: v1.ConditionTrue, Reason: "Passed"},
+			}},
+		},
+		"unavailable": {
+			Status: v1.APIServiceStatus{Conditions: []v1.APIServiceCondition{
+				{Type: v1.Available, Status: v1.ConditionFalse, Reason: "ServiceNotFound", Message: `service/dummy in "default" is not present`},
+			}},
+			ExpectedError: `condition Available is False: ServiceNotFound: service/dummy in "default" is not present`,
+		},
+		"no conditions": {
+			ExpectedError: "condition Available is not reported yet",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := apiServiceAvailable(tc.Status)
+			if tc.ExpectedError == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.ExpectedError {
+				t.Fatalf("Expected error %q, got %v", tc.ExpectedError, err)
+			}
+		})
+	}
+}
+
+func testAccCheckKubernetesAPIServiceNotRecreated(before, after *v1.APIService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.UID != after.UID {
+			return fmt.Errorf("Expected API service to be updated in place, but it was recreated")
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesAPIServiceDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).AggregatorClientset()
 	if err != nil {
@@ -115,7 +221,7 @@ func testAccCheckKubernetesAPIServiceDestroy(s *terraform.State) error {
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "kubernetes_api_service" {
+		if rs.Type != "kubernetes_api_service" && rs.Type != "kubernetes_api_service_v1" {
 			continue
 		}
 
@@ -132,7 +238,7 @@ func testAccCheckKubernetesAPIServiceDestroy(s *terraform.State) error {
 	return nil
 }
 
-func testAccCheckKubernetesAPIServiceExists(n string) resource.TestCheckFunc {
+func testAccCheckKubernetesAPIServiceExists(n string, obj *v1.APIService) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
 		if !ok {
@@ -147,11 +253,12 @@ func testAccCheckKubernetesAPIServiceExists(n string) resource.TestCheckFunc {
 
 		name := rs.Primary.ID
 
-		_, err = conn.ApiregistrationV1().APIServices().Get(ctx, name, metav1.GetOptions{})
+		out, err := conn.ApiregistrationV1().APIServices().Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			return err
 		}
 
+		*obj = *out
 		return nil
 	}
 }
@@ -253,3 +360,57 @@ func testAccKubernetesAPIServiceConfig_modified_local_service(name, group, versi
 }
 `, name, group, version)
 }
+
+func testAccKubernetesAPIServiceV1Config_caBundle(name, group, version, caBundle string) string {
+	return fmt.Sprintf(`resource "kubernetes_api_service_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    service {
+      name      = "metrics-server"
+      namespace = "kube-system"
+    }
+
+    group                  = %q
+    group_priority_minimum = 1
+
+    version          = %q
+    version_priority = 1
+
+    ca_bundle = %q
+  }
+}
+`, name, group, version, caBundle)
+}
+
+func testAccKubernetesAPIServiceV1Config_waitForAvailable(name, group, version string) string {
+	return fmt.Sprintf(`resource "kubernetes_api_service_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    service {
+      name      = "tf-acc-test-missing"
+      namespace = "default"
+    }
+
+    group                  = %q
+    group_priority_minimum = 1
+
+    version          = %q
+    version_priority = 1
+
+    insecure_skip_tls_verify = true
+  }
+
+  wait_for_available = true
+
+  timeouts {
+    create = "30s"
+  }
+}
+`, name, group, version)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	certificates "k8s.io/api/certificates/v1"
 	v1 "k8s.io/api/core/v1"
@@ -73,6 +74,13 @@ func resourceKubernetesCertificateSigningRequestV1() *schema.Resource {
 							Optional:    true,
 							ForceNew:    true,
 						},
+						"expiration_seconds": {
+							Type:         schema.TypeInt,
+							Description:  apiDocSpec["expirationSeconds"],
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntAtLeast(600),
+						},
 					},
 				},
 			},
@@ -144,6 +152,12 @@ func resourceKubernetesCertificateSigningRequestV1Create(ctx context.Context, d
 			return resource.NonRetryableError(err)
 		}
 
+		// A denied or failed request never gets a certificate, so there is no
+		// point in waiting for the timeout.
+		if c := certificateSigningRequestV1FailedCondition(out.Status); c != nil {
+			return resource.NonRetryableError(fmt.Errorf("CertificateSigningRequest %s has condition %s: %s: %s", csrName, c.Type, c.Reason, c.Message))
+		}
+
 		// Check to see if a certificate has been issued, and update status accordingly,
 		// since 'Issued' is not a state ever populated in the Status Conditions.
 		for _, condition := range out.Status.Conditions {
@@ -175,6 +189,20 @@ func resourceKubernetesCertificateSigningRequestV1Create(ctx context.Context, d
 	return resourceKubernetesCertificateSigningRequestV1Read(ctx, d, meta)
 }
 
+// certificateSigningRequestV1FailedCondition returns the Denied or Failed
+// condition of the request, if any.
+func certificateSigningRequestV1FailedCondition(status certificates.CertificateSigningRequestStatus) *certificates.CertificateSigningRequestCondition {
+	for i, c := range status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		if c.Type == certificates.CertificateDenied || c.Type == certificates.CertificateFailed {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
 // resourceKubernetesCertificateSigningRequestV1Read does not return any data, because Read functions exist to
 // sync the local state with the remote state. Since this data is local-only, there is nothing to read.
 func resourceKubernetesCertificateSigningRequestV1Read(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -60,6 +61,51 @@ func TestAccKubernetesCertificateSigningRequestV1_generateName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCertificateSigningRequestV1_expirationSeconds(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.22.0")
+		},
+		IDRefreshName:     "kubernetes_certificate_signing_request_v1.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCertificateSigningRequestV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCertificateSigningRequestV1Config_expirationSeconds(name, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCertificateSigningRequestV1Valid,
+					resource.TestCheckResourceAttrSet("kubernetes_certificate_signing_request_v1.test", "certificate"),
+					resource.TestCheckResourceAttr("kubernetes_certificate_signing_request_v1.test", "spec.0.expiration_seconds", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCertificateSigningRequestV1_failed(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	// The kube-apiserver-client signer only issues client certificates and
+	// marks requests for other usages as failed.
+	usages := []string{"server auth"}
+	signerName := "kubernetes.io/kube-apiserver-client"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.22.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCertificateSigningRequestV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesCertificateSigningRequestV1Config_basic(name, signerName, usages, true),
+				ExpectError: regexp.MustCompile("has condition Failed"),
+			},
+		},
+	})
+}
+
 // testAccCheckKubernetesCertificateSigningRequestV1Valid checks to see that the locally-stored certificate
 // contains a valid PEM preamble. It also checks that the CSR resource has been deleted from Kubernetes, since
 // the CSR is only supposed to exist momentarily as the certificate is generated. (CSR resources are ephemeral
@@ -144,3 +190,27 @@ EOT
 }
 `, generateName)
 }
+
+func testAccKubernetesCertificateSigningRequestV1Config_expirationSeconds(name string, expirationSeconds int) string {
+	return fmt.Sprintf(`resource "kubernetes_certificate_signing_request_v1" "test" {
+  metadata {
+    name = %q
+  }
+  auto_approve = true
+  spec {
+    request            = <<EOT
+-----BEGIN CERTIFICATE REQUEST-----
+MIHSMIGBAgEAMCoxGDAWBgNVBAoTD2V4YW1wbGUgY2x1c3RlcjEOMAwGA1UEAxMF
+YWRtaW4wTjAQBgcqhkjOPQIBBgUrgQQAIQM6AASSG8S2+hQvfMq5ucngPCzK0m0C
+ImigHcF787djpF2QDbz3oQ3QsM/I7ftdjB/HHlG2a5YpqjzT0KAAMAoGCCqGSM49
+BAMCA0AAMD0CHQDErNLjX86BVfOsYh/A4zmjmGknZpc2u6/coTHqAhxcR41hEU1I
+DpNPvh30e0Js8/DYn2YUfu/pQU19
+-----END CERTIFICATE REQUEST-----
+EOT
+    signer_name        = "kubernetes.io/kube-apiserver-client"
+    usages             = ["client auth"]
+    expiration_seconds = %d
+  }
+}
+`, name, expirationSeconds)
+}
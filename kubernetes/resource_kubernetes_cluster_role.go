@@ -26,20 +26,22 @@ func resourceKubernetesClusterRole() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchemaRBAC("clusterRole", false, false),
 			"rule": {
-				Type:        schema.TypeList,
-				Description: "List of PolicyRules for this ClusterRole",
-				Optional:    true,
-				Computed:    true,
-				MinItems:    1,
+				Type:          schema.TypeList,
+				Description:   "List of PolicyRules for this ClusterRole. When `aggregation_rule` is set the rules are managed by the controller and are computed.",
+				Optional:      true,
+				Computed:      true,
+				MinItems:      1,
+				ConflictsWith: []string{"aggregation_rule"},
 				Elem: &schema.Resource{
 					Schema: policyRuleSchema(),
 				},
 			},
 			"aggregation_rule": {
-				Type:        schema.TypeList,
-				Description: "Describes how to build the Rules for this ClusterRole.",
-				Optional:    true,
-				MaxItems:    1,
+				Type:          schema.TypeList,
+				Description:   "Describes how to build the Rules for this ClusterRole. The rules are then populated by the controller and cannot be set in `rule`.",
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"rule"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"cluster_role_selectors": {
@@ -142,7 +144,7 @@ func resourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received cluster role: %#v", cRole)
-	err = d.Set("metadata", flattenMetadata(cRole.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cRole.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -150,11 +152,13 @@ func resourceKubernetesClusterRoleRead(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	aggregationRule := []interface{}{}
 	if cRole.AggregationRule != nil {
-		err = d.Set("aggregation_rule", flattenClusterRoleAggregationRule(cRole.AggregationRule))
-		if err != nil {
-			return diag.FromErr(err)
-		}
+		aggregationRule = flattenClusterRoleAggregationRule(cRole.AggregationRule)
+	}
+	err = d.Set("aggregation_rule", aggregationRule)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 	return nil
 }
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -215,6 +216,72 @@ func TestAccKubernetesClusterRole_aggregationRuleRuleAggregation(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterRole_aggregationRuleMultipleSelectors(t *testing.T) {
+	var conf api.ClusterRole
+	resourceName := "kubernetes_cluster_role.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesClusterRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesClusterRoleConfig_aggRuleMultipleSelectors(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_rule.0.cluster_role_selectors.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_rule.0.cluster_role_selectors.0.match_labels.rbac.example.com/aggregate-to-view", "true"),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_rule.0.cluster_role_selectors.1.match_labels.rbac.example.com/aggregate-to-edit", "true"),
+				),
+			},
+			{
+				// Both aggregated roles contribute their rules.
+				Config: testAccKubernetesClusterRoleConfig_aggRuleMultipleSelectors(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				// Dropping the aggregation rule hands the rules back to the configuration.
+				Config: testAccKubernetesClusterRoleConfig_aggRuleRemoved(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesClusterRoleExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "aggregation_rule.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.resources.0", "configmaps"),
+					func(s *terraform.State) error {
+						if conf.AggregationRule != nil {
+							return fmt.Errorf("Expected aggregation rule to be removed, got %#v", conf.AggregationRule)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesClusterRole_aggregationRuleConflictsWithRule(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesClusterRoleConfig_aggRuleWithRule(name),
+				ExpectError: regexp.MustCompile(`"aggregation_rule": conflicts with rule`),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesClusterRoleDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -478,3 +545,94 @@ resource "kubernetes_cluster_role" "test2" {
 }
 `, name)
 }
+
+func testAccKubernetesClusterRoleConfig_aggRuleMultipleSelectors(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_role" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  aggregation_rule {
+    cluster_role_selectors {
+      match_labels = {
+        "rbac.example.com/aggregate-to-view" = "true"
+      }
+    }
+
+    cluster_role_selectors {
+      match_labels = {
+        "rbac.example.com/aggregate-to-edit" = "true"
+      }
+    }
+  }
+}
+
+resource "kubernetes_cluster_role" "view" {
+  metadata {
+    labels = {
+      "rbac.example.com/aggregate-to-view" = "true"
+    }
+    name = "%[1]s-view"
+  }
+
+  rule {
+    api_groups = [""]
+    resources  = ["pods"]
+    verbs      = ["get", "list"]
+  }
+}
+
+resource "kubernetes_cluster_role" "edit" {
+  metadata {
+    labels = {
+      "rbac.example.com/aggregate-to-edit" = "true"
+    }
+    name = "%[1]s-edit"
+  }
+
+  rule {
+    api_groups = [""]
+    resources  = ["pods"]
+    verbs      = ["create", "delete"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesClusterRoleConfig_aggRuleRemoved(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_role" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  rule {
+    api_groups = [""]
+    resources  = ["configmaps"]
+    verbs      = ["get"]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesClusterRoleConfig_aggRuleWithRule(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_cluster_role" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  aggregation_rule {
+    cluster_role_selectors {
+      match_labels = {
+        "rbac.example.com/aggregate-to-view" = "true"
+      }
+    }
+  }
+
+  rule {
+    api_groups = [""]
+    resources  = ["configmaps"]
+    verbs      = ["get"]
+  }
+}
+`, name)
+}
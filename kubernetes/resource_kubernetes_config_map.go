@@ -1,7 +1,9 @@
 package kubernetes
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,6 +24,24 @@ func resourceKubernetesConfigMap() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if diff.Id() == "" {
+				return nil
+			}
+
+			// Immutable config maps reject any change to their data, so
+			// changes are planned as a replacement instead of failing at apply.
+			immutable, _ := diff.GetChange("immutable")
+			if immutable.(bool) {
+				for _, f := range []string{"data", "binary_data", "immutable"} {
+					if diff.HasChange(f) {
+						diff.ForceNew(f)
+					}
+				}
+			}
+
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("config map", true),
@@ -36,6 +56,11 @@ func resourceKubernetesConfigMap() *schema.Resource {
 				Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
 				Optional:    true,
 			},
+			"immutable": {
+				Type:        schema.TypeBool,
+				Description: "Immutable, if set to true, ensures that data stored in the ConfigMap cannot be updated (only object metadata can be modified). Changing the data of an immutable config map forces a new config map to be created.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -52,6 +77,9 @@ func resourceKubernetesConfigMapCreate(ctx context.Context, d *schema.ResourceDa
 		BinaryData: expandBase64MapToByteMap(d.Get("binary_data").(map[string]interface{})),
 		Data:       expandStringMap(d.Get("data").(map[string]interface{})),
 	}
+	if v, ok := d.GetOkExists("immutable"); ok {
+		cfgMap.Immutable = ptrToBool(v.(bool))
+	}
 	log.Printf("[INFO] Creating new config map: %#v", cfgMap)
 	out, err := conn.CoreV1().ConfigMaps(metadata.Namespace).Create(ctx, &cfgMap, metav1.CreateOptions{})
 	if err != nil {
@@ -88,17 +116,45 @@ func resourceKubernetesConfigMapRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received config map: %#v", cfgMap)
-	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfgMap.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.Set("binary_data", flattenByteMapToBase64Map(cfgMap.BinaryData))
-	d.Set("data", cfgMap.Data)
+	binaryData := flattenConfigMapBinaryData(cfgMap.BinaryData, d.Get("binary_data").(map[string]interface{}))
+	d.Set("binary_data", binaryData)
+	data := map[string]string{}
+	for k, v := range cfgMap.Data {
+		if _, ok := binaryData[k]; ok {
+			continue
+		}
+		data[k] = v
+	}
+	d.Set("data", data)
+	d.Set("immutable", cfgMap.Immutable)
 
 	return nil
 }
 
+// flattenConfigMapBinaryData encodes the binary data of a config map. The
+// encoding of the prior value is kept when it decodes to the same bytes, so
+// that payloads which are not encoded like by the standard encoder, e.g.
+// wrapped into lines, do not show a diff.
+func flattenConfigMapBinaryData(in map[string][]byte, prior map[string]interface{}) map[string]string {
+	result := flattenByteMapToBase64Map(in)
+	for k, v := range prior {
+		current, ok := in[k]
+		if !ok {
+			continue
+		}
+		b, err := base64.StdEncoding.DecodeString(v.(string))
+		if err == nil && bytes.Equal(b, current) {
+			result[k] = v.(string)
+		}
+	}
+	return result
+}
+
 func resourceKubernetesConfigMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -123,6 +179,13 @@ func resourceKubernetesConfigMapUpdate(ctx context.Context, d *schema.ResourceDa
 		ops = append(ops, diffOps...)
 	}
 
+	if d.HasChange("immutable") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/immutable",
+			Value: ptrToBool(d.Get("immutable").(bool)),
+		})
+	}
+
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -1,8 +1,11 @@
 package kubernetes
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"fmt"
+	"os"
 	"reflect"
 	"regexp"
 	"testing"
@@ -182,6 +185,113 @@ func TestAccKubernetesConfigMap_binaryData(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesConfigMap_binaryDataKeystore(t *testing.T) {
+	var conf api.ConfigMap
+	prefix := "tf-acc-test-gen-"
+	resourceName := "kubernetes_config_map.test"
+
+	keystore, err := os.ReadFile("./test-fixtures/keystore.jks")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_binaryDataKeystore(prefix),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "binary_data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "binary_data.keystore.jks", base64.StdEncoding.EncodeToString(keystore)),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "0"),
+					func(s *terraform.State) error {
+						if !bytes.Equal(conf.BinaryData["keystore.jks"], keystore) {
+							return fmt.Errorf("keystore.jks does not match the fixture")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMap_immutable(t *testing.T) {
+	var conf1, conf2 api.ConfigMap
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_config_map.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapConfig_immutable(name, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "immutable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data.key", "one"),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapConfig_immutable(name, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "immutable", "true"),
+					resource.TestCheckResourceAttr(resourceName, "data.key", "two"),
+					func(s *terraform.State) error {
+						if conf1.UID == conf2.UID {
+							return fmt.Errorf("config map %q should have been recreated", conf1.Name)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenConfigMapBinaryData(t *testing.T) {
+	keystore, err := os.ReadFile("./test-fixtures/keystore.jks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(keystore)
+	wrapped := encoded[:76] + "\n" + encoded[76:]
+
+	in := map[string][]byte{
+		"keystore.jks": keystore,
+		"wrapped":      keystore,
+		"changed":      []byte("new"),
+	}
+	prior := map[string]interface{}{
+		"keystore.jks": encoded,
+		"wrapped":      wrapped,
+		"changed":      base64.StdEncoding.EncodeToString([]byte("old")),
+		"removed":      "",
+	}
+	expected := map[string]string{
+		"keystore.jks": encoded,
+		"wrapped":      wrapped,
+		"changed":      base64.StdEncoding.EncodeToString([]byte("new")),
+	}
+	if got := flattenConfigMapBinaryData(in, prior); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %q, got %q", expected, got)
+	}
+}
+
 func TestAccKubernetesConfigMap_generatedName(t *testing.T) {
 	var conf api.ConfigMap
 	prefix := "tf-acc-test-gen-"
@@ -431,3 +541,31 @@ func testAccKubernetesConfigMapConfig_binaryData2(prefix string) string {
 }
 `, prefix)
 }
+
+func testAccKubernetesConfigMapConfig_binaryDataKeystore(prefix string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
+  metadata {
+    generate_name = "%s"
+  }
+
+  binary_data = {
+    "keystore.jks" = filebase64("./test-fixtures/keystore.jks")
+  }
+}
+`, prefix)
+}
+
+func testAccKubernetesConfigMapConfig_immutable(name, value string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  immutable = true
+
+  data = {
+    key = "%s"
+  }
+}
+`, name, value)
+}
@@ -0,0 +1,225 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesConfigMapV1Data() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesConfigMapV1DataCreate,
+		ReadContext:   resourceKubernetesConfigMapV1DataRead,
+		UpdateContext: resourceKubernetesConfigMapV1DataUpdate,
+		DeleteContext: resourceKubernetesConfigMapV1DataDelete,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:        schema.TypeList,
+				Description: "Metadata identifying the config map whose data is managed.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the config map.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateName,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the config map.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "default",
+						},
+						"labels": {
+							Type:             schema.TypeMap,
+							Description:      "The labels we want to add to the config map. Labels set by other field managers are left untouched.",
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: validateLabels,
+						},
+						"annotations": {
+							Type:         schema.TypeMap,
+							Description:  "The annotations we want to add to the config map, e.g. to annotate the `kube-root-ca.crt` config map of a namespace. Annotations set by other field managers are left untouched.",
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateAnnotations,
+						},
+					},
+				},
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Description: "The data we want to add to the config map.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the specified keys.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting data that is managed outside of Terraform.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesConfigMapV1DataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	d.SetId(buildId(metadata))
+	diags := resourceKubernetesConfigMapV1DataUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceKubernetesConfigMapV1DataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading config map %s", name)
+	cfgMap, err := conn.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "ConfigMap deleted",
+					Detail:   fmt.Sprintf("The underlying config map %q has been deleted. You should recreate the underlying config map, or remove it from your configuration.", name),
+				},
+			}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	managed, err := getManagedFieldsMap(cfgMap.ManagedFields, d.Get("field_manager").(string), "f:data")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only keep the keys owned by our field manager, or the ones which are
+	// configured, so keys added by other managers don't show up as drift.
+	configured := d.Get("data").(map[string]interface{})
+	data := map[string]string{}
+	for k, v := range cfgMap.Data {
+		_, isManaged := managed["f:"+k]
+		_, isConfigured := configured[k]
+		if isManaged || isConfigured {
+			data[k] = v
+		}
+	}
+
+	fields, err := getManagedFields(cfgMap.ManagedFields, d.Get("field_manager").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":        cfgMap.Name,
+		"namespace":   cfgMap.Namespace,
+		"labels":      filterManagedKeys(cfgMap.Labels, fields, "metadata", "labels"),
+		"annotations": filterManagedKeys(cfgMap.Annotations, fields, "metadata", "annotations"),
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("data", data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesConfigMapV1DataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	name := metadata.Name
+	namespace := metadata.Namespace
+
+	_, err = conn.CoreV1().ConfigMaps(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			if d.Id() == "" {
+				// The config map is gone, and the keys we managed with it.
+				return nil
+			}
+			return diag.Errorf("The config map %q does not exist in namespace %q", name, namespace)
+		}
+		return diag.FromErr(err)
+	}
+
+	m := map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+	}
+	data := d.Get("data").(map[string]interface{})
+	if d.Id() == "" {
+		// Applying an empty data map removes the keys owned by our field
+		// manager, and so does leaving out the labels and annotations.
+		data = map[string]interface{}{}
+	} else {
+		m["labels"] = metadata.Labels
+		m["annotations"] = metadata.Annotations
+	}
+
+	patch := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   m,
+		"data":       data,
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return diag.Errorf("Failed to marshal config map data: %s", err)
+	}
+
+	log.Printf("[INFO] Applying data to config map %q: %s", name, string(patchBytes))
+	_, err = conn.CoreV1().ConfigMaps(namespace).Patch(ctx, name, types.ApplyPatchType, patchBytes, metav1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+		Force:        ptrToBool(d.Get("force").(bool)),
+	})
+	if err != nil {
+		return applyErrorDiagnostics(err, d.Get("field_manager").(string))
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	return resourceKubernetesConfigMapV1DataRead(ctx, d, meta)
+}
+
+func resourceKubernetesConfigMapV1DataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return resourceKubernetesConfigMapV1DataUpdate(ctx, d, meta)
+}
@@ -0,0 +1,219 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesConfigMapV1Data_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_config_map_v1_data.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapV1DataConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapV1DataOnServer(name, map[string]string{
+						"one": "first",
+						"two": "second",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "field_manager", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.two", "second"),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapV1DataConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapV1DataOnServer(name, map[string]string{
+						"one":   "first",
+						"two":   "second_modified",
+						"three": "third",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data.two", "second_modified"),
+					resource.TestCheckResourceAttr(resourceName, "data.three", "third"),
+				),
+			},
+			{
+				Config:      testAccKubernetesConfigMapV1DataConfig_conflict(name, false),
+				ExpectError: regexp.MustCompile("Field manager conflict"),
+			},
+			{
+				Config: testAccKubernetesConfigMapV1DataConfig_conflict(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapV1DataOnServer(name, map[string]string{
+						"one": "first_forced",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.one", "first_forced"),
+				),
+			},
+			{
+				Config: testAccKubernetesConfigMapV1DataConfig_configMapOnly(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesConfigMapV1DataOnServer(name, map[string]string{}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesConfigMapV1Data_rootCAAnnotations(t *testing.T) {
+	resourceName := "kubernetes_config_map_v1_data.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.21.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesConfigMapV1DataRootCAReleased,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesConfigMapV1DataConfig_rootCAAnnotations(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", "kube-root-ca.crt"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.tf-acc-test/owner", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKubernetesConfigMapV1DataRootCAReleased checks that destroying
+// the resource kept the config map and removed the annotation it set.
+func testAccCheckKubernetesConfigMapV1DataRootCAReleased(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	cfgMap, err := conn.CoreV1().ConfigMaps("default").Get(context.TODO(), "kube-root-ca.crt", metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Expected config map kube-root-ca.crt to be kept: %s", err)
+	}
+	if _, ok := cfgMap.Annotations["tf-acc-test/owner"]; ok {
+		return fmt.Errorf("Expected annotation tf-acc-test/owner to be removed from config map kube-root-ca.crt")
+	}
+	if _, ok := cfgMap.Data["ca.crt"]; !ok {
+		return fmt.Errorf("Expected key ca.crt of config map kube-root-ca.crt to be kept")
+	}
+	return nil
+}
+
+func testAccCheckKubernetesConfigMapV1DataOnServer(name string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		cfgMap, err := conn.CoreV1().ConfigMaps("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for k, v := range expected {
+			if cfgMap.Data[k] != v {
+				return fmt.Errorf("Expected config map key %q to be %q, got %q", k, v, cfgMap.Data[k])
+			}
+		}
+		if len(expected) == 0 {
+			for _, k := range []string{"two", "three"} {
+				if _, ok := cfgMap.Data[k]; ok {
+					return fmt.Errorf("Expected config map key %q to be removed", k)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesConfigMapV1DataConfig_configMapOnly(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_config_map_v1" "test" {
+  metadata {
+    name = %q
+  }
+  data = {
+    "one" = "first"
+  }
+  lifecycle {
+    ignore_changes = [data]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesConfigMapV1DataConfig_basic(name string) string {
+	return testAccKubernetesConfigMapV1DataConfig_configMapOnly(name) + `
+resource "kubernetes_config_map_v1_data" "test" {
+  metadata {
+    name = kubernetes_config_map_v1.test.metadata.0.name
+  }
+  field_manager = "tftest"
+  data = {
+    "two" = "second"
+  }
+}
+`
+}
+
+func testAccKubernetesConfigMapV1DataConfig_modified(name string) string {
+	return testAccKubernetesConfigMapV1DataConfig_configMapOnly(name) + `
+resource "kubernetes_config_map_v1_data" "test" {
+  metadata {
+    name = kubernetes_config_map_v1.test.metadata.0.name
+  }
+  field_manager = "tftest"
+  data = {
+    "two"   = "second_modified"
+    "three" = "third"
+  }
+}
+`
+}
+
+func testAccKubernetesConfigMapV1DataConfig_conflict(name string, force bool) string {
+	return testAccKubernetesConfigMapV1DataConfig_configMapOnly(name) + fmt.Sprintf(`
+resource "kubernetes_config_map_v1_data" "test" {
+  metadata {
+    name = kubernetes_config_map_v1.test.metadata.0.name
+  }
+  field_manager = "tftest"
+  force         = %t
+  data = {
+    "one" = "first_forced"
+  }
+}
+`, force)
+}
+
+func testAccKubernetesConfigMapV1DataConfig_rootCAAnnotations() string {
+	return `resource "kubernetes_config_map_v1_data" "test" {
+  metadata {
+    name = "kube-root-ca.crt"
+    annotations = {
+      "tf-acc-test/owner" = "platform"
+    }
+  }
+  field_manager = "tftest"
+}
+`
+}
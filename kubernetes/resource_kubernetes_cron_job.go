@@ -21,6 +21,7 @@ func resourceKubernetesCronJob() *schema.Resource {
 		ReadContext:   resourceKubernetesCronJobRead,
 		UpdateContext: resourceKubernetesCronJobUpdate,
 		DeleteContext: resourceKubernetesCronJobDelete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.job_template.0.spec.0.template.0.spec"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -100,6 +101,7 @@ func resourceKubernetesCronJobUpdate(ctx context.Context, d *schema.ResourceData
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	orderContainerEnvAsConfigured(d, "spec.0.job_template.0.spec.0.template.0.spec.0", &spec.JobTemplate.Spec.Template.Spec)
 	spec.JobTemplate.ObjectMeta.Annotations = metadata.Annotations
 
 	cronjob := &v1beta1.CronJob{
@@ -168,22 +170,23 @@ func resourceKubernetesCronJobRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	jobSpec, err := flattenCronJobSpec(job.Spec, d)
+	jobSpec, err := flattenCronJobSpec(job.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", jobSpec, "0.job_template.0.spec.0.template.0.spec.0")
 	err = d.Set("spec", jobSpec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceKubernetesCronJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -22,6 +22,7 @@ func resourceKubernetesCronJobV1() *schema.Resource {
 		ReadContext:   resourceKubernetesCronJobV1Read,
 		UpdateContext: resourceKubernetesCronJobV1Update,
 		DeleteContext: resourceKubernetesCronJobV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.job_template.0.spec.0.template.0.spec"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -36,7 +37,7 @@ func resourceKubernetesCronJobV1() *schema.Resource {
 				Required:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
-					Schema: cronJobSpecFields(),
+					Schema: cronJobSpecFieldsV1(),
 				},
 			},
 		},
@@ -64,13 +65,14 @@ func resourceKubernetesCronJobV1Create(ctx context.Context, d *schema.ResourceDa
 
 	out, err := conn.BatchV1().CronJobs(metadata.Namespace).Create(ctx, &job, metav1.CreateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return cronJobV1TimeZoneDiagnostics(d, err)
 	}
 	log.Printf("[INFO] Submitted new cron job: %#v", out)
 
 	d.SetId(buildId(out.ObjectMeta))
 
-	return resourceKubernetesCronJobV1Read(ctx, d, meta)
+	diags := cronJobV1DroppedTimeZoneDiagnostics(d, out)
+	return append(diags, resourceKubernetesCronJobV1Read(ctx, d, meta)...)
 }
 
 func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -89,6 +91,7 @@ func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceDa
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	orderContainerEnvAsConfigured(d, "spec.0.job_template.0.spec.0.template.0.spec.0", &spec.JobTemplate.Spec.Template.Spec)
 	spec.JobTemplate.ObjectMeta.Annotations = metadata.Annotations
 
 	cronjob := &batch.CronJob{
@@ -100,12 +103,43 @@ func resourceKubernetesCronJobV1Update(ctx context.Context, d *schema.ResourceDa
 
 	out, err := conn.BatchV1().CronJobs(namespace).Update(ctx, cronjob, metav1.UpdateOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		return cronJobV1TimeZoneDiagnostics(d, err)
 	}
 	log.Printf("[INFO] Submitted updated cron job: %#v", out)
 
 	d.SetId(buildId(out.ObjectMeta))
-	return resourceKubernetesCronJobV1Read(ctx, d, meta)
+
+	diags := cronJobV1DroppedTimeZoneDiagnostics(d, out)
+	return append(diags, resourceKubernetesCronJobV1Read(ctx, d, meta)...)
+}
+
+const cronJobV1TimeZoneHint = "The spec.0.time_zone field requires Kubernetes 1.27 or later (1.25 and 1.26 with the CronJobTimeZone feature gate enabled)."
+
+// cronJobV1TimeZoneDiagnostics adds a hint about the minimum cluster version
+// to API errors for cron jobs that set a time zone.
+func cronJobV1TimeZoneDiagnostics(d *schema.ResourceData, err error) diag.Diagnostics {
+	if d.Get("spec.0.time_zone").(string) == "" {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  err.Error(),
+		Detail:   cronJobV1TimeZoneHint,
+	}}
+}
+
+// cronJobV1DroppedTimeZoneDiagnostics warns when the API server silently
+// dropped the configured time zone, which older clusters do instead of
+// rejecting the field.
+func cronJobV1DroppedTimeZoneDiagnostics(d *schema.ResourceData, out *batch.CronJob) diag.Diagnostics {
+	if d.Get("spec.0.time_zone").(string) == "" || out.Spec.TimeZone != nil {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The time zone of cron job %q was not stored by the API server", out.Name),
+		Detail:   cronJobV1TimeZoneHint,
+	}}
 }
 
 func resourceKubernetesCronJobV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -157,22 +191,23 @@ func resourceKubernetesCronJobV1Read(ctx context.Context, d *schema.ResourceData
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	jobSpec, err := flattenCronJobSpecV1(job.Spec, d)
+	jobSpec, err := flattenCronJobSpecV1(job.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", jobSpec, "0.job_template.0.spec.0.template.0.spec.0")
 	err = d.Set("spec", jobSpec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceKubernetesCronJobV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
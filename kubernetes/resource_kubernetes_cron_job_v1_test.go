@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -40,6 +41,7 @@ func TestAccKubernetesCronJobV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.starting_deadline_seconds", "10"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.successful_jobs_history_limit", "10"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.suspend", "true"),
+					testAccCheckKubernetesCronJobV1Suspended(&conf1, true),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.job_template.0.spec.0.parallelism", "1"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.job_template.0.spec.0.backoff_limit", "2"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.job_template.0.spec.0.template.0.spec.0.container.0.name", "hello"),
@@ -61,6 +63,7 @@ func TestAccKubernetesCronJobV1_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.starting_deadline_seconds", "0"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.successful_jobs_history_limit", "3"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.suspend", "false"),
+					testAccCheckKubernetesCronJobV1Suspended(&conf2, false),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.job_template.0.spec.0.parallelism", "2"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.job_template.0.spec.0.backoff_limit", "0"),
 					resource.TestCheckResourceAttr("kubernetes_cron_job_v1.test", "spec.0.job_template.0.spec.0.template.0.spec.0.container.0.name", "hello"),
@@ -114,6 +117,59 @@ func TestAccKubernetesCronJobV1_extra(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCronJobV1_timeZone(t *testing.T) {
+	var conf batch.CronJob
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := alpineImageVersion
+	resourceName := "kubernetes_cron_job_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.27.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCronJobV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCronJobV1Config_timeZone(name, imageName, "0 3 * * *", "Europe/Berlin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.time_zone", "Europe/Berlin"),
+				),
+			},
+			{
+				Config: testAccKubernetesCronJobV1Config_timeZone(name, imageName, "0 3 * * *", "Etc/UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCronJobV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.time_zone", "Etc/UTC"),
+				),
+			},
+			{
+				Config:      testAccKubernetesCronJobV1Config_timeZone(name, imageName, "0 3 * * *", "Mars/Olympus_Mons"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is not a valid time zone`),
+			},
+			{
+				Config:      testAccKubernetesCronJobV1Config_timeZone(name, imageName, "0 25 * * *", "Etc/UTC"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`should be an valid Cron expression`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCronJobV1Suspended(obj *batch.CronJob, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		suspended := obj.Spec.Suspend != nil && *obj.Spec.Suspend
+		if suspended != expected {
+			return fmt.Errorf("Expected .spec.suspend of cron job %s to be %t, got %t", obj.Name, expected, suspended)
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesCronJobV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -296,6 +352,33 @@ func testAccKubernetesCronJobV1Config_extraModified(name, imageName string) stri
 }`, name, imageName)
 }
 
+func testAccKubernetesCronJobV1Config_timeZone(name, imageName, schedule, timeZone string) string {
+	return fmt.Sprintf(`resource "kubernetes_cron_job_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    schedule  = "%s"
+    time_zone = "%s"
+    job_template {
+      metadata {}
+      spec {
+        template {
+          metadata {}
+          spec {
+            container {
+              name    = "hello"
+              image   = "%s"
+              command = ["echo", "'hello'"]
+            }
+          }
+        }
+      }
+    }
+  }
+}`, name, schedule, timeZone, imageName)
+}
+
 func testAccCheckKubernetesCronJobV1ForceNew(old, new *batch.CronJob, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
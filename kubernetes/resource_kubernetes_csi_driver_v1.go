@@ -47,11 +47,13 @@ func resourceKubernetesCSIDriverV1() *schema.Resource {
 							Type:        schema.TypeBool,
 							Description: "Indicates that the CSI volume driver requires additional pod information (like podName, podUID, etc.) during mount operations",
 							Optional:    true,
+							ForceNew:    true,
 						},
 						"volume_lifecycle_modes": {
 							Type:        schema.TypeList,
 							Description: "Defines what kind of volumes this CSI volume driver supports",
 							Optional:    true,
+							ForceNew:    true,
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 								ValidateFunc: validation.StringInSlice([]string{
@@ -60,6 +62,56 @@ func resourceKubernetesCSIDriverV1() *schema.Resource {
 								}, false),
 							},
 						},
+						"storage_capacity": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that the CSI volume driver wants pod scheduling to consider the storage capacity it reports through CSIStorageCapacity objects",
+							Optional:    true,
+							Computed:    true,
+						},
+						"fs_group_policy": {
+							Type:        schema.TypeString,
+							Description: "Defines if the underlying volume supports changing ownership and permission of the volume before being mounted",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(storage.ReadWriteOnceWithFSTypeFSGroupPolicy),
+								string(storage.FileFSGroupPolicy),
+								string(storage.NoneFSGroupPolicy),
+							}, false),
+						},
+						"token_requests": {
+							Type:        schema.TypeList,
+							Description: "Service account tokens that kubelet passes to the CSI driver in NodePublishVolume",
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audience": {
+										Type:        schema.TypeString,
+										Description: "The intended audience of the token. Defaults to the audiences of the kube apiserver when empty",
+										Optional:    true,
+									},
+									"expiration_seconds": {
+										Type:         schema.TypeInt,
+										Description:  "The duration of validity of the token in seconds. Must be at least 600",
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(600),
+									},
+								},
+							},
+						},
+						"requires_republish": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that the CSI volume driver wants NodePublishVolume to be periodically called to reflect changes in the mounted volume",
+							Optional:    true,
+							Computed:    true,
+						},
+						"se_linux_mount": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that the CSI volume driver supports the `-o context` mount option, so volumes can be mounted with the SELinux context of the pod",
+							Optional:    true,
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -111,7 +163,7 @@ func resourceKubernetesCSIDriverV1Read(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received CSIDriver: %#v", CSIDriver)
-	err = d.Set("metadata", flattenMetadata(CSIDriver.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(CSIDriver.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
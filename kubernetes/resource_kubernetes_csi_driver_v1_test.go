@@ -46,6 +46,62 @@ func TestAccKubernetesCSIDriverV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesCSIDriverV1_update(t *testing.T) {
+	var conf1, conf2 storage.CSIDriver
+	resourceName := "kubernetes_csi_driver_v1.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.26.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCSIDriverV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCSIDriverV1Config_full(name, false, 3600),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCSIDriverV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.fs_group_policy", "File"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.storage_capacity", "false"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.requires_republish", "false"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.se_linux_mount", "false"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.token_requests.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.token_requests.0.audience", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.token_requests.0.expiration_seconds", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesCSIDriverV1Config_full(name, true, 7200),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCSIDriverV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesCSIDriverV1NotRecreated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.storage_capacity", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.requires_republish", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.se_linux_mount", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.token_requests.0.expiration_seconds", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCSIDriverV1NotRecreated(before, after *storage.CSIDriver) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.UID != after.UID {
+			return fmt.Errorf("Expected CSIDriver to be updated in place, but it was recreated")
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesCSIDriverV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -107,3 +163,26 @@ func testAccKubernetesCSIDriverV1BasicConfig(name string, attached bool) string
 }
 `, name, attached)
 }
+
+func testAccKubernetesCSIDriverV1Config_full(name string, enabled bool, expiration int) string {
+	return fmt.Sprintf(`resource "kubernetes_csi_driver_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    attach_required        = false
+    volume_lifecycle_modes = ["Persistent"]
+    fs_group_policy        = "File"
+    storage_capacity       = %[2]t
+    requires_republish     = %[2]t
+    se_linux_mount         = %[2]t
+
+    token_requests {
+      audience           = "vault"
+      expiration_seconds = %[3]d
+    }
+  }
+}
+`, name, enabled, expiration)
+}
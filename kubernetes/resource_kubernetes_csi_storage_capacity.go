@@ -0,0 +1,187 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesCSIStorageCapacity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesCSIStorageCapacityCreate,
+		ReadContext:   resourceKubernetesCSIStorageCapacityRead,
+		UpdateContext: resourceKubernetesCSIStorageCapacityUpdate,
+		DeleteContext: resourceKubernetesCSIStorageCapacityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("CSI storage capacity", true),
+			"node_topology": {
+				Type:        schema.TypeList,
+				Description: "Selects the nodes the capacity applies to, matched against node labels. When omitted the storage is not accessible from any node, an empty block makes it accessible from all nodes.",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: labelSelectorFields(false),
+				},
+			},
+			"storage_class_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the storage class the capacity applies to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"capacity": {
+				Type:             schema.TypeString,
+				Description:      "The capacity available for new volumes of the storage class in the node topology, as reported by the CSI driver. When omitted the capacity is unknown.",
+				Optional:         true,
+				ValidateFunc:     validateResourceQuantity,
+				DiffSuppressFunc: suppressEquivalentResourceQuantity,
+			},
+			"maximum_volume_size": {
+				Type:             schema.TypeString,
+				Description:      "The largest size that may be used in the capacity request of a volume claim. When omitted it is the same as `capacity`.",
+				Optional:         true,
+				ValidateFunc:     validateResourceQuantity,
+				DiffSuppressFunc: suppressEquivalentResourceQuantity,
+			},
+		},
+	}
+}
+
+func resourceKubernetesCSIStorageCapacityCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	capacity, err := expandCSIStorageCapacity(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new CSI storage capacity: %#v", capacity)
+	out, err := conn.StorageV1().CSIStorageCapacities(capacity.Namespace).Create(ctx, capacity, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create CSI storage capacity: %s", err)
+	}
+	log.Printf("[INFO] Submitted new CSI storage capacity: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesCSIStorageCapacityRead(ctx, d, meta)
+}
+
+func resourceKubernetesCSIStorageCapacityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading CSI storage capacity %s", name)
+	capacity, err := conn.StorageV1().CSIStorageCapacities(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] CSI storage capacity %s not found, removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received CSI storage capacity: %#v", capacity)
+
+	err = d.Set("metadata", flattenMetadata(capacity.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("node_topology", flattenCSIStorageCapacityNodeTopology(capacity.NodeTopology))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("storage_class_name", capacity.StorageClassName)
+	d.Set("capacity", flattenCSIStorageCapacityQuantity(capacity.Capacity))
+	d.Set("maximum_volume_size", flattenCSIStorageCapacityQuantity(capacity.MaximumVolumeSize))
+
+	return nil
+}
+
+func resourceKubernetesCSIStorageCapacityUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("capacity") {
+		op, err := patchCSIStorageCapacityQuantity("capacity", "/capacity", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, op)
+	}
+	if d.HasChange("maximum_volume_size") {
+		op, err := patchCSIStorageCapacityQuantity("maximum_volume_size", "/maximumVolumeSize", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, op)
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating CSI storage capacity %q: %v", name, string(data))
+	out, err := conn.StorageV1().CSIStorageCapacities(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update CSI storage capacity: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated CSI storage capacity: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesCSIStorageCapacityRead(ctx, d, meta)
+}
+
+func resourceKubernetesCSIStorageCapacityDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting CSI storage capacity: %#v", name)
+	err = conn.StorageV1().CSIStorageCapacities(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] CSI storage capacity %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,178 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	storage "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesCSIStorageCapacity_basic(t *testing.T) {
+	var conf1, conf2 storage.CSIStorageCapacity
+	resourceName := "kubernetes_csi_storage_capacity.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCSIStorageCapacityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCSIStorageCapacityConfig_basic(name, "1Gi", "1073741824"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCSIStorageCapacityExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "storage_class_name", name),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_volume_size", "1073741824"),
+					resource.TestCheckResourceAttr(resourceName, "node_topology.0.match_labels.topology.kubernetes.io/zone", "zone-a"),
+				),
+			},
+			{
+				// Equivalent quantities must not show a diff.
+				Config:   testAccKubernetesCSIStorageCapacityConfig_basic(name, "1024Mi", "1Gi"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesCSIStorageCapacityConfig_basic(name, "10Gi", "5Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCSIStorageCapacityExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "10Gi"),
+					resource.TestCheckResourceAttr(resourceName, "maximum_volume_size", "5Gi"),
+					func(s *terraform.State) error {
+						if conf1.UID != conf2.UID {
+							return fmt.Errorf("Expected CSI storage capacity to be updated in place, but it was recreated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesCSIStorageCapacity_unknownCapacity(t *testing.T) {
+	var conf storage.CSIStorageCapacity
+	resourceName := "kubernetes_csi_storage_capacity.test"
+	name := acctest.RandomWithPrefix("tf-acc-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesCSIStorageCapacityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesCSIStorageCapacityConfig_unknownCapacity(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesCSIStorageCapacityExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "capacity", ""),
+					resource.TestCheckResourceAttr(resourceName, "node_topology.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesCSIStorageCapacityDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.TODO()
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_csi_storage_capacity" {
+			continue
+		}
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		_, err = conn.StorageV1().CSIStorageCapacities(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("CSI storage capacity still exists: %s", rs.Primary.ID)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesCSIStorageCapacityExists(n string, obj *storage.CSIStorageCapacity) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		out, err := conn.StorageV1().CSIStorageCapacities(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesCSIStorageCapacityConfig_basic(name, capacity, maximumVolumeSize string) string {
+	return fmt.Sprintf(`resource "kubernetes_csi_storage_capacity" "test" {
+  metadata {
+    name      = %[1]q
+    namespace = "default"
+  }
+
+  storage_class_name  = %[1]q
+  capacity            = %[2]q
+  maximum_volume_size = %[3]q
+
+  node_topology {
+    match_labels = {
+      "topology.kubernetes.io/zone" = "zone-a"
+    }
+  }
+}
+`, name, capacity, maximumVolumeSize)
+}
+
+func testAccKubernetesCSIStorageCapacityConfig_unknownCapacity(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_csi_storage_capacity" "test" {
+  metadata {
+    name      = %[1]q
+    namespace = "default"
+  }
+
+  storage_class_name = %[1]q
+}
+`, name)
+}
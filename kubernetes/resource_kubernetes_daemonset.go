@@ -25,6 +25,7 @@ func resourceKubernetesDaemonSet() *schema.Resource {
 		ReadContext:   resourceKubernetesDaemonSetRead,
 		UpdateContext: resourceKubernetesDaemonSetUpdate,
 		DeleteContext: resourceKubernetesDaemonSetDelete,
+		CustomizeDiff: resourceKubernetesDaemonSetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -100,12 +101,21 @@ func resourceKubernetesDaemonSetSchemaV1() map[string]*schema.Schema {
 									MaxItems:    1,
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
+											"max_surge": {
+												Type:             schema.TypeString,
+												Description:      "The maximum number of nodes with an existing available DaemonSet pod that can have an updated DaemonSet pod during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up to a minimum of 1. Default value is 0. When this is set, the updated pod of a node is started and becomes available before the old pod of the node is stopped.",
+												Optional:         true,
+												Default:          "0",
+												ValidateFunc:     validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]%|[1-9][0-9]%|100%)$`), ""),
+												DiffSuppressFunc: suppressEquivalentIntOrString,
+											},
 											"max_unavailable": {
-												Type:         schema.TypeString,
-												Description:  "The maximum number of DaemonSet pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of total number of DaemonSet pods at the start of the update (ex: 10%). Absolute number is calculated from percentage by rounding up. This cannot be 0. Default value is 1. Example: when this is set to 30%, at most 30% of the total number of nodes that should be running the daemon pod (i.e. status.desiredNumberScheduled) can have their pods stopped for an update at any given time. The update starts by stopping at most 30% of those DaemonSet pods and then brings up new DaemonSet pods in their place. Once the new pods are available, it then proceeds onto other DaemonSet pods, thus ensuring that at least 70% of original number of DaemonSet pods are available at all times during the update.",
-												Optional:     true,
-												Default:      1,
-												ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([1-9][0-9]*|[1-9][0-9]%|[1-9]%|100%)$`), ""),
+												Type:             schema.TypeString,
+												Description:      "The maximum number of DaemonSet pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of total number of DaemonSet pods at the start of the update (ex: 10%). Absolute number is calculated from percentage by rounding up. This can not be 0 if MaxSurge is 0. Default value is 1. Example: when this is set to 30%, at most 30% of the total number of nodes that should be running the daemon pod (i.e. status.desiredNumberScheduled) can have their pods stopped for an update at any given time. The update starts by stopping at most 30% of those DaemonSet pods and then brings up new DaemonSet pods in their place. Once the new pods are available, it then proceeds onto other DaemonSet pods, thus ensuring that at least 70% of original number of DaemonSet pods are available at all times during the update.",
+												Optional:         true,
+												Default:          1,
+												ValidateFunc:     validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]%|[1-9][0-9]%|100%)$`), ""),
+												DiffSuppressFunc: suppressEquivalentIntOrString,
 											},
 										},
 									},
@@ -127,13 +137,42 @@ func resourceKubernetesDaemonSetSchemaV1() map[string]*schema.Schema {
 		},
 		"wait_for_rollout": {
 			Type:        schema.TypeBool,
-			Description: "Wait for the rollout of the deployment to complete. Defaults to true.",
+			Description: "Wait for the rollout of the daemonset to complete. Defaults to true.",
 			Default:     true,
 			Optional:    true,
 		},
 	}
 }
 
+func resourceKubernetesDaemonSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := podSpecCustomizeDiff("spec.0.template.0.spec")(ctx, d, meta); err != nil {
+		return err
+	}
+	return validateDaemonSetRollingUpdate(d)
+}
+
+// validateDaemonSetRollingUpdate checks that a rolling update either stops or
+// surges pods, as the update would not make progress otherwise.
+func validateDaemonSetRollingUpdate(d *schema.ResourceDiff) error {
+	prefix := "spec.0.strategy.0.rolling_update.0."
+	if !d.NewValueKnown(prefix+"max_surge") || !d.NewValueKnown(prefix+"max_unavailable") {
+		return nil
+	}
+	if d.Get("spec.0.strategy.0.type").(string) != string(appsv1.RollingUpdateDaemonSetStrategyType) {
+		return nil
+	}
+	ru, _ := d.Get("spec.0.strategy.0.rolling_update").([]interface{})
+	if len(ru) == 0 || ru[0] == nil {
+		return nil
+	}
+	maxSurge, _ := d.Get(prefix + "max_surge").(string)
+	maxUnavailable, _ := d.Get(prefix + "max_unavailable").(string)
+	if equivalentIntOrStrings(maxSurge, "0") && equivalentIntOrStrings(maxUnavailable, "0") {
+		return fmt.Errorf("%smax_unavailable: may not be 0 when max_surge is 0", prefix)
+	}
+	return nil
+}
+
 func resourceKubernetesDaemonSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -159,8 +198,7 @@ func resourceKubernetesDaemonSetCreate(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if d.Get("wait_for_rollout").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDaemonSetReplicasFunc(ctx, conn, metadata.Namespace, metadata.Name))
+		err = waitForDaemonSetRollout(ctx, conn, metadata.Namespace, metadata.Name, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -191,6 +229,7 @@ func resourceKubernetesDaemonSetUpdate(ctx context.Context, d *schema.ResourceDa
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &spec.Template.Spec)
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -210,8 +249,7 @@ func resourceKubernetesDaemonSetUpdate(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Submitted updated daemonset: %#v", out)
 
 	if d.Get("wait_for_rollout").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			waitForDaemonSetReplicasFunc(ctx, conn, namespace, name))
+		err = waitForDaemonSetRollout(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -251,22 +289,23 @@ func resourceKubernetesDaemonSetRead(ctx context.Context, d *schema.ResourceData
 	}
 	log.Printf("[INFO] Received daemonset: %#v", daemonset)
 
-	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(daemonset.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	spec, err := flattenDaemonSetSpec(daemonset.Spec, d)
+	spec, err := flattenDaemonSetSpec(daemonset.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", spec, "0.template.0.spec.0")
 	err = d.Set("spec", spec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceKubernetesDaemonSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -314,6 +353,26 @@ func resourceKubernetesDaemonSetExists(ctx context.Context, d *schema.ResourceDa
 	return true, err
 }
 
+// waitForDaemonSetRollout waits until every pod of the DaemonSet runs the
+// current template and is ready.
+func waitForDaemonSetRollout(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) error {
+	start := time.Now()
+	err := resource.RetryContext(ctx, timeout, waitForDaemonSetReplicasFunc(ctx, conn, ns, name))
+	if !rolloutTimedOut(ctx, start, timeout, err) {
+		return err
+	}
+
+	// The context of the wait expires together with the timeout, so the pods
+	// are looked up with a fresh one.
+	lctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	ds, gerr := conn.AppsV1().DaemonSets(ns).Get(lctx, name, metav1.GetOptions{})
+	if gerr != nil {
+		return err
+	}
+	return rolloutTimeoutError(lctx, conn, ns, ds.UID, ds.Spec.Selector, err)
+}
+
 func waitForDaemonSetReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
 	return func() *resource.RetryError {
 		daemonSet, err := conn.AppsV1().DaemonSets(ns).Get(ctx, name, metav1.GetOptions{})
@@ -321,15 +380,23 @@ func waitForDaemonSetReplicasFunc(ctx context.Context, conn *kubernetes.Clientse
 			return resource.NonRetryableError(err)
 		}
 
-		desiredReplicas := daemonSet.Status.DesiredNumberScheduled
-		log.Printf("[DEBUG] Current number of labelled replicas of %q: %d (of %d)\n",
-			daemonSet.GetName(), daemonSet.Status.CurrentNumberScheduled, desiredReplicas)
-
-		if daemonSet.Status.CurrentNumberScheduled == desiredReplicas {
+		done, progress := daemonSetRolloutComplete(daemonSet)
+		if done && daemonSetSurges(daemonSet) {
+			s, err := metav1.LabelSelectorAsSelector(daemonSet.Spec.Selector)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			pods, err := conn.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: s.String()})
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			done, progress = daemonSetSurgeComplete(daemonSet, pods.Items)
+		}
+		logRolloutProgress(ctx, "DaemonSet", ns, name, progress)
+		if done {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("Waiting for %d replicas of %q to be scheduled (%d)",
-			desiredReplicas, daemonSet.GetName(), daemonSet.Status.CurrentNumberScheduled))
+		return resource.RetryableError(fmt.Errorf("Waiting for DaemonSet %s/%s to roll out: %s", ns, name, progress))
 	}
 }
@@ -0,0 +1,197 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// builtInNamespaces are the namespaces created by Kubernetes itself, which
+// kubernetes_default_namespace can adopt.
+var builtInNamespaces = []string{"default", "kube-system", "kube-public", "kube-node-lease"}
+
+// resourceKubernetesDefaultNamespace manages the labels and annotations of a
+// namespace created by Kubernetes with server-side apply. The namespace is
+// adopted on create and never deleted: destroying the resource only removes
+// the labels and annotations owned by its field manager.
+func resourceKubernetesDefaultNamespace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesDefaultNamespaceCreate,
+		ReadContext:   resourceKubernetesDefaultNamespaceRead,
+		UpdateContext: resourceKubernetesDefaultNamespaceUpdate,
+		DeleteContext: resourceKubernetesDefaultNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:        schema.TypeList,
+				Description: "Metadata identifying the namespace and the labels and annotations managed on it.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the namespace, one of `default`, `kube-system`, `kube-public` or `kube-node-lease`.",
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "default",
+							ValidateFunc: validation.StringInSlice(builtInNamespaces, false),
+						},
+						"labels": {
+							Type:             schema.TypeMap,
+							Description:      "The labels to add to the namespace. Labels set by other field managers are left untouched.",
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: validateLabels,
+						},
+						"annotations": {
+							Type:         schema.TypeMap,
+							Description:  "The annotations to add to the namespace. Annotations set by other field managers are left untouched.",
+							Optional:     true,
+							Elem:         &schema.Schema{Type: schema.TypeString},
+							ValidateFunc: validateAnnotations,
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Description: "The unique in time and space value for this namespace.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the specified labels and annotations.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting labels and annotations that are managed outside of Terraform.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesDefaultNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	d.SetId(metadata.Name)
+	diags := resourceKubernetesDefaultNamespaceUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceKubernetesDefaultNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading namespace %s", name)
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Printf("[DEBUG] Namespace %s not found", name)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	fieldManager := d.Get("field_manager").(string)
+	if fieldManager == "" {
+		// Imported resources have no field manager yet.
+		fieldManager = defaultFieldManagerName
+	}
+	fields, err := getManagedFields(ns.ManagedFields, fieldManager)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":        ns.Name,
+		"labels":      filterManagedKeys(ns.Labels, fields, "metadata", "labels"),
+		"annotations": filterManagedKeys(ns.Annotations, fields, "metadata", "annotations"),
+		"uid":         string(ns.UID),
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesDefaultNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	name := metadata.Name
+
+	_, err = conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			if d.Id() == "" {
+				// The namespace is gone, and the labels we managed with it.
+				return nil
+			}
+			return diag.Errorf("The namespace %q does not exist", name)
+		}
+		return diag.FromErr(err)
+	}
+
+	m := map[string]interface{}{
+		"name": name,
+	}
+	if d.Id() != "" {
+		// Applying no labels or annotations removes the ones owned by our
+		// field manager, so they are only sent while the resource exists.
+		m["labels"] = metadata.Labels
+		m["annotations"] = metadata.Annotations
+	}
+	patch := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   m,
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return diag.Errorf("Failed to marshal namespace metadata: %s", err)
+	}
+
+	log.Printf("[INFO] Applying metadata to namespace %q: %s", name, string(patchBytes))
+	_, err = conn.CoreV1().Namespaces().Patch(ctx, name, types.ApplyPatchType, patchBytes, metav1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+		Force:        ptrToBool(d.Get("force").(bool)),
+	})
+	if err != nil {
+		return applyErrorDiagnostics(err, d.Get("field_manager").(string))
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	return resourceKubernetesDefaultNamespaceRead(ctx, d, meta)
+}
+
+func resourceKubernetesDefaultNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Releasing the labels and annotations of namespace %s, the namespace itself is kept", d.Id())
+	d.SetId("")
+	return resourceKubernetesDefaultNamespaceUpdate(ctx, d, meta)
+}
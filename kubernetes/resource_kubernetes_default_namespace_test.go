@@ -0,0 +1,113 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesDefaultNamespace_basic(t *testing.T) {
+	resourceName := "kubernetes_default_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDefaultNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDefaultNamespaceConfig_basic("baseline"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDefaultNamespaceOnServer(map[string]string{
+						"pod-security.kubernetes.io/warn": "baseline",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "id", "default"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.pod-security.kubernetes.io/warn", "baseline"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.tf-acc-test/owner", "platform"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"field_manager", "force"},
+			},
+			{
+				Config: testAccKubernetesDefaultNamespaceConfig_basic("restricted"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDefaultNamespaceOnServer(map[string]string{
+						"pod-security.kubernetes.io/warn": "restricted",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.pod-security.kubernetes.io/warn", "restricted"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKubernetesDefaultNamespaceDestroy checks that destroying the
+// resource kept the namespace and removed the labels and annotations it set.
+func testAccCheckKubernetesDefaultNamespaceDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	ns, err := conn.CoreV1().Namespaces().Get(context.Background(), "default", metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Expected namespace default to be kept: %s", err)
+	}
+	if _, ok := ns.Labels["pod-security.kubernetes.io/warn"]; ok {
+		return fmt.Errorf("Expected label pod-security.kubernetes.io/warn to be removed from namespace default")
+	}
+	if _, ok := ns.Annotations["tf-acc-test/owner"]; ok {
+		return fmt.Errorf("Expected annotation tf-acc-test/owner to be removed from namespace default")
+	}
+	return nil
+}
+
+func testAccCheckKubernetesDefaultNamespaceOnServer(labels map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+
+		ns, err := conn.CoreV1().Namespaces().Get(context.Background(), "default", metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for k, v := range labels {
+			if ns.Labels[k] != v {
+				return fmt.Errorf("Expected namespace label %q to be %q, got %q", k, v, ns.Labels[k])
+			}
+		}
+		// The label set by Kubernetes itself is not managed, but kept.
+		if _, ok := ns.Labels["kubernetes.io/metadata.name"]; !ok {
+			return fmt.Errorf("Expected label kubernetes.io/metadata.name to be kept")
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesDefaultNamespaceConfig_basic(level string) string {
+	return fmt.Sprintf(`resource "kubernetes_default_namespace" "test" {
+  metadata {
+    labels = {
+      "pod-security.kubernetes.io/warn" = %q
+    }
+    annotations = {
+      "tf-acc-test/owner" = "platform"
+    }
+  }
+}
+`, level)
+}
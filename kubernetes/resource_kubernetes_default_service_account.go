@@ -61,11 +61,11 @@ func resourceKubernetesDefaultServiceAccountCreate(ctx context.Context, d *schem
 
 	d.SetId(buildId(metadata))
 
-	secret, err := getServiceAccountDefaultSecret(ctx, "default", svcAcc, d.Timeout(schema.TimeoutCreate), conn)
+	defaultSecretName, err := waitForServiceAccountDefaultSecretName(ctx, "default", svcAcc, d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	d.Set("default_secret_name", secret.Name)
+	d.Set("default_secret_name", defaultSecretName)
 
 	return resourceKubernetesServiceAccountUpdate(ctx, d, meta)
 }
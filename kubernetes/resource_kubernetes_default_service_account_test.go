@@ -56,7 +56,10 @@ func TestAccKubernetesDefaultServiceAccount_secrets(t *testing.T) {
 	namespace := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		IDRefreshName:     "kubernetes_default_service_account.test",
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
@@ -84,6 +87,36 @@ func TestAccKubernetesDefaultServiceAccount_secrets(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDefaultServiceAccount_noTokenSecret(t *testing.T) {
+	var conf api.ServiceAccount
+	namespace := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_default_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDefaultServiceAccountConfig_secrets(namespace),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceAccountExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "secret.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_secret_name", ""),
+					testAccCheckServiceAccountSecrets(&conf, []*regexp.Regexp{
+						regexp.MustCompile("^one$"),
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccKubernetesDefaultServiceAccountConfig_basic(namespace string) string {
 	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
   metadata {
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"regexp"
@@ -22,6 +23,10 @@ import (
 const (
 	// https://github.com/kubernetes/kubernetes/blob/master/pkg/controller/deployment/util/deployment_util.go#L93
 	TimedOutReason = "ProgressDeadlineExceeded"
+
+	// restartedAtAnnotation is the pod template annotation set by
+	// `kubectl rollout restart`.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
 )
 
 func resourceKubernetesDeployment() *schema.Resource {
@@ -30,6 +35,7 @@ func resourceKubernetesDeployment() *schema.Resource {
 		ReadContext:   resourceKubernetesDeploymentRead,
 		UpdateContext: resourceKubernetesDeploymentUpdate,
 		DeleteContext: resourceKubernetesDeploymentDelete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -61,10 +67,11 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"min_ready_seconds": {
-						Type:        schema.TypeInt,
-						Description: "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
-						Optional:    true,
-						Default:     0,
+						Type:         schema.TypeInt,
+						Description:  "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
 					},
 					"paused": {
 						Type:        schema.TypeBool,
@@ -73,10 +80,11 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 						Default:     false,
 					},
 					"progress_deadline_seconds": {
-						Type:        schema.TypeInt,
-						Description: "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.",
-						Optional:    true,
-						Default:     600,
+						Type:         schema.TypeInt,
+						Description:  "The maximum time in seconds for a deployment to make progress before it is considered to be failed. The deployment controller will continue to process failed deployments and a condition with a ProgressDeadlineExceeded reason will be surfaced in the deployment status. Note that progress will not be estimated during the time a deployment is paused. Defaults to 600s.",
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
 					},
 					"replicas": {
 						Type:         schema.TypeString,
@@ -154,26 +162,29 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 									ValidateFunc: validation.StringInSlice([]string{"RollingUpdate", "Recreate"}, false),
 								},
 								"rolling_update": {
-									Type:        schema.TypeList,
-									Description: "Rolling update config params. Present only if DeploymentStrategyType = RollingUpdate.",
-									Optional:    true,
-									Computed:    true,
-									MaxItems:    1,
+									Type:             schema.TypeList,
+									Description:      "Rolling update config params. Present only if DeploymentStrategyType = RollingUpdate.",
+									Optional:         true,
+									Computed:         true,
+									MaxItems:         1,
+									DiffSuppressFunc: suppressRollingUpdateForRecreate,
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"max_surge": {
-												Type:         schema.TypeString,
-												Description:  "The maximum number of pods that can be scheduled above the desired number of pods. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Defaults to 25%. Example: when this is set to 30%, the new RC can be scaled up immediately when the rolling update starts, such that the total number of old and new pods do not exceed 130% of desired pods. Once old pods have been killed, new RC can be scaled up further, ensuring that total number of pods running at any time during the update is atmost 130% of desired pods.",
-												Optional:     true,
-												Default:      "25%",
-												ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]+%|)$`), ""),
+												Type:             schema.TypeString,
+												Description:      "The maximum number of pods that can be scheduled above the desired number of pods. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). This can not be 0 if MaxUnavailable is 0. Absolute number is calculated from percentage by rounding up. Defaults to 25%. Example: when this is set to 30%, the new RC can be scaled up immediately when the rolling update starts, such that the total number of old and new pods do not exceed 130% of desired pods. Once old pods have been killed, new RC can be scaled up further, ensuring that total number of pods running at any time during the update is atmost 130% of desired pods.",
+												Optional:         true,
+												Default:          "25%",
+												ValidateFunc:     validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]+%|)$`), ""),
+												DiffSuppressFunc: suppressEquivalentIntOrString,
 											},
 											"max_unavailable": {
-												Type:         schema.TypeString,
-												Description:  "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 25%. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.",
-												Optional:     true,
-												Default:      "25%",
-												ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]+%|)$`), ""),
+												Type:             schema.TypeString,
+												Description:      "The maximum number of pods that can be unavailable during the update. Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%). Absolute number is calculated from percentage by rounding down. This can not be 0 if MaxSurge is 0. Defaults to 25%. Example: when this is set to 30%, the old RC can be scaled down to 70% of desired pods immediately when the rolling update starts. Once new pods are ready, old RC can be scaled down further, followed by scaling up the new RC, ensuring that the total number of pods available at all times during the update is at least 70% of desired pods.",
+												Optional:         true,
+												Default:          "25%",
+												ValidateFunc:     validation.StringMatch(regexp.MustCompile(`^([0-9]+|[0-9]+%|)$`), ""),
+												DiffSuppressFunc: suppressEquivalentIntOrString,
 											},
 										},
 									},
@@ -210,6 +221,18 @@ func resourceKubernetesDeploymentSchemaV1() map[string]*schema.Schema {
 			Default:     true,
 			Optional:    true,
 		},
+		"restart_on_change": {
+			Type:        schema.TypeMap,
+			Description: "Arbitrary values which trigger a rolling restart of the deployment when they change, like `kubectl rollout restart`, e.g. the hash of a config map mounted by the pods.",
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"server_side_apply": serverSideApplySchema("deployment"),
+		"force": {
+			Type:        schema.TypeBool,
+			Description: "Force taking ownership of the fields of the deployment managed by other field managers when it is server-side applied.",
+			Optional:    true,
+		},
 	}
 }
 
@@ -230,10 +253,28 @@ func resourceKubernetesDeploymentCreate(ctx context.Context, d *schema.ResourceD
 		Spec:       *spec,
 	}
 
-	log.Printf("[INFO] Creating new deployment: %#v", deployment)
-	out, err := conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
-	if err != nil {
-		return diag.Errorf("Failed to create deployment: %s", err)
+	var out *appsv1.Deployment
+	if useServerSideApply(d, meta) {
+		_, err = conn.AppsV1().Deployments(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+		if err == nil {
+			return diag.Errorf("Failed to create deployment: deployment %q already exists", metadata.Namespace+"/"+metadata.Name)
+		}
+		if !errors.IsNotFound(err) {
+			return diag.Errorf("Failed to create deployment: %s", err)
+		}
+		log.Printf("[INFO] Applying new deployment: %#v", deployment)
+		out, err = applyDeployment(ctx, conn, d, &deployment)
+		if err != nil {
+			return applyErrorDiagnostics(err, defaultFieldManagerName)
+		}
+		d.Set("server_side_apply", true)
+	} else {
+		log.Printf("[INFO] Creating new deployment: %#v", deployment)
+		out, err = conn.AppsV1().Deployments(metadata.Namespace).Create(ctx, &deployment, metav1.CreateOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to create deployment: %s", err)
+		}
+		d.Set("server_side_apply", false)
 	}
 
 	d.SetId(buildId(out.ObjectMeta))
@@ -265,6 +306,11 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(err)
 	}
 
+	if useServerSideApply(d, meta) {
+		return resourceKubernetesDeploymentApply(ctx, d, meta)
+	}
+	d.Set("server_side_apply", false)
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 
 	if d.HasChange("spec") {
@@ -272,6 +318,11 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &spec.Template.Spec)
+		err = keepDeploymentLiveFields(ctx, conn, namespace, name, spec, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -279,16 +330,6 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 		})
 	}
 
-	if d.HasChange("spec.0.strategy") {
-		o, n := d.GetChange("spec.0.strategy.0.type")
-
-		if o.(string) == "RollingUpdate" && n.(string) == "Recreate" {
-			ops = append(ops, &RemoveOperation{
-				Path: "/spec/strategy/rollingUpdate",
-			})
-		}
-	}
-
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -300,6 +341,13 @@ func resourceKubernetesDeploymentUpdate(ctx context.Context, d *schema.ResourceD
 	}
 	log.Printf("[INFO] Submitted updated deployment: %#v", out)
 
+	if d.HasChange("restart_on_change") {
+		out, err = restartDeployment(ctx, conn, namespace, name)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.Get("wait_for_rollout").(bool) {
 		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
 		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
@@ -339,21 +387,175 @@ func resourceKubernetesDeploymentRead(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[INFO] Received deployment: %#v", deployment)
 
-	err = d.Set("metadata", flattenMetadata(deployment.ObjectMeta, d))
+	metadata := flattenMetadata(deployment.ObjectMeta, d, meta)
+	spec, err := flattenDeploymentSpec(deployment.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	spec, err := flattenDeploymentSpec(deployment.Spec, d)
+	if useServerSideApply(d, meta) {
+		err = reconcileDeploymentManagedFields(deployment, d, metadata, spec)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = d.Set("metadata", metadata)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", spec, "0.template.0.spec.0")
 	err = d.Set("spec", spec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	return diags
+}
+
+// resourceKubernetesDeploymentApply updates the deployment with a
+// server-side apply patch of the whole configuration.
+func resourceKubernetesDeploymentApply(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := expandDeploymentSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &spec.Template.Spec)
+	deployment := appsv1.Deployment{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       *spec,
+	}
+
+	log.Printf("[INFO] Applying deployment %q: %#v", d.Id(), deployment)
+	out, err := applyDeployment(ctx, conn, d, &deployment)
+	if err != nil {
+		return applyErrorDiagnostics(err, defaultFieldManagerName)
+	}
+	d.Set("server_side_apply", true)
+	log.Printf("[INFO] Submitted updated deployment: %#v", out)
+
+	if d.HasChange("restart_on_change") {
+		out, err = restartDeployment(ctx, conn, out.GetNamespace(), out.GetName())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.Get("wait_for_rollout").(bool) {
+		log.Printf("[INFO] Waiting for deployment %s/%s to rollout", out.ObjectMeta.Namespace, out.ObjectMeta.Name)
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
+			waitForDeploymentReplicasFunc(ctx, conn, out.GetNamespace(), out.GetName()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesDeploymentRead(ctx, d, meta)
+}
+
+// restartDeployment triggers a rolling restart of the deployment by setting
+// the annotation `kubectl rollout restart` sets on the pod template. It is a
+// merge patch rather than part of the applied configuration, so that the
+// next apply does not remove the annotation and restart the pods again.
+func restartDeployment(ctx context.Context, conn *kubernetes.Clientset, namespace, name string) (*appsv1.Deployment, error) {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{
+						restartedAtAnnotation: time.Now().Format(time.RFC3339),
+					},
+				},
+			},
+		},
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to marshal restart patch: %s", err)
+	}
+	log.Printf("[INFO] Restarting deployment %s/%s", namespace, name)
+	out, err := conn.AppsV1().Deployments(namespace).Patch(ctx, name, types.MergePatchType, data, metav1.PatchOptions{
+		FieldManager: defaultFieldManagerName,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Failed to restart deployment: %s", err)
+	}
+	return out, nil
+}
+
+// keepDeploymentLiveFields copies the fields which Terraform does not manage
+// from the live deployment into the spec which replaces it: the replica count
+// when it is not configured, as it may be set by a HorizontalPodAutoscaler,
+// and the restart annotation, as dropping it would restart the pods again.
+func keepDeploymentLiveFields(ctx context.Context, conn *kubernetes.Clientset, namespace, name string, spec *appsv1.DeploymentSpec, d *schema.ResourceData) error {
+	keepReplicas := !isConfigured(d, "spec", "replicas")
+	configured, _ := d.Get("spec.0.template.0.metadata.0.annotations").(map[string]interface{})
+	_, restartedAtConfigured := configured[restartedAtAnnotation]
+	if !keepReplicas && restartedAtConfigured {
+		return nil
+	}
+
+	live, err := conn.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to read deployment: %s", err)
+	}
+	if keepReplicas {
+		spec.Replicas = live.Spec.Replicas
+	}
+	if v, ok := live.Spec.Template.Annotations[restartedAtAnnotation]; ok && !restartedAtConfigured {
+		if spec.Template.Annotations == nil {
+			spec.Template.Annotations = map[string]string{}
+		}
+		spec.Template.Annotations[restartedAtAnnotation] = v
+	}
+	return nil
+}
+
+// applyDeployment server-side applies the deployment. The replica count is
+// only sent when it is set in the configuration, so that it can be left to
+// a HorizontalPodAutoscaler.
+func applyDeployment(ctx context.Context, conn *kubernetes.Clientset, d *schema.ResourceData, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+	if !isConfigured(d, "spec", "replicas") {
+		deployment.Spec.Replicas = nil
+	}
+	data, err := serverSideApplyPatch(deployment, "apps/v1", "Deployment")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to marshal deployment: %s", err)
+	}
+	return conn.AppsV1().Deployments(deployment.Namespace).Patch(ctx, deployment.Name, types.ApplyPatchType, data, metav1.PatchOptions{
+		FieldManager: defaultFieldManagerName,
+		Force:        ptrToBool(d.Get("force").(bool)),
+	})
+}
+
+// reconcileDeploymentManagedFields keeps only the parts of the flattened
+// deployment which are owned by Terraform's field manager, so that fields
+// set by controllers and admission webhooks do not show up as diffs.
+func reconcileDeploymentManagedFields(deployment *appsv1.Deployment, d *schema.ResourceData, metadata, spec []interface{}) error {
+	fields, err := getManagedFields(deployment.ManagedFields, defaultFieldManagerName)
+	if err != nil {
+		return err
+	}
+
+	reconcileManagedMetadata(metadata, fields, "metadata")
+	if len(spec) == 0 || spec[0] == nil {
+		return nil
+	}
+	s := spec[0].(map[string]interface{})
+	if _, ok := managedFieldAt(fields, "spec", "replicas"); !ok {
+		s["replicas"] = d.Get("spec.0.replicas")
+	}
+	if t, ok := s["template"].([]interface{}); ok && len(t) > 0 && t[0] != nil {
+		if tm, ok := t[0].(map[string]interface{})["metadata"].([]interface{}); ok {
+			reconcileManagedMetadata(tm, fields, "spec", "template", "metadata")
+		}
+	}
 	return nil
 }
 
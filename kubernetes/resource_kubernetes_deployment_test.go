@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -42,6 +43,115 @@ func TestAccKubernetesDeployment_minimal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_restartOnChange(t *testing.T) {
+	var conf1, conf2 appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := nginxImageVersion
+	resourceName := "kubernetes_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_restartOnChange(name, imageName, "one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "restart_on_change.config", "one"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.progress_deadline_seconds", "600"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.min_ready_seconds", "5"),
+				),
+			},
+			{
+				Config: testAccKubernetesDeploymentConfig_restartOnChange(name, imageName, "two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "restart_on_change.config", "two"),
+					resource.TestCheckNoResourceAttr(resourceName, "spec.0.template.0.metadata.0.annotations.kubectl.kubernetes.io/restartedAt"),
+					func(s *terraform.State) error {
+						if _, ok := conf2.Spec.Template.Annotations[restartedAtAnnotation]; !ok {
+							return fmt.Errorf("expected the pod template to have the %s annotation", restartedAtAnnotation)
+						}
+						if conf1.Generation == conf2.Generation {
+							return fmt.Errorf("expected the deployment to be restarted")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccKubernetesDeploymentConfig_restartOnChange(name, imageName, "two"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesDeployment_horizontalPodAutoscaler(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_deployment_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_horizontalPodAutoscaler(name, nginxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf),
+					testAccWaitForScale(func() (int32, error) {
+						conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+						if err != nil {
+							return 0, err
+						}
+						scale, err := conn.AppsV1().Deployments(conf.Namespace).GetScale(context.Background(), conf.Name, metav1.GetOptions{})
+						if err != nil {
+							return 0, err
+						}
+						return scale.Spec.Replicas, nil
+					}, 3),
+				),
+			},
+			{
+				Config: testAccKubernetesDeploymentConfig_horizontalPodAutoscaler(name, nginxImageVersion1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "3"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.container.0.image", nginxImageVersion1),
+					func(s *terraform.State) error {
+						if *conf.Spec.Replicas != 3 {
+							return fmt.Errorf("expected the replicas set by the autoscaler to be kept, got %d", *conf.Spec.Replicas)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+// testAccWaitForScale waits for the replica count returned by get to reach
+// the expected value, e.g. after an autoscaler acted on the workload.
+func testAccWaitForScale(get func() (int32, error), expected int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		return resource.RetryContext(context.Background(), 3*time.Minute, func() *resource.RetryError {
+			replicas, err := get()
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			if replicas != expected {
+				return resource.RetryableError(fmt.Errorf("waiting for %d replicas, got %d", expected, replicas))
+			}
+			return nil
+		})
+	}
+}
+
 func TestAccKubernetesDeployment_basic(t *testing.T) {
 	var conf appsv1.Deployment
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -860,6 +970,35 @@ func TestAccKubernetesDeployment_with_share_process_namespace(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesDeployment_with_sidecar_init_container(t *testing.T) {
+	var conf appsv1.Deployment
+
+	deploymentName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_deployment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.29.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The sidecar never terminates, so the rollout wait must not
+				// depend on init containers completing.
+				Config: testAccKubernetesDeploymentConfigWithSidecarInitContainer(deploymentName, nginxImageVersion, busyboxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.init_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.init_container.0.name", "sidecar"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.init_container.0.restart_policy", "Always"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesDeployment_no_rollout_wait(t *testing.T) {
 	deploymentName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 	imageName := nginxImageVersion
@@ -1049,13 +1188,18 @@ func TestAccKubernetesDeployment_with_host_aliases(t *testing.T) {
 				Config: testAccKubernetesDeploymentConfigHostAliases(deploymentName, imageName),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckKubernetesDeploymentExists("kubernetes_deployment.test", &conf),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.0.hostnames.#", "2"),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.0.hostnames.0", "abc.com"),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.0.hostnames.1", "contoso.com"),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.0.ip", "127.0.0.5"),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.1.hostnames.#", "1"),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.1.hostnames.0", "xyz.com"),
-					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.1.ip", "127.0.0.6"),
+					resource.TestCheckResourceAttr("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.*", map[string]string{
+						"hostnames.#": "2",
+						"hostnames.0": "abc.com",
+						"hostnames.1": "contoso.com",
+						"ip":          "127.0.0.5",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("kubernetes_deployment.test", "spec.0.template.0.spec.0.host_aliases.*", map[string]string{
+						"hostnames.#": "1",
+						"hostnames.0": "xyz.com",
+						"ip":          "127.0.0.6",
+					}),
 				),
 			},
 		},
@@ -1233,6 +1377,54 @@ func getDeploymentFromResourceName(s *terraform.State, n string) (*appsv1.Deploy
 	return out, nil
 }
 
+func TestAccKubernetesDeployment_serverSideApply(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := nginxImageVersion
+	resourceName := "kubernetes_deployment_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_serverSideApply(name, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf),
+					testAccCheckKubernetesDeploymentAppliedBy(resourceName, defaultFieldManagerName),
+					resource.TestCheckResourceAttr(resourceName, "server_side_apply", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.%", "1"),
+				),
+			},
+			{
+				// scale the deployment and add a label from outside Terraform,
+				// neither must show up as a diff
+				PreConfig: func() {
+					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+					if err != nil {
+						t.Fatal(err)
+					}
+					ctx := context.TODO()
+					d, err := conn.AppsV1().Deployments("default").Get(ctx, name, metav1.GetOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+					d.Labels["external"] = "true"
+					d.Spec.Replicas = ptrToInt32(3)
+					_, err = conn.AppsV1().Deployments("default").Update(ctx, d, metav1.UpdateOptions{FieldManager: "tftest"})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccKubernetesDeploymentConfig_serverSideApply(name, imageName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesDeploymentExists(n string, obj *appsv1.Deployment) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		d, err := getDeploymentFromResourceName(s, n)
@@ -1244,6 +1436,21 @@ func testAccCheckKubernetesDeploymentExists(n string, obj *appsv1.Deployment) re
 	}
 }
 
+func testAccCheckKubernetesDeploymentAppliedBy(n, manager string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		d, err := getDeploymentFromResourceName(s, n)
+		if err != nil {
+			return err
+		}
+		for _, m := range d.ManagedFields {
+			if m.Manager == manager && m.Operation == metav1.ManagedFieldsOperationApply {
+				return nil
+			}
+		}
+		return fmt.Errorf("deployment was not applied by field manager %q", manager)
+	}
+}
+
 func testAccCheckKubernetesDeploymentRollingOut(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		d, err := getDeploymentFromResourceName(s, n)
@@ -1289,6 +1496,134 @@ func testAccKubernetesDeploymentConfig_minimal(name, imageName string) string {
 `, name, imageName)
 }
 
+func testAccKubernetesDeploymentConfig_restartOnChange(name, imageName, trigger string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+  }
+  restart_on_change = {
+    config = "%s"
+  }
+  spec {
+    replicas          = 1
+    min_ready_seconds = 5
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, trigger, imageName)
+}
+
+func testAccKubernetesDeploymentConfig_horizontalPodAutoscaler(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  wait_for_rollout = false
+  spec {
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+          resources {
+            requests = {
+              cpu = "10m"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "kubernetes_horizontal_pod_autoscaler_v2" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    min_replicas = 3
+    max_replicas = 5
+    scale_target_ref {
+      api_version = "apps/v1"
+      kind        = "Deployment"
+      name        = kubernetes_deployment_v1.test.metadata.0.name
+    }
+    metric {
+      type = "Resource"
+      resource {
+        name = "cpu"
+        target {
+          type                = "Utilization"
+          average_utilization = 80
+        }
+      }
+    }
+  }
+}
+`, name, imageName, name)
+}
+
+func testAccKubernetesDeploymentConfig_serverSideApply(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name = "%s"
+    labels = {
+      TestLabelOne = "one"
+    }
+  }
+  server_side_apply = true
+  wait_for_rollout  = false
+  spec {
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, imageName)
+}
+
 func testAccKubernetesDeploymentConfig_basic(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
   metadata {
@@ -2490,6 +2825,48 @@ func testAccKubernetesDeploymentConfigWithDeploymentStrategy(deploymentName, str
 `, deploymentName, strategy, imageName)
 }
 
+func testAccKubernetesDeploymentConfigWithSidecarInitContainer(deploymentName, imageName, sidecarImageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
+  metadata {
+    name = "%s"
+
+    labels = {
+      Test = "TfAcceptanceTest"
+    }
+  }
+
+  spec {
+    selector {
+      match_labels = {
+        Test = "TfAcceptanceTest"
+      }
+    }
+
+    template {
+      metadata {
+        labels = {
+          Test = "TfAcceptanceTest"
+        }
+      }
+
+      spec {
+        init_container {
+          image          = "%s"
+          name           = "sidecar"
+          command        = ["sh", "-c", "while true; do date; sleep 10; done"]
+          restart_policy = "Always"
+        }
+        container {
+          image = "%s"
+          name  = "containername"
+        }
+      }
+    }
+  }
+}
+`, deploymentName, sidecarImageName, imageName)
+}
+
 func testAccKubernetesDeploymentConfigWithShareProcessNamespace(deploymentName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_deployment" "test" {
   metadata {
@@ -3024,3 +3401,100 @@ func testAccKubernetesDeploymentConfig_afterUpdate(name, nginxImage, busyboxImag
 }
 `, name, nginxImage, busyboxImage)
 }
+
+func TestAccKubernetesDeployment_validatePodSecurity(t *testing.T) {
+	var conf appsv1.Deployment
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := nginxImageVersion
+	resourceName := "kubernetes_deployment_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesDeploymentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesDeploymentConfig_podSecurityNamespace(name),
+			},
+			{
+				Config:      testAccKubernetesDeploymentConfig_podSecurityNamespace(name) + testAccKubernetesDeploymentConfig_podSecurity(name, imageName, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`pod of namespace "` + name + `" violates PodSecurity "restricted:latest": allowPrivilegeEscalation != false`),
+			},
+			{
+				Config: testAccKubernetesDeploymentConfig_podSecurityNamespace(name) + testAccKubernetesDeploymentConfig_podSecurity(name, imageName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesDeploymentExists(resourceName, &conf),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesDeploymentConfig_podSecurityNamespace(name string) string {
+	return fmt.Sprintf(`provider "kubernetes" {
+  validate_pod_security = true
+}
+
+resource "kubernetes_namespace_v1" "test" {
+  metadata {
+    name = "%s"
+    labels = {
+      "pod-security.kubernetes.io/enforce" = "restricted"
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesDeploymentConfig_podSecurity(name, imageName string, restricted bool) string {
+	securityContext := ""
+	containerSecurityContext := ""
+	if restricted {
+		securityContext = `
+        security_context {
+          run_as_non_root = true
+          run_as_user     = 101
+          seccomp_profile {
+            type = "RuntimeDefault"
+          }
+        }`
+		containerSecurityContext = `
+          security_context {
+            allow_privilege_escalation = false
+            capabilities {
+              drop = ["ALL"]
+            }
+          }`
+	}
+	return fmt.Sprintf(`
+resource "kubernetes_deployment_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = kubernetes_namespace_v1.test.metadata.0.name
+  }
+  spec {
+    replicas = 1
+    selector {
+      match_labels = {
+        TestLabelOne = "one"
+      }
+    }
+    template {
+      metadata {
+        labels = {
+          TestLabelOne = "one"
+        }
+      }
+      spec {%s
+        container {
+          image = "%s"
+          name  = "tf-acc-test"%s
+        }
+      }
+    }
+  }
+  wait_for_rollout = false
+}
+`, name, securityContext, imageName, containerSecurityContext)
+}
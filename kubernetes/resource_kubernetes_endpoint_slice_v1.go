@@ -0,0 +1,232 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	utilValidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+// endpointSliceV1MaxEndpoints is the number of endpoints the endpoint slice
+// controller puts in a single slice, larger slices are expensive to watch.
+const endpointSliceV1MaxEndpoints = 100
+
+func resourceKubernetesEndpointSliceV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesEndpointSliceV1Create,
+		ReadContext:   resourceKubernetesEndpointSliceV1Read,
+		UpdateContext: resourceKubernetesEndpointSliceV1Update,
+		DeleteContext: resourceKubernetesEndpointSliceV1Delete,
+		CustomizeDiff: resourceKubernetesEndpointSliceV1CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("endpoint slice", true),
+			"address_type": {
+				Type:        schema.TypeString,
+				Description: "Specifies the type of address carried by this EndpointSlice. All addresses in this slice must be the same type. Supported types are `IPv4`, `IPv6` and `FQDN`. This field is immutable after creation.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(discovery.AddressTypeIPv4),
+					string(discovery.AddressTypeIPv6),
+					string(discovery.AddressTypeFQDN),
+				}, false),
+			},
+			"endpoint": {
+				Type:        schema.TypeList,
+				Description: "A list of unique endpoints in this slice.",
+				Required:    true,
+				MaxItems:    endpointSliceV1MaxEndpoints,
+				Elem:        schemaEndpointSliceV1Endpoint(),
+			},
+			"port": {
+				Type:        schema.TypeList,
+				Description: "The list of network ports exposed by each endpoint in this slice. When no ports are specified the endpoints carry no port information and are not usable by a service.",
+				Optional:    true,
+				MaxItems:    100,
+				Elem:        schemaEndpointSliceV1Port(),
+			},
+		},
+	}
+}
+
+func resourceKubernetesEndpointSliceV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	addressType := d.Get("address_type").(string)
+	for i, e := range d.Get("endpoint").([]interface{}) {
+		endpoint, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, a := range endpoint["addresses"].([]interface{}) {
+			addr, ok := a.(string)
+			// Unknown addresses are validated once they are known.
+			if !ok || addr == "" {
+				continue
+			}
+			if err := validateEndpointSliceV1Address(addressType, addr); err != nil {
+				return fmt.Errorf("endpoint.%d: %s", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validateEndpointSliceV1Address(addressType, addr string) error {
+	switch discovery.AddressType(addressType) {
+	case discovery.AddressTypeIPv4:
+		if ip := net.ParseIP(addr); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%q is not a valid IPv4 address", addr)
+		}
+	case discovery.AddressTypeIPv6:
+		if ip := net.ParseIP(addr); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%q is not a valid IPv6 address", addr)
+		}
+	case discovery.AddressTypeFQDN:
+		if errs := utilValidation.IsDNS1123Subdomain(addr); len(errs) > 0 {
+			return fmt.Errorf("%q is not a valid FQDN: %s", addr, errs[0])
+		}
+	}
+	return nil
+}
+
+func resourceKubernetesEndpointSliceV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	slice := discovery.EndpointSlice{
+		ObjectMeta:  metadata,
+		AddressType: discovery.AddressType(d.Get("address_type").(string)),
+		Endpoints:   expandEndpointSliceV1Endpoints(d.Get("endpoint").([]interface{})),
+		Ports:       expandEndpointSliceV1Ports(d.Get("port").([]interface{})),
+	}
+	log.Printf("[INFO] Creating new endpoint slice: %#v", slice)
+	out, err := conn.DiscoveryV1().EndpointSlices(metadata.Namespace).Create(ctx, &slice, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create endpoint slice because: %s", err)
+	}
+	log.Printf("[INFO] Submitted new endpoint slice: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointSliceV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesEndpointSliceV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to read endpoint slice because: %s", err)
+	}
+
+	log.Printf("[INFO] Reading endpoint slice %s", name)
+	slice, err := conn.DiscoveryV1().EndpointSlices(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Endpoint slice %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read endpoint slice because: %s", err)
+	}
+	log.Printf("[INFO] Received endpoint slice: %#v", slice)
+	err = d.Set("metadata", flattenMetadata(slice.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.Errorf("Failed to read endpoint slice because: %s", err)
+	}
+	err = d.Set("address_type", string(slice.AddressType))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("endpoint", flattenEndpointSliceV1Endpoints(slice.Endpoints))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("port", flattenEndpointSliceV1Ports(slice.Ports))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesEndpointSliceV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to update endpoint slice because: %s", err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("endpoint") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/endpoints",
+			Value: expandEndpointSliceV1Endpoints(d.Get("endpoint").([]interface{})),
+		})
+	}
+	if d.HasChange("port") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/ports",
+			Value: expandEndpointSliceV1Ports(d.Get("port").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating endpoint slice %q: %v", name, string(data))
+	out, err := conn.DiscoveryV1().EndpointSlices(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update endpoint slice: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated endpoint slice: %#v", out)
+	d.SetId(buildId(out.ObjectMeta))
+
+	return resourceKubernetesEndpointSliceV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesEndpointSliceV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.Errorf("Failed to delete endpoint slice because: %s", err)
+	}
+	log.Printf("[INFO] Deleting endpoint slice: %#v", name)
+	err = conn.DiscoveryV1().EndpointSlices(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete endpoint slice because: %s", err)
+	}
+	log.Printf("[INFO] Endpoint slice %s deleted", name)
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesEndpointSliceV1_basic(t *testing.T) {
+	var conf discovery.EndpointSlice
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_endpoint_slice_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.21.0") },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesEndpointSliceV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesEndpointSliceV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointSliceV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name+"-1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.labels.kubernetes.io/service-name", name),
+					resource.TestCheckResourceAttr(resourceName, "address_type", "IPv4"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.addresses.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.addresses.0", "10.0.0.4"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.condition.0.ready", "true"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.condition.0.serving", "true"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.condition.0.terminating", "false"),
+					resource.TestCheckResourceAttr(resourceName, "port.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "port.0.name", "http"),
+					resource.TestCheckResourceAttr(resourceName, "port.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "port.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "port.0.app_protocol", "http"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesEndpointSliceV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesEndpointSliceV1Exists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.addresses.0", "10.0.0.5"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.hostname", "backend-0"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.0.zone", "zone-a"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.1.addresses.0", "10.0.0.6"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.1.condition.0.ready", "false"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.1.condition.0.serving", "true"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint.1.condition.0.terminating", "true"),
+					resource.TestCheckResourceAttr(resourceName, "port.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "port.1.name", "https"),
+					resource.TestCheckResourceAttr(resourceName, "port.1.port", "443"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesEndpointSliceV1_addressTypeMismatch(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesEndpointSliceV1Config_addressTypeMismatch(name),
+				ExpectError: regexp.MustCompile(`"10.0.0.4" is not a valid IPv6 address`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesEndpointSliceV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_endpoint_slice_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DiscoveryV1().EndpointSlices(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("Endpoint slice still exists: %s", rs.Primary.ID)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesEndpointSliceV1Exists(n string, obj *discovery.EndpointSlice) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.DiscoveryV1().EndpointSlices(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesEndpointSliceV1Config_service(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    port {
+      name        = "http"
+      port        = 80
+      target_port = 80
+    }
+
+    port {
+      name        = "https"
+      port        = 443
+      target_port = 443
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesEndpointSliceV1Config_basic(name string) string {
+	return testAccKubernetesEndpointSliceV1Config_service(name) + fmt.Sprintf(`
+resource "kubernetes_endpoint_slice_v1" "test" {
+  metadata {
+    name = "%s-1"
+    labels = {
+      "kubernetes.io/service-name" = kubernetes_service_v1.test.metadata.0.name
+    }
+  }
+
+  address_type = "IPv4"
+
+  endpoint {
+    addresses = ["10.0.0.4"]
+  }
+
+  port {
+    name         = "http"
+    port         = 80
+    app_protocol = "http"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesEndpointSliceV1Config_modified(name string) string {
+	return testAccKubernetesEndpointSliceV1Config_service(name) + fmt.Sprintf(`
+resource "kubernetes_endpoint_slice_v1" "test" {
+  metadata {
+    name = "%s-1"
+    labels = {
+      "kubernetes.io/service-name" = kubernetes_service_v1.test.metadata.0.name
+    }
+  }
+
+  address_type = "IPv4"
+
+  endpoint {
+    addresses = ["10.0.0.5"]
+    hostname  = "backend-0"
+    zone      = "zone-a"
+  }
+
+  endpoint {
+    addresses = ["10.0.0.6"]
+    condition {
+      ready       = false
+      serving     = true
+      terminating = true
+    }
+  }
+
+  port {
+    name         = "http"
+    port         = 80
+    app_protocol = "http"
+  }
+
+  port {
+    name = "https"
+    port = 443
+  }
+}
+`, name)
+}
+
+func testAccKubernetesEndpointSliceV1Config_addressTypeMismatch(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_endpoint_slice_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  address_type = "IPv6"
+
+  endpoint {
+    addresses = ["10.0.0.4"]
+  }
+}
+`, name)
+}
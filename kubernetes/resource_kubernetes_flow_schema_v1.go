@@ -0,0 +1,344 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesFlowSchemaV1() *schema.Resource {
+	apiDoc := flowcontrolv1beta3.FlowSchema{}.SwaggerDoc()
+	specDoc := flowcontrolv1beta3.FlowSchemaSpec{}.SwaggerDoc()
+	ruleDoc := flowcontrolv1beta3.PolicyRulesWithSubjects{}.SwaggerDoc()
+	subjectDoc := flowcontrolv1beta3.Subject{}.SwaggerDoc()
+	resourceRuleDoc := flowcontrolv1beta3.ResourcePolicyRule{}.SwaggerDoc()
+	nonResourceRuleDoc := flowcontrolv1beta3.NonResourcePolicyRule{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesFlowSchemaV1Create,
+		ReadContext:   resourceKubernetesFlowSchemaV1Read,
+		UpdateContext: resourceKubernetesFlowSchemaV1Update,
+		DeleteContext: resourceKubernetesFlowSchemaV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("flow schema", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: apiDoc["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority_level_configuration": {
+							Type:        schema.TypeList,
+							Description: specDoc["priorityLevelConfiguration"],
+							Required:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the priority level configuration being referenced.",
+										Required:    true,
+									},
+								},
+							},
+						},
+						"matching_precedence": {
+							Type:         schema.TypeInt,
+							Description:  "The precedence of the flow schema among the flow schemas matching a request, the one with the numerically lowest value is chosen. Must be between 1 and 10000. Defaults to 1000.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 10000),
+						},
+						"distinguisher_method": {
+							Type:        schema.TypeList,
+							Description: specDoc["distinguisherMethod"],
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Description:  "The type of the flow distinguisher method. Valid values are `ByUser` and `ByNamespace`.",
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"ByUser", "ByNamespace"}, false),
+									},
+								},
+							},
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Description: specDoc["rules"],
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"subject": {
+										Type:        schema.TypeList,
+										Description: ruleDoc["subjects"],
+										Required:    true,
+										MinItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"kind": {
+													Type:         schema.TypeString,
+													Description:  "The kind of the subject. Valid values are `User`, `Group` and `ServiceAccount`.",
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"User", "Group", "ServiceAccount"}, false),
+												},
+												"user": {
+													Type:        schema.TypeList,
+													Description: subjectDoc["user"],
+													Optional:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:        schema.TypeString,
+																Description: "The username that matches, or `*` to match all usernames.",
+																Required:    true,
+															},
+														},
+													},
+												},
+												"group": {
+													Type:        schema.TypeList,
+													Description: subjectDoc["group"],
+													Optional:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"name": {
+																Type:        schema.TypeString,
+																Description: "The user group that matches, or `*` to match all user groups.",
+																Required:    true,
+															},
+														},
+													},
+												},
+												"service_account": {
+													Type:        schema.TypeList,
+													Description: subjectDoc["serviceAccount"],
+													Optional:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"namespace": {
+																Type:        schema.TypeString,
+																Description: "The namespace of the matching service accounts.",
+																Required:    true,
+															},
+															"name": {
+																Type:        schema.TypeString,
+																Description: "The name of the matching service accounts, or `*` to match regardless of name.",
+																Required:    true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"resource_rule": {
+										Type:        schema.TypeList,
+										Description: ruleDoc["resourceRules"],
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"verbs": {
+													Type:        schema.TypeSet,
+													Description: resourceRuleDoc["verbs"],
+													Required:    true,
+													MinItems:    1,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+												"api_groups": {
+													Type:        schema.TypeSet,
+													Description: resourceRuleDoc["apiGroups"],
+													Required:    true,
+													MinItems:    1,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+												"resources": {
+													Type:        schema.TypeSet,
+													Description: resourceRuleDoc["resources"],
+													Required:    true,
+													MinItems:    1,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+												"cluster_scope": {
+													Type:        schema.TypeBool,
+													Description: resourceRuleDoc["clusterScope"],
+													Optional:    true,
+												},
+												"namespaces": {
+													Type:        schema.TypeSet,
+													Description: resourceRuleDoc["namespaces"],
+													Optional:    true,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+									"non_resource_rule": {
+										Type:        schema.TypeList,
+										Description: ruleDoc["nonResourceRules"],
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"verbs": {
+													Type:        schema.TypeSet,
+													Description: nonResourceRuleDoc["verbs"],
+													Required:    true,
+													MinItems:    1,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+												"non_resource_urls": {
+													Type:        schema.TypeSet,
+													Description: nonResourceRuleDoc["nonResourceURLs"],
+													Required:    true,
+													MinItems:    1,
+													Elem:        &schema.Schema{Type: schema.TypeString},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesFlowSchemaV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	fs := flowcontrolv1beta3.FlowSchema{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: flowControlV1GroupVersion,
+			Kind:       "FlowSchema",
+		},
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandFlowSchemaV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&fs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new FlowSchema: %#v", obj)
+	out, err := dc.Resource(flowSchemaV1Resource).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create FlowSchema %q because: %s", fs.Name, err)
+	}
+	log.Printf("[INFO] Submitted new FlowSchema: %#v", out)
+
+	d.SetId(out.GetName())
+
+	return resourceKubernetesFlowSchemaV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesFlowSchemaV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading FlowSchema %s", name)
+	out, err := dc.Resource(flowSchemaV1Resource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] FlowSchema %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read FlowSchema %q because: %s", name, err)
+	}
+	fs := flowcontrolv1beta3.FlowSchema{}
+	if err := fromUnstructuredObject(out, &fs); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received FlowSchema: %#v", fs)
+
+	err = d.Set("metadata", flattenMetadata(fs.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenFlowSchemaV1Spec(fs.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesFlowSchemaV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandFlowSchemaV1Spec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Updating FlowSchema %q: %v", name, string(data))
+	out, err := dc.Resource(flowSchemaV1Resource).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update FlowSchema %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated FlowSchema: %#v", out)
+
+	return resourceKubernetesFlowSchemaV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesFlowSchemaV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting FlowSchema: %#v", name)
+	err = dc.Resource(flowSchemaV1Resource).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete FlowSchema %q because: %s", name, err)
+	}
+
+	log.Printf("[INFO] FlowSchema %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,173 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesFlowSchemaV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_flow_schema_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.29.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesFlowControlV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesFlowSchemaV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesFlowSchemaV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.priority_level_configuration.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.matching_precedence", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.distinguisher_method.0.type", "ByUser"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.kind", "ServiceAccount"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.0.service_account.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.resource_rule.0.verbs.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "spec.0.rule.0.resource_rule.0.verbs.*", "list"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesFlowSchemaV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.matching_precedence", "500"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.distinguisher_method.0.type", "ByNamespace"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.subject.1.group.0.name", "system:authenticated"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.non_resource_rule.0.non_resource_urls.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesFlowSchemaV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = dc.Resource(flowSchemaV1Resource).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesFlowSchemaV1Config_priorityLevel(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      limit_response {
+        type = "Reject"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesFlowSchemaV1Config_basic(name string) string {
+	return testAccKubernetesFlowSchemaV1Config_priorityLevel(name) + fmt.Sprintf(`
+resource "kubernetes_flow_schema_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    priority_level_configuration {
+      name = kubernetes_priority_level_configuration_v1.test.metadata.0.name
+    }
+    distinguisher_method {
+      type = "ByUser"
+    }
+    rule {
+      subject {
+        kind = "ServiceAccount"
+        service_account {
+          namespace = "default"
+          name      = "*"
+        }
+      }
+      resource_rule {
+        verbs      = ["list", "watch"]
+        api_groups = [""]
+        resources  = ["pods"]
+        namespaces = ["default"]
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesFlowSchemaV1Config_modified(name string) string {
+	return testAccKubernetesFlowSchemaV1Config_priorityLevel(name) + fmt.Sprintf(`
+resource "kubernetes_flow_schema_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    priority_level_configuration {
+      name = kubernetes_priority_level_configuration_v1.test.metadata.0.name
+    }
+    matching_precedence = 500
+    distinguisher_method {
+      type = "ByNamespace"
+    }
+    rule {
+      subject {
+        kind = "ServiceAccount"
+        service_account {
+          namespace = "default"
+          name      = "*"
+        }
+      }
+      subject {
+        kind = "Group"
+        group {
+          name = "system:authenticated"
+        }
+      }
+      resource_rule {
+        verbs      = ["list", "watch"]
+        api_groups = [""]
+        resources  = ["pods"]
+        namespaces = ["default"]
+      }
+      non_resource_rule {
+        verbs             = ["get"]
+        non_resource_urls = ["/healthz"]
+      }
+    }
+  }
+}
+`, name)
+}
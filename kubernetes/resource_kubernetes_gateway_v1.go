@@ -0,0 +1,343 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesGatewayV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesGatewayV1Create,
+		ReadContext:   resourceKubernetesGatewayV1Read,
+		UpdateContext: resourceKubernetesGatewayV1Update,
+		DeleteContext: resourceKubernetesGatewayV1Delete,
+		CustomizeDiff: resourceKubernetesGatewayV1CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("gateway", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired state of the Gateway.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gateway_class_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the GatewayClass used for this Gateway.",
+							Required:    true,
+						},
+						"listener": {
+							Type:        schema.TypeList,
+							Description: "The logical endpoints that are bound on this Gateway's addresses. At least one listener must be specified.",
+							Required:    true,
+							MaxItems:    64,
+							Elem:        schemaGatewayV1Listener(),
+						},
+						"address": {
+							Type:        schema.TypeList,
+							Description: "The network addresses requested for this Gateway. When no address is specified the implementation assigns one, which is reported in `status.0.address`.",
+							Optional:    true,
+							MaxItems:    16,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:        schema.TypeString,
+										Description: "The type of the address, e.g. `IPAddress` or `Hostname`. Defaults to `IPAddress`.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Description: "The value of the address. The validity of the value depends on the type.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"wait_for_programmed": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the Gateway to report the `Programmed` condition before considering the resource created or updated.",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:        schema.TypeList,
+							Description: "The network addresses that have been bound to the Gateway.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"condition": gatewayAPIV1ConditionSchema(),
+						"listener": {
+							Type:        schema.TypeList,
+							Description: "The status of each listener.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"attached_routes": {
+										Type:        schema.TypeInt,
+										Description: "The number of routes that have been successfully attached to the listener.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesGatewayV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	return customizeDiffGatewayAPIV1(meta, gatewayV1Resource.Resource)
+}
+
+func resourceKubernetesGatewayV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkGatewayAPIV1Installed(conn, gatewayV1Resource.Resource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	gw := gatewayV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayAPIV1GroupVersion,
+			Kind:       "Gateway",
+		},
+		ObjectMeta: metadata,
+		Spec:       expandGatewayV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&gw)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Creating new gateway: %#v", obj)
+	out, err := dc.Resource(gatewayV1Resource).Namespace(metadata.Namespace).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create Gateway %q because: %s", buildId(metadata), err)
+	}
+	log.Printf("[INFO] Submitted new gateway: %#v", out)
+	d.SetId(buildId(metav1.ObjectMeta{Namespace: out.GetNamespace(), Name: out.GetName()}))
+
+	if d.Get("wait_for_programmed").(bool) {
+		if diags := waitForGatewayV1Programmed(ctx, meta, d.Id(), d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceKubernetesGatewayV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading gateway %s", name)
+	out, err := dc.Resource(gatewayV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Gateway %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read Gateway %q because: %s", d.Id(), err)
+	}
+	gw := gatewayV1{}
+	if err := fromUnstructuredObject(out, &gw); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received gateway: %#v", gw)
+
+	err = d.Set("metadata", flattenMetadata(gw.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenGatewayV1Spec(gw.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenGatewayV1Status(gw.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesGatewayV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandGatewayV1Spec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating gateway %q: %v", name, string(data))
+	out, err := dc.Resource(gatewayV1Resource).Namespace(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update Gateway %q because: %s", d.Id(), err)
+	}
+	log.Printf("[INFO] Submitted updated gateway: %#v", out)
+
+	if d.HasChange("spec") && d.Get("wait_for_programmed").(bool) {
+		if diags := waitForGatewayV1Programmed(ctx, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceKubernetesGatewayV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesGatewayV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting gateway: %#v", name)
+	err = dc.Resource(gatewayV1Resource).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete Gateway %q because: %s", d.Id(), err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := dc.Resource(gatewayV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Gateway (%s) still exists", d.Id())
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Gateway %s deleted", name)
+	d.SetId("")
+	return nil
+}
+
+func waitForGatewayV1Programmed(ctx context.Context, meta interface{}, id string, timeout time.Duration) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Waiting for gateway %s to be programmed", id)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		out, err := dc.Resource(gatewayV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			// NOTE it is possible in some HA apiserver setups that are eventually consistent
+			// that we could get a 404 when doing a Get immediately after a Create
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		gw := gatewayV1{}
+		if err := fromUnstructuredObject(out, &gw); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if gatewayAPIV1ConditionTrue(gw.Status.Conditions, "Programmed", gw.Generation) {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for Gateway %q to be programmed (%s)",
+			id, gatewayAPIV1ConditionMessage(gw.Status.Conditions, "Programmed")))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesGatewayV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_gateway_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfNoGatewayAPI(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesGatewayV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesGatewayV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesGatewayV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.gateway_class_name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.name", "http"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.protocol", "HTTP"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.allowed_routes.0.namespaces.0.from", "Same"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_programmed"},
+			},
+			{
+				Config: testAccKubernetesGatewayV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesGatewayV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.0.allowed_routes.0.namespaces.0.from", "All"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.name", "https"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.hostname", "*.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.tls.0.mode", "Terminate"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.tls.0.certificate_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.listener.1.tls.0.certificate_ref.0.kind", "Secret"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesGatewayV1_notInstalled(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfGatewayAPI(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesGatewayV1Config_basic(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Gateway API not installed"),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesGatewayV1Destroy(s *terraform.State) error {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_gateway_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = dc.Resource(gatewayV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("Gateway still exists: %s", rs.Primary.ID)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesGatewayV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = dc.Resource(gatewayV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesGatewayV1Config_basic(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_gateway_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  spec {
+    gateway_class_name = "%[1]s"
+
+    listener {
+      name     = "http"
+      port     = 80
+      protocol = "HTTP"
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesGatewayV1Config_modified(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_gateway_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  spec {
+    gateway_class_name = "%[1]s"
+
+    listener {
+      name     = "http"
+      port     = 80
+      protocol = "HTTP"
+
+      allowed_routes {
+        namespaces {
+          from = "All"
+        }
+      }
+    }
+
+    listener {
+      name     = "https"
+      hostname = "*.example.com"
+      port     = 443
+      protocol = "HTTPS"
+
+      tls {
+        certificate_ref {
+          name = "%[1]s"
+        }
+      }
+    }
+  }
+}
+`, name)
+}
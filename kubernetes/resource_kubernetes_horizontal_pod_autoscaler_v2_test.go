@@ -0,0 +1,233 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesHorizontalPodAutoscalerV2_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_horizontal_pod_autoscaler_v2.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.23.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesHorizontalPodAutoscalerV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerV2Config_noBehavior(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.max_replicas", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.type", "Resource"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.resource.0.name", "cpu"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesHorizontalPodAutoscalerV2Config_behavior(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.type", "ContainerResource"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.container_resource.0.name", "cpu"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.container_resource.0.container", "application"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.container_resource.0.target.0.type", "Utilization"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.metric.0.container_resource.0.target.0.average_utilization", "60"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.0.select_policy", "Max"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.0.policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.0.policy.0.type", "Pods"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_up.0.policy.0.value", "4"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.behavior.0.scale_down.0.stabilization_window_seconds", "300"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesHorizontalPodAutoscalerV2_invalidPolicy(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesHorizontalPodAutoscalerV2Config_invalidPolicy(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected .*period_seconds to be in the range`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesHorizontalPodAutoscalerV2Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_horizontal_pod_autoscaler_v2" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("Horizontal Pod Autoscaler still exists: %s", rs.Primary.ID)
+		}
+		if statusErr, ok := err.(*errors.StatusError); !ok || !errors.IsNotFound(statusErr) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesHorizontalPodAutoscalerV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.AutoscalingV2().HorizontalPodAutoscalers(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesHorizontalPodAutoscalerV2Config_noBehavior(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_horizontal_pod_autoscaler_v2" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    max_replicas = 10
+
+    scale_target_ref {
+      kind = "Deployment"
+      name = "TerraformAccTest"
+    }
+
+    metric {
+      type = "Resource"
+      resource {
+        name = "cpu"
+        target {
+          type                = "Utilization"
+          average_utilization = 50
+        }
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesHorizontalPodAutoscalerV2Config_behavior(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_horizontal_pod_autoscaler_v2" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    max_replicas = 10
+
+    scale_target_ref {
+      kind = "Deployment"
+      name = "TerraformAccTest"
+    }
+
+    behavior {
+      scale_up {
+        select_policy = "Max"
+
+        policy {
+          period_seconds = 60
+          type           = "Pods"
+          value          = 4
+        }
+      }
+    }
+
+    metric {
+      type = "ContainerResource"
+      container_resource {
+        name      = "cpu"
+        container = "application"
+        target {
+          type                = "Utilization"
+          average_utilization = 60
+        }
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesHorizontalPodAutoscalerV2Config_invalidPolicy(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_horizontal_pod_autoscaler_v2" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    max_replicas = 10
+
+    scale_target_ref {
+      kind = "Deployment"
+      name = "TerraformAccTest"
+    }
+
+    behavior {
+      scale_down {
+        policy {
+          period_seconds = 0
+          type           = "Pods"
+          value          = 1
+        }
+      }
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,328 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesHTTPRouteV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesHTTPRouteV1Create,
+		ReadContext:   resourceKubernetesHTTPRouteV1Read,
+		UpdateContext: resourceKubernetesHTTPRouteV1Update,
+		DeleteContext: resourceKubernetesHTTPRouteV1Delete,
+		CustomizeDiff: resourceKubernetesHTTPRouteV1CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("HTTP route", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired state of the HTTPRoute.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parent_ref": {
+							Type:        schema.TypeList,
+							Description: "The resources, usually Gateways, the route attaches to.",
+							Optional:    true,
+							MaxItems:    32,
+							Elem:        schemaGatewayV1ParentReference(),
+						},
+						"hostnames": {
+							Type:        schema.TypeList,
+							Description: "The hostnames matched against the `Host` header of the request. A leading wildcard label (e.g. `*.example.com`) is allowed.",
+							Optional:    true,
+							MaxItems:    16,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"rule": {
+							Type:        schema.TypeList,
+							Description: "The rules matching HTTP requests and the actions taken on them. Defaults to a single rule matching all requests.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    16,
+							Elem:        schemaHTTPRouteV1Rule(),
+						},
+					},
+				},
+			},
+			"wait_for_accepted": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for every parent of the route to report the `Accepted` condition before considering the resource created or updated.",
+				Optional:    true,
+				Default:     false,
+			},
+			"status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parent": {
+							Type:        schema.TypeList,
+							Description: "The status of the route for each parent it references.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"parent_ref": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     schemaGatewayV1ParentReference(),
+									},
+									"controller_name": {
+										Type:        schema.TypeString,
+										Description: "The name of the controller that wrote the status.",
+										Computed:    true,
+									},
+									"condition": gatewayAPIV1ConditionSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesHTTPRouteV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+	return customizeDiffGatewayAPIV1(meta, httpRouteV1Resource.Resource)
+}
+
+func resourceKubernetesHTTPRouteV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkGatewayAPIV1Installed(conn, httpRouteV1Resource.Resource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	route := httpRouteV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: gatewayAPIV1GroupVersion,
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metadata,
+		Spec:       expandHTTPRouteV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&route)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Creating new HTTP route: %#v", obj)
+	out, err := dc.Resource(httpRouteV1Resource).Namespace(metadata.Namespace).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create HTTPRoute %q because: %s", buildId(metadata), err)
+	}
+	log.Printf("[INFO] Submitted new HTTP route: %#v", out)
+	d.SetId(buildId(metav1.ObjectMeta{Namespace: out.GetNamespace(), Name: out.GetName()}))
+
+	if d.Get("wait_for_accepted").(bool) {
+		if diags := waitForHTTPRouteV1Accepted(ctx, meta, d.Id(), d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceKubernetesHTTPRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesHTTPRouteV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading HTTP route %s", name)
+	out, err := dc.Resource(httpRouteV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] HTTP route %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read HTTPRoute %q because: %s", d.Id(), err)
+	}
+	route := httpRouteV1{}
+	if err := fromUnstructuredObject(out, &route); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received HTTP route: %#v", route)
+
+	err = d.Set("metadata", flattenMetadata(route.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenHTTPRouteV1Spec(route.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenHTTPRouteV1Status(route.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesHTTPRouteV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandHTTPRouteV1Spec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating HTTP route %q: %v", name, string(data))
+	out, err := dc.Resource(httpRouteV1Resource).Namespace(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update HTTPRoute %q because: %s", d.Id(), err)
+	}
+	log.Printf("[INFO] Submitted updated HTTP route: %#v", out)
+
+	if d.HasChange("spec") && d.Get("wait_for_accepted").(bool) {
+		if diags := waitForHTTPRouteV1Accepted(ctx, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceKubernetesHTTPRouteV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesHTTPRouteV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting HTTP route: %#v", name)
+	err = dc.Resource(httpRouteV1Resource).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete HTTPRoute %q because: %s", d.Id(), err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := dc.Resource(httpRouteV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("HTTPRoute (%s) still exists", d.Id())
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] HTTP route %s deleted", name)
+	d.SetId("")
+	return nil
+}
+
+func waitForHTTPRouteV1Accepted(ctx context.Context, meta interface{}, id string, timeout time.Duration) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Waiting for HTTP route %s to be accepted", id)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		out, err := dc.Resource(httpRouteV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			// NOTE it is possible in some HA apiserver setups that are eventually consistent
+			// that we could get a 404 when doing a Get immediately after a Create
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		route := httpRouteV1{}
+		if err := fromUnstructuredObject(out, &route); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if done, msg := httpRouteV1Accepted(&route); !done {
+			return resource.RetryableError(fmt.Errorf("Waiting for HTTPRoute %q to be accepted (%s)", id, msg))
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// httpRouteV1Accepted reports whether every parent referenced by the route
+// has accepted the current generation of the route.
+func httpRouteV1Accepted(route *httpRouteV1) (bool, string) {
+	if len(route.Status.Parents) < len(route.Spec.ParentRefs) {
+		return false, fmt.Sprintf("%d of %d parents reported status", len(route.Status.Parents), len(route.Spec.ParentRefs))
+	}
+	for _, p := range route.Status.Parents {
+		if !gatewayAPIV1ConditionTrue(p.Conditions, "Accepted", route.Generation) {
+			return false, fmt.Sprintf("parent %q: %s", p.ParentRef.Name, gatewayAPIV1ConditionMessage(p.Conditions, "Accepted"))
+		}
+	}
+	return true, ""
+}
@@ -0,0 +1,215 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesHTTPRouteV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_http_route_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfNoGatewayAPI(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesHTTPRouteV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesHTTPRouteV1Config_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHTTPRouteV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parent_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.parent_ref.0.kind", "Gateway"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.hostnames.0", "www.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.path.0.type", "PathPrefix"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.path.0.value", "/"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.weight", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_accepted"},
+			},
+			{
+				Config: testAccKubernetesHTTPRouteV1Config_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesHTTPRouteV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.path.0.value", "/api"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.header.0.name", "X-Version"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.match.0.header.0.type", "Exact"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.filter.0.type", "RequestHeaderModifier"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.filter.0.request_header_modifier.0.set.0.name", "X-Env"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.0.backend_ref.0.weight", "90"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.filter.0.type", "RequestRedirect"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.filter.0.request_redirect.0.scheme", "https"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.rule.1.filter.0.request_redirect.0.status_code", "301"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesHTTPRouteV1Destroy(s *terraform.State) error {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_http_route_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = dc.Resource(httpRouteV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("HTTPRoute still exists: %s", rs.Primary.ID)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return testAccCheckKubernetesGatewayV1Destroy(s)
+}
+
+func testAccCheckKubernetesHTTPRouteV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = dc.Resource(httpRouteV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesHTTPRouteV1Config_basic(name string) string {
+	return testAccKubernetesGatewayV1Config_basic(name) + fmt.Sprintf(`
+resource "kubernetes_http_route_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  spec {
+    parent_ref {
+      name = kubernetes_gateway_v1.test.metadata.0.name
+    }
+
+    hostnames = ["www.example.com"]
+
+    rule {
+      backend_ref {
+        name = "%[1]s"
+        port = 80
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesHTTPRouteV1Config_modified(name string) string {
+	return testAccKubernetesGatewayV1Config_basic(name) + fmt.Sprintf(`
+resource "kubernetes_http_route_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+
+  spec {
+    parent_ref {
+      name         = kubernetes_gateway_v1.test.metadata.0.name
+      section_name = "http"
+    }
+
+    hostnames = ["www.example.com"]
+
+    rule {
+      match {
+        path {
+          type  = "PathPrefix"
+          value = "/api"
+        }
+
+        header {
+          name  = "X-Version"
+          value = "2"
+        }
+      }
+
+      filter {
+        type = "RequestHeaderModifier"
+
+        request_header_modifier {
+          set {
+            name  = "X-Env"
+            value = "prod"
+          }
+        }
+      }
+
+      backend_ref {
+        name   = "%[1]s"
+        port   = 80
+        weight = 90
+      }
+
+      backend_ref {
+        name   = "%[1]s-canary"
+        port   = 80
+        weight = 10
+      }
+    }
+
+    rule {
+      match {
+        path {
+          value = "/old"
+        }
+      }
+
+      filter {
+        type = "RequestRedirect"
+
+        request_redirect {
+          scheme      = "https"
+          status_code = 301
+        }
+      }
+    }
+  }
+}
+`, name)
+}
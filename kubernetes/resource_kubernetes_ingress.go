@@ -241,7 +241,7 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 		return diag.Errorf("Failed to read Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
-	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -255,7 +255,7 @@ func resourceKubernetesIngressRead(ctx context.Context, d *schema.ResourceData,
 
 	err = d.Set("status", []interface{}{
 		map[string][]interface{}{
-			"load_balancer": flattenLoadBalancerStatus(ing.Status.LoadBalancer),
+			"load_balancer": flattenIngressLoadBalancerStatus(ing.Status.LoadBalancer),
 		},
 	})
 	if err != nil {
@@ -51,8 +51,9 @@ func resourceKubernetesIngressClassSchema() map[string]*schema.Schema {
 					},
 					"parameters": {
 						Type:        schema.TypeList,
-						Description: docIngressClass["parameters"],
+						Description: docIngressClassSpec["parameters"],
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"api_group": {
@@ -88,6 +89,12 @@ func resourceKubernetesIngressClassSchema() map[string]*schema.Schema {
 				},
 			},
 		},
+		"is_default": {
+			Type:        schema.TypeBool,
+			Description: "Marks this Ingress Class as the default of the cluster by setting the `" + networking.AnnotationIsDefaultIngressClass + "` annotation. Ingresses without an `ingress_class_name` are assigned the default class.",
+			Optional:    true,
+			Default:     false,
+		},
 	}
 }
 
@@ -97,7 +104,7 @@ func resourceKubernetesIngressClassCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandIngressClassMetadata(d)
 	ing := &networking.IngressClass{
 		Spec: expandIngressClassSpec(d.Get("spec").([]interface{})),
 	}
@@ -110,7 +117,7 @@ func resourceKubernetesIngressClassCreate(ctx context.Context, d *schema.Resourc
 	log.Printf("[INFO] Submitted new IngressClass: %#v", out)
 	d.SetId(out.ObjectMeta.GetName())
 
-	return diag.Diagnostics{}
+	return resourceKubernetesIngressClassRead(ctx, d, meta)
 }
 
 func resourceKubernetesIngressClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -136,7 +143,12 @@ func resourceKubernetesIngressClassRead(ctx context.Context, d *schema.ResourceD
 		return diag.Errorf("Failed to read Ingress Class '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
 	log.Printf("[INFO] Received Ingress Class: %#v", ing)
-	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = d.Set("is_default", ing.Annotations[networking.AnnotationIsDefaultIngressClass] == "true")
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -157,7 +169,7 @@ func resourceKubernetesIngressClassUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata := expandIngressClassMetadata(d)
 	spec := expandIngressClassSpec(d.Get("spec").([]interface{}))
 
 	if metadata.Namespace == "" {
@@ -233,6 +245,19 @@ func resourceKubernetesIngressClassExists(ctx context.Context, d *schema.Resourc
 	return true, err
 }
 
+// expandIngressClassMetadata adds the default class annotation managed by
+// `is_default` to the configured metadata.
+func expandIngressClassMetadata(d *schema.ResourceData) metav1.ObjectMeta {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	if d.Get("is_default").(bool) {
+		if metadata.Annotations == nil {
+			metadata.Annotations = map[string]string{}
+		}
+		metadata.Annotations[networking.AnnotationIsDefaultIngressClass] = "true"
+	}
+	return metadata
+}
+
 func expandIngressClassSpec(l []interface{}) networking.IngressClassSpec {
 	if len(l) == 0 || l[0] == nil {
 		return networking.IngressClassSpec{}
@@ -140,6 +140,55 @@ func TestAccKubernetesIngressClass_parameters_apiGroup(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesIngressClass_isDefault(t *testing.T) {
+	var conf networking.IngressClass
+	rName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_ingress_class_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesIngressClassConfigIsDefault(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					testAccCheckKubernetesIngressClassIsDefault(&conf, true),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "true"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.TestAnnotationOne", "one"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesIngressClassConfigIsDefault(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressClassExists(resourceName, &conf),
+					testAccCheckKubernetesIngressClassIsDefault(&conf, false),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.annotations.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesIngressClassIsDefault(obj *networking.IngressClass, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := obj.Annotations[networking.AnnotationIsDefaultIngressClass]
+		if ok != expected {
+			return fmt.Errorf("Expected the default class annotation to be present: %t, annotations: %v", expected, obj.Annotations)
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesIngressClassDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 	if err != nil {
@@ -147,7 +196,7 @@ func testAccCheckKubernetesIngressClassDestroy(s *terraform.State) error {
 	}
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "kubernetes_ingress_class" {
+		if rs.Type != "kubernetes_ingress_class" && rs.Type != "kubernetes_ingress_class_v1" {
 			continue
 		}
 
@@ -235,3 +284,20 @@ resource "kubernetes_ingress_class" "test" {
 }
 `, name, paramName)
 }
+
+func testAccKubernetesIngressClassConfigIsDefault(name string, isDefault bool) string {
+	return fmt.Sprintf(`
+resource "kubernetes_ingress_class_v1" "test" {
+  metadata {
+    name = %q
+    annotations = {
+      TestAnnotationOne = "one"
+    }
+  }
+  spec {
+    controller = "example.com/ingress-controller"
+  }
+  is_default = %t
+}
+`, name, isDefault)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	networking "k8s.io/api/networking/v1"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesIngressV1() *schema.Resource {
@@ -21,6 +23,11 @@ func resourceKubernetesIngressV1() *schema.Resource {
 		ReadContext:   resourceKubernetesIngressV1Read,
 		UpdateContext: resourceKubernetesIngressV1Update,
 		DeleteContext: resourceKubernetesIngressV1Delete,
+		CustomizeDiff: resourceKubernetesIngressV1CustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -159,9 +166,86 @@ func resourceKubernetesIngressV1Schema() map[string]*schema.Schema {
 		"wait_for_load_balancer": {
 			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Terraform will wait for the load balancer to have at least 1 endpoint before considering the resource created.",
+			Description: "Terraform will wait for the load balancer to have at least 1 endpoint with an IP or a hostname before considering the resource created, or updated when its class or rules change.",
 		},
+		"validate_ingress_class": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Check during plan that the Ingress Class referenced by `spec.0.ingress_class_name` exists in the cluster. Classes created in the same configuration pass the check when the name is a reference to the `kubernetes_ingress_class_v1` resource. The check is skipped when the cluster cannot be reached.",
+		},
+	}
+}
+
+// resourceKubernetesIngressV1CustomizeDiff fails the plan when a backend does
+// not set exactly one of service or resource, or when the ingress references
+// an Ingress Class which does not exist. Unknown names come from classes
+// created in the same configuration and API errors other than not found, such
+// as an unreachable cluster, skip the check of the class.
+func resourceKubernetesIngressV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := validateIngressV1Backends(d); err != nil {
+		return err
+	}
+	if !d.Get("validate_ingress_class").(bool) {
+		return nil
+	}
+	key := "spec.0.ingress_class_name"
+	if !d.NewValueKnown(key) || (d.Id() != "" && !d.HasChange(key)) {
+		return nil
 	}
+	name := d.Get(key).(string)
+	if name == "" {
+		return nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		log.Printf("[WARN] Skipping validation of Ingress Class %q: %s", name, err)
+		return nil
+	}
+	_, err = conn.NetworkingV1().IngressClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("Ingress Class %q referenced by spec.0.ingress_class_name does not exist", name)
+		}
+		log.Printf("[WARN] Skipping validation of Ingress Class %q: %s", name, err)
+	}
+	return nil
+}
+
+// validateIngressV1Backends checks the default backend and the backend of
+// each path, skipping those which are not known until apply.
+func validateIngressV1Backends(d *schema.ResourceDiff) error {
+	keys := []string{"spec.0.default_backend"}
+	for i, r := range d.Get("spec.0.rule").([]interface{}) {
+		if r == nil {
+			continue
+		}
+		for _, h := range r.(map[string]interface{})["http"].([]interface{}) {
+			if h == nil {
+				continue
+			}
+			for j := range h.(map[string]interface{})["path"].([]interface{}) {
+				keys = append(keys, fmt.Sprintf("spec.0.rule.%d.http.0.path.%d.backend", i, j))
+			}
+		}
+	}
+	for _, key := range keys {
+		if !d.NewValueKnown(key) {
+			continue
+		}
+		l := d.Get(key).([]interface{})
+		if len(l) == 0 || l[0] == nil {
+			continue
+		}
+		b := l[0].(map[string]interface{})
+		if !d.NewValueKnown(key+".0.service") || !d.NewValueKnown(key+".0.resource") {
+			continue
+		}
+		if (len(b["service"].([]interface{})) == 0) == (len(b["resource"].([]interface{})) == 0) {
+			return fmt.Errorf("%s: exactly one of service or resource must be specified", key)
+		}
+	}
+	return nil
 }
 
 func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -187,34 +271,11 @@ func resourceKubernetesIngressV1Create(ctx context.Context, d *schema.ResourceDa
 		return resourceKubernetesIngressV1Read(ctx, d, meta)
 	}
 
-	log.Printf("[INFO] Waiting for load balancer to become ready: %#v", out)
-	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		res, err := conn.NetworkingV1().Ingresses(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
-		if err != nil {
-			// NOTE it is possible in some HA apiserver setups that are eventually consistent
-			// that we could get a 404 when doing a Get immediately after a Create
-			if errors.IsNotFound(err) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-
-		if len(res.Status.LoadBalancer.Ingress) > 0 {
-			diagnostics := resourceKubernetesIngressV1Read(ctx, d, meta)
-			if diagnostics.HasError() {
-				errmsg := diagnostics[0].Summary
-				return resource.NonRetryableError(fmt.Errorf("Error reading ingress: %v", errmsg))
-			}
-			return nil
-		}
-
-		log.Printf("[INFO] Load Balancer not ready yet...")
-		return resource.RetryableError(fmt.Errorf("Load Balancer is not ready yet"))
-	})
+	err = waitForIngressV1LoadBalancer(ctx, conn, out.ObjectMeta, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return diag.Diagnostics{}
+	return resourceKubernetesIngressV1Read(ctx, d, meta)
 }
 
 func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -243,7 +304,7 @@ func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData
 		return diag.Errorf("Failed to read Ingress '%s' because: %s", buildId(ing.ObjectMeta), err)
 	}
 	log.Printf("[INFO] Received ingress: %#v", ing)
-	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(ing.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -257,7 +318,7 @@ func resourceKubernetesIngressV1Read(ctx context.Context, d *schema.ResourceData
 
 	err = d.Set("status", []interface{}{
 		map[string][]interface{}{
-			"load_balancer": flattenLoadBalancerStatus(ing.Status.LoadBalancer),
+			"load_balancer": flattenIngressV1LoadBalancerStatus(ing.Status.LoadBalancer),
 		},
 	})
 	if err != nil {
@@ -296,6 +357,15 @@ func resourceKubernetesIngressV1Update(ctx context.Context, d *schema.ResourceDa
 	}
 	log.Printf("[INFO] Submitted updated ingress: %#v", out)
 
+	// A new class or new rules can make the controller provision another
+	// load balancer.
+	if d.Get("wait_for_load_balancer").(bool) && d.HasChanges("spec.0.ingress_class_name", "spec.0.rule") {
+		err = waitForIngressV1LoadBalancer(ctx, conn, out.ObjectMeta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesIngressV1Read(ctx, d, meta)
 }
 
@@ -359,3 +429,44 @@ func resourceKubernetesIngressV1Exists(ctx context.Context, d *schema.ResourceDa
 	}
 	return true, err
 }
+
+// waitForIngressV1LoadBalancer waits for the ingress controller to populate
+// the load balancer status of the ingress with an IP or a hostname, as some
+// controllers only report the latter. When the timeout expires the recent
+// warning events of the ingress are added to the error, as they usually
+// explain why the controller did not provision it.
+func waitForIngressV1LoadBalancer(ctx context.Context, conn *kubernetes.Clientset, om metav1.ObjectMeta, timeout time.Duration) error {
+	log.Printf("[INFO] Waiting for load balancer of ingress %s", buildId(om))
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		res, err := conn.NetworkingV1().Ingresses(om.Namespace).Get(ctx, om.Name, metav1.GetOptions{})
+		if err != nil {
+			// NOTE it is possible in some HA apiserver setups that are eventually consistent
+			// that we could get a 404 when doing a Get immediately after a Create
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(fmt.Errorf("Ingress %s not found", buildId(om)))
+			}
+			return resource.NonRetryableError(err)
+		}
+		if isIngressV1LoadBalancerReady(res.Status.LoadBalancer) {
+			return nil
+		}
+		log.Printf("[INFO] Load Balancer not ready yet...")
+		return resource.RetryableError(fmt.Errorf("Load Balancer of Ingress %s is not ready yet", buildId(om)))
+	})
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*resource.TimeoutError); !ok && ctx.Err() == nil {
+		return err
+	}
+
+	// The context passed to the operation expires together with the timeout,
+	// so the events are looked up with a fresh one.
+	ectx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	lastWarnings, wErr := getLastWarningsForObject(ectx, conn, om, "Ingress", 3)
+	if wErr != nil {
+		log.Printf("[WARN] Failed to look up events of ingress %s: %s", buildId(om), wErr)
+	}
+	return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+}
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -80,6 +81,11 @@ func TestAccKubernetesIngressV1_resourceBackend(t *testing.T) {
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesIngressV1Destroy,
 		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesIngressV1Config_resourceAndServiceBackend(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`spec.0.rule.0.http.0.path.0.backend: exactly one of service or resource must be specified`),
+			},
 			{
 				Config: testAccKubernetesIngressV1Config_resourceBackend(name),
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -304,6 +310,36 @@ func TestAccKubernetesIngressV1_multipleRulesDifferentHosts(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesIngressV1_validateIngressClass(t *testing.T) {
+	var conf networking.Ingress
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.22.0")
+		},
+		IDRefreshName:     "kubernetes_ingress_v1.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesIngressV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesIngressV1Config_validateMissingIngressClass(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Ingress Class "` + name + `-missing" referenced by spec.0.ingress_class_name does not exist`),
+			},
+			{
+				Config: testAccKubernetesIngressV1Config_validateIngressClass(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesIngressV1Exists("kubernetes_ingress_v1.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_ingress_v1.test", "spec.0.ingress_class_name", name),
+					resource.TestCheckResourceAttr("kubernetes_ingress_v1.test", "validate_ingress_class", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesIngressV1ForceNew(old, new *networking.Ingress, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
@@ -447,6 +483,38 @@ resource "kubernetes_ingress_v1" "test" {
 }`, name)
 }
 
+func testAccKubernetesIngressV1Config_resourceAndServiceBackend(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_ingress_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    ingress_class_name = "ingress-class"
+    rule {
+      http {
+        path {
+          path = "/icons"
+          backend {
+            resource {
+              api_group = "k8s.example.com"
+              kind = "StorageBucket"
+              name = "icon-assets"
+            }
+            service {
+              name = "app1"
+              port {
+                number = 443
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`, name)
+}
+
 func testAccKubernetesIngressV1Config_serviceBackend_modified(name string) string {
 	return fmt.Sprintf(`
 resource "kubernetes_ingress_v1" "test" {
@@ -715,3 +783,44 @@ resource "kubernetes_ingress_v1" "test" {
   }
 }`, name)
 }
+
+func testAccKubernetesIngressV1Config_validateMissingIngressClass(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_ingress_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    ingress_class_name = "%[1]s-missing"
+    rule {
+      host = "server.domain.com"
+    }
+  }
+  validate_ingress_class = true
+}`, name)
+}
+
+func testAccKubernetesIngressV1Config_validateIngressClass(name string) string {
+	return fmt.Sprintf(`
+resource "kubernetes_ingress_class_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    controller = "example.com/ingress-controller"
+  }
+}
+
+resource "kubernetes_ingress_v1" "test" {
+  metadata {
+    name = "%[1]s"
+  }
+  spec {
+    ingress_class_name = kubernetes_ingress_class_v1.test.metadata.0.name
+    rule {
+      host = "server.domain.com"
+    }
+  }
+  validate_ingress_class = true
+}`, name)
+}
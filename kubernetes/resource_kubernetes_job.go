@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -24,6 +27,7 @@ func resourceKubernetesJob() *schema.Resource {
 		ReadContext:   resourceKubernetesJobRead,
 		UpdateContext: resourceKubernetesJobUpdate,
 		DeleteContext: resourceKubernetesJobDelete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -62,6 +66,44 @@ func resourceKubernetesJobSchemaV1() map[string]*schema.Schema {
 			Optional: true,
 			Default:  true,
 		},
+		"failure_log_lines": {
+			Type:         schema.TypeInt,
+			Description:  "Number of log lines of each failed container to include in the error when the job fails while waiting for completion. Set to 0 to disable.",
+			Optional:     true,
+			Default:      20,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"conditions": {
+			Type:        schema.TypeList,
+			Description: "The latest available observations of the job's current state.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"type": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"reason": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"message": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+		"failed_pods": {
+			Type:        schema.TypeList,
+			Description: "Names of the pods of the job which failed.",
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
 	}
 }
 
@@ -97,12 +139,7 @@ func resourceKubernetesJobCreate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 	if d.Get("wait_for_completion").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilJobIsFinished(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		return diag.Diagnostics{}
+		return waitForJobCompletion(ctx, conn, d, namespace, name, d.Timeout(schema.TimeoutCreate))
 	}
 
 	return resourceKubernetesJobRead(ctx, d, meta)
@@ -145,10 +182,9 @@ func resourceKubernetesJobUpdate(ctx context.Context, d *schema.ResourceData, me
 	d.SetId(buildId(out.ObjectMeta))
 
 	if d.Get("wait_for_completion").(bool) {
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate),
-			retryUntilJobIsFinished(ctx, conn, namespace, name))
-		if err != nil {
-			return diag.FromErr(err)
+		diags := waitForJobCompletion(ctx, conn, d, namespace, name, d.Timeout(schema.TimeoutUpdate))
+		if diags.HasError() {
+			return diags
 		}
 	}
 	return resourceKubernetesJobRead(ctx, d, meta)
@@ -181,6 +217,14 @@ func resourceKubernetesJobRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	log.Printf("[INFO] Received job: %#v", job)
 
+	// The pods are listed before the selector loses its controller-uid label.
+	failed, err := getJobFailedPods(ctx, conn, job)
+	if err != nil {
+		log.Printf("[WARN] Failed to list the pods of job %s: %s", name, err)
+	} else {
+		d.Set("failed_pods", podNames(failed))
+	}
+
 	// Remove server-generated labels unless using manual selector
 	if _, ok := d.GetOk("spec.0.manual_selector"); !ok {
 		labels := job.ObjectMeta.Labels
@@ -200,21 +244,27 @@ func resourceKubernetesJobRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(job.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	jobSpec, err := flattenJobSpec(job.Spec, d)
+	jobSpec, err := flattenJobSpec(job.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", jobSpec, "0.template.0.spec.0")
 	err = d.Set("spec", jobSpec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return diag.Diagnostics{}
+
+	err = d.Set("conditions", flattenJobConditions(job.Status.Conditions))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return diags
 }
 
 func resourceKubernetesJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -296,7 +346,7 @@ func retryUntilJobIsFinished(ctx context.Context, conn *kubernetes.Clientset, ns
 				case batchv1.JobComplete:
 					return nil
 				case batchv1.JobFailed:
-					return resource.NonRetryableError(fmt.Errorf("job: %s/%s is in failed state", ns, name))
+					return resource.NonRetryableError(&jobFailedError{job: job, condition: c})
 				}
 			}
 		}
@@ -304,3 +354,134 @@ func retryUntilJobIsFinished(ctx context.Context, conn *kubernetes.Clientset, ns
 		return resource.RetryableError(fmt.Errorf("job: %s/%s is not in complete state", ns, name))
 	}
 }
+
+// jobFailedError is returned when a job being waited for reaches the Failed condition.
+type jobFailedError struct {
+	job       *batchv1.Job
+	condition batchv1.JobCondition
+}
+
+func (e *jobFailedError) Error() string {
+	return fmt.Sprintf("job: %s/%s is in failed state", e.job.Namespace, e.job.Name)
+}
+
+// waitForJobCompletion waits for the job to complete. When the job fails, the
+// failed pods and the last lines of the logs of their failed containers are
+// included in the error.
+func waitForJobCompletion(ctx context.Context, conn *kubernetes.Clientset, d *schema.ResourceData, ns, name string, timeout time.Duration) diag.Diagnostics {
+	err := resource.RetryContext(ctx, timeout, retryUntilJobIsFinished(ctx, conn, ns, name))
+	if err == nil {
+		job, err := conn.BatchV1().Jobs(ns).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			d.Set("conditions", flattenJobConditions(job.Status.Conditions))
+		}
+		return diag.Diagnostics{}
+	}
+
+	jobErr, ok := err.(*jobFailedError)
+	if !ok {
+		return diag.FromErr(err)
+	}
+	job := jobErr.job
+
+	d.Set("conditions", flattenJobConditions(job.Status.Conditions))
+	failed, err := getJobFailedPods(ctx, conn, job)
+	if err != nil {
+		log.Printf("[WARN] Failed to list the pods of job %s/%s: %s", ns, name, err)
+	}
+	names := podNames(failed)
+	d.Set("failed_pods", names)
+
+	var summary string
+	switch jobErr.condition.Reason {
+	case "BackoffLimitExceeded":
+		summary = fmt.Sprintf("Job %s/%s failed: the backoff limit was exceeded", ns, name)
+	case "DeadlineExceeded":
+		summary = fmt.Sprintf("Job %s/%s failed: the active deadline was exceeded", ns, name)
+	default:
+		summary = fmt.Sprintf("Job %s/%s failed", ns, name)
+	}
+
+	var detail strings.Builder
+	if jobErr.condition.Message != "" {
+		fmt.Fprintf(&detail, "%s\n", jobErr.condition.Message)
+	}
+	if len(names) > 0 {
+		fmt.Fprintf(&detail, "Failed pods: %s\n", strings.Join(names, ", "))
+	}
+	lines := int64(d.Get("failure_log_lines").(int))
+	if lines > 0 {
+		for _, p := range failed {
+			for _, c := range failedContainers(p) {
+				logs, err := conn.CoreV1().Pods(ns).GetLogs(p.Name, &corev1.PodLogOptions{
+					Container: c,
+					TailLines: &lines,
+				}).DoRaw(ctx)
+				if err != nil {
+					fmt.Fprintf(&detail, "\nFailed to get the logs of container %s of pod %s: %s\n", c, p.Name, err)
+					continue
+				}
+				fmt.Fprintf(&detail, "\nLast %d lines of the logs of container %s of pod %s:\n%s", lines, c, p.Name, string(logs))
+			}
+		}
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   strings.TrimSpace(detail.String()),
+		},
+	}
+}
+
+// getJobFailedPods returns the pods owned by the job which failed or have a
+// failed container.
+func getJobFailedPods(ctx context.Context, conn kubernetes.Interface, job *batchv1.Job) ([]corev1.Pod, error) {
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := conn.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	var failed []corev1.Pod
+	for _, p := range pods.Items {
+		if !isOwnedBy(p.ObjectMeta, job.UID) {
+			continue
+		}
+		if p.Status.Phase == corev1.PodFailed || len(failedContainers(p)) > 0 {
+			failed = append(failed, p)
+		}
+	}
+	sort.Slice(failed, func(i, j int) bool {
+		return failed[i].Name < failed[j].Name
+	})
+	return failed, nil
+}
+
+func podNames(pods []corev1.Pod) []string {
+	names := make([]string, len(pods))
+	for i, p := range pods {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// failedContainers returns the names of the containers of the pod, including
+// init containers, which terminated with a non-zero exit code.
+func failedContainers(p corev1.Pod) []string {
+	var names []string
+	statuses := append(append([]corev1.ContainerStatus{}, p.Status.InitContainerStatuses...), p.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		t := cs.State.Terminated
+		if t == nil {
+			t = cs.LastTerminationState.Terminated
+		}
+		if t != nil && t.ExitCode != 0 {
+			names = append(names, cs.Name)
+		}
+	}
+	return names
+}
@@ -3,6 +3,8 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
 	"time"
 
@@ -10,9 +12,46 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
+func TestGetJobFailedPods(t *testing.T) {
+	job := &api.Job{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test", UID: "job-uid"},
+		Spec: api.JobSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"controller-uid": "job-uid"}},
+		},
+	}
+	pod := func(name string, phase corev1.PodPhase, owner string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "default",
+				Name:            name,
+				Labels:          map[string]string{"controller-uid": "job-uid"},
+				OwnerReferences: []metav1.OwnerReference{{UID: types.UID(owner)}},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+	conn := fake.NewSimpleClientset(
+		pod("test-b", corev1.PodFailed, "job-uid"),
+		pod("test-a", corev1.PodFailed, "job-uid"),
+		pod("test-c", corev1.PodSucceeded, "job-uid"),
+		pod("other", corev1.PodFailed, "other-uid"),
+	)
+
+	failed, err := getJobFailedPods(context.Background(), conn, job)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := podNames(failed); !reflect.DeepEqual(names, []string{"test-a", "test-b"}) {
+		t.Errorf("Expected the failed pods of the job, got %v", names)
+	}
+}
+
 func TestAccKubernetesJob_wait_for_completion(t *testing.T) {
 	var conf api.Job
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -40,6 +79,67 @@ func TestAccKubernetesJob_wait_for_completion(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesJob_wait_for_completion_failure(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesJobConfig_wait_for_completion_failure(name, busyboxImageVersion),
+				ExpectError: regexp.MustCompile(`(?s)backoff limit was exceeded.*migration failed: boom`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesJob_suspend(t *testing.T) {
+	var conf1, conf2 api.Job
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	imageName := busyboxImageVersion
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesJobConfig_suspend(name, imageName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job_v1.test", &conf1),
+					resource.TestCheckResourceAttr("kubernetes_job_v1.test", "spec.0.suspend", "true"),
+					resource.TestCheckResourceAttr("kubernetes_job_v1.test", "spec.0.ttl_seconds_after_finished", "60"),
+					resource.TestCheckResourceAttr("kubernetes_job_v1.test", "spec.0.pod_failure_policy.0.rule.0.action", "FailJob"),
+					resource.TestCheckResourceAttr("kubernetes_job_v1.test", "spec.0.pod_failure_policy.0.rule.0.on_exit_codes.0.values.0", "42"),
+					resource.TestCheckResourceAttr("kubernetes_job_v1.test", "spec.0.pod_failure_policy.0.rule.1.on_pod_condition.0.type", "DisruptionTarget"),
+					testAccCheckKubernetesJobSuspended(&conf1, true),
+				),
+			},
+			{
+				Config: testAccKubernetesJobConfig_suspend(name, imageName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesJobExists("kubernetes_job_v1.test", &conf2),
+					resource.TestCheckResourceAttr("kubernetes_job_v1.test", "spec.0.suspend", "false"),
+					testAccCheckKubernetesJobSuspended(&conf2, false),
+					testAccCheckKubernetesJobForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesJobSuspended(obj *api.Job, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		suspended := obj.Spec.Suspend != nil && *obj.Spec.Suspend
+		if suspended != expected {
+			return fmt.Errorf("Expected .spec.suspend of job %s to be %t, got %t", obj.Name, expected, suspended)
+		}
+		return nil
+	}
+}
+
 func testAccCheckJobWaited(minDuration time.Duration) func(*terraform.State) error {
 	// NOTE this works because this function is called when setting up the test
 	// and the function it returns is called after the resource has been created
@@ -412,6 +512,73 @@ func testAccKubernetesJobConfig_wait_for_completion(name, imageName string) stri
 }`, name, imageName)
 }
 
+func testAccKubernetesJobConfig_wait_for_completion_failure(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_job" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    backoff_limit = 0
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "migrate"
+          image   = "%s"
+          command = ["sh", "-c", "echo 'migration failed: boom'; exit 1"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+  wait_for_completion = true
+  failure_log_lines   = 5
+  timeouts {
+    create = "2m"
+  }
+}`, name, imageName)
+}
+
+func testAccKubernetesJobConfig_suspend(name, imageName string, suspend bool) string {
+	return fmt.Sprintf(`resource "kubernetes_job_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    suspend                    = %t
+    ttl_seconds_after_finished = "60"
+    pod_failure_policy {
+      rule {
+        action = "FailJob"
+        on_exit_codes {
+          container_name = "hello"
+          operator       = "In"
+          values         = [42]
+        }
+      }
+      rule {
+        action = "Ignore"
+        on_pod_condition {
+          type = "DisruptionTarget"
+        }
+      }
+    }
+    template {
+      metadata {}
+      spec {
+        container {
+          name    = "hello"
+          image   = "%s"
+          command = ["sleep", "5"]
+        }
+        restart_policy = "Never"
+      }
+    }
+  }
+  wait_for_completion = false
+}`, name, suspend, imageName)
+}
+
 func testAccKubernetesJobConfig_modified(name, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_job" "test" {
   metadata {
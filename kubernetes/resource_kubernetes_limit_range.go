@@ -4,6 +4,7 @@ import (
 	"context"
 	"log"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,30 +40,36 @@ func resourceKubernetesLimitRange() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"default": {
-										Type:        schema.TypeMap,
-										Description: "Default resource requirement limit value by resource name if resource limit is omitted.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "Default resource requirement limit value by resource name if resource limit is omitted.",
+										Optional:         true,
+										Computed:         true,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"default_request": {
-										Type:        schema.TypeMap,
-										Description: "The default resource requirement request value by resource name if resource request is omitted.",
-										Optional:    true,
-										Computed:    true,
+										Type:             schema.TypeMap,
+										Description:      "The default resource requirement request value by resource name if resource request is omitted.",
+										Optional:         true,
+										Computed:         true,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"max": {
-										Type:        schema.TypeMap,
-										Description: "Max usage constraints on this kind by resource name.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "Max usage constraints on this kind by resource name.",
+										Optional:         true,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"max_limit_request_ratio": {
-										Type:        schema.TypeMap,
-										Description: "The named resource must have a request and limit that are both non-zero where limit divided by request is less than or equal to the enumerated value; this represents the max burst for the named resource.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "The named resource must have a request and limit that are both non-zero where limit divided by request is less than or equal to the enumerated value; this represents the max burst for the named resource.",
+										Optional:         true,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"min": {
-										Type:        schema.TypeMap,
-										Description: "Min usage constraints on this kind by resource name.",
-										Optional:    true,
+										Type:             schema.TypeMap,
+										Description:      "Min usage constraints on this kind by resource name.",
+										Optional:         true,
+										DiffSuppressFunc: suppressEquivalentResourceQuantity,
 									},
 									"type": {
 										Type:        schema.TypeString,
@@ -131,11 +138,11 @@ func resourceKubernetesLimitRangeRead(ctx context.Context, d *schema.ResourceDat
 	}
 	log.Printf("[INFO] Received limit range: %#v", limitRange)
 
-	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(limitRange.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = d.Set("spec", flattenLimitRangeSpec(limitRange.Spec))
+	err = d.Set("spec", flattenLimitRangeSpec(limitRange.Spec, d.Get("spec").([]interface{})))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -160,6 +167,20 @@ func resourceKubernetesLimitRangeUpdate(ctx context.Context, d *schema.ResourceD
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		// The planned defaults of a container limit hold whatever the server
+		// filled in before when they are not configured. They are left out so
+		// the server derives them again from the updated limit.
+		for i, l := range spec.Limits {
+			if l.Type != api.LimitTypeContainer {
+				continue
+			}
+			if !isLimitRangeItemFieldConfigured(d, i, "default") {
+				spec.Limits[i].Default = nil
+			}
+			if !isLimitRangeItemFieldConfigured(d, i, "default_request") {
+				spec.Limits[i].DefaultRequest = nil
+			}
+		}
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
 			Value: spec,
@@ -224,3 +245,30 @@ func resourceKubernetesLimitRangeExists(ctx context.Context, d *schema.ResourceD
 	}
 	return true, err
 }
+
+// isLimitRangeItemFieldConfigured reports whether the field of the limit at
+// the given index is set in the configuration.
+func isLimitRangeItemFieldConfigured(d *schema.ResourceData, index int, field string) bool {
+	v := d.GetRawConfig()
+	for _, p := range []string{"spec", "limit"} {
+		if v.IsNull() || !v.IsKnown() || !v.Type().IsObjectType() || !v.Type().HasAttribute(p) {
+			return false
+		}
+		v = v.GetAttr(p)
+		if v.IsNull() || !v.IsKnown() || !v.Type().IsListType() {
+			return false
+		}
+		i := 0
+		if p == "limit" {
+			i = index
+		}
+		if v.LengthInt() <= i {
+			return false
+		}
+		v = v.Index(cty.NumberIntVal(int64(i)))
+	}
+	if v.IsNull() || !v.IsKnown() || !v.Type().HasAttribute(field) {
+		return false
+	}
+	return !v.GetAttr(field).IsNull()
+}
@@ -264,6 +264,72 @@ func TestAccKubernetesLimitRange_multipleLimits(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesLimitRange_equivalentQuantities(t *testing.T) {
+	var conf api.LimitRange
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     "kubernetes_limit_range.test",
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesLimitRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesLimitRangeConfig_quantities(name, "0.5", "1024Mi", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesLimitRangeExists("kubernetes_limit_range.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_limit_range.test", "spec.0.limit.0.default.cpu", "500m"),
+					resource.TestCheckResourceAttr("kubernetes_limit_range.test", "spec.0.limit.0.default.memory", "1Gi"),
+					resource.TestCheckResourceAttr("kubernetes_limit_range.test", "spec.0.limit.0.max_limit_request_ratio.cpu", "2"),
+				),
+			},
+			{
+				Config:   testAccKubernetesLimitRangeConfig_quantities(name, "500m", "1Gi", "2000m"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesLimitRange_serverDefaults(t *testing.T) {
+	var conf api.LimitRange
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_limit_range.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesLimitRangeDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The server fills in default and default_request from max.
+				Config: testAccKubernetesLimitRangeConfig_maxOnly(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesLimitRangeExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.type", "Container"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.max.cpu", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.max.memory", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.default.cpu", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.default.memory", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.default_request.memory", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.1.type", "PersistentVolumeClaim"),
+				),
+			},
+			{
+				// Setting part of the defaults must not bring back the ones the server filled in.
+				Config: testAccKubernetesLimitRangeConfig_partialDefault(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesLimitRangeExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.default.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limit.0.default.cpu", "500m"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesLimitRangeDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -521,3 +587,87 @@ func testAccKubernetesLimitRangeConfig_multipleLimits(name string) string {
 }
 `, name)
 }
+
+func testAccKubernetesLimitRangeConfig_quantities(name, cpu, memory, ratio string) string {
+	return fmt.Sprintf(`resource "kubernetes_limit_range" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  spec {
+    limit {
+      type = "Container"
+
+      default = {
+        cpu    = %[2]q
+        memory = %[3]q
+      }
+
+      max_limit_request_ratio = {
+        cpu = %[4]q
+      }
+    }
+  }
+}
+`, name, cpu, memory, ratio)
+}
+
+func testAccKubernetesLimitRangeConfig_maxOnly(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_limit_range" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    limit {
+      type = "Container"
+
+      max = {
+        cpu    = "1000m"
+        memory = "1024Mi"
+      }
+    }
+
+    limit {
+      type = "PersistentVolumeClaim"
+
+      min = {
+        storage = "1Gi"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesLimitRangeConfig_partialDefault(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_limit_range" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    limit {
+      type = "Container"
+
+      default = {
+        cpu = "0.5"
+      }
+
+      max = {
+        cpu    = "1000m"
+        memory = "1024Mi"
+      }
+    }
+
+    limit {
+      type = "PersistentVolumeClaim"
+
+      min = {
+        storage = "1Gi"
+      }
+    }
+  }
+}
+`, name)
+}
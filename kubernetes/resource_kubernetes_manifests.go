@@ -0,0 +1,656 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// resourceKubernetesManifests applies every document of a multi-document YAML
+// string with server-side apply. The objects are tracked in the manifests
+// attribute, so documents removed from the YAML are deleted on update.
+func resourceKubernetesManifests() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesManifestsCreate,
+		ReadContext:   resourceKubernetesManifestsRead,
+		UpdateContext: resourceKubernetesManifestsUpdate,
+		DeleteContext: resourceKubernetesManifestsDelete,
+		CustomizeDiff: resourceKubernetesManifestsCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"yaml_body": {
+				Type:         schema.TypeString,
+				Description:  "One or more Kubernetes manifests in YAML or JSON, separated by `---`. Every document must set `apiVersion`, `kind` and `metadata.name`.",
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager used to apply the manifests.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting fields that are managed by other field managers.",
+				Optional:    true,
+			},
+			"manifests": {
+				Type:        schema.TypeList,
+				Description: "The objects applied from `yaml_body`, in the order they were applied.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Description: "The API version of the object.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "The kind of the object.",
+							Computed:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the object, empty for cluster-scoped objects.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// manifestDocument is one document of yaml_body. The index is the position of
+// the document in yaml_body, and is used to name the document in errors.
+type manifestDocument struct {
+	index  int
+	object *unstructured.Unstructured
+}
+
+func (m manifestDocument) String() string {
+	kind, name := m.object.GetKind(), m.object.GetName()
+	switch {
+	case kind == "":
+		return fmt.Sprintf("document %d", m.index)
+	case name == "":
+		return fmt.Sprintf("document %d (%s)", m.index, kind)
+	}
+	return fmt.Sprintf("document %d (%s %q)", m.index, kind, name)
+}
+
+// manifestIdentity identifies an object applied from yaml_body.
+type manifestIdentity struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+}
+
+// manifestObjectKey identifies the object of a manifest regardless of the
+// version of its API, all the versions of a group serve the same objects.
+type manifestObjectKey struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (m manifestIdentity) objectKey() manifestObjectKey {
+	gv, _ := apimachineryschema.ParseGroupVersion(m.APIVersion)
+	return manifestObjectKey{
+		Group:     gv.Group,
+		Kind:      m.Kind,
+		Namespace: m.Namespace,
+		Name:      m.Name,
+	}
+}
+
+func (m manifestIdentity) String() string {
+	if m.Namespace != "" {
+		return fmt.Sprintf("%s %q", m.Kind, m.Namespace+"/"+m.Name)
+	}
+	return fmt.Sprintf("%s %q", m.Kind, m.Name)
+}
+
+// parseManifestDocuments splits a multi-document YAML or JSON string into its
+// documents. Empty documents are skipped.
+func parseManifestDocuments(body string) ([]manifestDocument, error) {
+	var docs []manifestDocument
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(body), 4096)
+	for i := 0; ; i++ {
+		var obj map[string]interface{}
+		err := decoder.Decode(&obj)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %s", i, err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+		doc := manifestDocument{index: i, object: &unstructured.Unstructured{Object: obj}}
+		if err := validateManifestDocument(doc); err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("yaml_body does not contain any manifest")
+	}
+	if err := checkDuplicateManifests(docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func validateManifestDocument(doc manifestDocument) error {
+	if doc.object.GetAPIVersion() == "" {
+		return fmt.Errorf("%s: apiVersion must be set", doc)
+	}
+	if doc.object.GetKind() == "" {
+		return fmt.Errorf("%s: kind must be set", doc)
+	}
+	if doc.object.GetName() == "" {
+		return fmt.Errorf("%s: metadata.name must be set", doc)
+	}
+	return nil
+}
+
+func checkDuplicateManifests(docs []manifestDocument) error {
+	seen := make(map[manifestObjectKey]int, len(docs))
+	for _, doc := range docs {
+		key := manifestIdentityOf(doc.object).objectKey()
+		if i, ok := seen[key]; ok {
+			return fmt.Errorf("%s: the object is already defined by document %d", doc, i)
+		}
+		seen[key] = doc.index
+	}
+	return nil
+}
+
+func manifestIdentityOf(obj *unstructured.Unstructured) manifestIdentity {
+	return manifestIdentity{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// manifestApplyOrder ranks kinds which other objects commonly depend on, so
+// Namespaces and CustomResourceDefinitions are applied first and deleted last.
+func manifestApplyOrder(obj *unstructured.Unstructured) int {
+	gvk := obj.GroupVersionKind()
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Namespace":
+		return 0
+	case isCustomResourceDefinition(gvk):
+		return 1
+	}
+	return 2
+}
+
+func isCustomResourceDefinition(gvk apimachineryschema.GroupVersionKind) bool {
+	return gvk.Group == "apiextensions.k8s.io" && gvk.Kind == "CustomResourceDefinition"
+}
+
+// sortManifestDocuments orders the documents for apply, keeping the order of
+// yaml_body for documents of the same rank.
+func sortManifestDocuments(docs []manifestDocument) {
+	sort.SliceStable(docs, func(i, j int) bool {
+		return manifestApplyOrder(docs[i].object) < manifestApplyOrder(docs[j].object)
+	})
+}
+
+func expandManifestIdentities(l []interface{}) []manifestIdentity {
+	ids := make([]manifestIdentity, 0, len(l))
+	for _, v := range l {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ids = append(ids, manifestIdentity{
+			APIVersion: m["api_version"].(string),
+			Kind:       m["kind"].(string),
+			Namespace:  m["namespace"].(string),
+			Name:       m["name"].(string),
+		})
+	}
+	return ids
+}
+
+func flattenManifestIdentities(ids []manifestIdentity) []interface{} {
+	l := make([]interface{}, len(ids))
+	for i, id := range ids {
+		l[i] = map[string]interface{}{
+			"api_version": id.APIVersion,
+			"kind":        id.Kind,
+			"namespace":   id.Namespace,
+			"name":        id.Name,
+		}
+	}
+	return l
+}
+
+// manifestsClient resolves the kinds of the manifests to API resources. The
+// discovery information is cached for the duration of one operation.
+type manifestsClient struct {
+	dynamic dynamic.Interface
+	mapper  *restmapper.DeferredDiscoveryRESTMapper
+}
+
+func newManifestsClient(meta interface{}) (*manifestsClient, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return nil, err
+	}
+	return &manifestsClient{
+		dynamic: dc,
+		mapper:  restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(conn.Discovery())),
+	}, nil
+}
+
+// resourceFor returns the client of the API resource serving the given kind,
+// scoped to the namespace when the resource is namespaced. When wait is set, a
+// kind missing from discovery is retried until the timeout, as discovery may
+// lag behind a CustomResourceDefinition which was just established.
+func (c *manifestsClient) resourceFor(ctx context.Context, apiVersion, kind, namespace string, wait bool, timeout time.Duration) (dynamic.ResourceInterface, bool, error) {
+	gv, err := apimachineryschema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, false, err
+	}
+	gk := gv.WithKind(kind).GroupKind()
+	mapping, err := c.mapper.RESTMapping(gk, gv.Version)
+	if err != nil && wait && apimeta.IsNoMatchError(err) {
+		err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+			c.mapper.Reset()
+			mapping, err = c.mapper.RESTMapping(gk, gv.Version)
+			if err != nil {
+				if apimeta.IsNoMatchError(err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if mapping.Scope.Name() != apimeta.RESTScopeNameNamespace {
+		return c.dynamic.Resource(mapping.Resource), false, nil
+	}
+	if namespace == "" {
+		namespace = "default"
+	}
+	return c.dynamic.Resource(mapping.Resource).Namespace(namespace), true, nil
+}
+
+func resourceKubernetesManifestsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("yaml_body") {
+		return d.SetNewComputed("manifests")
+	}
+	docs, err := parseManifestDocuments(d.Get("yaml_body").(string))
+	if err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	// Objects which were deleted outside of Terraform are dropped from
+	// manifests on read, they are applied again on update.
+	if d.HasChange("yaml_body") || len(docs) != len(d.Get("manifests").([]interface{})) {
+		return d.SetNewComputed("manifests")
+	}
+	return nil
+}
+
+func resourceKubernetesManifestsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(resource.UniqueId())
+	diags := applyManifestDocuments(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		if len(d.Get("manifests").([]interface{})) == 0 {
+			d.SetId("")
+		}
+		return diags
+	}
+	return resourceKubernetesManifestsRead(ctx, d, meta)
+}
+
+func resourceKubernetesManifestsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := newManifestsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := expandManifestIdentities(d.Get("manifests").([]interface{}))
+	found, err := readManifestObjects(ctx, c, ids, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(ids) > 0 && len(found) == 0 {
+		log.Printf("[WARN] None of the manifests of %s were found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("manifests", flattenManifestIdentities(found))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// readManifestObjects returns the objects which still exist, dropping the ones
+// which are not found or no longer carry the given labels.
+func readManifestObjects(ctx context.Context, c *manifestsClient, ids []manifestIdentity, labels map[string]string) ([]manifestIdentity, error) {
+	found := make([]manifestIdentity, 0, len(ids))
+	for _, id := range ids {
+		log.Printf("[INFO] Reading %s", id)
+		ri, _, err := c.resourceFor(ctx, id.APIVersion, id.Kind, id.Namespace, false, 0)
+		if err != nil {
+			if apimeta.IsNoMatchError(err) {
+				log.Printf("[WARN] The kind of %s is no longer served, removing from state", id)
+				continue
+			}
+			return nil, fmt.Errorf("Failed to read %s: %s", id, err)
+		}
+		out, err := ri.Get(ctx, id.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				log.Printf("[WARN] %s not found, removing from state", id)
+				continue
+			}
+			return nil, fmt.Errorf("Failed to read %s: %s", id, err)
+		}
+		if !hasManifestLabels(out, labels) {
+			log.Printf("[WARN] %s is no longer labeled as managed by Terraform, removing from state", id)
+			continue
+		}
+		found = append(found, id)
+	}
+	return found, nil
+}
+
+func hasManifestLabels(obj *unstructured.Unstructured, labels map[string]string) bool {
+	current := obj.GetLabels()
+	for k, v := range labels {
+		if current[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceKubernetesManifestsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := applyManifestDocuments(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+	return resourceKubernetesManifestsRead(ctx, d, meta)
+}
+
+func resourceKubernetesManifestsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := newManifestsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := expandManifestIdentities(d.Get("manifests").([]interface{}))
+	if err := deleteManifestObjects(ctx, c, ids, nil, metav1.DeleteOptions{}, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Manifests %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// applyManifestDocuments applies the documents of yaml_body in dependency
+// order, then deletes the objects of the previous apply which are no longer
+// defined. The objects applied so far are kept in manifests when an apply
+// fails, so they are not orphaned.
+func applyManifestDocuments(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	c, err := newManifestsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	docs, err := parseManifestDocuments(d.Get("yaml_body").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sortManifestDocuments(docs)
+
+	// manifests is computed during the update, so the objects of the previous
+	// apply are only known from its prior value.
+	old, _ := d.GetChange("manifests")
+	previous := expandManifestIdentities(old.([]interface{}))
+	applied, diags := applyManifestObjects(ctx, c, docs, d.Get("field_manager").(string), d.Get("force").(bool), timeout)
+	if diags.HasError() {
+		setAppliedManifests(d, "manifests", previous, applied)
+		return diags
+	}
+
+	pruned := prunedManifests(previous, applied)
+	if err := d.Set("manifests", flattenManifestIdentities(applied)); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(pruned) > 0 {
+		log.Printf("[INFO] Pruning %d objects removed from yaml_body", len(pruned))
+		if err := deleteManifestObjects(ctx, c, pruned, nil, metav1.DeleteOptions{}, timeout); err != nil {
+			setAppliedManifests(d, "manifests", pruned, applied)
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+// applyManifestObjects applies the documents with server-side apply, in the
+// order given. It returns the objects applied before any failure.
+func applyManifestObjects(ctx context.Context, c *manifestsClient, docs []manifestDocument, fieldManager string, force bool, timeout time.Duration) ([]manifestIdentity, diag.Diagnostics) {
+	applied := make([]manifestIdentity, 0, len(docs))
+	crdGroups := map[string]bool{}
+
+	for _, doc := range docs {
+		obj := doc.object
+		gvk := obj.GroupVersionKind()
+		ri, namespaced, err := c.resourceFor(ctx, obj.GetAPIVersion(), obj.GetKind(), obj.GetNamespace(), crdGroups[gvk.Group], timeout)
+		if err != nil {
+			return applied, diag.Errorf("%s: %s", doc, err)
+		}
+		if namespaced {
+			if obj.GetNamespace() == "" {
+				obj.SetNamespace("default")
+			}
+		} else {
+			obj.SetNamespace("")
+		}
+
+		data, err := obj.MarshalJSON()
+		if err != nil {
+			return applied, diag.Errorf("%s: failed to marshal manifest: %s", doc, err)
+		}
+		log.Printf("[INFO] Applying %s: %s", doc, string(data))
+		_, err = ri.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+			FieldManager: fieldManager,
+			Force:        ptrToBool(force),
+		})
+		if err != nil {
+			diags := applyErrorDiagnostics(err, fieldManager)
+			for i := range diags {
+				diags[i].Summary = fmt.Sprintf("%s: %s", doc, diags[i].Summary)
+			}
+			return applied, diags
+		}
+
+		applied = append(applied, manifestIdentityOf(obj))
+		if isCustomResourceDefinition(gvk) {
+			if err := waitForCRDEstablished(ctx, ri, obj.GetName(), timeout); err != nil {
+				return applied, diag.Errorf("%s: %s", doc, err)
+			}
+			// The kinds of the definition are missing from the discovery
+			// information cached before it was established.
+			c.mapper.Reset()
+			if group, ok, _ := unstructured.NestedString(obj.Object, "spec", "group"); ok {
+				crdGroups[group] = true
+			}
+		}
+	}
+	return applied, nil
+}
+
+// prunedManifests returns the objects of the previous apply which were not
+// applied again. An object applied again with another version of its API is
+// not pruned, deleting it would delete the object just applied.
+func prunedManifests(previous, applied []manifestIdentity) []manifestIdentity {
+	appliedSet := make(map[manifestObjectKey]bool, len(applied))
+	for _, id := range applied {
+		appliedSet[id.objectKey()] = true
+	}
+	var pruned []manifestIdentity
+	for _, id := range previous {
+		if !appliedSet[id.objectKey()] {
+			pruned = append(pruned, id)
+		}
+	}
+	return pruned
+}
+
+// setAppliedManifests records the objects applied by a failed apply, followed
+// by the objects of the previous apply which were not applied again.
+func setAppliedManifests(d *schema.ResourceData, key string, previous, applied []manifestIdentity) {
+	ids := append([]manifestIdentity{}, applied...)
+	seen := make(map[manifestObjectKey]bool, len(applied))
+	for _, id := range applied {
+		seen[id.objectKey()] = true
+	}
+	for _, id := range previous {
+		if !seen[id.objectKey()] {
+			ids = append(ids, id)
+		}
+	}
+	if err := d.Set(key, flattenManifestIdentities(ids)); err != nil {
+		log.Printf("[WARN] Failed to record the applied manifests: %s", err)
+	}
+}
+
+// waitForCRDEstablished waits for a CustomResourceDefinition to report the
+// Established condition, after which its kinds are served.
+func waitForCRDEstablished(ctx context.Context, ri dynamic.ResourceInterface, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for CustomResourceDefinition %s to be established", name)
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		out, err := ri.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		conditions, _, _ := unstructured.NestedSlice(out.Object, "status", "conditions")
+		for _, c := range conditions {
+			if m, ok := c.(map[string]interface{}); ok && m["type"] == "Established" && m["status"] == "True" {
+				return nil
+			}
+		}
+		return resource.RetryableError(fmt.Errorf("CustomResourceDefinition %s is not established yet", name))
+	})
+}
+
+// deleteManifestObjects deletes the objects in the reverse order of apply and
+// waits for them to be gone, so namespaces are deleted after their contents.
+// When labels are given, objects which no longer carry them are left in place.
+func deleteManifestObjects(ctx context.Context, c *manifestsClient, ids []manifestIdentity, labels map[string]string, opts metav1.DeleteOptions, timeout time.Duration) error {
+	clients := make([]dynamic.ResourceInterface, len(ids))
+	for i := len(ids) - 1; i >= 0; i-- {
+		id := ids[i]
+		ri, _, err := c.resourceFor(ctx, id.APIVersion, id.Kind, id.Namespace, false, 0)
+		if err != nil {
+			if apimeta.IsNoMatchError(err) {
+				log.Printf("[WARN] The kind of %s is no longer served, skipping deletion", id)
+				continue
+			}
+			return fmt.Errorf("Failed to delete %s: %s", id, err)
+		}
+		deleteOpts := opts
+		if len(labels) > 0 {
+			out, err := ri.Get(ctx, id.Name, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return fmt.Errorf("Failed to delete %s: %s", id, err)
+			}
+			if !hasManifestLabels(out, labels) {
+				log.Printf("[WARN] %s is no longer labeled as managed by Terraform, skipping deletion", id)
+				continue
+			}
+			// Do not delete an object which was replaced since it was read.
+			uid := out.GetUID()
+			deleteOpts.Preconditions = &metav1.Preconditions{UID: &uid}
+		}
+		log.Printf("[INFO] Deleting %s", id)
+		err = ri.Delete(ctx, id.Name, deleteOpts)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("Failed to delete %s: %s", id, err)
+		}
+		clients[i] = ri
+	}
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		for i, ri := range clients {
+			if ri == nil {
+				continue
+			}
+			_, err := ri.Get(ctx, ids[i].Name, metav1.GetOptions{})
+			if err != nil {
+				if errors.IsNotFound(err) {
+					clients[i] = nil
+					continue
+				}
+				return resource.NonRetryableError(err)
+			}
+			return resource.RetryableError(fmt.Errorf("%s still exists", ids[i]))
+		}
+		return nil
+	})
+}
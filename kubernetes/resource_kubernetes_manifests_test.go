@@ -0,0 +1,571 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+)
+
+func TestParseManifestDocuments(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		expected []string
+		err      string
+	}{
+		{
+			name: "multiple documents",
+			body: `# vendor manifests
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: example
+---
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+`,
+			expected: []string{`document 1 (ConfigMap "config")`, `document 2 (Namespace "example")`},
+		},
+		{
+			name:     "json",
+			body:     `{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "example"}}`,
+			expected: []string{`document 0 (Namespace "example")`},
+		},
+		{
+			name: "missing name",
+			body: `apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: example
+`,
+			err: "document 1 (ConfigMap): metadata.name must be set",
+		},
+		{
+			name: "missing kind",
+			body: `apiVersion: v1
+metadata:
+  name: example
+`,
+			err: "document 0: kind must be set",
+		},
+		{
+			name: "duplicate object",
+			body: `apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+`,
+			err: `document 1 (Namespace "example"): the object is already defined by document 0`,
+		},
+		{
+			name: "no documents",
+			body: "# nothing here\n---\n",
+			err:  "yaml_body does not contain any manifest",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			docs, err := parseManifestDocuments(tc.body)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, len(docs))
+			for i, doc := range docs {
+				got[i] = doc.String()
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected documents (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortManifestDocuments(t *testing.T) {
+	docs, err := parseManifestDocuments(`apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: first
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: example
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortManifestDocuments(docs)
+
+	expected := []string{
+		`document 3 (Namespace "example")`,
+		`document 1 (CustomResourceDefinition "widgets.example.com")`,
+		`document 0 (Widget "first")`,
+		`document 2 (ConfigMap "second")`,
+	}
+	got := make([]string, len(docs))
+	for i, doc := range docs {
+		got[i] = doc.String()
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("unexpected order (-want +got):\n%s", diff)
+	}
+}
+
+func TestResourceKubernetesManifestsUpdate_removedDocument(t *testing.T) {
+	api, meta := newFakeConfigMapAPI(t)
+	r := resourceKubernetesManifests()
+
+	state := applyTestResource(t, r, nil, map[string]interface{}{
+		"yaml_body": testConfigMapManifest("first") + "---\n" + testConfigMapManifest("second"),
+	}, meta)
+	if diff := cmp.Diff([]string{"first", "second"}, api.names()); diff != "" {
+		t.Fatalf("Unexpected config maps after create (-want +got):\n%s", diff)
+	}
+
+	state = applyTestResource(t, r, state, map[string]interface{}{
+		"yaml_body": testConfigMapManifest("first"),
+	}, meta)
+	if diff := cmp.Diff([]string{"first"}, api.names()); diff != "" {
+		t.Fatalf("Expected the removed document to be pruned (-want +got):\n%s", diff)
+	}
+	if state.Attributes["manifests.#"] != "1" || state.Attributes["manifests.0.name"] != "first" {
+		t.Fatalf("Unexpected manifests: %v", state.Attributes)
+	}
+}
+
+func TestResourceKubernetesManifestsUpdate_changedAPIVersion(t *testing.T) {
+	api, meta := newFakeConfigMapAPI(t)
+	r := resourceKubernetesManifests()
+
+	state := applyTestResource(t, r, nil, map[string]interface{}{
+		"yaml_body": testWidgetManifest("first", "v1beta1"),
+	}, meta)
+	if diff := cmp.Diff([]string{"first"}, api.names()); diff != "" {
+		t.Fatalf("Unexpected widgets after create (-want +got):\n%s", diff)
+	}
+
+	state = applyTestResource(t, r, state, map[string]interface{}{
+		"yaml_body": testWidgetManifest("first", "v1"),
+	}, meta)
+	if diff := cmp.Diff([]string{"first"}, api.names()); diff != "" {
+		t.Fatalf("Expected the widget applied with another version not to be pruned (-want +got):\n%s", diff)
+	}
+	if state.Attributes["manifests.#"] != "1" || state.Attributes["manifests.0.api_version"] != "example.com/v1" {
+		t.Fatalf("Unexpected manifests: %v", state.Attributes)
+	}
+}
+
+func TestPrunedManifests(t *testing.T) {
+	first := manifestIdentity{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "first"}
+	second := manifestIdentity{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "second"}
+	hpa := manifestIdentity{APIVersion: "autoscaling/v2beta2", Kind: "HorizontalPodAutoscaler", Namespace: "default", Name: "first"}
+	hpaV2 := manifestIdentity{APIVersion: "autoscaling/v2", Kind: "HorizontalPodAutoscaler", Namespace: "default", Name: "first"}
+
+	cases := map[string]struct {
+		previous []manifestIdentity
+		applied  []manifestIdentity
+		expected []manifestIdentity
+	}{
+		"unchanged": {
+			previous: []manifestIdentity{first, second},
+			applied:  []manifestIdentity{first, second},
+		},
+		"removed": {
+			previous: []manifestIdentity{first, second},
+			applied:  []manifestIdentity{first},
+			expected: []manifestIdentity{second},
+		},
+		"changed API version": {
+			previous: []manifestIdentity{first, hpa},
+			applied:  []manifestIdentity{first, hpaV2},
+		},
+		"same name in another group": {
+			previous: []manifestIdentity{first, hpa},
+			applied:  []manifestIdentity{hpaV2},
+			expected: []manifestIdentity{first},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expected, prunedManifests(tc.previous, tc.applied)); diff != "" {
+				t.Fatalf("Unexpected pruned manifests (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAccKubernetesManifests_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_manifests.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesManifestsDestroy(name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestsConfig_basic(name, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifests.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "manifests.0.kind", "Namespace"),
+					resource.TestCheckResourceAttr(resourceName, "manifests.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "manifests.0.namespace", ""),
+					resource.TestCheckResourceAttr(resourceName, "manifests.1.kind", "ConfigMap"),
+					resource.TestCheckResourceAttr(resourceName, "manifests.1.namespace", name),
+					resource.TestCheckResourceAttr(resourceName, "manifests.2.kind", "ConfigMap"),
+					resource.TestCheckResourceAttr(resourceName, "manifests.2.name", "second"),
+					testAccCheckKubernetesManifestsConfigMapExists(name, "first", true),
+					testAccCheckKubernetesManifestsConfigMapExists(name, "second", true),
+				),
+			},
+			{
+				Config: testAccKubernetesManifestsConfig_basic(name, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "manifests.#", "2"),
+					testAccCheckKubernetesManifestsConfigMapExists(name, "first", true),
+					testAccCheckKubernetesManifestsConfigMapExists(name, "second", false),
+				),
+			},
+			{
+				Config:      testAccKubernetesManifestsConfig_missingName(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`document 1 \(ConfigMap\): metadata.name must be set`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesManifestsConfigMapExists(namespace, name string, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		_, err = conn.CoreV1().ConfigMaps(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) && !expected {
+				return nil
+			}
+			return err
+		}
+		if !expected {
+			return fmt.Errorf("Expected config map %s/%s to be pruned", namespace, name)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesManifestsDestroy(namespace string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		_, err = conn.CoreV1().Namespaces().Get(context.Background(), namespace, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("Namespace still exists: %s", namespace)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesManifestsConfig_basic(name string, second bool) string {
+	body := fmt.Sprintf(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+  namespace: %[1]s
+data:
+  key: value
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %[1]s
+`, name)
+	if second {
+		body += fmt.Sprintf(`---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+  namespace: %s
+`, name)
+	}
+	return fmt.Sprintf(`resource "kubernetes_manifests" "test" {
+  yaml_body = <<EOT
+%s
+EOT
+}
+`, body)
+}
+
+func testAccKubernetesManifestsConfig_missingName(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_manifests" "test" {
+  yaml_body = <<EOT
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: %s
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: %s
+EOT
+}
+`, name, name)
+}
+
+func TestAccKubernetesManifests_customResourceDefinition(t *testing.T) {
+	kind := "Tfacc" + acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	plural := strings.ToLower(kind) + "s"
+	group := "terraform.io"
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesManifestsCRDDestroy(plural + "." + group),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesManifestsConfig_customResourceDefinition(group, kind, plural, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("kubernetes_manifests.crd", "manifests.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_manifests.cr", "manifests.#", "1"),
+					resource.TestCheckResourceAttr("kubernetes_manifests.cr", "manifests.0.kind", kind),
+					resource.TestCheckResourceAttr("kubernetes_manifests.cr", "manifests.0.namespace", "default"),
+					testAccCheckKubernetesManifestsCustomResourceExists(group, plural, name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesManifestsCustomResourceExists(group, plural, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		gvr := apimachineryschema.GroupVersionResource{Group: group, Version: "v1", Resource: plural}
+		_, err = dc.Resource(gvr).Namespace("default").Get(context.Background(), name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccCheckKubernetesManifestsCRDDestroy(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		gvr := apimachineryschema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+		_, err = dc.Resource(gvr).Get(context.Background(), name, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("CustomResourceDefinition still exists: %s", name)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesManifestsConfig_customResourceDefinition(group, kind, plural, name string) string {
+	return fmt.Sprintf(`resource "kubernetes_manifests" "crd" {
+  yaml_body = <<EOT
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: %[3]s.%[1]s
+spec:
+  group: %[1]s
+  scope: Namespaced
+  names:
+    kind: %[2]s
+    plural: %[3]s
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    schema:
+      openAPIV3Schema:
+        type: object
+        properties:
+          data:
+            type: string
+EOT
+}
+
+resource "kubernetes_manifests" "cr" {
+  yaml_body = <<EOT
+apiVersion: %[1]s/v1
+kind: %[2]s
+metadata:
+  name: %[4]s
+data: example
+EOT
+
+  depends_on = [kubernetes_manifests.crd]
+}
+`, group, kind, plural, name)
+}
+
+// fakeConfigMapAPI serves the discovery of the core API group and the config
+// maps of the default namespace from memory, enough for the clients of the
+// resources applying manifests. The config maps are also served as the Widget
+// kind of the example.com group, in versions v1beta1 and v1.
+type fakeConfigMapAPI struct {
+	mu         sync.Mutex
+	configMaps map[string]map[string]interface{}
+}
+
+var fakeConfigMapPathRegexp = regexp.MustCompile(`^/(?:api/v1|apis/example\.com/v1(?:beta1)?)/namespaces/default/(?:configmaps|widgets)/([^/]+)$`)
+
+func newFakeConfigMapAPI(t *testing.T) (*fakeConfigMapAPI, kubeClientsets) {
+	api := &fakeConfigMapAPI{configMaps: map[string]map[string]interface{}{}}
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	meta := kubeClientsets{
+		config:        &restclient.Config{Host: server.URL},
+		clients:       &clientsetsCache{},
+		serverVersion: &serverVersionCache{},
+	}
+	return api, meta
+}
+
+func (api *fakeConfigMapAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/api":
+		fmt.Fprint(w, `{"kind":"APIVersions","versions":["v1"]}`)
+		return
+	case "/apis":
+		fmt.Fprint(w, `{"kind":"APIGroupList","apiVersion":"v1","groups":[{"name":"example.com","versions":[{"groupVersion":"example.com/v1","version":"v1"},{"groupVersion":"example.com/v1beta1","version":"v1beta1"}],"preferredVersion":{"groupVersion":"example.com/v1","version":"v1"}}]}`)
+		return
+	case "/api/v1":
+		fmt.Fprint(w, `{"kind":"APIResourceList","groupVersion":"v1","resources":[{"name":"configmaps","singularName":"configmap","namespaced":true,"kind":"ConfigMap","verbs":["get","patch","delete"]}]}`)
+		return
+	case "/apis/example.com/v1", "/apis/example.com/v1beta1":
+		fmt.Fprintf(w, `{"kind":"APIResourceList","groupVersion":%q,"resources":[{"name":"widgets","singularName":"widget","namespaced":true,"kind":"Widget","verbs":["get","patch","delete"]}]}`, strings.TrimPrefix(r.URL.Path, "/apis/"))
+		return
+	}
+	if m := fakeConfigMapPathRegexp.FindStringSubmatch(r.URL.Path); m != nil {
+		name := m[1]
+		switch r.Method {
+		case http.MethodPatch:
+			obj := map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&obj); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			obj["metadata"].(map[string]interface{})["uid"] = "uid-" + name
+			api.configMaps[name] = obj
+			json.NewEncoder(w).Encode(obj)
+			return
+		case http.MethodGet:
+			if obj, ok := api.configMaps[name]; ok {
+				json.NewEncoder(w).Encode(obj)
+				return
+			}
+		case http.MethodDelete:
+			if _, ok := api.configMaps[name]; ok {
+				delete(api.configMaps, name)
+				fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Success"}`)
+				return
+			}
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+	fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"NotFound","code":404}`)
+}
+
+// names returns the names of the config maps, sorted.
+func (api *fakeConfigMapAPI) names() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	names := make([]string, 0, len(api.configMaps))
+	for name := range api.configMaps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func testConfigMapManifest(name string) string {
+	return fmt.Sprintf("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: %s\ndata:\n  key: value\n", name)
+}
+
+func testWidgetManifest(name, version string) string {
+	return fmt.Sprintf("apiVersion: example.com/%s\nkind: Widget\nmetadata:\n  name: %s\ndata:\n  key: value\n", version, name)
+}
+
+// applyTestResource plans and applies the configuration for the resource
+// like Terraform does, and returns the new state.
+func applyTestResource(t *testing.T, r *schema.Resource, state *terraform.InstanceState, raw map[string]interface{}, meta interface{}) *terraform.InstanceState {
+	ctx := context.Background()
+	diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newState, diags := r.Apply(ctx, state, diff, meta)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %s", diags[0].Summary)
+	}
+	return newState
+}
@@ -71,6 +71,15 @@ func resourceKubernetesMutatingWebhookConfigurationV1() *schema.Resource {
 								string(admissionregistrationv1.Exact),
 							}, false),
 						},
+						"match_condition": {
+							Type:        schema.TypeList,
+							Description: webhookDoc["matchConditions"],
+							Optional:    true,
+							MaxItems:    64,
+							Elem: &schema.Resource{
+								Schema: matchConditionFields(),
+							},
+						},
 						"name": {
 							Type:        schema.TypeString,
 							Description: webhookDoc["name"],
@@ -183,19 +192,21 @@ func resourceKubernetesMutatingWebhookConfigurationV1Read(ctx context.Context, d
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d, meta))
 	if err != nil {
 		return nil
 	}
 
 	log.Printf("[DEBUG] Setting webhook to: %#v", cfg.Webhooks)
 
-	err = d.Set("webhook", flattenMutatingWebhooks(cfg.Webhooks))
+	webhooks := flattenMutatingWebhooks(cfg.Webhooks)
+	diags := preserveWebhookMatchConditions(d, webhooks)
+	err = d.Set("webhook", webhooks)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceKubernetesMutatingWebhookConfigurationV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
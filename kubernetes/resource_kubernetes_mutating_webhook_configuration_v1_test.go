@@ -112,6 +112,46 @@ func TestAccKubernetesMutatingWebhookConfigurationV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesMutatingWebhookConfigurationV1_matchConditions(t *testing.T) {
+	name := fmt.Sprintf("acc-test-%v.terraform.io", acctest.RandString(10))
+	resourceName := "kubernetes_mutating_webhook_configuration_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.28.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesMutatingWebhookConfigurationV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesMutatingWebhookConfigurationV1Config_matchConditions(name, "exclude-leases", "request.resource.resource != 'leases'"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesMutatingWebhookConfigurationV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.0.name", "exclude-leases"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.0.expression", "request.resource.resource != 'leases'"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.1.name", "exclude-kubelet-requests"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesMutatingWebhookConfigurationV1Config_matchConditions(name, "exclude-configmaps", "request.resource.resource != 'configmaps'"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.0.name", "exclude-configmaps"),
+					resource.TestCheckResourceAttr(resourceName, "webhook.0.match_condition.0.expression", "request.resource.resource != 'configmaps'"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesMutatingWebhookConfigurationV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -250,3 +290,47 @@ func testAccKubernetesMutatingWebhookConfigurationV1Config_modified(name string)
 }
 `, name, name)
 }
+
+func testAccKubernetesMutatingWebhookConfigurationV1Config_matchConditions(name, conditionName, expression string) string {
+	return fmt.Sprintf(`resource "kubernetes_mutating_webhook_configuration_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+
+  webhook {
+    name = %[1]q
+
+    admission_review_versions = ["v1"]
+
+    client_config {
+      service {
+        namespace = "example-namespace"
+        name      = "example-service"
+      }
+    }
+
+    rule {
+      api_groups   = ["*"]
+      api_versions = ["*"]
+      operations   = ["CREATE"]
+      resources    = ["*"]
+      scope        = "Namespaced"
+    }
+
+    match_condition {
+      name       = %[2]q
+      expression = %[3]q
+    }
+
+    match_condition {
+      name       = "exclude-kubelet-requests"
+      expression = "!(\"system:nodes\" in request.userInfo.groups)"
+    }
+
+    reinvocation_policy = "IfNeeded"
+    failure_policy      = "Ignore"
+    side_effects        = "None"
+  }
+}
+`, name, conditionName, expression)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,9 +15,16 @@ import (
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 )
 
+// namespaceRemainingResourcesLimit caps the number of remaining objects listed
+// in the error when a namespace is stuck terminating.
+const namespaceRemainingResourcesLimit = 20
+
 func resourceKubernetesNamespace() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceKubernetesNamespaceCreate,
@@ -28,8 +37,15 @@ func resourceKubernetesNamespace() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("namespace", true),
+			"wait_for_default_service_account": {
+				Type:        schema.TypeBool,
+				Description: "Wait for the default service account to be created in the namespace before the namespace is considered created.",
+				Optional:    true,
+				Default:     false,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 	}
@@ -53,6 +69,23 @@ func resourceKubernetesNamespaceCreate(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Submitted new namespace: %#v", out)
 	d.SetId(out.Name)
 
+	if d.Get("wait_for_default_service_account").(bool) {
+		log.Printf("[INFO] Waiting for the default service account of namespace %s", out.Name)
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+			_, err := conn.CoreV1().ServiceAccounts(out.Name).Get(ctx, "default", metav1.GetOptions{})
+			if err != nil {
+				if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+					return resource.RetryableError(fmt.Errorf("the default service account of namespace %s does not exist yet", out.Name))
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesNamespaceRead(ctx, d, meta)
 }
 
@@ -78,7 +111,7 @@ func resourceKubernetesNamespaceRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received namespace: %#v", namespace)
-	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(namespace.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -119,7 +152,12 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Deleting namespace: %#v", name)
 	err = conn.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
 	if err != nil {
-		return diag.FromErr(err)
+		// A namespace that is already terminating, e.g. after a previous
+		// delete timed out, reports a conflict. Keep waiting for it.
+		if statusErr, ok := err.(*errors.StatusError); !ok || !errors.IsConflict(statusErr) {
+			return diag.FromErr(err)
+		}
+		log.Printf("[INFO] Namespace %s is already terminating", name)
 	}
 
 	stateConf := &resource.StateChangeConf{
@@ -143,7 +181,10 @@ func resourceKubernetesNamespaceDelete(ctx context.Context, d *schema.ResourceDa
 	}
 	_, err = stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		if _, ok := err.(*resource.TimeoutError); !ok && ctx.Err() != context.DeadlineExceeded {
+			return diag.FromErr(err)
+		}
+		return diag.FromErr(namespaceTerminatingError(name, d.Timeout(schema.TimeoutDelete), meta))
 	}
 	log.Printf("[INFO] Namespace %s deleted", name)
 
@@ -169,3 +210,97 @@ func resourceKubernetesNamespaceExists(ctx context.Context, d *schema.ResourceDa
 	log.Printf("[INFO] Namespace %s exists", name)
 	return true, err
 }
+
+// namespaceTerminatingError describes why a namespace did not disappear in
+// time: the conditions of the namespace and the objects still left in it,
+// typically waiting for their finalizers.
+func namespaceTerminatingError(name string, timeout time.Duration, meta interface{}) error {
+	// The context of the delete operation has expired by now.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+
+	var conditions []api.NamespaceCondition
+	ns, err := conn.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[WARN] Failed to read terminating namespace %s: %s", name, err)
+	} else {
+		conditions = ns.Status.Conditions
+	}
+	remaining, err := namespaceRemainingResources(ctx, conn.Discovery(), dc, name)
+	if err != nil {
+		log.Printf("[WARN] Failed to list the remaining resources of namespace %s: %s", name, err)
+	}
+	return formatNamespaceTerminatingError(name, timeout, conditions, remaining)
+}
+
+// namespaceRemainingResources lists the objects left in the namespace as
+// `resource.group/name` along with their finalizers. Groups that fail
+// discovery are skipped.
+func namespaceRemainingResources(ctx context.Context, disco discovery.DiscoveryInterface, dc dynamic.Interface, namespace string) ([]string, error) {
+	lists, err := disco.ServerPreferredNamespacedResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	var remaining []string
+	for _, list := range discovery.FilteredBy(discovery.SupportsAllVerbs{Verbs: []string{"list"}}, lists) {
+		gv, err := apimachineryschema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") {
+				continue
+			}
+			objs, err := dc.Resource(gv.WithResource(r.Name)).Namespace(namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				log.Printf("[DEBUG] Failed to list %s in namespace %s: %s", r.Name, namespace, err)
+				continue
+			}
+			resourceName := r.Name
+			if gv.Group != "" {
+				resourceName = r.Name + "." + gv.Group
+			}
+			for _, obj := range objs.Items {
+				entry := resourceName + "/" + obj.GetName()
+				if f := obj.GetFinalizers(); len(f) > 0 {
+					entry += fmt.Sprintf(" (finalizers: %s)", strings.Join(f, ", "))
+				}
+				remaining = append(remaining, entry)
+			}
+		}
+	}
+	sort.Strings(remaining)
+	return remaining, nil
+}
+
+func formatNamespaceTerminatingError(name string, timeout time.Duration, conditions []api.NamespaceCondition, remaining []string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "namespace %s was not deleted within %s", name, timeout)
+	for _, c := range conditions {
+		if c.Status != api.ConditionTrue {
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s: %s", c.Type, c.Message)
+	}
+	if len(remaining) > 0 {
+		b.WriteString("\nRemaining resources:")
+		for i, r := range remaining {
+			if i == namespaceRemainingResourcesLimit {
+				fmt.Fprintf(&b, "\n  ... and %d more", len(remaining)-i)
+				break
+			}
+			fmt.Fprintf(&b, "\n  %s", r)
+		}
+	}
+	return fmt.Errorf("%s", b.String())
+}
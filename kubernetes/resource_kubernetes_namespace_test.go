@@ -6,12 +6,15 @@ import (
 	"reflect"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
 )
 
 func TestAccKubernetesNamespace_basic(t *testing.T) {
@@ -41,7 +44,7 @@ func TestAccKubernetesNamespace_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_default_service_account"},
 			},
 			{
 				Config: testAccKubernetesNamespaceConfig_addAnnotations(nsName),
@@ -143,7 +146,7 @@ func TestAccKubernetesNamespace_generatedName(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_default_service_account"},
 			},
 		},
 	})
@@ -213,6 +216,154 @@ func TestAccKubernetesNamespace_deleteTimeout(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesNamespace_waitForDefaultServiceAccount(t *testing.T) {
+	var conf api.Namespace
+	nsName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_namespace.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNamespaceConfig_waitForDefaultServiceAccount(nsName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNamespaceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_default_service_account", "true"),
+					testAccCheckKubernetesNamespaceDefaultServiceAccountExists(nsName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesNamespace_deleteBlockedByFinalizer(t *testing.T) {
+	var conf api.Namespace
+	nsName := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_namespace.test.0"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNamespaceConfig_finalizerDeleteTimeout(nsName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNamespaceExists(resourceName, &conf),
+				),
+			},
+			{
+				PreConfig: func() {
+					createNamespaceFinalizerBlocker(t, nsName)
+				},
+				Config:      testAccKubernetesNamespaceConfig_finalizerDeleteTimeout(nsName, 0),
+				ExpectError: regexp.MustCompile(`(?s)namespace .+ was not deleted within 20s.*Remaining resources:\s+configmaps/blocker \(finalizers: tf-acc-test/block\)`),
+			},
+			{
+				PreConfig: func() {
+					removeNamespaceFinalizerBlocker(t, nsName)
+				},
+				Config: testAccKubernetesNamespaceConfig_finalizerDeleteTimeout(nsName, 0),
+			},
+		},
+	})
+}
+
+func TestFormatNamespaceTerminatingError(t *testing.T) {
+	conditions := []api.NamespaceCondition{
+		{
+			Type:    api.NamespaceContentRemaining,
+			Status:  api.ConditionTrue,
+			Message: "Some resources are remaining: configmaps. has 1 resource instances",
+		},
+		{
+			Type:   api.NamespaceDeletionDiscoveryFailure,
+			Status: api.ConditionFalse,
+		},
+	}
+	remaining := make([]string, namespaceRemainingResourcesLimit+2)
+	for i := range remaining {
+		remaining[i] = fmt.Sprintf("configmaps/cm-%02d (finalizers: example.com/block)", i)
+	}
+
+	err := formatNamespaceTerminatingError("test", 5*time.Minute, conditions, remaining)
+	expected := "namespace test was not deleted within 5m0s\n" +
+		"NamespaceContentRemaining: Some resources are remaining: configmaps. has 1 resource instances\n" +
+		"Remaining resources:"
+	for i := 0; i < namespaceRemainingResourcesLimit; i++ {
+		expected += fmt.Sprintf("\n  configmaps/cm-%02d (finalizers: example.com/block)", i)
+	}
+	expected += "\n  ... and 2 more"
+	if err.Error() != expected {
+		t.Fatalf("Unexpected error message:\n%s\nexpected:\n%s", err, expected)
+	}
+
+	err = formatNamespaceTerminatingError("test", time.Minute, nil, nil)
+	if err.Error() != "namespace test was not deleted within 1m0s" {
+		t.Fatalf("Unexpected error message: %s", err)
+	}
+}
+
+func testAccCheckKubernetesNamespaceDefaultServiceAccountExists(namespace string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		_, err = conn.CoreV1().ServiceAccounts(namespace).Get(context.TODO(), "default", metav1.GetOptions{})
+		return err
+	}
+}
+
+func createNamespaceFinalizerBlocker(t *testing.T, namespace string) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cm := api.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "blocker",
+			Namespace:  namespace,
+			Finalizers: []string{"tf-acc-test/block"},
+		},
+	}
+	_, err = conn.CoreV1().ConfigMaps(namespace).Create(context.TODO(), &cm, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// removeNamespaceFinalizerBlocker releases the blocking config map and waits
+// for the namespace controller to finish deleting the namespace.
+func removeNamespaceFinalizerBlocker(t *testing.T, namespace string) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.TODO()
+	patch := []byte(`[{"op": "remove", "path": "/metadata/finalizers"}]`)
+	_, err = conn.CoreV1().ConfigMaps(namespace).Patch(ctx, "blocker", pkgApi.JSONPatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		_, err := conn.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		return resource.RetryableError(fmt.Errorf("namespace %s still exists", namespace))
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func testAccCheckMetaAnnotations(om *metav1.ObjectMeta, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(expected) == 0 && len(om.Annotations) == 0 {
@@ -414,3 +565,26 @@ func testAccKubernetesNamespaceConfig_deleteTimeout(nsName string) string {
 }
 `, nsName)
 }
+
+func testAccKubernetesNamespaceConfig_waitForDefaultServiceAccount(nsName string) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  metadata {
+    name = %q
+  }
+  wait_for_default_service_account = true
+}
+`, nsName)
+}
+
+func testAccKubernetesNamespaceConfig_finalizerDeleteTimeout(nsName string, count int) string {
+	return fmt.Sprintf(`resource "kubernetes_namespace" "test" {
+  count = %d
+  metadata {
+    name = %q
+  }
+  timeouts {
+    delete = "20s"
+  }
+}
+`, count, nsName)
+}
@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,6 +24,7 @@ var (
 	networkPolicyEgressRulePortsDoc       = api.NetworkPolicyEgressRule{}.SwaggerDoc()["ports"]
 	networkPolicyEgressRuleToDoc          = api.NetworkPolicyEgressRule{}.SwaggerDoc()["to"]
 	networkPolicyPortPortDoc              = api.NetworkPolicyPort{}.SwaggerDoc()["port"]
+	networkPolicyPortEndPortDoc           = api.NetworkPolicyPort{}.SwaggerDoc()["endPort"]
 	networkPolicyPortProtocolDoc          = api.NetworkPolicyPort{}.SwaggerDoc()["protocol"]
 	networkPolicyPeerIpBlockDoc           = api.NetworkPolicyPeer{}.SwaggerDoc()["ipBlock"]
 	ipBlockCidrDoc                        = api.IPBlock{}.SwaggerDoc()["cidr"]
@@ -39,6 +41,7 @@ func resourceKubernetesNetworkPolicy() *schema.Resource {
 		ReadContext:   resourceKubernetesNetworkPolicyRead,
 		UpdateContext: resourceKubernetesNetworkPolicyUpdate,
 		DeleteContext: resourceKubernetesNetworkPolicyDelete,
+		CustomizeDiff: resourceKubernetesNetworkPolicyCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -69,6 +72,12 @@ func resourceKubernetesNetworkPolicy() *schema.Resource {
 													Description: networkPolicyPortPortDoc,
 													Optional:    true,
 												},
+												"end_port": {
+													Type:         schema.TypeInt,
+													Description:  networkPolicyPortEndPortDoc,
+													Optional:     true,
+													ValidateFunc: validatePortNum,
+												},
 												"protocol": {
 													Type:        schema.TypeString,
 													Description: networkPolicyPortProtocolDoc,
@@ -146,6 +155,12 @@ func resourceKubernetesNetworkPolicy() *schema.Resource {
 													Description: networkPolicyPortPortDoc,
 													Optional:    true,
 												},
+												"end_port": {
+													Type:         schema.TypeInt,
+													Description:  networkPolicyPortEndPortDoc,
+													Optional:     true,
+													ValidateFunc: validatePortNum,
+												},
 												"protocol": {
 													Type:        schema.TypeString,
 													Description: networkPolicyPortProtocolDoc,
@@ -215,18 +230,17 @@ func resourceKubernetesNetworkPolicy() *schema.Resource {
 								Schema: labelSelectorFields(true),
 							},
 						},
-						// The policy_types property is made required because the default value is only evaluated server side on resource creation.
-						// During the initial creation, a default value is determined and stored, then PolicyTypes is no longer considered unset,
-						// it will stick to that value on further updates unless explicitly overridden.
-						// Leaving the policy_types property optional here would prevent further updates adding egress rules after the initial resource creation
-						// without egress rules nor policy types from working as expected as PolicyTypes will stick to Ingress server side.
+						// The default value of policy_types is only evaluated server side on resource creation, after which PolicyTypes
+						// sticks to that value on further updates unless explicitly overridden. When policy_types is not configured the
+						// provider sends the types the server would infer from the current rules instead, so that adding egress rules
+						// later on still takes effect, and suppresses the diff against the inferred types.
 						"policy_types": {
-							Type:        schema.TypeList,
-							Description: networkPolicySpecPolicyTypesDoc,
-							Required:    true,
-							MinItems:    1,
-							MaxItems:    2,
-							Elem:        &schema.Schema{Type: schema.TypeString},
+							Type:             schema.TypeList,
+							Description:      networkPolicySpecPolicyTypesDoc,
+							Optional:         true,
+							MaxItems:         2,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							DiffSuppressFunc: suppressInferredNetworkPolicyTypes,
 						},
 					},
 				},
@@ -235,6 +249,24 @@ func resourceKubernetesNetworkPolicy() *schema.Resource {
 	}
 }
 
+func resourceKubernetesNetworkPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, rule := range []string{"ingress", "egress"} {
+		for i := range d.Get("spec.0." + rule).([]interface{}) {
+			key := fmt.Sprintf("spec.0.%s.%d.ports", rule, i)
+			for j, p := range d.Get(key).([]interface{}) {
+				port, ok := p.(map[string]interface{})
+				if !ok || !d.NewValueKnown(fmt.Sprintf("%s.%d", key, j)) {
+					continue
+				}
+				if err := validateNetworkPolicyPortRange(port); err != nil {
+					return fmt.Errorf("%s.%d: %s", key, j, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func resourceKubernetesNetworkPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -288,7 +320,7 @@ func resourceKubernetesNetworkPolicyRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received network policy: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -3,6 +3,7 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -341,6 +342,56 @@ func TestAccKubernetesNetworkPolicy_withEgressAtCreation(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesNetworkPolicyV1_endPort(t *testing.T) {
+	var conf api.NetworkPolicy
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_network_policy_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.25.0") },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNetworkPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNetworkPolicyV1Config_endPort(name, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNetworkPolicyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ingress.0.ports.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ingress.0.ports.0.port", "32000"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ingress.0.ports.0.end_port", "32768"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ingress.0.ports.0.protocol", "TCP"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.policy_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.policy_types.0", "Ingress"),
+				),
+			},
+			{
+				Config: testAccKubernetesNetworkPolicyV1Config_endPort(name, `
+    egress {
+      ports {
+        port     = "53"
+        end_port = 54
+        protocol = "UDP"
+      }
+    }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNetworkPolicyExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.egress.0.ports.0.port", "53"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.egress.0.ports.0.end_port", "54"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.policy_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.policy_types.0", "Ingress"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.policy_types.1", "Egress"),
+				),
+			},
+			{
+				Config:      testAccKubernetesNetworkPolicyV1Config_endPortNamed(name),
+				ExpectError: regexp.MustCompile("end_port can only be set together with a numerical port"),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesNetworkPolicyDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -350,7 +401,7 @@ func testAccCheckKubernetesNetworkPolicyDestroy(s *terraform.State) error {
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "kubernetes_network_policy" {
+		if rs.Type != "kubernetes_network_policy" && rs.Type != "kubernetes_network_policy_v1" {
 			continue
 		}
 
@@ -671,3 +722,46 @@ func testAccKubernetesNetworkPolicyConfig_withEgress(name string) string {
 }
   `, name)
 }
+
+func testAccKubernetesNetworkPolicyV1Config_endPort(name, egress string) string {
+	return fmt.Sprintf(`resource "kubernetes_network_policy_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+
+  spec {
+    pod_selector {}
+
+    ingress {
+      ports {
+        port     = "32000"
+        end_port = 32768
+        protocol = "TCP"
+      }
+    }
+%s  }
+}
+`, name, egress)
+}
+
+func testAccKubernetesNetworkPolicyV1Config_endPortNamed(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_network_policy_v1" "test" {
+  metadata {
+    name      = "%s"
+    namespace = "default"
+  }
+
+  spec {
+    pod_selector {}
+
+    ingress {
+      ports {
+        port     = "http"
+        end_port = 32768
+      }
+    }
+  }
+}
+`, name)
+}
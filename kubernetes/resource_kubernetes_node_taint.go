@@ -0,0 +1,261 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+func resourceKubernetesNodeTaint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesNodeTaintCreate,
+		ReadContext:   resourceKubernetesNodeTaintRead,
+		UpdateContext: resourceKubernetesNodeTaintUpdate,
+		DeleteContext: resourceKubernetesNodeTaintDelete,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:        schema.TypeList,
+				Description: "Metadata identifying the node to taint.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the node.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateName,
+						},
+					},
+				},
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the node taints.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting taints that were created or edited outside of Terraform.",
+				Optional:    true,
+			},
+			"taint": {
+				Type:        schema.TypeList,
+				Description: "The taints to apply to the node.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:         schema.TypeString,
+							Description:  "The taint key.",
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The taint value.",
+							Optional:    true,
+						},
+						"effect": {
+							Type:        schema.TypeString,
+							Description: "The taint effect. Allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+							Required:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(api.TaintEffectNoSchedule),
+								string(api.TaintEffectPreferNoSchedule),
+								string(api.TaintEffectNoExecute),
+							}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesNodeTaintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId(d.Get("metadata.0.name").(string))
+	diags := resourceKubernetesNodeTaintUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceKubernetesNodeTaintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading node %s", name)
+	node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Node %s not found, removing taints from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	// spec.taints is an atomic list for server-side apply, so managed fields
+	// only record who owns the list as a whole. Reconcile the taints owned by
+	// this resource by matching on key and effect instead.
+	taints := []api.Taint{}
+	for _, t := range expandNodeTaints(d.Get("taint").([]interface{})) {
+		for _, nt := range node.Spec.Taints {
+			if nt.Key == t.Key && nt.Effect == t.Effect {
+				taints = append(taints, nt)
+				break
+			}
+		}
+	}
+
+	err = d.Set("metadata", []interface{}{map[string]interface{}{"name": node.Name}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("taint", flattenNodeTaints(taints))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesNodeTaintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var remove []api.Taint
+	if d.HasChange("taint") {
+		o, _ := d.GetChange("taint")
+		remove = expandNodeTaints(o.([]interface{}))
+	}
+	add := expandNodeTaints(d.Get("taint").([]interface{}))
+
+	err := applyNodeTaints(ctx, d, meta, remove, add)
+	if err != nil {
+		return applyErrorDiagnostics(err, d.Get("field_manager").(string))
+	}
+	return resourceKubernetesNodeTaintRead(ctx, d, meta)
+}
+
+func resourceKubernetesNodeTaintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	remove := expandNodeTaints(d.Get("taint").([]interface{}))
+	err := applyNodeTaints(ctx, d, meta, remove, nil)
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			d.SetId("")
+			return nil
+		}
+		return applyErrorDiagnostics(err, d.Get("field_manager").(string))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// applyNodeTaints removes the given taints from the node, adds the new ones
+// and server-side applies the resulting list, leaving taints managed by
+// others (e.g. cloud controllers) in place. The apply is made against the
+// observed version of the node and retried when the taints changed meanwhile,
+// so taints added concurrently by others are not dropped.
+func applyNodeTaints(ctx context.Context, d *schema.ResourceData, meta interface{}, remove, add []api.Taint) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+
+	name := d.Id()
+	return retry.OnError(retry.DefaultRetry, isResourceVersionConflict, func() error {
+		node, err := conn.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		taints := []api.Taint{}
+		for _, t := range node.Spec.Taints {
+			if hasNodeTaint(remove, t) || hasNodeTaint(add, t) {
+				continue
+			}
+			taints = append(taints, t)
+		}
+		taints = append(taints, add...)
+
+		patch := map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Node",
+			"metadata": map[string]interface{}{
+				"name":            name,
+				"resourceVersion": node.ResourceVersion,
+			},
+			"spec": map[string]interface{}{
+				"taints": taints,
+			},
+		}
+		data, err := json.Marshal(patch)
+		if err != nil {
+			return fmt.Errorf("Failed to marshal node taints: %s", err)
+		}
+
+		log.Printf("[INFO] Applying taints to node %q: %s", name, string(data))
+		_, err = conn.CoreV1().Nodes().Patch(ctx, name, types.ApplyPatchType, data, metav1.PatchOptions{
+			FieldManager: d.Get("field_manager").(string),
+			Force:        ptrToBool(d.Get("force").(bool)),
+		})
+		return err
+	})
+}
+
+func hasNodeTaint(taints []api.Taint, t api.Taint) bool {
+	for _, tt := range taints {
+		if tt.Key == t.Key && tt.Effect == t.Effect {
+			return true
+		}
+	}
+	return false
+}
+
+func expandNodeTaints(in []interface{}) []api.Taint {
+	taints := make([]api.Taint, 0, len(in))
+	for _, t := range in {
+		m, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		taints = append(taints, api.Taint{
+			Key:    m["key"].(string),
+			Value:  m["value"].(string),
+			Effect: api.TaintEffect(m["effect"].(string)),
+		})
+	}
+	return taints
+}
+
+func flattenNodeTaints(in []api.Taint) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, t := range in {
+		att[i] = map[string]interface{}{
+			"key":    t.Key,
+			"value":  t.Value,
+			"effect": string(t.Effect),
+		}
+	}
+	return att
+}
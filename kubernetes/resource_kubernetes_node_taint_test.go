@@ -0,0 +1,212 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+)
+
+const testAccNodeTaintKey = "tf-acc-test.terraform.io/taint"
+
+func TestAccKubernetesNodeTaint_basic(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar)
+	}
+	// The node name has to be known before the test configuration is rendered.
+	testAccPreCheck(t)
+	node, err := getFirstNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeName := node.Name
+	resourceName := "kubernetes_node_taint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesNodeTaintDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesNodeTaintConfig_basic(nodeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNodeTaintExists(resourceName, api.Taint{Key: testAccNodeTaintKey, Value: "one", Effect: api.TaintEffectPreferNoSchedule}),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", nodeName),
+					resource.TestCheckResourceAttr(resourceName, "field_manager", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "taint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.key", testAccNodeTaintKey),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.value", "one"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.effect", "PreferNoSchedule"),
+				),
+			},
+			{
+				Config: testAccKubernetesNodeTaintConfig_modified(nodeName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesNodeTaintExists(resourceName, api.Taint{Key: testAccNodeTaintKey, Value: "two", Effect: api.TaintEffectNoSchedule}),
+					resource.TestCheckResourceAttr(resourceName, "taint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.key", testAccNodeTaintKey),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.value", "two"),
+					resource.TestCheckResourceAttr(resourceName, "taint.0.effect", "NoSchedule"),
+				),
+			},
+		},
+	})
+}
+
+func TestApplyNodeTaints_conflict(t *testing.T) {
+	var mu sync.Mutex
+	node := &api.Node{}
+	node.APIVersion = "v1"
+	node.Kind = "Node"
+	node.Name = "test"
+	node.ResourceVersion = "1"
+	var patches []api.Node
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(node)
+			// Another client adds a taint once the node was read the
+			// first time.
+			if node.ResourceVersion == "1" {
+				node.ResourceVersion = "2"
+				node.Spec.Taints = append(node.Spec.Taints, api.Taint{Key: "cloud", Effect: api.TaintEffectNoSchedule})
+			}
+		case http.MethodPatch:
+			patch := api.Node{}
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			patches = append(patches, patch)
+			if patch.ResourceVersion != node.ResourceVersion {
+				status := apierrors.NewConflict(api.Resource("nodes"), node.Name, fmt.Errorf("the object has been modified")).ErrStatus
+				w.WriteHeader(http.StatusConflict)
+				json.NewEncoder(w).Encode(status)
+				return
+			}
+			node.Spec.Taints = patch.Spec.Taints
+			json.NewEncoder(w).Encode(node)
+		}
+	}))
+	defer server.Close()
+	meta := kubeClientsets{
+		config:        &restclient.Config{Host: server.URL},
+		clients:       &clientsetsCache{},
+		serverVersion: &serverVersionCache{},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceKubernetesNodeTaint().Schema, map[string]interface{}{})
+	d.SetId("test")
+	add := []api.Taint{{Key: "app", Value: "true", Effect: api.TaintEffectNoExecute}}
+	if err := applyNodeTaints(context.Background(), d, meta, nil, add); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(patches) != 2 {
+		t.Fatalf("Expected the apply to be retried once, got %d patches", len(patches))
+	}
+	if patches[0].ResourceVersion != "1" || patches[1].ResourceVersion != "2" {
+		t.Errorf("Expected the observed resource versions to be sent, got %q and %q", patches[0].ResourceVersion, patches[1].ResourceVersion)
+	}
+	if len(node.Spec.Taints) != 2 || !hasNodeTaint(node.Spec.Taints, add[0]) || node.Spec.Taints[0].Key != "cloud" {
+		t.Errorf("Expected the taint added concurrently to be kept, got %#v", node.Spec.Taints)
+	}
+}
+
+func testAccCheckKubernetesNodeTaintDestroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_node_taint" {
+			continue
+		}
+
+		node, err := conn.CoreV1().Nodes().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, t := range node.Spec.Taints {
+			if t.Key == testAccNodeTaintKey {
+				return fmt.Errorf("Taint %q still exists on node %s", t.Key, node.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesNodeTaintExists(n string, expected api.Taint) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		node, err := conn.CoreV1().Nodes().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, t := range node.Spec.Taints {
+			if t.Key == expected.Key && t.Value == expected.Value && t.Effect == expected.Effect {
+				return nil
+			}
+		}
+		return fmt.Errorf("Taint %s=%s:%s not found on node %s", expected.Key, expected.Value, expected.Effect, node.Name)
+	}
+}
+
+func testAccKubernetesNodeTaintConfig_basic(nodeName string) string {
+	return fmt.Sprintf(`resource "kubernetes_node_taint" "test" {
+  metadata {
+    name = %q
+  }
+  field_manager = "tftest"
+  taint {
+    key    = %q
+    value  = "one"
+    effect = "PreferNoSchedule"
+  }
+}
+`, nodeName, testAccNodeTaintKey)
+}
+
+func testAccKubernetesNodeTaintConfig_modified(nodeName string) string {
+	return fmt.Sprintf(`resource "kubernetes_node_taint" "test" {
+  metadata {
+    name = %q
+  }
+  field_manager = "tftest"
+  taint {
+    key    = %q
+    value  = "two"
+    effect = "NoSchedule"
+  }
+}
+`, nodeName, testAccNodeTaintKey)
+}
@@ -15,6 +15,7 @@ import (
 	k8sresource "k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
@@ -27,6 +28,11 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 		Optional:    true,
 		Default:     true,
 	}
+	fields["wait_until_resized"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Whether to wait for the claim capacity to reflect an increased `resources.requests.storage` after an in-place update. File system expansion may require the claim to be mounted by a running pod.",
+		Optional:    true,
+	}
 	return &schema.Resource{
 		CreateContext: resourceKubernetesPersistentVolumeClaimCreate,
 		ReadContext:   resourceKubernetesPersistentVolumeClaimRead,
@@ -41,6 +47,7 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
 
 		Schema: fields,
@@ -79,6 +86,10 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 					if err != nil {
 						return err
 					}
+					return nil
+				}
+				if newStorageQuantity.Cmp(oldStorageQuantity) == 1 {
+					return validatePersistentVolumeClaimExpansion(ctx, diff, meta)
 				}
 			}
 			return nil
@@ -86,6 +97,31 @@ func resourceKubernetesPersistentVolumeClaim() *schema.Resource {
 	}
 }
 
+// validatePersistentVolumeClaimExpansion fails the plan when the storage class of the claim
+// does not allow volume expansion, instead of letting the API server reject the update on apply.
+func validatePersistentVolumeClaimExpansion(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.HasChange("spec.0.storage_class_name") {
+		return nil
+	}
+	className := diff.Get("spec.0.storage_class_name").(string)
+	if className == "" {
+		return nil
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	sc, err := conn.StorageV1().StorageClasses().Get(ctx, className, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] CustomizeDiff skipping volume expansion check, failed to read storage class %q: %s", className, err)
+		return nil
+	}
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		return fmt.Errorf("spec.0.resources.0.requests.storage: can not be increased in place, storage class %q does not set `allow_volume_expansion`", className)
+	}
+	return nil
+}
+
 func resourceKubernetesPersistentVolumeClaimCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -179,7 +215,7 @@ func resourceKubernetesPersistentVolumeClaimRead(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received persistent volume claim: %#v", claim)
-	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(claim.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -227,9 +263,49 @@ func resourceKubernetesPersistentVolumeClaimUpdate(ctx context.Context, d *schem
 	}
 	log.Printf("[INFO] Submitted updated persistent volume claim: %#v", out)
 
+	if d.HasChange("spec.0.resources.0.requests.storage") && d.Get("wait_until_resized").(bool) {
+		err = waitForPersistentVolumeClaimResize(ctx, conn, out, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceKubernetesPersistentVolumeClaimRead(ctx, d, meta)
 }
 
+func waitForPersistentVolumeClaimResize(ctx context.Context, conn *kubernetes.Clientset, claim *api.PersistentVolumeClaim, timeout time.Duration) error {
+	requested := claim.Spec.Resources.Requests[api.ResourceStorage]
+	stateConf := &resource.StateChangeConf{
+		Target:  []string{"Resized"},
+		Pending: []string{"Resizing"},
+		Timeout: timeout,
+		Refresh: func() (interface{}, string, error) {
+			out, err := conn.CoreV1().PersistentVolumeClaims(claim.Namespace).Get(ctx, claim.Name, metav1.GetOptions{})
+			if err != nil {
+				log.Printf("[ERROR] Received error: %#v", err)
+				return out, "", err
+			}
+
+			capacity, ok := out.Status.Capacity[api.ResourceStorage]
+			if ok && capacity.Cmp(requested) >= 0 {
+				return out, "Resized", nil
+			}
+			log.Printf("[DEBUG] Persistent volume claim %s capacity is %s, waiting for %s", out.Name, capacity.String(), requested.String())
+			return out, "Resizing", nil
+		},
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, claim.ObjectMeta, "PersistentVolumeClaim", 3)
+		if wErr != nil {
+			return wErr
+		}
+		return fmt.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+	}
+	log.Printf("[INFO] Persistent volume claim %s resized to %s", claim.Name, requested.String())
+	return nil
+}
+
 func resourceKubernetesPersistentVolumeClaimDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -525,6 +526,60 @@ func TestAccKubernetesPersistentVolumeClaim_expansionMinikube(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPersistentVolumeClaim_expansionNotAllowed(t *testing.T) {
+	var conf api.PersistentVolumeClaim
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_expansionNotAllowed(name, "1Gi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPersistentVolumeClaimExists("kubernetes_persistent_volume_claim.test", &conf),
+					resource.TestCheckResourceAttr("kubernetes_persistent_volume_claim.test", "spec.0.resources.0.requests.storage", "1Gi"),
+				),
+			},
+			{
+				Config:      testAccKubernetesPersistentVolumeClaimConfig_expansionNotAllowed(name, "2Gi"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`does not set .allow_volume_expansion.`),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPersistentVolumeClaim_dataSourceRef(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_persistent_volume_claim.clone"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPersistentVolumeClaimDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPersistentVolumeClaimConfig_dataSourceRef(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source_ref.0.kind", "PersistentVolumeClaim"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source_ref.0.name", name),
+					// The API server mirrors the reference into spec.dataSource.
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source.0.kind", "PersistentVolumeClaim"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.data_source.0.name", name),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_until_bound"},
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPersistentVolumeClaim_regression(t *testing.T) {
 	var conf1, conf2 api.PersistentVolumeClaim
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
@@ -695,8 +750,9 @@ func testAccKubernetesPersistentVolumeClaimConfig_updateStorageMinikube(name, re
   metadata {
     name = "allow-expansion"
   }
-  reclaim_policy      = "Delete"
-  storage_provisioner = "k8s.io/minikube-hostpath"
+  allow_volume_expansion = true
+  reclaim_policy         = "Delete"
+  storage_provisioner    = "k8s.io/minikube-hostpath"
 }
 resource "kubernetes_persistent_volume" "test" {
   metadata {
@@ -739,6 +795,69 @@ resource "kubernetes_persistent_volume_claim" "test" {
 `, name, requests, limits)
 }
 
+func testAccKubernetesPersistentVolumeClaimConfig_expansionNotAllowed(name, requests string) string {
+	return fmt.Sprintf(`resource "kubernetes_storage_class" "test" {
+  metadata {
+    name = %[1]q
+  }
+  allow_volume_expansion = false
+  storage_provisioner    = "kubernetes.io/no-provisioner"
+  volume_binding_mode    = "WaitForFirstConsumer"
+}
+resource "kubernetes_persistent_volume_claim" "test" {
+  wait_until_bound = false
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = kubernetes_storage_class.test.metadata.0.name
+    resources {
+      requests = {
+        storage = %[2]q
+      }
+    }
+  }
+}
+`, name, requests)
+}
+
+func testAccKubernetesPersistentVolumeClaimConfig_dataSourceRef(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_persistent_volume_claim" "source" {
+  wait_until_bound = false
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+  }
+}
+resource "kubernetes_persistent_volume_claim" "clone" {
+  wait_until_bound = false
+  metadata {
+    name = "%[1]s-clone"
+  }
+  spec {
+    access_modes = ["ReadWriteOnce"]
+    resources {
+      requests = {
+        storage = "1Gi"
+      }
+    }
+    data_source_ref {
+      kind = "PersistentVolumeClaim"
+      name = kubernetes_persistent_volume_claim.source.metadata.0.name
+    }
+  }
+}
+`, name)
+}
+
 func testAccKubernetesPersistentVolumeClaimConfig_updateStorageGKE(name, requests, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_storage_class" "test" {
   metadata {
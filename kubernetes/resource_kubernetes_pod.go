@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesPod() *schema.Resource {
@@ -22,6 +24,7 @@ func resourceKubernetesPod() *schema.Resource {
 		ReadContext:   resourceKubernetesPodRead,
 		UpdateContext: resourceKubernetesPodUpdate,
 		DeleteContext: resourceKubernetesPodDelete,
+		CustomizeDiff: resourceKubernetesPodCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -35,6 +38,7 @@ func resourceKubernetesPod() *schema.Resource {
 		SchemaVersion: 1,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(5 * time.Minute),
 		},
 		Schema: resourceKubernetesPodSchemaV1(),
@@ -42,6 +46,15 @@ func resourceKubernetesPod() *schema.Resource {
 }
 
 func resourceKubernetesPodSchemaV1() map[string]*schema.Schema {
+	spec := podSpecFields(false, false)
+	// Container resources can be resized in place on clusters which support
+	// it, resourceKubernetesPodCustomizeDiff forces a new pod otherwise.
+	spec["container"].Elem.(*schema.Resource).Schema["resources"].ForceNew = false
+	// Scheduling gates are removed from a pending pod to release it to the
+	// scheduler.
+	spec["scheduling_gate"].ForceNew = false
+	spec["scheduling_gate"].Elem.(*schema.Resource).Schema["name"].ForceNew = false
+
 	return map[string]*schema.Schema{
 		"metadata": namespacedMetadataSchema("pod", true),
 		"spec": {
@@ -50,12 +63,99 @@ func resourceKubernetesPodSchemaV1() map[string]*schema.Schema {
 			Required:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
-				Schema: podSpecFields(false, false),
+				Schema: spec,
 			},
 		},
 	}
 }
 
+// resourceKubernetesPodCustomizeDiff replaces the pod when container resources
+// change and the cluster cannot resize them in place. Support for the
+// InPlacePodVerticalScaling feature is detected with a dry-run of the resize.
+func resourceKubernetesPodCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := podSpecCustomizeDiff("spec")(ctx, d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	var changed []string
+	for i := range d.Get("spec.0.container").([]interface{}) {
+		key := fmt.Sprintf("spec.0.container.%d.resources", i)
+		if d.HasChange(key) {
+			changed = append(changed, key)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	ops, err := patchPodContainerResources("spec.0.", d.Get)
+	if err == nil && len(ops) > 0 {
+		err = resizePod(ctx, d.Id(), ops, meta, true)
+	}
+	if _, ok := err.(*errIncompleteConfiguration); ok {
+		log.Printf("[WARN] Skipping in-place resize check of pod %s: %s", d.Id(), err)
+		return nil
+	}
+	if err != nil {
+		log.Printf("[INFO] Pod %s cannot be resized in place, it will be replaced: %s", d.Id(), err)
+		for _, key := range changed {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// patchPodContainerResources returns the operations replacing the resources
+// of every container of the pod.
+func patchPodContainerResources(prefix string, get func(string) interface{}) (PatchOperations, error) {
+	ctrs, err := expandContainers(get(prefix + "container").([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	ops := make(PatchOperations, 0, len(ctrs))
+	for i, c := range ctrs {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/containers/" + strconv.Itoa(i) + "/resources",
+			Value: c.Resources,
+		})
+	}
+	return ops, nil
+}
+
+// resizePod patches the container resources of a pod. Recent clusters only
+// allow resizing through the resize subresource while older releases with
+// the InPlacePodVerticalScaling feature accept patching the pod itself.
+func resizePod(ctx context.Context, id string, ops PatchOperations, meta interface{}, dryRun bool) error {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	namespace, name, err := idParts(id)
+	if err != nil {
+		return err
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return fmt.Errorf("Failed to marshal resize operations: %s", err)
+	}
+
+	opts := metav1.PatchOptions{}
+	if dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	log.Printf("[INFO] Resizing pod %s: %s", id, ops)
+	_, err = conn.CoreV1().Pods(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, opts, "resize")
+	if errors.IsNotFound(err) || errors.IsMethodNotSupported(err) {
+		_, err = conn.CoreV1().Pods(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, opts)
+	}
+	return err
+}
+
 func resourceKubernetesPodCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -83,12 +183,24 @@ func resourceKubernetesPodCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(buildId(out.ObjectMeta))
 
+	// A pod with scheduling gates stays pending until they are removed.
+	if len(spec.SchedulingGates) == 0 {
+		if diags := waitForPodRunning(ctx, conn, out.ObjectMeta, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+	log.Printf("[INFO] Pod %s created", out.Name)
+
+	return resourceKubernetesPodRead(ctx, d, meta)
+}
+
+func waitForPodRunning(ctx context.Context, conn *kubernetes.Clientset, meta metav1.ObjectMeta, timeout time.Duration) diag.Diagnostics {
 	stateConf := &resource.StateChangeConf{
 		Target:  []string{"Running"},
 		Pending: []string{"Pending"},
-		Timeout: d.Timeout(schema.TimeoutCreate),
+		Timeout: timeout,
 		Refresh: func() (interface{}, string, error) {
-			out, err := conn.CoreV1().Pods(metadata.Namespace).Get(ctx, metadata.Name, metav1.GetOptions{})
+			out, err := conn.CoreV1().Pods(meta.Namespace).Get(ctx, meta.Name, metav1.GetOptions{})
 			if err != nil {
 				log.Printf("[ERROR] Received error: %#v", err)
 				return out, "Error", err
@@ -99,17 +211,15 @@ func resourceKubernetesPodCreate(ctx context.Context, d *schema.ResourceData, me
 			return out, statusPhase, nil
 		},
 	}
-	_, err = stateConf.WaitForStateContext(ctx)
+	_, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Pod", 3)
+		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, meta, "Pod", 3)
 		if wErr != nil {
 			return diag.FromErr(wErr)
 		}
 		return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
 	}
-	log.Printf("[INFO] Pod %s created", out.Name)
-
-	return resourceKubernetesPodRead(ctx, d, meta)
+	return nil
 }
 
 func resourceKubernetesPodUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -123,6 +233,23 @@ func resourceKubernetesPodUpdate(ctx context.Context, d *schema.ResourceData, me
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("spec.0.container") {
+		resized := false
+		for i := range d.Get("spec.0.container").([]interface{}) {
+			resized = resized || d.HasChange(fmt.Sprintf("spec.0.container.%d.resources", i))
+		}
+		if resized {
+			ops, err := patchPodContainerResources("spec.0.", d.Get)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			err = resizePod(ctx, d.Id(), ops, meta, false)
+			if err != nil {
+				return diag.Errorf("Failed to resize pod %s: %s", d.Id(), err)
+			}
+		}
+	}
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 	if d.HasChange("spec") {
 		specOps, err := patchPodSpec("/spec", "spec.0.", d)
@@ -145,6 +272,12 @@ func resourceKubernetesPodUpdate(ctx context.Context, d *schema.ResourceData, me
 	log.Printf("[INFO] Submitted updated pod: %#v", out)
 
 	d.SetId(buildId(out.ObjectMeta))
+
+	if o, n := d.GetChange("spec.0.scheduling_gate"); len(o.([]interface{})) > 0 && len(n.([]interface{})) == 0 {
+		if diags := waitForPodRunning(ctx, conn, out.ObjectMeta, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
+		}
+	}
 	return resourceKubernetesPodRead(ctx, d, meta)
 }
 
@@ -175,7 +308,7 @@ func resourceKubernetesPodRead(ctx context.Context, d *schema.ResourceData, meta
 	}
 	log.Printf("[INFO] Received pod: %#v", pod)
 
-	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(pod.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -185,11 +318,12 @@ func resourceKubernetesPodRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", podSpec, "0")
 	err = d.Set("spec", podSpec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return diags
 
 }
 
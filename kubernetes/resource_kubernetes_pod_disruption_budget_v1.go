@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	policy "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -15,13 +16,14 @@ import (
 
 // Use generated swagger docs from kubernetes' client-go to avoid copy/pasting them here
 var (
-	podDisruptionBudgetV1SpecDoc               = policy.PodDisruptionBudget{}.SwaggerDoc()["spec"]
-	podDisruptionBudgetV1SpecMaxUnavailableDoc = policy.PodDisruptionBudget{}.SwaggerDoc()["maxUnavailable"]
-	podDisruptionBudgetV1SpecMinAvailableDoc   = policy.PodDisruptionBudget{}.SwaggerDoc()["minAvailable"]
-	podDisruptionBudgetV1SpecSelectorDoc       = policy.PodDisruptionBudget{}.SwaggerDoc()["selector"]
+	podDisruptionBudgetV1SpecDoc   = policy.PodDisruptionBudget{}.SwaggerDoc()["spec"]
+	podDisruptionBudgetV1StatusDoc = policy.PodDisruptionBudget{}.SwaggerDoc()["status"]
 )
 
 func resourceKubernetesPodDisruptionBudgetV1() *schema.Resource {
+	specDoc := policy.PodDisruptionBudgetSpec{}.SwaggerDoc()
+	statusDoc := policy.PodDisruptionBudgetStatus{}.SwaggerDoc()
+
 	return &schema.Resource{
 		CreateContext: resourceKubernetesPodDisruptionBudgetV1Create,
 		ReadContext:   resourceKubernetesPodDisruptionBudgetV1Read,
@@ -33,41 +35,72 @@ func resourceKubernetesPodDisruptionBudgetV1() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"metadata": namespacedMetadataSchema("pod disruption budget", true),
-			// Updates to spec not allowed until Kubernetes dependencies are updated to
-			// 1.13; have to delete and recreate until then
-			// https://github.com/kubernetes/kubernetes/issues/45398
 			"spec": {
 				Type:        schema.TypeList,
 				Description: podDisruptionBudgetV1SpecDoc,
 				Required:    true,
-				ForceNew:    true,
 				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"max_unavailable": {
 							Type:         schema.TypeString,
-							Description:  podDisruptionBudgetV1SpecMaxUnavailableDoc,
+							Description:  specDoc["maxUnavailable"],
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validateTypeStringNullableIntOrPercent,
 						},
 						"min_available": {
 							Type:         schema.TypeString,
-							Description:  podDisruptionBudgetV1SpecMinAvailableDoc,
+							Description:  specDoc["minAvailable"],
 							Optional:     true,
-							ForceNew:     true,
 							ValidateFunc: validateTypeStringNullableIntOrPercent,
 						},
 						"selector": {
 							Type:        schema.TypeList,
-							Description: podDisruptionBudgetV1SpecSelectorDoc,
+							Description: specDoc["selector"],
 							Required:    true,
-							ForceNew:    true,
 							MaxItems:    1,
 							Elem: &schema.Resource{
-								Schema: labelSelectorFields(false),
+								Schema: labelSelectorFields(true),
 							},
 						},
+						"unhealthy_pod_eviction_policy": {
+							Type:        schema.TypeString,
+							Description: specDoc["unhealthyPodEvictionPolicy"],
+							Optional:    true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(policy.IfHealthyBudget),
+								string(policy.AlwaysAllow),
+							}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: podDisruptionBudgetV1StatusDoc,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"current_healthy": {
+							Type:        schema.TypeInt,
+							Description: statusDoc["currentHealthy"],
+							Computed:    true,
+						},
+						"desired_healthy": {
+							Type:        schema.TypeInt,
+							Description: statusDoc["desiredHealthy"],
+							Computed:    true,
+						},
+						"disruptions_allowed": {
+							Type:        schema.TypeInt,
+							Description: statusDoc["disruptionsAllowed"],
+							Computed:    true,
+						},
+						"expected_pods": {
+							Type:        schema.TypeInt,
+							Description: statusDoc["expectedPods"],
+							Computed:    true,
+						},
 					},
 				},
 			},
@@ -87,6 +120,13 @@ func resourceKubernetesPodDisruptionBudgetV1Update(ctx context.Context, d *schem
 	}
 
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		specOps, err := patchPodDisruptionBudgetV1Spec("spec.0.", "/spec", d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ops = append(ops, *specOps...)
+	}
 	data, err := ops.MarshalJSON()
 	if err != nil {
 		return diag.Errorf("Failed to marshal update operations: %s", err)
@@ -159,7 +199,7 @@ func resourceKubernetesPodDisruptionBudgetV1Read(ctx context.Context, d *schema.
 	}
 
 	log.Printf("[INFO] Received pod disruption budget: %#v", pdb)
-	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(pdb.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -169,6 +209,11 @@ func resourceKubernetesPodDisruptionBudgetV1Read(ctx context.Context, d *schema.
 		return diag.FromErr(err)
 	}
 
+	err = d.Set("status", flattenPodDisruptionBudgetV1Status(pdb.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -87,6 +87,63 @@ func TestAccKubernetesPodDisruptionBudgetV1_basic(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPodDisruptionBudgetV1_updateInPlace(t *testing.T) {
+	var conf1, conf2 policy.PodDisruptionBudget
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_pod_disruption_budget_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.27.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDisruptionBudgetV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodDisruptionBudgetV1Config_evictionPolicy(name, "max_unavailable", "25%", "foo", "IfHealthyBudget"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodDisruptionBudgetV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.max_unavailable", "25%"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.selector.0.match_labels.app", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.unhealthy_pod_eviction_policy", "IfHealthyBudget"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "status.0.desired_healthy"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesPodDisruptionBudgetV1Config_evictionPolicy(name, "min_available", "2", "bar", "AlwaysAllow"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodDisruptionBudgetV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.max_unavailable", ""),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.min_available", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.selector.0.match_labels.app", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.unhealthy_pod_eviction_policy", "AlwaysAllow"),
+					testAccCheckKubernetesPodDisruptionBudgetV1ForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodDisruptionBudgetV1ForceNew(old, new *policy.PodDisruptionBudget, wantNew bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if wantNew && old.ObjectMeta.UID == new.ObjectMeta.UID {
+			return fmt.Errorf("Expecting forced replacement")
+		}
+		if !wantNew && old.ObjectMeta.UID != new.ObjectMeta.UID {
+			return fmt.Errorf("Unexpected forced replacement")
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesPodDisruptionBudgetV1Destroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -202,3 +259,22 @@ func testAccKubernetesPodDisruptionBudgetV1Config_minAvailable(name string) stri
 }
 `, name)
 }
+
+func testAccKubernetesPodDisruptionBudgetV1Config_evictionPolicy(name, budgetAttr, budget, app, evictionPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_disruption_budget_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    %s = "%s"
+    selector {
+      match_labels = {
+        app = %q
+      }
+    }
+    unhealthy_pod_eviction_policy = %q
+  }
+}
+`, name, budgetAttr, budget, app, evictionPolicy)
+}
@@ -0,0 +1,259 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func resourceKubernetesPodEphemeralContainers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesPodEphemeralContainersCreate,
+		ReadContext:   resourceKubernetesPodEphemeralContainersRead,
+		UpdateContext: resourceKubernetesPodEphemeralContainersUpdate,
+		DeleteContext: resourceKubernetesPodEphemeralContainersDelete,
+		CustomizeDiff: resourceKubernetesPodEphemeralContainersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:        schema.TypeList,
+				Description: "Metadata identifying the pod to add ephemeral containers to.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the pod.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateName,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the pod.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "default",
+						},
+					},
+				},
+			},
+			"ephemeral_container": {
+				Type:        schema.TypeList,
+				Description: "Ephemeral containers to run in the pod. Ephemeral containers can be added to a running pod but cannot be changed or removed once added.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: ephemeralContainerFields(),
+				},
+			},
+		},
+	}
+}
+
+// ephemeralContainerFields is the container schema without the fields which
+// are not allowed for ephemeral containers.
+func ephemeralContainerFields() map[string]*schema.Schema {
+	s := containerFields(true)
+	for _, k := range []string{"lifecycle", "liveness_probe", "port", "readiness_probe", "resize_policy", "resources", "startup_probe"} {
+		delete(s, k)
+	}
+	s["target_container_name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the container from the pod spec whose namespaces (IPC, PID, etc.) the ephemeral container targets. If not set, the ephemeral container uses the namespaces configured in the pod spec.",
+		Optional:    true,
+	}
+	return s
+}
+
+func resourceKubernetesPodEphemeralContainersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A different pod gets a fresh set of ephemeral containers.
+	if d.Id() == "" || d.HasChange("metadata") {
+		return nil
+	}
+	o, n := d.GetChange("ephemeral_container")
+	old := o.([]interface{})
+	if len(n.([]interface{})) < len(old) {
+		return fmt.Errorf("ephemeral containers cannot be removed from a pod once added")
+	}
+	for i := range old {
+		if d.HasChange(fmt.Sprintf("ephemeral_container.%d", i)) {
+			return fmt.Errorf("ephemeral containers cannot be changed once added, append a new ephemeral_container block instead")
+		}
+	}
+	return nil
+}
+
+func resourceKubernetesPodEphemeralContainersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	d.SetId(buildId(metadata))
+	diags := resourceKubernetesPodEphemeralContainersUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceKubernetesPodEphemeralContainersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading pod %s", name)
+	pod, err := conn.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Pod %s not found, removing ephemeral containers from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	// Only reflect the ephemeral containers added by this resource, other
+	// tools (e.g. kubectl debug) may have added their own to the same pod.
+	configured, err := expandEphemeralContainers(d.Get("ephemeral_container").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ctrs := []v1.EphemeralContainer{}
+	for _, c := range configured {
+		for _, ec := range pod.Spec.EphemeralContainers {
+			if ec.Name == c.Name {
+				ctrs = append(ctrs, ec)
+				break
+			}
+		}
+	}
+
+	err = d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":      pod.Name,
+		"namespace": pod.Namespace,
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	flattened, err := flattenEphemeralContainers(ctrs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("ephemeral_container", flattened)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesPodEphemeralContainersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pod, err := conn.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return diag.Errorf("The pod %q does not exist in namespace %q", name, namespace)
+		}
+		return diag.FromErr(err)
+	}
+
+	existing := make(map[string]bool, len(pod.Spec.EphemeralContainers))
+	for _, ec := range pod.Spec.EphemeralContainers {
+		existing[ec.Name] = true
+	}
+	ctrs, err := expandEphemeralContainers(d.Get("ephemeral_container").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	added := false
+	for _, c := range ctrs {
+		if existing[c.Name] {
+			if d.IsNewResource() {
+				return diag.Errorf("The pod %q already has an ephemeral container named %q", name, c.Name)
+			}
+			continue
+		}
+		pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, c)
+		added = true
+	}
+
+	if added {
+		log.Printf("[INFO] Adding ephemeral containers to pod %s", name)
+		_, err = conn.CoreV1().Pods(namespace).UpdateEphemeralContainers(ctx, name, pod, metav1.UpdateOptions{})
+		if err != nil {
+			return diag.Errorf("Failed to add ephemeral containers to pod %q: %s", name, err)
+		}
+	}
+	return resourceKubernetesPodEphemeralContainersRead(ctx, d, meta)
+}
+
+func resourceKubernetesPodEphemeralContainersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Ephemeral containers were not removed",
+			Detail:   "Kubernetes does not support removing ephemeral containers from a pod. The ephemeral containers were removed from the state but remain part of the pod until it is deleted.",
+		},
+	}
+}
+
+func expandEphemeralContainers(in []interface{}) ([]v1.EphemeralContainer, error) {
+	ctrs, err := expandContainers(in)
+	if err != nil {
+		return nil, err
+	}
+	ecs := make([]v1.EphemeralContainer, len(ctrs))
+	for i, c := range ctrs {
+		ecs[i] = v1.EphemeralContainer{
+			EphemeralContainerCommon: v1.EphemeralContainerCommon(c),
+		}
+		if m, ok := in[i].(map[string]interface{}); ok {
+			if v, ok := m["target_container_name"].(string); ok {
+				ecs[i].TargetContainerName = v
+			}
+		}
+	}
+	return ecs, nil
+}
+
+func flattenEphemeralContainers(in []v1.EphemeralContainer) ([]interface{}, error) {
+	ctrs := make([]v1.Container, len(in))
+	for i, ec := range in {
+		ctrs[i] = v1.Container(ec.EphemeralContainerCommon)
+	}
+	// Ephemeral containers do not get the service account token mounted,
+	// so there is no default volume mount to hide.
+	att, err := flattenContainers(ctrs, "^$")
+	if err != nil {
+		return nil, err
+	}
+	for i, c := range att {
+		m := c.(map[string]interface{})
+		delete(m, "resources")
+		if in[i].TargetContainerName != "" {
+			m["target_container_name"] = in[i].TargetContainerName
+		}
+	}
+	return att, nil
+}
@@ -0,0 +1,124 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPodEphemeralContainers_basic(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod_ephemeral_containers.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.25.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodEphemeralContainersConfig_basic(name, busyboxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodEphemeralContainersOnServer(name, []string{"debugger"}),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "ephemeral_container.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "ephemeral_container.0.name", "debugger"),
+					resource.TestCheckResourceAttr(resourceName, "ephemeral_container.0.target_container_name", "main"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodEphemeralContainersConfig_appended(name, busyboxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodEphemeralContainersOnServer(name, []string{"debugger", "debugger-2"}),
+					resource.TestCheckResourceAttr(resourceName, "ephemeral_container.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "ephemeral_container.1.name", "debugger-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesPodEphemeralContainersOnServer(name string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		pod, err := conn.CoreV1().Pods("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if len(pod.Spec.EphemeralContainers) != len(expected) {
+			return fmt.Errorf("Expected %d ephemeral containers, got %d", len(expected), len(pod.Spec.EphemeralContainers))
+		}
+		for i, n := range expected {
+			if pod.Spec.EphemeralContainers[i].Name != n {
+				return fmt.Errorf("Expected ephemeral container %d to be %q, got %q", i, n, pod.Spec.EphemeralContainers[i].Name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesPodEphemeralContainersConfig_pod(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    container {
+      image   = %q
+      name    = "main"
+      command = ["sleep", "3600"]
+    }
+  }
+}
+`, name, imageName)
+}
+
+func testAccKubernetesPodEphemeralContainersConfig_basic(name, imageName string) string {
+	return testAccKubernetesPodEphemeralContainersConfig_pod(name, imageName) + fmt.Sprintf(`
+resource "kubernetes_pod_ephemeral_containers" "test" {
+  metadata {
+    name = kubernetes_pod_v1.test.metadata.0.name
+  }
+  ephemeral_container {
+    name                  = "debugger"
+    image                 = %q
+    command               = ["sleep", "3600"]
+    target_container_name = "main"
+  }
+}
+`, imageName)
+}
+
+func testAccKubernetesPodEphemeralContainersConfig_appended(name, imageName string) string {
+	return testAccKubernetesPodEphemeralContainersConfig_pod(name, imageName) + fmt.Sprintf(`
+resource "kubernetes_pod_ephemeral_containers" "test" {
+  metadata {
+    name = kubernetes_pod_v1.test.metadata.0.name
+  }
+  ephemeral_container {
+    name                  = "debugger"
+    image                 = %q
+    command               = ["sleep", "3600"]
+    target_container_name = "main"
+  }
+  ephemeral_container {
+    name    = "debugger-2"
+    image   = %q
+    command = ["sleep", "3600"]
+  }
+}
+`, imageName, imageName)
+}
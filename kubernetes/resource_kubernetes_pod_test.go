@@ -483,6 +483,80 @@ func TestAccKubernetesPod_with_container_liveness_probe_using_tcp(t *testing.T)
 	})
 }
 
+func TestAccKubernetesPod_with_container_liveness_probe_using_grpc(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := "registry.k8s.io/etcd:3.5.1-0"
+	resourceName := "kubernetes_pod.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigWithLivenessProbeUsingGRPC(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.liveness_probe.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.liveness_probe.0.grpc.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.liveness_probe.0.grpc.0.port", "2379"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.startup_probe.0.grpc.0.port", "2379"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.startup_probe.0.failure_threshold", "30"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_resizeResourcesInPlace(t *testing.T) {
+	var conf1, conf2 api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.33.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigResizePolicy(podName, busyboxImageVersion, "100m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.0.resource_name", "cpu"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.0.restart_policy", "NotRequired"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.1.resource_name", "memory"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resize_policy.1.restart_policy", "RestartContainer"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resources.0.requests.cpu", "100m"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodConfigResizePolicy(podName, busyboxImageVersion, "200m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.container.0.resources.0.requests.cpu", "200m"),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_with_container_lifecycle(t *testing.T) {
 	var conf api.Pod
 
@@ -724,6 +798,38 @@ func TestAccKubernetesPod_with_projected_volume(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_with_projected_service_account_token(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodProjectedServiceAccountToken(podName, busyboxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume.0.projected.0.sources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume.0.projected.0.sources.0.service_account_token.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume.0.projected.0.sources.0.service_account_token.0.audience", "tf-acc-test.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume.0.projected.0.sources.0.service_account_token.0.expiration_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.volume.0.projected.0.sources.0.service_account_token.0.path", "token"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_with_resource_requirements(t *testing.T) {
 	var conf api.Pod
 
@@ -1233,6 +1339,152 @@ func TestAccKubernetesPod_readinessGate(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_schedulingGates(t *testing.T) {
+	var conf1, conf2 api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := nginxImageVersion
+	resourceName := "kubernetes_pod.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.27.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigSchedulingGates(podName, imageName, `
+    scheduling_gate {
+      name = "example.com/quota"
+    }
+    scheduling_gate {
+      name = "example.com/approval"
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.os.0.name", "linux"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.set_hostname_as_fqdn", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.host_users", "true"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.0.name", "example.com/quota"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.1.name", "example.com/approval"),
+					testAccCheckKubernetesPodPhase(&conf1, api.PodPending),
+				),
+			},
+			{
+				Config: testAccKubernetesPodConfigSchedulingGates(podName, imageName, `
+    scheduling_gate {
+      name = "example.com/approval"
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf2),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.0.name", "example.com/approval"),
+				),
+			},
+			{
+				Config: testAccKubernetesPodConfigSchedulingGates(podName, imageName, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf2),
+					testAccCheckKubernetesPodForceNew(&conf1, &conf2, false),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scheduling_gate.#", "0"),
+					testAccCheckKubernetesPodPhase(&conf2, api.PodRunning),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_tolerationsExistsAndZeroSeconds(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := nginxImageVersion
+	resourceName := "kubernetes_pod.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodConfigTolerationsExistsAndZeroSeconds(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.toleration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.toleration.0.key", ""),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.toleration.0.operator", "Exists"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.toleration.0.toleration_seconds", ""),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.toleration.1.key", "node.kubernetes.io/unreachable"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.toleration.1.toleration_seconds", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPod_dnsConfigHostNetwork(t *testing.T) {
+	var conf api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	imageName := nginxImageVersion
+	resourceName := "kubernetes_pod.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPodConfigDNSConfig(podName, imageName, "", `name = "use-vc"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("at least one nameserver is required"),
+			},
+			{
+				Config: testAccKubernetesPodConfigDNSConfig(podName, imageName, `["1.1.1.1"]`, `name  = "ndots"
+        value = "two"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("the value of the ndots option must be a non-negative integer"),
+			},
+			{
+				Config: testAccKubernetesPodConfigDNSConfig(podName, imageName, `["1.1.1.1"]`, `name = "use-vc"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.dns_config.0.option.0.name", "use-vc"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.dns_config.0.option.0.value", ""),
+					func(s *terraform.State) error {
+						if v := conf.Spec.DNSConfig.Options[0].Value; v != nil {
+							return fmt.Errorf("Expected no value for the use-vc option, got %q", *v)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccKubernetesPod_topologySpreadConstraint(t *testing.T) {
 	var conf1 api.Pod
 
@@ -1265,6 +1517,42 @@ func TestAccKubernetesPod_topologySpreadConstraint(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPod_topologySpreadConstraintNodeInclusionPolicy(t *testing.T) {
+	var conf1 api.Pod
+
+	podName := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_pod.test"
+	imageName := "nginx:1.7.9"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.26.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPodTopologySpreadConstraintConfigNodeInclusionPolicy(podName, imageName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPodExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.topology_spread_constraint.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.topology_spread_constraint.0.when_unsatisfiable", "DoNotSchedule"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.topology_spread_constraint.0.min_domains", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.topology_spread_constraint.0.node_affinity_policy", "Honor"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.topology_spread_constraint.0.node_taints_policy", "Ignore"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesPodDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -1360,6 +1648,15 @@ func testAccCheckKubernetesPodExists(n string, obj *api.Pod) resource.TestCheckF
 	}
 }
 
+func testAccCheckKubernetesPodPhase(pod *api.Pod, phase api.PodPhase) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if pod.Status.Phase != phase {
+			return fmt.Errorf("Expected pod %s to be %s, got %s", pod.Name, phase, pod.Status.Phase)
+		}
+		return nil
+	}
+}
+
 func testAccCheckKubernetesPodForceNew(old, new *api.Pod, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
@@ -1754,6 +2051,77 @@ func testAccKubernetesPodConfigWithLivenessProbeUsingTCP(podName, imageName stri
 `, podName, imageName)
 }
 
+func testAccKubernetesPodConfigWithLivenessProbeUsingGRPC(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image   = "%s"
+      name    = "etcd"
+      command = ["/usr/local/bin/etcd", "--data-dir", "/var/lib/etcd", "--listen-client-urls", "http://0.0.0.0:2379", "--advertise-client-urls", "http://127.0.0.1:2379", "--log-level", "debug"]
+
+      liveness_probe {
+        grpc {
+          port = 2379
+        }
+
+        initial_delay_seconds = 10
+      }
+
+      startup_probe {
+        grpc {
+          port = 2379
+        }
+
+        failure_threshold = 30
+      }
+    }
+  }
+}
+`, podName, imageName)
+}
+
+func testAccKubernetesPodConfigResizePolicy(podName, imageName, cpu string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+
+      resize_policy {
+        resource_name  = "cpu"
+        restart_policy = "NotRequired"
+      }
+
+      resize_policy {
+        resource_name  = "memory"
+        restart_policy = "RestartContainer"
+      }
+
+      resources {
+        requests = {
+          cpu    = "%s"
+          memory = "64Mi"
+        }
+        limits = {
+          cpu    = "500m"
+          memory = "64Mi"
+        }
+      }
+    }
+  }
+}
+`, podName, imageName, cpu)
+}
+
 func testAccKubernetesPodConfigWithLifeCycle(podName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
   metadata {
@@ -2071,6 +2439,41 @@ resource "kubernetes_pod" "test" {
     }`, podName, imageName, secretName, volumeName)
 }
 
+func testAccKubernetesPodProjectedServiceAccountToken(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    container {
+      image   = "%s"
+      name    = "containername"
+      command = ["sleep", "3600"]
+
+      volume_mount {
+        name       = "token"
+        mount_path = "/var/run/secrets/tokens"
+      }
+    }
+
+    volume {
+      name = "token"
+      projected {
+        sources {
+          service_account_token {
+            audience           = "tf-acc-test.example.com"
+            expiration_seconds = 7200
+            path               = "token"
+          }
+        }
+      }
+    }
+  }
+}
+`, podName, imageName)
+}
+
 func testAccKubernetesPodProjectedVolume(cfgMapName, cfgMap2Name, secretName, podName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_config_map" "test" {
     metadata {
@@ -2503,6 +2906,82 @@ func testAccKubernetesPodConfigEnableServiceLinks(podName, imageName string) str
 `, podName, imageName)
 }
 
+func testAccKubernetesPodConfigTolerationsExistsAndZeroSeconds(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    toleration {
+      operator = "Exists"
+    }
+    toleration {
+      key                = "node.kubernetes.io/unreachable"
+      operator           = "Exists"
+      effect             = "NoExecute"
+      toleration_seconds = 0
+    }
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, podName, imageName)
+}
+
+func testAccKubernetesPodConfigDNSConfig(podName, imageName, nameservers, option string) string {
+	nameserversAttr := ""
+	if nameservers != "" {
+		nameserversAttr = "nameservers = " + nameservers
+	}
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    host_network = true
+    dns_policy   = "None"
+
+    dns_config {
+      %s
+      option {
+        %s
+      }
+    }
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, podName, nameserversAttr, option, imageName)
+}
+
+func testAccKubernetesPodConfigSchedulingGates(podName, imageName, gates string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    os {
+      name = "linux"
+    }
+    set_hostname_as_fqdn = true
+    subdomain            = "example"
+%s
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+  }
+}
+`, podName, gates, imageName)
+}
+
 func testAccKubernetesPodConfigReadinessGate(secretName, configMapName, podName, imageName string) string {
 	return fmt.Sprintf(`resource "kubernetes_secret" "test" {
   metadata {
@@ -2818,3 +3297,31 @@ func testAccKubernetesPodTopologySpreadConstraintConfig(podName, imageName strin
 }
 `, podName, imageName)
 }
+
+func testAccKubernetesPodTopologySpreadConstraintConfigNodeInclusionPolicy(podName, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_pod" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    container {
+      image = "%s"
+      name  = "containername"
+    }
+    topology_spread_constraint {
+      max_skew             = 1
+      topology_key         = "kubernetes.io/hostname"
+      when_unsatisfiable   = "DoNotSchedule"
+      min_domains          = 1
+      node_affinity_policy = "Honor"
+      node_taints_policy   = "Ignore"
+      label_selector {
+        match_labels = {
+          "app.kubernetes.io/instance" = "terraform-example"
+        }
+      }
+    }
+  }
+}
+`, podName, imageName)
+}
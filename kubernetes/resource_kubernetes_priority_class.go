@@ -7,6 +7,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	corev1 "k8s.io/api/core/v1"
 	api "k8s.io/api/scheduling/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -43,6 +45,17 @@ func resourceKubernetesPriorityClass() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"preemption_policy": {
+				Type:        schema.TypeString,
+				Description: "The policy for preempting pods with lower priority, either `PreemptLowerPriority` or `Never`. Defaults to `PreemptLowerPriority`.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(corev1.PreemptLowerPriority),
+					string(corev1.PreemptNever),
+				}, false),
+			},
 		},
 	}
 }
@@ -64,6 +77,10 @@ func resourceKubernetesPriorityClassCreate(ctx context.Context, d *schema.Resour
 		GlobalDefault: globalDefault,
 		Value:         int32(value),
 	}
+	if v, ok := d.GetOk("preemption_policy"); ok {
+		policy := corev1.PreemptionPolicy(v.(string))
+		priorityClass.PreemptionPolicy = &policy
+	}
 
 	log.Printf("[INFO] Creating new priority class: %#v", priorityClass)
 	out, err := conn.SchedulingV1().PriorityClasses().Create(ctx, &priorityClass, metav1.CreateOptions{})
@@ -100,7 +117,7 @@ func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.Resource
 	}
 	log.Printf("[INFO] Received priority class: %#v", priorityClass)
 
-	err = d.Set("metadata", flattenMetadata(priorityClass.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(priorityClass.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -120,6 +137,13 @@ func resourceKubernetesPriorityClassRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
+	if priorityClass.PreemptionPolicy != nil {
+		err = d.Set("preemption_policy", string(*priorityClass.PreemptionPolicy))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -40,6 +40,7 @@ func TestAccKubernetesPriorityClass_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet("kubernetes_priority_class.test", "metadata.0.resource_version"),
 					resource.TestCheckResourceAttrSet("kubernetes_priority_class.test", "metadata.0.uid"),
 					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "value", "100"),
+					resource.TestCheckResourceAttr("kubernetes_priority_class.test", "preemption_policy", "PreemptLowerPriority"),
 				),
 			},
 			{
@@ -118,6 +119,61 @@ func TestAccKubernetesPriorityClass_generatedName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesPriorityClassV1_preemptionPolicy(t *testing.T) {
+	var conf1, conf2 api.PriorityClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_priority_class_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesPriorityClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPriorityClassV1Config_preemptionPolicy(name, "Never", "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityClassExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "preemption_policy", "Never"),
+					resource.TestCheckResourceAttr(resourceName, "description", "first"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesPriorityClassV1Config_preemptionPolicy(name, "Never", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityClassExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "preemption_policy", "Never"),
+					resource.TestCheckResourceAttr(resourceName, "description", "second"),
+					func(s *terraform.State) error {
+						if conf1.UID != conf2.UID {
+							return fmt.Errorf("Expected priority class to be updated in place, but it was recreated")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccKubernetesPriorityClassV1Config_preemptionPolicy(name, "PreemptLowerPriority", "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityClassExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "preemption_policy", "PreemptLowerPriority"),
+					func(s *terraform.State) error {
+						if conf1.UID == conf2.UID {
+							return fmt.Errorf("Expected priority class to be recreated when the preemption policy changes")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesPriorityClassDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -127,7 +183,7 @@ func testAccCheckKubernetesPriorityClassDestroy(s *terraform.State) error {
 	ctx := context.TODO()
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "kubernetes_priority_class" {
+		if rs.Type != "kubernetes_priority_class" && rs.Type != "kubernetes_priority_class_v1" {
 			continue
 		}
 
@@ -235,3 +291,16 @@ func testAccKubernetesPriorityClassConfig_generatedName(prefix string) string {
 }
 `, prefix)
 }
+
+func testAccKubernetesPriorityClassV1Config_preemptionPolicy(name, policy, description string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+
+  value             = 100
+  preemption_policy = %q
+  description       = %q
+}
+`, name, policy, description)
+}
@@ -0,0 +1,297 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesPriorityLevelConfigurationV1() *schema.Resource {
+	apiDoc := flowcontrolv1beta3.PriorityLevelConfiguration{}.SwaggerDoc()
+	specDoc := flowcontrolv1beta3.PriorityLevelConfigurationSpec{}.SwaggerDoc()
+	limitedDoc := flowcontrolv1beta3.LimitedPriorityLevelConfiguration{}.SwaggerDoc()
+	exemptDoc := flowcontrolv1beta3.ExemptPriorityLevelConfiguration{}.SwaggerDoc()
+	queuingDoc := flowcontrolv1beta3.QueuingConfiguration{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesPriorityLevelConfigurationV1Create,
+		ReadContext:   resourceKubernetesPriorityLevelConfigurationV1Read,
+		UpdateContext: resourceKubernetesPriorityLevelConfigurationV1Update,
+		DeleteContext: resourceKubernetesPriorityLevelConfigurationV1Delete,
+		CustomizeDiff: resourceKubernetesPriorityLevelConfigurationV1CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("priority level configuration", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: apiDoc["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "Whether requests of this priority level are subject to concurrency limits. Valid values are `Limited` and `Exempt`.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Limited", "Exempt"}, false),
+						},
+						"limited": {
+							Type:        schema.TypeList,
+							Description: specDoc["limited"],
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"nominal_concurrency_shares": {
+										Type:         schema.TypeInt,
+										Description:  "The share of the server's concurrency limit the priority level is entitled to. Defaults to 30.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+									"lendable_percent": {
+										Type:         schema.TypeInt,
+										Description:  limitedDoc["lendablePercent"],
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"borrowing_limit_percent": {
+										Type:         schema.TypeInt,
+										Description:  limitedDoc["borrowingLimitPercent"],
+										Optional:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"limit_response": {
+										Type:        schema.TypeList,
+										Description: limitedDoc["limitResponse"],
+										Required:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"type": {
+													Type:         schema.TypeString,
+													Description:  "How requests which cannot be executed right away are handled. Valid values are `Queue` and `Reject`.",
+													Required:     true,
+													ValidateFunc: validation.StringInSlice([]string{"Queue", "Reject"}, false),
+												},
+												"queuing": {
+													Type:        schema.TypeList,
+													Description: "The queuing parameters, only used when `type` is `Queue`. Omitted parameters are defaulted by the API server.",
+													Optional:    true,
+													Computed:    true,
+													MaxItems:    1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"queues": {
+																Type:         schema.TypeInt,
+																Description:  queuingDoc["queues"],
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"hand_size": {
+																Type:         schema.TypeInt,
+																Description:  queuingDoc["handSize"],
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+															"queue_length_limit": {
+																Type:         schema.TypeInt,
+																Description:  queuingDoc["queueLengthLimit"],
+																Optional:     true,
+																Computed:     true,
+																ValidateFunc: validation.IntAtLeast(1),
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"exempt": {
+							Type:        schema.TypeList,
+							Description: "The parameters of an exempt priority level, only used when `type` is `Exempt`. Omitted parameters are defaulted by the API server.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"nominal_concurrency_shares": {
+										Type:         schema.TypeInt,
+										Description:  exemptDoc["nominalConcurrencyShares"],
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+									"lendable_percent": {
+										Type:         schema.TypeInt,
+										Description:  exemptDoc["lendablePercent"],
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceKubernetesPriorityLevelConfigurationV1CustomizeDiff checks at plan
+// time that the `limited` block is set exactly when the type is `Limited`.
+func resourceKubernetesPriorityLevelConfigurationV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("spec.0.type") || !d.NewValueKnown("spec.0.limited") {
+		return nil
+	}
+	limited := len(d.Get("spec.0.limited").([]interface{})) > 0
+	switch d.Get("spec.0.type").(string) {
+	case string(flowcontrolv1beta3.PriorityLevelEnablementLimited):
+		if !limited {
+			return fmt.Errorf("spec.0.limited: required when spec.0.type is %q", flowcontrolv1beta3.PriorityLevelEnablementLimited)
+		}
+	case string(flowcontrolv1beta3.PriorityLevelEnablementExempt):
+		if limited {
+			return fmt.Errorf("spec.0.limited: must not be set when spec.0.type is %q", flowcontrolv1beta3.PriorityLevelEnablementExempt)
+		}
+	}
+	return nil
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plc := priorityLevelConfigurationV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: flowControlV1GroupVersion,
+			Kind:       "PriorityLevelConfiguration",
+		},
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandPriorityLevelConfigurationV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&plc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new PriorityLevelConfiguration: %#v", obj)
+	out, err := dc.Resource(priorityLevelConfigurationV1Resource).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create PriorityLevelConfiguration %q because: %s", plc.Name, err)
+	}
+	log.Printf("[INFO] Submitted new PriorityLevelConfiguration: %#v", out)
+
+	d.SetId(out.GetName())
+
+	return resourceKubernetesPriorityLevelConfigurationV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading PriorityLevelConfiguration %s", name)
+	out, err := dc.Resource(priorityLevelConfigurationV1Resource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] PriorityLevelConfiguration %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read PriorityLevelConfiguration %q because: %s", name, err)
+	}
+	plc := priorityLevelConfigurationV1{}
+	if err := fromUnstructuredObject(out, &plc); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received PriorityLevelConfiguration: %#v", plc)
+
+	err = d.Set("metadata", flattenMetadata(plc.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenPriorityLevelConfigurationV1Spec(plc.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandPriorityLevelConfigurationV1Spec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Updating PriorityLevelConfiguration %q: %v", name, string(data))
+	out, err := dc.Resource(priorityLevelConfigurationV1Resource).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update PriorityLevelConfiguration %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated PriorityLevelConfiguration: %#v", out)
+
+	return resourceKubernetesPriorityLevelConfigurationV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesPriorityLevelConfigurationV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting PriorityLevelConfiguration: %#v", name)
+	err = dc.Resource(priorityLevelConfigurationV1Resource).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete PriorityLevelConfiguration %q because: %s", name, err)
+	}
+
+	log.Printf("[INFO] PriorityLevelConfiguration %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
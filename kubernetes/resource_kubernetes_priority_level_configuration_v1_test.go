@@ -0,0 +1,252 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesPriorityLevelConfigurationV1_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_priority_level_configuration_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.29.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesFlowControlV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPriorityLevelConfigurationV1Config_defaults(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityLevelConfigurationV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "Limited"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.nominal_concurrency_shares", "30"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.lendable_percent", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.type", "Queue"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.queues", "64"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.hand_size", "8"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.queue_length_limit", "50"),
+				),
+			},
+			{
+				Config:   testAccKubernetesPriorityLevelConfigurationV1Config_defaults(name),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesPriorityLevelConfigurationV1Config_queuing(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.nominal_concurrency_shares", "10"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.lendable_percent", "50"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.borrowing_limit_percent", "20"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.queues", "16"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.hand_size", "4"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.0.queue_length_limit", "100"),
+				),
+			},
+			{
+				Config: testAccKubernetesPriorityLevelConfigurationV1Config_reject(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.type", "Reject"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.0.limit_response.0.queuing.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPriorityLevelConfigurationV1_exempt(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_priority_level_configuration_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.29.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesFlowControlV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesPriorityLevelConfigurationV1Config_exempt(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesPriorityLevelConfigurationV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "Exempt"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.limited.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.exempt.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesPriorityLevelConfigurationV1_limitedRequired(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesPriorityLevelConfigurationV1Config_missingLimited(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`spec.0.limited: required when spec.0.type is "Limited"`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesFlowControlV1Destroy(s *terraform.State) error {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		var gvr = priorityLevelConfigurationV1Resource
+		switch rs.Type {
+		case "kubernetes_priority_level_configuration_v1":
+		case "kubernetes_flow_schema_v1":
+			gvr = flowSchemaV1Resource
+		default:
+			continue
+		}
+
+		_, err := dc.Resource(gvr).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("%s still exists: %s", rs.Type, rs.Primary.ID)
+		}
+		if statusErr, ok := err.(*errors.StatusError); !ok || !errors.IsNotFound(statusErr) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesPriorityLevelConfigurationV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = dc.Resource(priorityLevelConfigurationV1Resource).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Config_defaults(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      limit_response {
+        type = "Queue"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Config_queuing(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      nominal_concurrency_shares = 10
+      lendable_percent           = 50
+      borrowing_limit_percent    = 20
+      limit_response {
+        type = "Queue"
+        queuing {
+          queues             = 16
+          hand_size          = 4
+          queue_length_limit = 100
+        }
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Config_reject(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+    limited {
+      nominal_concurrency_shares = 10
+      limit_response {
+        type = "Reject"
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Config_exempt(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Exempt"
+  }
+}
+`, name)
+}
+
+func testAccKubernetesPriorityLevelConfigurationV1Config_missingLimited(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_priority_level_configuration_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    type = "Limited"
+  }
+}
+`, name)
+}
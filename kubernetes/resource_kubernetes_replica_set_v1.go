@@ -0,0 +1,346 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+func resourceKubernetesReplicaSetV1() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesReplicaSetV1Create,
+		ReadContext:   resourceKubernetesReplicaSetV1Read,
+		UpdateContext: resourceKubernetesReplicaSetV1Update,
+		DeleteContext: resourceKubernetesReplicaSetV1Delete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec"),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: resourceKubernetesReplicaSetV1Schema(),
+	}
+}
+
+func resourceKubernetesReplicaSetV1Schema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"metadata": namespacedMetadataSchema("replica set", true),
+		"spec": {
+			Type:        schema.TypeList,
+			Description: "Spec defines the specification of the desired behavior of the replica set. More info: https://kubernetes.io/docs/reference/kubernetes-api/workload-resources/replica-set-v1/#ReplicaSetSpec",
+			Required:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"min_ready_seconds": {
+						Type:         schema.TypeInt,
+						Description:  "Minimum number of seconds for which a newly created pod should be ready without any of its container crashing, for it to be considered available. Defaults to 0 (pod will be considered available as soon as it is ready)",
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+					"replicas": {
+						Type:         schema.TypeString,
+						Description:  "Number of desired pods. This is a string to be able to distinguish between explicit zero and not specified. When not specified, the replica count set outside of Terraform is kept.",
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validateTypeStringNullableInt,
+					},
+					"selector": {
+						Type:        schema.TypeList,
+						Description: "A label query over pods that should match the replica count. It must match the labels of the pod template, and can not be changed once the replica set is created.",
+						Required:    true,
+						ForceNew:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: labelSelectorFields(false),
+						},
+					},
+					"template": {
+						Type:        schema.TypeList,
+						Description: "Template describes the pods that will be created if insufficient replicas are detected. Changes to the template only apply to the pods created afterwards.",
+						Required:    true,
+						MaxItems:    1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"metadata": namespacedMetadataSchemaIsTemplate("pod", true, true),
+								"spec": {
+									Type:        schema.TypeList,
+									Description: "Spec of the pods managed by the replica set.",
+									Required:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: podSpecFields(true, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"wait_for_rollout": {
+			Type:        schema.TypeBool,
+			Description: "Wait for the desired number of pods of the replica set to be available. Defaults to true.",
+			Default:     true,
+			Optional:    true,
+		},
+	}
+}
+
+func resourceKubernetesReplicaSetV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rs := appsv1.ReplicaSet{
+		ObjectMeta: metadata,
+		Spec:       *spec,
+	}
+
+	log.Printf("[INFO] Creating new replica set: %#v", rs)
+	out, err := conn.AppsV1().ReplicaSets(metadata.Namespace).Create(ctx, &rs, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create replica set: %s", err)
+	}
+
+	d.SetId(buildId(out.ObjectMeta))
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForReplicaSetRollout(ctx, conn, out.Namespace, out.Name, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	log.Printf("[INFO] Submitted new replica set: %#v", out)
+
+	return resourceKubernetesReplicaSetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReplicaSetV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+
+	if d.HasChange("spec") {
+		spec, err := expandReplicaSetSpec(d.Get("spec").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &spec.Template.Spec)
+		// The replica count is left to the tooling which scales the
+		// replica set when it is not configured.
+		if !isConfigured(d, "spec", "replicas") {
+			live, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return diag.Errorf("Failed to read replica set: %s", err)
+			}
+			spec.Replicas = live.Spec.Replicas
+		}
+
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: spec,
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating replica set %q: %v", name, string(data))
+	out, err := conn.AppsV1().ReplicaSets(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update replica set: %s", err)
+	}
+	log.Printf("[INFO] Submitted updated replica set: %#v", out)
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForReplicaSetRollout(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceKubernetesReplicaSetV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesReplicaSetV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	exists, err := resourceKubernetesReplicaSetV1Exists(ctx, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		d.SetId("")
+		return diag.Diagnostics{}
+	}
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading replica set %s", name)
+	rs, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received replica set: %#v", rs)
+
+	err = d.Set("metadata", flattenMetadata(rs.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	spec, err := flattenReplicaSetSpec(rs.Spec, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	diags := keepDroppedResourceClaims(d, "spec", spec, "0.template.0.spec.0")
+	err = d.Set("spec", spec)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceKubernetesReplicaSetV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting replica set: %#v", name)
+
+	err = conn.AppsV1().ReplicaSets(namespace).Delete(ctx, name, deleteOptions)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// The pods are deleted before the replica set, so that a replica set
+	// created again with the same selector does not adopt them.
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("Replica set (%s) still exists", d.Id())
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Replica set %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKubernetesReplicaSetV1Exists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return false, err
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return false, err
+	}
+
+	log.Printf("[INFO] Checking replica set %s", name)
+	_, err = conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+	}
+	return true, err
+}
+
+// waitForReplicaSetRollout waits until the desired number of pods of the
+// ReplicaSet are available.
+func waitForReplicaSetRollout(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) error {
+	start := time.Now()
+	err := resource.RetryContext(ctx, timeout, waitForReplicaSetReplicasFunc(ctx, conn, ns, name))
+	if !rolloutTimedOut(ctx, start, timeout, err) {
+		return err
+	}
+
+	// The context of the wait expires together with the timeout, so the pods
+	// are looked up with a fresh one.
+	lctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	rs, gerr := conn.AppsV1().ReplicaSets(ns).Get(lctx, name, metav1.GetOptions{})
+	if gerr != nil {
+		return err
+	}
+	return rolloutTimeoutError(lctx, conn, ns, rs.UID, rs.Spec.Selector, err)
+}
+
+func waitForReplicaSetReplicasFunc(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		rs, err := conn.AppsV1().ReplicaSets(ns).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		done, progress := replicaSetRolloutComplete(rs)
+		logRolloutProgress(ctx, "ReplicaSet", ns, name, progress)
+		if done {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf("Waiting for ReplicaSet %s/%s to roll out: %s", ns, name, progress))
+	}
+}
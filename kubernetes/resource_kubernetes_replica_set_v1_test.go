@@ -0,0 +1,172 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesReplicaSetV1_basic(t *testing.T) {
+	var conf1, conf2 appsv1.ReplicaSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_replica_set_v1.test"
+	imageName := nginxImageVersion
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		IDRefreshIgnore:   []string{"metadata.0.resource_version"},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesReplicaSetV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesReplicaSetV1Config_basic(name, imageName, "2", "foo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.selector.0.match_labels.app", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.template.0.spec.0.container.0.image", imageName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_rollout", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_rollout"},
+			},
+			{
+				Config: testAccKubernetesReplicaSetV1Config_basic(name, imageName, "3", "foo"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "3"),
+					testAccCheckKubernetesReplicaSetV1AvailableReplicas(&conf2, 3),
+					testAccCheckKubernetesReplicaSetV1Recreated(&conf1, &conf2, false),
+				),
+			},
+			{
+				Config: testAccKubernetesReplicaSetV1Config_basic(name, imageName, "3", "bar"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesReplicaSetV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.selector.0.match_labels.app", "bar"),
+					testAccCheckKubernetesReplicaSetV1Recreated(&conf2, &conf1, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesReplicaSetV1AvailableReplicas(obj *appsv1.ReplicaSet, expected int32) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if obj.Status.AvailableReplicas != expected {
+			return fmt.Errorf("Expected %d available replicas, got %d", expected, obj.Status.AvailableReplicas)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesReplicaSetV1Recreated(before, after *appsv1.ReplicaSet, expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if recreated := before.UID != after.UID; recreated != expected {
+			return fmt.Errorf("Expected replica set to be recreated: %t, got %t", expected, recreated)
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesReplicaSetV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_replica_set_v1" {
+			continue
+		}
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err == nil {
+			if resp.Namespace == namespace && resp.Name == name {
+				return fmt.Errorf("Replica set still exists: %s", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesReplicaSetV1Exists(n string, obj *appsv1.ReplicaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		out, err := conn.AppsV1().ReplicaSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesReplicaSetV1Config_basic(name, imageName, replicas, app string) string {
+	return fmt.Sprintf(`resource "kubernetes_replica_set_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    replicas = %s
+
+    selector {
+      match_labels = {
+        app = "%s"
+      }
+    }
+
+    template {
+      metadata {
+        labels = {
+          app = "%s"
+        }
+      }
+
+      spec {
+        container {
+          image = "%s"
+          name  = "tf-acc-test"
+        }
+      }
+    }
+  }
+}
+`, name, replicas, app, app, imageName)
+}
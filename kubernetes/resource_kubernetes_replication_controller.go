@@ -23,6 +23,7 @@ func resourceKubernetesReplicationController() *schema.Resource {
 		ReadContext:   resourceKubernetesReplicationControllerRead,
 		UpdateContext: resourceKubernetesReplicationControllerUpdate,
 		DeleteContext: resourceKubernetesReplicationControllerDelete,
+		CustomizeDiff: podSpecCustomizeDiff("spec.0.template.0.spec"),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -177,22 +178,23 @@ func resourceKubernetesReplicationControllerRead(ctx context.Context, d *schema.
 	}
 	log.Printf("[INFO] Received replication controller: %#v", rc)
 
-	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	spec, err := flattenReplicationControllerSpec(rc.Spec, d)
+	spec, err := flattenReplicationControllerSpec(rc.Spec, d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	diags := keepDroppedResourceClaims(d, "spec", spec, "0.template.0.spec.0")
 	err = d.Set("spec", spec)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceKubernetesReplicationControllerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -213,6 +215,7 @@ func resourceKubernetesReplicationControllerUpdate(ctx context.Context, d *schem
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &spec.Template.Spec)
 
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec",
@@ -56,7 +56,7 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 							ForceNew:    true,
 							Elem: &schema.Schema{
 								Type:         schema.TypeString,
-								ValidateFunc: validation.StringInSlice([]string{"Terminating", "NotTerminating", "BestEffort", "NotBestEffort", "PriorityClass"}, false),
+								ValidateFunc: validation.StringInSlice(resourceQuotaScopes, false),
 							},
 							Set: schema.HashString,
 						},
@@ -77,7 +77,7 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 													Type:         schema.TypeString,
 													Description:  "The name of the scope that the selector applies to.",
 													Required:     true,
-													ValidateFunc: validation.StringInSlice([]string{"Terminating", "NotTerminating", "BestEffort", "NotBestEffort", "PriorityClass"}, false),
+													ValidateFunc: validation.StringInSlice(resourceQuotaScopes, false),
 												},
 												"operator": {
 													Type:         schema.TypeString,
@@ -100,10 +100,40 @@ func resourceKubernetesResourceQuota() *schema.Resource {
 					},
 				},
 			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "Status defines the enforced hard limits and the resources currently used in the namespace.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hard": {
+							Type:        schema.TypeMap,
+							Description: "The set of enforced hard limits for each named resource.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"used": {
+							Type:        schema.TypeMap,
+							Description: "The current observed total usage of each named resource in the namespace.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+var resourceQuotaScopes = []string{
+	string(api.ResourceQuotaScopeTerminating),
+	string(api.ResourceQuotaScopeNotTerminating),
+	string(api.ResourceQuotaScopeBestEffort),
+	string(api.ResourceQuotaScopeNotBestEffort),
+	string(api.ResourceQuotaScopePriorityClass),
+	string(api.ResourceQuotaScopeCrossNamespacePodAffinity),
+}
+
 func resourceKubernetesResourceQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -181,7 +211,7 @@ func resourceKubernetesResourceQuotaRead(ctx context.Context, d *schema.Resource
 		}
 	}
 
-	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(resQuota.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -189,6 +219,10 @@ func resourceKubernetesResourceQuotaRead(ctx context.Context, d *schema.Resource
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("status", flattenResourceQuotaStatus(resQuota.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -43,6 +43,11 @@ func TestAccKubernetesResourceQuota_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "spec.0.hard.limits.cpu", "2"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.hard.limits.memory", "2Gi"),
 					resource.TestCheckResourceAttr(resourceName, "spec.0.hard.pods", "4"),
+					resource.TestCheckResourceAttr(resourceName, "status.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.hard.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.hard.limits.memory", "2Gi"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.hard.pods", "4"),
+					resource.TestCheckResourceAttrSet(resourceName, "status.0.used.pods"),
 				),
 			},
 			{
@@ -256,6 +261,41 @@ func TestAccKubernetesResourceQuota_scopeSelector(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesResourceQuota_scopeSelectorMultipleExpressions(t *testing.T) {
+	var conf api.ResourceQuota
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_resource_quota.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesResourceQuotaDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesResourceQuotaConfigScopeSelectorMultipleExpressions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesResourceQuotaExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope_selector.0.match_expression.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope_selector.0.match_expression.0.scope_name", "PriorityClass"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope_selector.0.match_expression.0.operator", "In"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "spec.0.scope_selector.0.match_expression.0.values.*", "high"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope_selector.0.match_expression.1.scope_name", "Terminating"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.scope_selector.0.match_expression.1.operator", "Exists"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.hard.pods", "4"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.used.pods", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func testAccCheckKubernetesResourceQuotaDestroy(s *terraform.State) error {
 	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
 
@@ -504,3 +544,32 @@ func testAccKubernetesResourceQuotaConfigScopeSelectorModified(name string) stri
 }
 `, name)
 }
+
+func testAccKubernetesResourceQuotaConfigScopeSelectorMultipleExpressions(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_resource_quota" "test" {
+  metadata {
+    name = %q
+  }
+
+  spec {
+    hard = {
+      "limits.cpu" = 2
+      pods         = 4
+    }
+
+    scope_selector {
+      match_expression {
+        scope_name = "PriorityClass"
+        operator   = "In"
+        values     = ["high"]
+      }
+
+      match_expression {
+        scope_name = "Terminating"
+        operator   = "Exists"
+      }
+    }
+  }
+}
+`, name)
+}
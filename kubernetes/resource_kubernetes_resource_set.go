@@ -0,0 +1,291 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const (
+	resourceSetManagedByLabel = "app.kubernetes.io/managed-by"
+	resourceSetManagedByValue = "Terraform"
+	resourceSetIDLabel        = "terraform.io/resource-set"
+)
+
+// resourceKubernetesResourceSet applies a list of manifests with server-side
+// apply and labels every object with the id of the set. The objects applied
+// are tracked in the inventory attribute, and the objects of the inventory
+// which are no longer in the list are deleted on update.
+func resourceKubernetesResourceSet() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesResourceSetCreate,
+		ReadContext:   resourceKubernetesResourceSetRead,
+		UpdateContext: resourceKubernetesResourceSetUpdate,
+		DeleteContext: resourceKubernetesResourceSetDelete,
+		CustomizeDiff: resourceKubernetesResourceSetCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"manifests": {
+				Type:        schema.TypeList,
+				Description: "The Kubernetes manifests of the set, each one a single object in YAML or JSON. Every manifest must set `apiVersion`, `kind` and `metadata.name`.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"set_id": {
+				Type:         schema.TypeString,
+				Description:  "The value of the `terraform.io/resource-set` label set on every object of the set. Generated when not set.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validateLabelValue,
+			},
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager used to apply the manifests.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting fields that are managed by other field managers.",
+				Optional:    true,
+			},
+			"propagation_policy": {
+				Type:         schema.TypeString,
+				Description:  "Whether and how garbage collection is performed for the dependents of the objects deleted. One of `Orphan`, `Background` or `Foreground`.",
+				Optional:     true,
+				Default:      string(metav1.DeletePropagationBackground),
+				ValidateFunc: validation.StringInSlice([]string{string(metav1.DeletePropagationOrphan), string(metav1.DeletePropagationBackground), string(metav1.DeletePropagationForeground)}, false),
+			},
+			"inventory": {
+				Type:        schema.TypeList,
+				Description: "The objects applied from `manifests`, in the order they were applied.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Description: "The API version of the object.",
+							Computed:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "The kind of the object.",
+							Computed:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the object, empty for cluster-scoped objects.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the object.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// parseResourceSetManifests decodes the manifests of the set. The index of a
+// document is its position in the list.
+func parseResourceSetManifests(manifests []interface{}) ([]manifestDocument, error) {
+	docs := make([]manifestDocument, 0, len(manifests))
+	for i, m := range manifests {
+		body, _ := m.(string)
+		decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(body), 4096)
+		var obj map[string]interface{}
+		if err := decoder.Decode(&obj); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("document %d: %s", i, err)
+		}
+		if len(obj) == 0 {
+			return nil, fmt.Errorf("document %d: the manifest is empty", i)
+		}
+		var extra map[string]interface{}
+		if err := decoder.Decode(&extra); err != io.EOF || len(extra) > 0 {
+			return nil, fmt.Errorf("document %d: each element of manifests must contain a single manifest", i)
+		}
+		doc := manifestDocument{index: i, object: &unstructured.Unstructured{Object: obj}}
+		if err := validateManifestDocument(doc); err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	if err := checkDuplicateManifests(docs); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func resourceSetLabels(setID string) map[string]string {
+	return map[string]string{
+		resourceSetManagedByLabel: resourceSetManagedByValue,
+		resourceSetIDLabel:        setID,
+	}
+}
+
+func resourceSetDeleteOptions(d *schema.ResourceData) metav1.DeleteOptions {
+	policy := metav1.DeletionPropagation(d.Get("propagation_policy").(string))
+	return metav1.DeleteOptions{PropagationPolicy: &policy}
+}
+
+func resourceKubernetesResourceSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("manifests") {
+		return d.SetNewComputed("inventory")
+	}
+	docs, err := parseResourceSetManifests(d.Get("manifests").([]interface{}))
+	if err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+	// Objects which were deleted or unlabeled outside of Terraform are
+	// dropped from the inventory on read, they are applied again on update.
+	if d.HasChange("manifests") || len(docs) != len(d.Get("inventory").([]interface{})) {
+		return d.SetNewComputed("inventory")
+	}
+	return nil
+}
+
+func resourceKubernetesResourceSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	setID := d.Get("set_id").(string)
+	if setID == "" {
+		setID = resource.UniqueId()
+		d.Set("set_id", setID)
+	}
+	d.SetId(setID)
+	diags := applyResourceSet(ctx, d, meta, d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		if len(d.Get("inventory").([]interface{})) == 0 {
+			d.SetId("")
+		}
+		return diags
+	}
+	return resourceKubernetesResourceSetRead(ctx, d, meta)
+}
+
+func resourceKubernetesResourceSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := newManifestsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := expandManifestIdentities(d.Get("inventory").([]interface{}))
+	found, err := readManifestObjects(ctx, c, ids, resourceSetLabels(d.Id()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(ids) > 0 && len(found) == 0 {
+		log.Printf("[WARN] None of the objects of resource set %s were found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = d.Set("set_id", d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("inventory", flattenManifestIdentities(found))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesResourceSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := applyResourceSet(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+		return diags
+	}
+	return resourceKubernetesResourceSetRead(ctx, d, meta)
+}
+
+func resourceKubernetesResourceSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	c, err := newManifestsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := expandManifestIdentities(d.Get("inventory").([]interface{}))
+	err = deleteManifestObjects(ctx, c, ids, resourceSetLabels(d.Id()), resourceSetDeleteOptions(d), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Resource set %s deleted", d.Id())
+	d.SetId("")
+	return nil
+}
+
+// applyResourceSet applies the manifests with the labels of the set, then
+// prunes the objects of the inventory which are no longer in the list. The
+// inventory is kept accurate when an apply fails, so a new apply converges.
+func applyResourceSet(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	c, err := newManifestsClient(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	docs, err := parseResourceSetManifests(d.Get("manifests").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	labels := resourceSetLabels(d.Id())
+	for _, doc := range docs {
+		l := doc.object.GetLabels()
+		if l == nil {
+			l = make(map[string]string, len(labels))
+		}
+		for k, v := range labels {
+			l[k] = v
+		}
+		doc.object.SetLabels(l)
+	}
+	sortManifestDocuments(docs)
+
+	// The inventory is computed during the update, so the objects of the
+	// previous apply are only known from its prior value.
+	old, _ := d.GetChange("inventory")
+	previous := expandManifestIdentities(old.([]interface{}))
+	applied, diags := applyManifestObjects(ctx, c, docs, d.Get("field_manager").(string), d.Get("force").(bool), timeout)
+	if diags.HasError() {
+		setAppliedManifests(d, "inventory", previous, applied)
+		return diags
+	}
+
+	pruned := prunedManifests(previous, applied)
+	if err := d.Set("inventory", flattenManifestIdentities(applied)); err != nil {
+		return diag.FromErr(err)
+	}
+	if len(pruned) > 0 {
+		log.Printf("[INFO] Pruning %d objects removed from resource set %s", len(pruned), d.Id())
+		if err := deleteManifestObjects(ctx, c, pruned, labels, resourceSetDeleteOptions(d), timeout); err != nil {
+			setAppliedManifests(d, "inventory", pruned, applied)
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
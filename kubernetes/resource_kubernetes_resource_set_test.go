@@ -0,0 +1,196 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseResourceSetManifests(t *testing.T) {
+	testCases := []struct {
+		name      string
+		manifests []interface{}
+		expected  []string
+		err       string
+	}{
+		{
+			name: "yaml and json",
+			manifests: []interface{}{
+				"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: config\n",
+				`{"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "example"}}`,
+			},
+			expected: []string{`document 0 (ConfigMap "config")`, `document 1 (Namespace "example")`},
+		},
+		{
+			name: "multiple documents",
+			manifests: []interface{}{
+				"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: first\n---\napiVersion: v1\nkind: Namespace\nmetadata:\n  name: second\n",
+			},
+			err: "document 0: each element of manifests must contain a single manifest",
+		},
+		{
+			name:      "empty manifest",
+			manifests: []interface{}{"# nothing here\n"},
+			err:       "document 0: the manifest is empty",
+		},
+		{
+			name: "duplicate object",
+			manifests: []interface{}{
+				"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: example\n",
+				"apiVersion: v1\nkind: Namespace\nmetadata:\n  name: example\n",
+			},
+			err: `document 1 (Namespace "example"): the object is already defined by document 0`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			docs, err := parseResourceSetManifests(tc.manifests)
+			if tc.err != "" {
+				if err == nil || err.Error() != tc.err {
+					t.Fatalf("expected error %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make([]string, len(docs))
+			for i, doc := range docs {
+				got[i] = doc.String()
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Fatalf("unexpected documents (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceKubernetesResourceSetUpdate_removedManifest(t *testing.T) {
+	api, meta := newFakeConfigMapAPI(t)
+	r := resourceKubernetesResourceSet()
+
+	state := applyTestResource(t, r, nil, map[string]interface{}{
+		"manifests": []interface{}{testConfigMapManifest("first"), testConfigMapManifest("second")},
+	}, meta)
+	if diff := cmp.Diff([]string{"first", "second"}, api.names()); diff != "" {
+		t.Fatalf("Unexpected config maps after create (-want +got):\n%s", diff)
+	}
+
+	state = applyTestResource(t, r, state, map[string]interface{}{
+		"manifests": []interface{}{testConfigMapManifest("first")},
+	}, meta)
+	if diff := cmp.Diff([]string{"first"}, api.names()); diff != "" {
+		t.Fatalf("Expected the removed manifest to be pruned (-want +got):\n%s", diff)
+	}
+	if state.Attributes["inventory.#"] != "1" || state.Attributes["inventory.0.name"] != "first" {
+		t.Fatalf("Unexpected inventory: %v", state.Attributes)
+	}
+}
+
+func TestResourceKubernetesResourceSetUpdate_changedAPIVersion(t *testing.T) {
+	api, meta := newFakeConfigMapAPI(t)
+	r := resourceKubernetesResourceSet()
+
+	state := applyTestResource(t, r, nil, map[string]interface{}{
+		"manifests": []interface{}{testWidgetManifest("first", "v1beta1")},
+	}, meta)
+	if diff := cmp.Diff([]string{"first"}, api.names()); diff != "" {
+		t.Fatalf("Unexpected widgets after create (-want +got):\n%s", diff)
+	}
+
+	state = applyTestResource(t, r, state, map[string]interface{}{
+		"manifests": []interface{}{testWidgetManifest("first", "v1")},
+	}, meta)
+	if diff := cmp.Diff([]string{"first"}, api.names()); diff != "" {
+		t.Fatalf("Expected the widget applied with another version not to be pruned (-want +got):\n%s", diff)
+	}
+	if state.Attributes["inventory.#"] != "1" || state.Attributes["inventory.0.api_version"] != "example.com/v1" {
+		t.Fatalf("Unexpected inventory: %v", state.Attributes)
+	}
+}
+
+func TestAccKubernetesResourceSet_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_resource_set.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesManifestsDestroy(name),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesResourceSetConfig_basic(name, []string{"first", "second"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set_id", name),
+					resource.TestCheckResourceAttr(resourceName, "inventory.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "inventory.0.kind", "Namespace"),
+					resource.TestCheckResourceAttr(resourceName, "inventory.1.name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "inventory.2.name", "second"),
+					testAccCheckKubernetesResourceSetLabels(name, "first"),
+					testAccCheckKubernetesResourceSetLabels(name, "second"),
+				),
+			},
+			{
+				Config: testAccKubernetesResourceSetConfig_basic(name, []string{"first"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "inventory.#", "2"),
+					testAccCheckKubernetesManifestsConfigMapExists(name, "first", true),
+					testAccCheckKubernetesManifestsConfigMapExists(name, "second", false),
+				),
+			},
+			{
+				Config:      testAccKubernetesResourceSetConfig_basic(name, []string{"first", "first"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`the object is already defined by document 1`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesResourceSetLabels(namespace, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		cm, err := conn.CoreV1().ConfigMaps(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for k, v := range resourceSetLabels(namespace) {
+			if cm.Labels[k] != v {
+				return fmt.Errorf("Expected config map %s/%s to have label %s=%s, got %q", namespace, name, k, v, cm.Labels[k])
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesResourceSetConfig_basic(name string, configMaps []string) string {
+	return fmt.Sprintf(`resource "kubernetes_resource_set" "test" {
+  set_id = %[1]q
+
+  manifests = concat(
+    [yamlencode({
+      apiVersion = "v1"
+      kind       = "Namespace"
+      metadata   = { name = %[1]q }
+    })],
+    [for name in %[2]s : jsonencode({
+      apiVersion = "v1"
+      kind       = "ConfigMap"
+      metadata   = { name = name, namespace = %[1]q }
+      data       = { key = "value" }
+    })],
+  )
+}
+`, name, `["`+strings.Join(configMaps, `", "`)+`"]`)
+}
@@ -0,0 +1,214 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesRuntimeClassV1() *schema.Resource {
+	apiDoc := nodev1.RuntimeClass{}.SwaggerDoc()
+	overheadDoc := nodev1.Overhead{}.SwaggerDoc()
+	schedulingDoc := nodev1.Scheduling{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesRuntimeClassV1Create,
+		ReadContext:   resourceKubernetesRuntimeClassV1Read,
+		UpdateContext: resourceKubernetesRuntimeClassV1Update,
+		DeleteContext: resourceKubernetesRuntimeClassV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("runtime class", true),
+			"handler": {
+				Type:        schema.TypeString,
+				Description: "The name of the CRI handler that runs the pods of this runtime class, as configured on the nodes, e.g. `runsc` for gVisor.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"overhead": {
+				Type:        schema.TypeList,
+				Description: apiDoc["overhead"],
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pod_fixed": {
+							Type:             schema.TypeMap,
+							Description:      overheadDoc["podFixed"],
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateFunc:     validateResourceList,
+							DiffSuppressFunc: suppressEquivalentResourceQuantity,
+						},
+					},
+				},
+			},
+			"scheduling": {
+				Type:        schema.TypeList,
+				Description: apiDoc["scheduling"],
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"node_selector": {
+							Type:        schema.TypeMap,
+							Description: schedulingDoc["nodeSelector"],
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"toleration": {
+							Type:        schema.TypeList,
+							Description: "Tolerations added to the pods running with this runtime class during admission.",
+							Optional:    true,
+							Elem:        tolerationSchema(true),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesRuntimeClassV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	overhead, err := expandRuntimeClassV1Overhead(d.Get("overhead").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	scheduling, err := expandRuntimeClassV1Scheduling(d.Get("scheduling").([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	rc := nodev1.RuntimeClass{
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Handler:    d.Get("handler").(string),
+		Overhead:   overhead,
+		Scheduling: scheduling,
+	}
+
+	log.Printf("[INFO] Creating new RuntimeClass: %#v", rc)
+	out, err := conn.NodeV1().RuntimeClasses().Create(ctx, &rc, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create RuntimeClass %q because: %s", rc.Name, err)
+	}
+	log.Printf("[INFO] Submitted new RuntimeClass: %#v", out)
+	d.SetId(out.Name)
+
+	return resourceKubernetesRuntimeClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesRuntimeClassV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Reading RuntimeClass %s", name)
+	rc, err := conn.NodeV1().RuntimeClasses().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] RuntimeClass %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read RuntimeClass %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Received RuntimeClass: %#v", rc)
+
+	err = d.Set("metadata", flattenMetadata(rc.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("handler", rc.Handler)
+	err = d.Set("overhead", flattenRuntimeClassV1Overhead(rc.Overhead))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("scheduling", flattenRuntimeClassV1Scheduling(rc.Scheduling))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesRuntimeClassV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("overhead") {
+		overhead, err := expandRuntimeClassV1Overhead(d.Get("overhead").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if overhead == nil {
+			ops = append(ops, &RemoveOperation{Path: "/overhead"})
+		} else {
+			ops = append(ops, &AddOperation{Path: "/overhead", Value: overhead})
+		}
+	}
+	if d.HasChange("scheduling") {
+		scheduling, err := expandRuntimeClassV1Scheduling(d.Get("scheduling").([]interface{}))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if scheduling == nil {
+			ops = append(ops, &RemoveOperation{Path: "/scheduling"})
+		} else {
+			ops = append(ops, &AddOperation{Path: "/scheduling", Value: scheduling})
+		}
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	log.Printf("[INFO] Updating RuntimeClass %q: %v", name, string(data))
+	out, err := conn.NodeV1().RuntimeClasses().Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update RuntimeClass %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated RuntimeClass: %#v", out)
+
+	return resourceKubernetesRuntimeClassV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesRuntimeClassV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Deleting RuntimeClass: %s", name)
+	err = conn.NodeV1().RuntimeClasses().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete RuntimeClass %q because: %s", name, err)
+	}
+	log.Printf("[INFO] RuntimeClass %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
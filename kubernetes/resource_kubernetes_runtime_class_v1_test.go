@@ -0,0 +1,181 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesRuntimeClassV1_basic(t *testing.T) {
+	var conf1, conf2 nodev1.RuntimeClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_runtime_class_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesRuntimeClassV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesRuntimeClassV1Config_basic(name, "runc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassV1Exists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttr(resourceName, "handler", "runc"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesRuntimeClassV1Config_full(name, "runc", "0.25", "1024Mi"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesRuntimeClassV1NotRecreated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "overhead.0.pod_fixed.cpu", "250m"),
+					resource.TestCheckResourceAttr(resourceName, "overhead.0.pod_fixed.memory", "1Gi"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.node_selector.tf-acc-test/runtime", "runc"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.key", "tf-acc-test/runtime"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.0.toleration.0.effect", "NoSchedule"),
+				),
+			},
+			{
+				Config:   testAccKubernetesRuntimeClassV1Config_full(name, "runc", "250m", "1Gi"),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesRuntimeClassV1Config_basic(name, "runc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassV1Exists(resourceName, &conf2),
+					testAccCheckKubernetesRuntimeClassV1NotRecreated(&conf1, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "overhead.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "scheduling.#", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesRuntimeClassV1Config_basic(name, "runsc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesRuntimeClassV1Exists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "handler", "runsc"),
+					func(s *terraform.State) error {
+						if conf1.UID == conf2.UID {
+							return fmt.Errorf("Expected RuntimeClass to be recreated when the handler changes")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesRuntimeClassV1NotRecreated(before, after *nodev1.RuntimeClass) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.UID != after.UID {
+			return fmt.Errorf("Expected RuntimeClass to be updated in place, but it was recreated")
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesRuntimeClassV1Destroy(s *terraform.State) error {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_runtime_class_v1" {
+			continue
+		}
+
+		_, err := conn.NodeV1().RuntimeClasses().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("RuntimeClass still exists: %s", rs.Primary.ID)
+		}
+		if statusErr, ok := err.(*errors.StatusError); !ok || !errors.IsNotFound(statusErr) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesRuntimeClassV1Exists(n string, obj *nodev1.RuntimeClass) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		out, err := conn.NodeV1().RuntimeClasses().Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		*obj = *out
+		return nil
+	}
+}
+
+func testAccKubernetesRuntimeClassV1Config_basic(name, handler string) string {
+	return fmt.Sprintf(`resource "kubernetes_runtime_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+  handler = %q
+}
+`, name, handler)
+}
+
+func testAccKubernetesRuntimeClassV1Config_full(name, handler, cpu, memory string) string {
+	return fmt.Sprintf(`resource "kubernetes_runtime_class_v1" "test" {
+  metadata {
+    name = %q
+  }
+  handler = %q
+  overhead {
+    pod_fixed = {
+      cpu    = %q
+      memory = %q
+    }
+  }
+  scheduling {
+    node_selector = {
+      "tf-acc-test/runtime" = "runc"
+    }
+    toleration {
+      key      = "tf-acc-test/runtime"
+      operator = "Exists"
+      effect   = "NoSchedule"
+    }
+  }
+}
+`, name, handler, cpu, memory)
+}
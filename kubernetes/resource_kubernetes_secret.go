@@ -2,8 +2,12 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
 	"log"
+	"unicode/utf8"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -23,6 +27,10 @@ func resourceKubernetesSecret() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			if err := validateSecretDataKeys(diff.GetRawConfig()); err != nil {
+				return err
+			}
+
 			if diff.Id() == "" {
 				return nil
 			}
@@ -34,6 +42,8 @@ func resourceKubernetesSecret() *schema.Resource {
 				immutableFields := []string{
 					"data",
 					"binary_data",
+					"wo_data",
+					"wo_revision",
 					"immutable",
 				}
 				for _, f := range immutableFields {
@@ -61,6 +71,8 @@ func resourceKubernetesSecret() *schema.Resource {
 				Sensitive:   true,
 				Description: "A map of the secret data in base64 encoding. Use this for binary data.",
 			},
+			"wo_data":     writeOnlyDataSchema(),
+			"wo_revision": writeOnlyRevisionSchema(),
 			"immutable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -68,7 +80,7 @@ func resourceKubernetesSecret() *schema.Resource {
 			},
 			"type": {
 				Type:        schema.TypeString,
-				Description: "Type of secret",
+				Description: "Type of secret. Changing the type forces a new secret to be created, as the API does not allow the type of a secret to be changed.",
 				Default:     string(api.SecretTypeOpaque),
 				Optional:    true,
 				ForceNew:    true,
@@ -97,6 +109,15 @@ func resourceKubernetesSecretCreate(ctx context.Context, d *schema.ResourceData,
 		secret.StringData = m
 	}
 
+	if m := expandWriteOnlyData(d.GetRawConfig()); len(m) > 0 {
+		if secret.StringData == nil {
+			secret.StringData = map[string]string{}
+		}
+		for k, v := range m {
+			secret.StringData[k] = v
+		}
+	}
+
 	if v, ok := d.GetOk("binary_data"); ok {
 		m, err := base64DecodeStringMap(v.(map[string]interface{}))
 		if err != nil {
@@ -151,31 +172,80 @@ func resourceKubernetesSecretRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	log.Printf("[INFO] Received secret: %#v", secret)
-	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(secret.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	binaryDataKeys := []string{}
+	binaryDataKeys := map[string]bool{}
 	if v, ok := d.GetOk("binary_data"); ok {
-		binaryData := map[string][]byte{}
 		for k := range v.(map[string]interface{}) {
-			binaryData[k] = secret.Data[k]
-			binaryDataKeys = append(binaryDataKeys, k)
+			binaryDataKeys[k] = true
 		}
-		d.Set("binary_data", base64EncodeByteMap(binaryData))
 	}
-
-	for _, k := range binaryDataKeys {
-		delete(secret.Data, k)
+	// The values of wo_data are only stored as hashes, and must not end up
+	// in data, which is also computed.
+	writeOnlyKeys, _ := d.Get("wo_data").(map[string]interface{})
+	if len(writeOnlyKeys) > 0 {
+		d.Set("wo_data", flattenWriteOnlyData(secret.Data, writeOnlyKeys))
+		for k := range writeOnlyKeys {
+			delete(secret.Data, k)
+		}
+	}
+	data, binaryData := flattenSecretData(secret.Data, binaryDataKeys)
+	d.Set("data", data)
+	if len(binaryData) > 0 || len(binaryDataKeys) > 0 {
+		d.Set("binary_data", base64EncodeByteMap(binaryData))
 	}
-	d.Set("data", flattenByteMapToStringMap(secret.Data))
 	d.Set("type", secret.Type)
 	d.Set("immutable", secret.Immutable)
 
 	return nil
 }
 
+// validateSecretDataKeys checks that no key is set in both data and
+// binary_data, or wo_data and binary_data, of the configuration, as a secret
+// holds a single value per key. The prior state is not looked at because
+// data is also computed.
+func validateSecretDataKeys(config cty.Value) error {
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	binaryData := config.GetAttr("binary_data")
+	if binaryData.IsNull() || !binaryData.IsKnown() {
+		return nil
+	}
+	for _, attr := range []string{"data", "wo_data"} {
+		data := config.GetAttr(attr)
+		if data.IsNull() || !data.IsKnown() {
+			continue
+		}
+		for it := data.ElementIterator(); it.Next(); {
+			k, _ := it.Element()
+			if binaryData.HasIndex(k).True() {
+				return fmt.Errorf("key %q is set in both %s and binary_data", k.AsString(), attr)
+			}
+		}
+	}
+	return nil
+}
+
+// flattenSecretData splits the data of a secret into the keys managed as
+// binary_data and the plain data. Values which are not valid UTF-8 cannot be
+// stored in data without being corrupted, so they always go to binary_data.
+func flattenSecretData(in map[string][]byte, binaryDataKeys map[string]bool) (map[string]string, map[string][]byte) {
+	data := map[string]string{}
+	binaryData := map[string][]byte{}
+	for k, v := range in {
+		if binaryDataKeys[k] || !utf8.Valid(v) {
+			binaryData[k] = v
+			continue
+		}
+		data[k] = string(v)
+	}
+	return data, binaryData
+}
+
 func resourceKubernetesSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
@@ -215,6 +285,14 @@ func resourceKubernetesSecretUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	// The whole data is replaced, so the values of wo_data are always sent.
+	for k, v := range expandWriteOnlyData(d.GetRawConfig()) {
+		newData[k] = base64.StdEncoding.EncodeToString([]byte(v))
+	}
+	if d.HasChange("wo_data") || d.HasChange("wo_revision") {
+		updateData = true
+	}
+
 	if updateData {
 		ops = append(ops, &AddOperation{
 			Path:  "/data",
@@ -7,6 +7,8 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -302,6 +304,159 @@ func TestAccKubernetesSecret_binaryData(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesSecret_writeOnlyData(t *testing.T) {
+	var conf1, conf2 api.Secret
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_secret.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretConfig_writeOnlyData(name, "first", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.one", hashSecretValue([]byte("first"))),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "0"),
+					testAccCheckSecretData(&conf1, map[string]string{"one": "first"}),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretConfig_writeOnlyData(name, "second", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.one", hashSecretValue([]byte("second"))),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "0"),
+					testAccCheckSecretData(&conf2, map[string]string{"one": "second"}),
+					testAccCheckSecretNotRecreated(&conf1, &conf2),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+					if err != nil {
+						t.Fatal(err)
+					}
+					conf2.Data["one"] = []byte("changed")
+					_, err = conn.CoreV1().Secrets(conf2.Namespace).Update(context.Background(), &conf2, metav1.UpdateOptions{})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testAccKubernetesSecretConfig_writeOnlyData(name, "second", 1),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccKubernetesSecretConfig_writeOnlyData(name, "second", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.one", hashSecretValue([]byte("second"))),
+					resource.TestCheckResourceAttr(resourceName, "wo_revision", "2"),
+					testAccCheckSecretData(&conf2, map[string]string{"one": "second"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesSecret_dataKeyConflict(t *testing.T) {
+	prefix := "tf-acc-test-gen-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesSecretConfig_dataKeyConflict(prefix),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`key "one" is set in both data and binary_data`),
+			},
+		},
+	})
+}
+
+func TestValidateSecretDataKeys(t *testing.T) {
+	config := func(data, binaryData cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"data":        data,
+			"binary_data": binaryData,
+			"wo_data":     cty.NullVal(cty.Map(cty.String)),
+		})
+	}
+	testCases := []struct {
+		name        string
+		config      cty.Value
+		expectError bool
+	}{
+		{
+			name: "distinct keys",
+			config: config(
+				cty.MapVal(map[string]cty.Value{"one": cty.StringVal("1")}),
+				cty.MapVal(map[string]cty.Value{"two": cty.StringVal("Mg==")}),
+			),
+		},
+		{
+			name: "same key",
+			config: config(
+				cty.MapVal(map[string]cty.Value{"one": cty.StringVal("1")}),
+				cty.MapVal(map[string]cty.Value{"one": cty.StringVal("MQ==")}),
+			),
+			expectError: true,
+		},
+		{
+			name: "unset binary data",
+			config: config(
+				cty.MapVal(map[string]cty.Value{"one": cty.StringVal("1")}),
+				cty.NullVal(cty.Map(cty.String)),
+			),
+		},
+		{
+			name: "unknown data",
+			config: config(
+				cty.UnknownVal(cty.Map(cty.String)),
+				cty.MapVal(map[string]cty.Value{"one": cty.StringVal("MQ==")}),
+			),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSecretDataKeys(tc.config)
+			if tc.expectError && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestFlattenSecretData(t *testing.T) {
+	in := map[string][]byte{
+		"text":   []byte("hello"),
+		"binary": {0xff, 0xfe, 0x00},
+		"kept":   []byte("world"),
+	}
+	data, binaryData := flattenSecretData(in, map[string]bool{"kept": true})
+
+	expectedData := map[string]string{"text": "hello"}
+	if diff := cmp.Diff(expectedData, data); diff != "" {
+		t.Fatalf("unexpected data (-want +got):\n%s", diff)
+	}
+	expectedBinaryData := map[string][]byte{
+		"binary": {0xff, 0xfe, 0x00},
+		"kept":   []byte("world"),
+	}
+	if diff := cmp.Diff(expectedBinaryData, binaryData); diff != "" {
+		t.Fatalf("unexpected binary data (-want +got):\n%s", diff)
+	}
+}
+
 func testAccCheckSecretData(m *api.Secret, expected map[string]string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(expected) == 0 && len(m.Data) == 0 {
@@ -526,6 +681,37 @@ func testAccKubernetesSecretConfig_binaryData(prefix string) string {
 `, prefix)
 }
 
+func testAccKubernetesSecretConfig_writeOnlyData(name, value string, revision int) string {
+	return fmt.Sprintf(`resource "kubernetes_secret" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  wo_data = {
+    one = "%s"
+  }
+  wo_revision = %d
+}
+`, name, value, revision)
+}
+
+func testAccKubernetesSecretConfig_dataKeyConflict(prefix string) string {
+	return fmt.Sprintf(`resource "kubernetes_secret" "test" {
+  metadata {
+    generate_name = "%s"
+  }
+
+  data = {
+    one = "first"
+  }
+
+  binary_data = {
+    one = filebase64("./test-fixtures/binary.data")
+  }
+}
+`, prefix)
+}
+
 func testAccKubernetesSecretConfig_binaryData2(prefix string) string {
 	return fmt.Sprintf(`resource "kubernetes_secret" "test" {
   metadata {
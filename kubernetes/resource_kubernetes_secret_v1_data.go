@@ -0,0 +1,242 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesSecretV1Data() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesSecretV1DataCreate,
+		ReadContext:   resourceKubernetesSecretV1DataRead,
+		UpdateContext: resourceKubernetesSecretV1DataUpdate,
+		DeleteContext: resourceKubernetesSecretV1DataDelete,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:        schema.TypeList,
+				Description: "Metadata identifying the secret whose data is managed.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the secret.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateName,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the secret.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "default",
+						},
+					},
+				},
+			},
+			"data": {
+				Type:        schema.TypeMap,
+				Description: "The data we want to add to the secret, as plain text. Values are base64-encoded before being sent to the API server.",
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"binary_data": {
+				Type:         schema.TypeMap,
+				Description:  "The binary data we want to add to the secret. This field only accepts base64-encoded payloads.",
+				Optional:     true,
+				Sensitive:    true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ValidateFunc: validateBase64EncodedMap,
+			},
+			"wo_data":     writeOnlyDataSchema(),
+			"wo_revision": writeOnlyRevisionSchema(),
+			"field_manager": {
+				Type:         schema.TypeString,
+				Description:  "Set the name of the field manager for the specified keys.",
+				Optional:     true,
+				Default:      defaultFieldManagerName,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Description: "Force overwriting data that is managed outside of Terraform.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceKubernetesSecretV1DataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	d.SetId(buildId(metadata))
+	diags := resourceKubernetesSecretV1DataUpdate(ctx, d, meta)
+	if diags.HasError() {
+		d.SetId("")
+	}
+	return diags
+}
+
+func resourceKubernetesSecretV1DataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading secret %s", name)
+	secret, err := conn.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			d.SetId("")
+			return diag.Diagnostics{
+				diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Secret deleted",
+					Detail:   fmt.Sprintf("The underlying secret %q has been deleted. You should recreate the underlying secret, or remove it from your configuration.", name),
+				},
+			}
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	managed, err := getManagedFieldsMap(secret.ManagedFields, d.Get("field_manager").(string), "f:data")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only keep the keys owned by our field manager, or the ones which are
+	// configured, so keys injected by other controllers don't churn state.
+	configuredData := d.Get("data").(map[string]interface{})
+	configuredBinaryData := d.Get("binary_data").(map[string]interface{})
+	configuredWriteOnlyData := d.Get("wo_data").(map[string]interface{})
+	data := map[string]string{}
+	binaryData := map[string]string{}
+	for k, v := range secret.Data {
+		_, isManaged := managed["f:"+k]
+		_, isData := configuredData[k]
+		_, isBinaryData := configuredBinaryData[k]
+		_, isWriteOnlyData := configuredWriteOnlyData[k]
+		switch {
+		case isWriteOnlyData:
+			// Only the hash is stored, see below.
+		case isBinaryData:
+			binaryData[k] = base64.StdEncoding.EncodeToString(v)
+		case isData || isManaged:
+			data[k] = string(v)
+		}
+	}
+
+	err = d.Set("metadata", []interface{}{map[string]interface{}{
+		"name":      secret.Name,
+		"namespace": secret.Namespace,
+	}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("data", data)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("binary_data", binaryData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("wo_data", flattenWriteOnlyData(secret.Data, configuredWriteOnlyData))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceKubernetesSecretV1DataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	name := metadata.Name
+	namespace := metadata.Namespace
+
+	_, err = conn.CoreV1().Secrets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			if d.Id() == "" {
+				// The secret is gone, and the keys we managed with it.
+				return nil
+			}
+			return diag.Errorf("The secret %q does not exist in namespace %q", name, namespace)
+		}
+		return diag.FromErr(err)
+	}
+
+	data := map[string]interface{}{}
+	if d.Id() != "" {
+		// Applying an empty data map removes the keys owned by our field manager.
+		data = base64EncodeStringMap(d.Get("data").(map[string]interface{}))
+		for k, v := range d.Get("binary_data").(map[string]interface{}) {
+			if _, ok := data[k]; ok {
+				return diag.Errorf("Key %q cannot be set in both data and binary_data", k)
+			}
+			data[k] = v
+		}
+		for k, v := range expandWriteOnlyData(d.GetRawConfig()) {
+			if _, ok := data[k]; ok {
+				return diag.Errorf("Key %q cannot be set in both wo_data and binary_data", k)
+			}
+			data[k] = base64.StdEncoding.EncodeToString([]byte(v))
+		}
+	}
+
+	patch := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":      name,
+			"namespace": namespace,
+		},
+		"data": data,
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return diag.Errorf("Failed to marshal secret data: %s", err)
+	}
+
+	log.Printf("[INFO] Applying data to secret %q", name)
+	_, err = conn.CoreV1().Secrets(namespace).Patch(ctx, name, types.ApplyPatchType, patchBytes, metav1.PatchOptions{
+		FieldManager: d.Get("field_manager").(string),
+		Force:        ptrToBool(d.Get("force").(bool)),
+	})
+	if err != nil {
+		return applyErrorDiagnostics(err, d.Get("field_manager").(string))
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+	return resourceKubernetesSecretV1DataRead(ctx, d, meta)
+}
+
+func resourceKubernetesSecretV1DataDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return resourceKubernetesSecretV1DataUpdate(ctx, d, meta)
+}
@@ -0,0 +1,205 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesSecretV1Data_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_secret_v1_data.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretV1DataConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1DataOnServer(name, map[string]string{
+						"one": "first",
+						"two": "second",
+						"bin": "\x00\x01\x02",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "field_manager", "tftest"),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "data.two", "second"),
+					resource.TestCheckResourceAttr(resourceName, "binary_data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "binary_data.bin", "AAEC"),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretV1DataConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1DataOnServer(name, map[string]string{
+						"one":   "first",
+						"two":   "second_modified",
+						"three": "third",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data.two", "second_modified"),
+					resource.TestCheckResourceAttr(resourceName, "data.three", "third"),
+					resource.TestCheckResourceAttr(resourceName, "binary_data.%", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretV1DataConfig_secretOnly(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1DataOnServer(name, map[string]string{
+						"one": "first",
+					}),
+					testAccCheckKubernetesSecretV1DataKeysRemoved(name, []string{"two", "three", "bin"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesSecretV1Data_writeOnlyData(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(10))
+	resourceName := "kubernetes_secret_v1_data.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretV1DataConfig_writeOnlyData(name, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1DataOnServer(name, map[string]string{
+						"one": "first",
+						"two": "second",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "data.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.two", hashSecretValue([]byte("second"))),
+				),
+			},
+			{
+				Config: testAccKubernetesSecretV1DataConfig_writeOnlyData(name, "second_modified"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesSecretV1DataOnServer(name, map[string]string{
+						"one": "first",
+						"two": "second_modified",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "wo_data.two", hashSecretValue([]byte("second_modified"))),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesSecretV1DataOnServer(name string, expected map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		secret, err := conn.CoreV1().Secrets("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for k, v := range expected {
+			if string(secret.Data[k]) != v {
+				return fmt.Errorf("Expected secret key %q to be %q, got %q", k, v, string(secret.Data[k]))
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckKubernetesSecretV1DataKeysRemoved(name string, keys []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		secret, err := conn.CoreV1().Secrets("default").Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if _, ok := secret.Data[k]; ok {
+				return fmt.Errorf("Expected secret key %q to be removed", k)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesSecretV1DataConfig_secretOnly(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_secret_v1" "test" {
+  metadata {
+    name = %q
+  }
+  data = {
+    "one" = "first"
+  }
+  lifecycle {
+    ignore_changes = [data]
+  }
+}
+`, name)
+}
+
+func testAccKubernetesSecretV1DataConfig_basic(name string) string {
+	return testAccKubernetesSecretV1DataConfig_secretOnly(name) + `
+resource "kubernetes_secret_v1_data" "test" {
+  metadata {
+    name = kubernetes_secret_v1.test.metadata.0.name
+  }
+  field_manager = "tftest"
+  data = {
+    "two" = "second"
+  }
+  binary_data = {
+    "bin" = "AAEC"
+  }
+}
+`
+}
+
+func testAccKubernetesSecretV1DataConfig_modified(name string) string {
+	return testAccKubernetesSecretV1DataConfig_secretOnly(name) + `
+resource "kubernetes_secret_v1_data" "test" {
+  metadata {
+    name = kubernetes_secret_v1.test.metadata.0.name
+  }
+  field_manager = "tftest"
+  data = {
+    "two"   = "second_modified"
+    "three" = "third"
+  }
+}
+`
+}
+
+func testAccKubernetesSecretV1DataConfig_writeOnlyData(name, value string) string {
+	return testAccKubernetesSecretV1DataConfig_secretOnly(name) + fmt.Sprintf(`
+resource "kubernetes_secret_v1_data" "test" {
+  metadata {
+    name = kubernetes_secret_v1.test.metadata.0.name
+  }
+  field_manager = "tftest"
+  wo_data = {
+    "two" = %q
+  }
+}
+`, value)
+}
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	pkgApi "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 )
 
 func resourceKubernetesService() *schema.Resource {
@@ -29,6 +30,7 @@ func resourceKubernetesService() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
@@ -52,6 +54,12 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
+					"allocate_load_balancer_node_ports": {
+						Type:        schema.TypeBool,
+						Description: "Defines if `NodePorts` will be automatically allocated for services with type `LoadBalancer`. It may be set to `false` if the cluster load-balancer does not rely on `NodePorts`. If the caller requests specific `NodePorts` (by specifying a value), those requests will be respected, regardless of this field. This field may only be set for services with type `LoadBalancer`. Default is `true`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-nodeport-allocation",
+						Optional:    true,
+						Computed:    true,
+					},
 					"cluster_ip": {
 						Type:        schema.TypeString,
 						Description: "The IP address of the service. It is usually assigned randomly by the master. If an address is specified manually and is not in use by others, it will be allocated to the service; otherwise, creation of the service will fail. `None` can be specified for headless services when proxying is not required. Ignored if type is `ExternalName`. More info: http://kubernetes.io/docs/user-guide/services#virtual-ips-and-service-proxies",
@@ -87,6 +95,48 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 							string(api.ServiceExternalTrafficPolicyTypeCluster),
 						}, false),
 					},
+					"internal_traffic_policy": {
+						Type:        schema.TypeString,
+						Description: "Specifies if the cluster internal traffic should be routed to all endpoints or node-local endpoints only. `Cluster` routes internal traffic to a Service to all endpoints. `Local` routes traffic to node-local endpoints only, traffic is dropped if no node-local endpoints are ready. The default value is `Cluster`. More info: https://kubernetes.io/docs/concepts/services-networking/service-traffic-policy/",
+						Optional:    true,
+						Computed:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.ServiceInternalTrafficPolicyCluster),
+							string(api.ServiceInternalTrafficPolicyLocal),
+						}, false),
+					},
+					"ip_families": {
+						Type:             schema.TypeList,
+						Description:      "IPFamilies is a list of IP families (e.g. `IPv4`, `IPv6`) assigned to this service. This field is usually assigned automatically based on cluster configuration and the `ip_family_policy` field. If this field is specified manually, the requested family is available in the cluster, and `ip_family_policy` allows it, it will be used; otherwise creation of the service will fail. This field is conditionally mutable: it allows for adding or removing a secondary IP family, but it does not allow changing the primary IP family of the service. More info: https://kubernetes.io/docs/concepts/services-networking/dual-stack/",
+						Optional:         true,
+						Computed:         true,
+						MaxItems:         2,
+						DiffSuppressFunc: suppressServerAddedIPFamily,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(api.IPv4Protocol),
+								string(api.IPv6Protocol),
+							}, false),
+						},
+					},
+					"ip_family_policy": {
+						Type:        schema.TypeString,
+						Description: "IPFamilyPolicy represents the dual-stack-ness requested or required by this Service. If there is no value provided, then this field will be set to `SingleStack`. Services can be `SingleStack` (a single IP family), `PreferDualStack` (two IP families on dual-stack configured clusters or a single IP family on single-stack clusters), or `RequireDualStack` (two IP families on dual-stack configured clusters, otherwise fail). More info: https://kubernetes.io/docs/concepts/services-networking/dual-stack/",
+						Optional:    true,
+						Computed:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.IPFamilyPolicySingleStack),
+							string(api.IPFamilyPolicyPreferDualStack),
+							string(api.IPFamilyPolicyRequireDualStack),
+						}, false),
+					},
+					"load_balancer_class": {
+						Type:        schema.TypeString,
+						Description: "The class of the load balancer implementation this Service belongs to. If specified, the value of this field must be a label-style identifier, with an optional prefix. This field can only be set when the Service type is `LoadBalancer`. If not set, the default load balancer implementation is used. This field can only be set when creating or updating a Service to type `LoadBalancer`. Once set, it can not be changed. More info: https://kubernetes.io/docs/concepts/services-networking/service/#load-balancer-class",
+						Optional:    true,
+						ForceNew:    true,
+					},
 					"load_balancer_ip": {
 						Type:         schema.TypeString,
 						Description:  "Only applies to `type = LoadBalancer`. LoadBalancer will get created with the IP specified in this field. This feature depends on whether the underlying cloud-provider supports specifying this field when a load balancer is created. This field will be ignored if the cloud-provider does not support the feature.",
@@ -109,6 +159,11 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 						MinItems:    1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
+								"app_protocol": {
+									Type:        schema.TypeString,
+									Description: "The application protocol for this port. This is used as a hint for implementations to offer richer behavior for protocols that they understand. This field follows standard Kubernetes label syntax. Valid values are either un-prefixed protocol names (e.g. `http`, `h2c`) or domain prefixed names such as `mycompany.com/my-custom-protocol`. More info: https://kubernetes.io/docs/concepts/services-networking/service/#application-protocol",
+									Optional:    true,
+								},
 								"name": {
 									Type:        schema.TypeString,
 									Description: "The name of this port within the service. All ports within the service must have unique names. Optional if only one ServicePort is defined on this service.",
@@ -220,6 +275,26 @@ func resourceKubernetesServiceSchemaV1() map[string]*schema.Schema {
 												Type:     schema.TypeString,
 												Computed: true,
 											},
+											"ports": {
+												Type:     schema.TypeList,
+												Computed: true,
+												Elem: &schema.Resource{
+													Schema: map[string]*schema.Schema{
+														"port": {
+															Type:     schema.TypeInt,
+															Computed: true,
+														},
+														"protocol": {
+															Type:     schema.TypeString,
+															Computed: true,
+														},
+														"error": {
+															Type:     schema.TypeString,
+															Computed: true,
+														},
+													},
+												},
+											},
 										},
 									},
 								},
@@ -243,6 +318,11 @@ func resourceKubernetesServiceCreate(ctx context.Context, d *schema.ResourceData
 		ObjectMeta: metadata,
 		Spec:       expandServiceSpec(d.Get("spec").([]interface{})),
 	}
+	// The zero value cannot be told apart from an unset field in the spec
+	// map and the API server only accepts the field for load balancers.
+	if v, ok := d.GetOkExists("spec.0.allocate_load_balancer_node_ports"); ok && svc.Spec.Type == api.ServiceTypeLoadBalancer {
+		svc.Spec.AllocateLoadBalancerNodePorts = ptrToBool(v.(bool))
+	}
 	log.Printf("[INFO] Creating new service: %#v", svc)
 	out, err := conn.CoreV1().Services(metadata.Namespace).Create(ctx, &svc, metav1.CreateOptions{})
 	if err != nil {
@@ -252,31 +332,9 @@ func resourceKubernetesServiceCreate(ctx context.Context, d *schema.ResourceData
 	d.SetId(buildId(out.ObjectMeta))
 
 	if out.Spec.Type == api.ServiceTypeLoadBalancer && d.Get("wait_for_load_balancer").(bool) {
-		log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
-
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			svc, err := conn.CoreV1().Services(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
-			if err != nil {
-				log.Printf("[DEBUG] Received error: %#v", err)
-				return resource.NonRetryableError(err)
-			}
-
-			lbIngress := svc.Status.LoadBalancer.Ingress
-
-			log.Printf("[INFO] Received service status: %#v", svc.Status)
-			if len(lbIngress) > 0 {
-				return nil
-			}
-
-			return resource.RetryableError(fmt.Errorf(
-				"Waiting for service %q to assign IP/hostname for a load balancer", d.Id()))
-		})
-		if err != nil {
-			lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Service", 3)
-			if wErr != nil {
-				return diag.FromErr(wErr)
-			}
-			return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+		diags := waitForServiceLoadBalancer(ctx, conn, out, d.Timeout(schema.TimeoutCreate))
+		if diags.HasError() {
+			return diags
 		}
 	}
 
@@ -309,7 +367,7 @@ func resourceKubernetesServiceRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received service: %#v", svc)
-	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -368,6 +426,13 @@ func resourceKubernetesServiceUpdate(ctx context.Context, d *schema.ResourceData
 	log.Printf("[INFO] Submitted updated service: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
+	if out.Spec.Type == api.ServiceTypeLoadBalancer && d.HasChange("spec.0.type") && d.Get("wait_for_load_balancer").(bool) {
+		diags := waitForServiceLoadBalancer(ctx, conn, out, d.Timeout(schema.TimeoutUpdate))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
 	return resourceKubernetesServiceRead(ctx, d, meta)
 }
 
@@ -431,3 +496,36 @@ func resourceKubernetesServiceExists(ctx context.Context, d *schema.ResourceData
 	}
 	return true, err
 }
+
+// waitForServiceLoadBalancer waits for the load balancer of the service to
+// be assigned an IP or hostname. On timeout the warning events of the
+// service are included in the error to explain why it was not provisioned.
+func waitForServiceLoadBalancer(ctx context.Context, conn *kubernetes.Clientset, out *api.Service, timeout time.Duration) diag.Diagnostics {
+	log.Printf("[DEBUG] Waiting for load balancer to assign IP/hostname")
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		svc, err := conn.CoreV1().Services(out.Namespace).Get(ctx, out.Name, metav1.GetOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] Received error: %#v", err)
+			return resource.NonRetryableError(err)
+		}
+
+		lbIngress := svc.Status.LoadBalancer.Ingress
+
+		log.Printf("[INFO] Received service status: %#v", svc.Status)
+		if len(lbIngress) > 0 {
+			return nil
+		}
+
+		return resource.RetryableError(fmt.Errorf(
+			"Waiting for service %q to assign IP/hostname for a load balancer", buildId(out.ObjectMeta)))
+	})
+	if err != nil {
+		lastWarnings, wErr := getLastWarningsForObject(ctx, conn, out.ObjectMeta, "Service", 3)
+		if wErr != nil {
+			return diag.FromErr(wErr)
+		}
+		return diag.Errorf("%s%s", err, stringifyEvents(lastWarnings))
+	}
+	return nil
+}
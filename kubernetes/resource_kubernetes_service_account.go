@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -69,8 +70,10 @@ func resourceKubernetesServiceAccount() *schema.Resource {
 				Default:     true,
 			},
 			"default_secret_name": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "Name of the token secret generated for the service account. Always empty on Kubernetes 1.24 and later, which no longer generate token secrets.",
+				Computed:    true,
+				Deprecated:  "Kubernetes 1.24 and later no longer generate token secrets for service accounts. Use the kubernetes_service_account_token resource to request a token instead.",
 			},
 		},
 	}
@@ -97,19 +100,55 @@ func resourceKubernetesServiceAccountCreate(ctx context.Context, d *schema.Resou
 	log.Printf("[INFO] Submitted new service account: %#v", out)
 	d.SetId(buildId(out.ObjectMeta))
 
-	secret, err := getServiceAccountDefaultSecret(ctx, out.Name, svcAcc, d.Timeout(schema.TimeoutCreate), conn)
+	defaultSecretName, err := waitForServiceAccountDefaultSecretName(ctx, out.Name, svcAcc, d.Timeout(schema.TimeoutCreate), meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("default_secret_name", secret.Name)
+	err = d.Set("default_secret_name", defaultSecretName)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	return resourceKubernetesServiceAccountRead(ctx, d, meta)
 }
 
-func getServiceAccountDefaultSecret(ctx context.Context, name string, config api.ServiceAccount, timeout time.Duration, conn *kubernetes.Clientset) (*api.Secret, error) {
+// serviceAccountTokenNoAutoGenerationVersion is the first Kubernetes version
+// which no longer generates a token secret for every service account, see
+// LegacyServiceAccountTokenNoAutoGeneration.
+var serviceAccountTokenNoAutoGenerationVersion = gversion.Must(gversion.NewVersion("1.24.0"))
+
+// serviceAccountTokenSecretsAutoGenerated reports whether a cluster of the given
+// version generates a token secret for every service account. Pre-release and
+// vendor suffixes such as "-gke.100" are ignored.
+func serviceAccountTokenSecretsAutoGenerated(v *gversion.Version) bool {
+	return v.Core().LessThan(serviceAccountTokenNoAutoGenerationVersion)
+}
+
+// waitForServiceAccountDefaultSecretName returns the name of the token secret
+// generated for the service account, or an empty name on clusters which no
+// longer generate them.
+func waitForServiceAccountDefaultSecretName(ctx context.Context, name string, config api.ServiceAccount, timeout time.Duration, meta interface{}) (string, error) {
+	v, err := meta.(KubeClientsets).ServerVersion()
+	if err != nil {
+		return "", err
+	}
+	if !serviceAccountTokenSecretsAutoGenerated(v) {
+		log.Printf("[INFO] Kubernetes %s does not generate token secrets, not waiting for the default secret of %s/%s", v, config.Namespace, name)
+		return "", nil
+	}
+
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return "", err
+	}
+	secret, err := getServiceAccountDefaultSecret(ctx, name, config, timeout, conn)
+	if err != nil {
+		return "", err
+	}
+	return secret.Name, nil
+}
+
+func getServiceAccountDefaultSecret(ctx context.Context, name string, config api.ServiceAccount, timeout time.Duration, conn kubernetes.Interface) (*api.Secret, error) {
 	var svcAccTokens []api.Secret
 	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 		resp, err := conn.CoreV1().ServiceAccounts(config.Namespace).Get(ctx, name, metav1.GetOptions{})
@@ -262,7 +301,7 @@ func resourceKubernetesServiceAccountRead(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 	log.Printf("[INFO] Received service account: %#v", svcAcc)
-	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(svcAcc.ObjectMeta, d, meta))
 	if err != nil {
 		return diag.FromErr(err)
 	}
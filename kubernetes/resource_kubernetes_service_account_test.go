@@ -5,12 +5,15 @@ import (
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestAccKubernetesServiceAccount_basic(t *testing.T) {
@@ -19,7 +22,10 @@ func TestAccKubernetesServiceAccount_basic(t *testing.T) {
 	resourceName := "kubernetes_service_account.test"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		IDRefreshName:     resourceName,
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
@@ -70,7 +76,10 @@ func TestAccKubernetesServiceAccount_automount(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		IDRefreshName:     "kubernetes_service_account.test",
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
@@ -115,7 +124,10 @@ func TestAccKubernetesServiceAccount_update(t *testing.T) {
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		IDRefreshName:     "kubernetes_service_account.test",
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
@@ -210,7 +222,10 @@ func TestAccKubernetesServiceAccount_generatedName(t *testing.T) {
 	prefix := "tf-acc-test-gen-"
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionGreaterThanOrEqual(t, "1.24.0")
+		},
 		IDRefreshName:     "kubernetes_service_account.test",
 		ProviderFactories: testAccProviderFactories,
 		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
@@ -239,6 +254,107 @@ func TestAccKubernetesServiceAccount_generatedName(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesServiceAccount_noTokenSecret(t *testing.T) {
+	var conf api.ServiceAccount
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceAccountConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceAccountExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "secret.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_secret_name", ""),
+					testAccCheckServiceAccountSecrets(&conf, []*regexp.Regexp{
+						regexp.MustCompile("^" + name + "-one$"),
+						regexp.MustCompile("^" + name + "-two$"),
+					}),
+				),
+			},
+			{
+				Config: testAccKubernetesServiceAccountConfig_modified(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceAccountExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "default_secret_name", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestServiceAccountTokenSecretsAutoGenerated(t *testing.T) {
+	cases := map[string]bool{
+		"v1.23.17":       true,
+		"v1.23.0-rc.1":   true,
+		"v1.24.0":        false,
+		"v1.24.0-gke.10": false,
+		"v1.28.15+k3s1":  false,
+	}
+	for vs, expected := range cases {
+		t.Run(vs, func(t *testing.T) {
+			v, err := gversion.NewVersion(vs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out := serviceAccountTokenSecretsAutoGenerated(v); out != expected {
+				t.Errorf("Expected %t, got %t", expected, out)
+			}
+		})
+	}
+}
+
+func TestGetServiceAccountDefaultSecret(t *testing.T) {
+	config := api.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Secrets:    []api.ObjectReference{{Name: "test-one"}},
+	}
+
+	t.Run("generated", func(t *testing.T) {
+		conn := fake.NewSimpleClientset(
+			&api.ServiceAccount{
+				ObjectMeta: config.ObjectMeta,
+				Secrets:    []api.ObjectReference{{Name: "test-one"}, {Name: "test-token-abcde"}},
+			},
+			&api.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-token-abcde", Namespace: "default"},
+				Type:       api.SecretTypeServiceAccountToken,
+			},
+			&api.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "other-token-fghij", Namespace: "default"},
+				Type:       api.SecretTypeServiceAccountToken,
+			},
+		)
+		secret, err := getServiceAccountDefaultSecret(context.Background(), "test", config, time.Second, conn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if secret.Name != "test-token-abcde" {
+			t.Errorf("Expected default secret %q, got %q", "test-token-abcde", secret.Name)
+		}
+	})
+
+	t.Run("not generated", func(t *testing.T) {
+		conn := fake.NewSimpleClientset(&api.ServiceAccount{
+			ObjectMeta: config.ObjectMeta,
+			Secrets:    config.Secrets,
+		})
+		_, err := getServiceAccountDefaultSecret(context.Background(), "test", config, time.Second, conn)
+		if err == nil {
+			t.Fatal("Expected waiting for the default secret to time out")
+		}
+	})
+}
+
 func testAccCheckServiceAccountImagePullSecrets(m *api.ServiceAccount, expected []*regexp.Regexp) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if len(expected) == 0 && len(m.ImagePullSecrets) == 0 {
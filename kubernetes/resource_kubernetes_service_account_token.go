@@ -0,0 +1,238 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func resourceKubernetesServiceAccountToken() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesServiceAccountTokenCreate,
+		ReadContext:   resourceKubernetesServiceAccountTokenRead,
+		UpdateContext: resourceKubernetesServiceAccountTokenUpdate,
+		DeleteContext: resourceKubernetesServiceAccountTokenDelete,
+		CustomizeDiff: resourceKubernetesServiceAccountTokenCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"metadata": {
+				Type:        schema.TypeList,
+				Description: "Metadata identifying the service account to request a token for.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The name of the service account.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validateName,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "The namespace of the service account.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "default",
+						},
+					},
+				},
+			},
+			"audiences": {
+				Type:        schema.TypeList,
+				Description: "The intended audiences of the token. When omitted the audiences of the API server are used.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expiration_seconds": {
+				Type:         schema.TypeInt,
+				Description:  "The requested duration of validity of the token. The API server may return a token with a shorter validity. When omitted the API server default of one hour is used.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(600),
+			},
+			"bound_object_ref": {
+				Type:        schema.TypeList,
+				Description: "A reference to an object the token is bound to. The token is invalidated when the object is deleted.",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:         schema.TypeString,
+							Description:  "The kind of the referent. Valid values are `Pod`, `Secret` and `Node`.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Pod", "Secret", "Node"}, false),
+						},
+						"api_version": {
+							Type:        schema.TypeString,
+							Description: "The API version of the referent.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "v1",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the referent.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Description: "The UID of the referent.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"rotate_before": {
+				Type:         schema.TypeString,
+				Description:  "A duration, such as `24h`, before the expiration of the token at which a plan replaces it with a new token.",
+				Optional:     true,
+				ValidateFunc: validateServiceAccountTokenRotateBefore,
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Description: "The service account token.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Description: "The time the token expires, in RFC3339 format.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func validateServiceAccountTokenRotateBefore(value interface{}, key string) (ws []string, es []error) {
+	v, err := time.ParseDuration(value.(string))
+	if err != nil {
+		es = append(es, fmt.Errorf("%s (%q) is not a valid duration: %s", key, value, err))
+	} else if v < 0 {
+		es = append(es, fmt.Errorf("%s (%q) must not be negative", key, value))
+	}
+	return
+}
+
+// resourceKubernetesServiceAccountTokenCustomizeDiff replaces the token when it
+// expires within rotate_before. Tokens are minted once at create time, so this
+// is the only way for a plan to pick up a new one.
+func resourceKubernetesServiceAccountTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	v := d.Get("rotate_before").(string)
+	if v == "" {
+		return nil
+	}
+	rotateBefore, err := time.ParseDuration(v)
+	if err != nil {
+		return err
+	}
+	expiration, err := time.Parse(time.RFC3339, d.Get("expiration").(string))
+	if err != nil {
+		log.Printf("[WARN] Skipping rotation check of service account token %s: %s", d.Id(), err)
+		return nil
+	}
+	if !serviceAccountTokenNeedsRotation(expiration, rotateBefore, time.Now()) {
+		return nil
+	}
+	log.Printf("[INFO] Service account token %s expires at %s, replacing it", d.Id(), expiration.Format(time.RFC3339))
+	if err := d.SetNewComputed("expiration"); err != nil {
+		return err
+	}
+	if err := d.SetNewComputed("token"); err != nil {
+		return err
+	}
+	return d.ForceNew("token")
+}
+
+func serviceAccountTokenNeedsRotation(expiration time.Time, rotateBefore time.Duration, now time.Time) bool {
+	return !now.Add(rotateBefore).Before(expiration)
+}
+
+func resourceKubernetesServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace := d.Get("metadata.0.namespace").(string)
+	name := d.Get("metadata.0.name").(string)
+	req := expandServiceAccountTokenRequest(d)
+
+	log.Printf("[INFO] Requesting token for service account %s/%s", namespace, name)
+	out, err := conn.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, name, req, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to request token for service account %s/%s: %s", namespace, name, err)
+	}
+	log.Printf("[INFO] Received token for service account %s/%s expiring at %s", namespace, name, out.Status.ExpirationTimestamp)
+
+	d.SetId(buildId(metav1.ObjectMeta{Namespace: namespace, Name: name}))
+	d.Set("token", out.Status.Token)
+	d.Set("expiration", out.Status.ExpirationTimestamp.UTC().Format(time.RFC3339))
+	err = d.Set("audiences", out.Spec.Audiences)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKubernetesServiceAccountTokenRead(ctx, d, meta)
+}
+
+// resourceKubernetesServiceAccountTokenRead only checks that the service
+// account still exists. The token itself cannot be read back from the API.
+func resourceKubernetesServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Checking service account %s/%s of token", namespace, name)
+	_, err = conn.CoreV1().ServiceAccounts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] Service account %s not found, removing token from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceKubernetesServiceAccountTokenUpdate only handles rotate_before,
+// which does not change the token.
+func resourceKubernetesServiceAccountTokenUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceKubernetesServiceAccountTokenRead(ctx, d, meta)
+}
+
+// resourceKubernetesServiceAccountTokenDelete only removes the token from
+// state. Tokens cannot be revoked and stay valid until they expire or the
+// service account or bound object is deleted.
+func resourceKubernetesServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Removing token of service account %s from state", d.Id())
+	d.SetId("")
+	return nil
+}
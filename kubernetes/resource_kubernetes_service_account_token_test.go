@@ -0,0 +1,152 @@
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccKubernetesServiceAccountToken_basic(t *testing.T) {
+	var token string
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_service_account_token.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceAccountTokenConfig_basic(name, "10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.namespace", "default"),
+					resource.TestCheckResourceAttr(resourceName, "audiences.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "audiences.0", "tf-acc-test"),
+					resource.TestCheckResourceAttr(resourceName, "expiration_seconds", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration"),
+					testAccCheckKubernetesServiceAccountTokenStore(resourceName, &token),
+				),
+			},
+			{
+				// The token expires within an hour, so every plan replaces it.
+				Config:             testAccKubernetesServiceAccountTokenConfig_basic(name, "2h"),
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					testAccCheckKubernetesServiceAccountTokenChanged(resourceName, &token),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesServiceAccountToken_boundObjectRef(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_service_account_token.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.24.0")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceAccountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceAccountTokenConfig_boundObjectRef(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bound_object_ref.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bound_object_ref.0.kind", "Secret"),
+					resource.TestCheckResourceAttr(resourceName, "bound_object_ref.0.api_version", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "bound_object_ref.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "audiences.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesServiceAccountTokenStore(n string, token *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		*token = rs.Primary.Attributes["token"]
+		return nil
+	}
+}
+
+func testAccCheckKubernetesServiceAccountTokenChanged(n string, token *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.Attributes["token"] == *token {
+			return fmt.Errorf("Expected token of %s to be rotated", n)
+		}
+		expiration, err := time.Parse(time.RFC3339, rs.Primary.Attributes["expiration"])
+		if err != nil {
+			return err
+		}
+		if !expiration.After(time.Now()) {
+			return fmt.Errorf("Expected rotated token of %s to expire in the future, got %s", n, expiration)
+		}
+		return nil
+	}
+}
+
+func testAccKubernetesServiceAccountTokenConfig_basic(name, rotateBefore string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_account_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_service_account_token" "test" {
+  metadata {
+    name = kubernetes_service_account_v1.test.metadata.0.name
+  }
+  audiences          = ["tf-acc-test"]
+  expiration_seconds = 3600
+  rotate_before      = "%s"
+}
+`, name, rotateBefore)
+}
+
+func testAccKubernetesServiceAccountTokenConfig_boundObjectRef(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service_account_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_secret_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+}
+
+resource "kubernetes_service_account_token" "test" {
+  metadata {
+    name = kubernetes_service_account_v1.test.metadata.0.name
+  }
+  bound_object_ref {
+    kind = "Secret"
+    name = kubernetes_secret_v1.test.metadata.0.name
+    uid  = kubernetes_secret_v1.test.metadata.0.uid
+  }
+}
+`, name, name)
+}
@@ -208,6 +208,37 @@ func TestAccKubernetesService_loadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesService_loadBalancer_typeChange(t *testing.T) {
+	var conf api.Service
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfNoLoadBalancersAvailable(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_basic(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "ClusterIP"),
+					resource.TestCheckResourceAttr(resourceName, "status.0.load_balancer.0.ingress.#", "0"),
+				),
+			},
+			{
+				Config: testAccKubernetesServiceConfig_typeLoadBalancer(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "LoadBalancer"),
+					resource.TestMatchResourceAttr(resourceName, "status.0.load_balancer.0.ingress.#", regexp.MustCompile(`^[1-9]`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesService_loadBalancer_healthcheck(t *testing.T) {
 	var conf api.Service
 	name := acctest.RandomWithPrefix("tf-acc-test")
@@ -750,6 +781,108 @@ func TestAccKubernetesService_regression(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesService_internalTrafficPolicy(t *testing.T) {
+	var conf api.Service
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.26.0") },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_internalTrafficPolicy(name, "Local", "http"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.internal_traffic_policy", "Local"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.port.0.app_protocol", "http"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ip_families.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ip_family_policy", "SingleStack"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_load_balancer"},
+			},
+			{
+				Config: testAccKubernetesServiceConfig_internalTrafficPolicy(name, "Cluster", "kubernetes.io/h2c"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.internal_traffic_policy", "Cluster"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.port.0.app_protocol", "kubernetes.io/h2c"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesService_loadBalancerClass(t *testing.T) {
+	var conf api.Service
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.24.0") },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_loadBalancerClass(name, "example.com/internal-vip"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.type", "LoadBalancer"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.load_balancer_class", "example.com/internal-vip"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.allocate_load_balancer_node_ports", "false"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.port.0.node_port", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_for_load_balancer"},
+			},
+			{
+				Config: testAccKubernetesServiceConfig_loadBalancerClass(name, "example.com/other-vip"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.load_balancer_class", "example.com/other-vip"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesService_dualStack(t *testing.T) {
+	var conf api.Service
+	name := acctest.RandomWithPrefix("tf-acc-test")
+	resourceName := "kubernetes_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfClusterVersionLessThan(t, "1.23.0"); skipIfNotDualStack(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesServiceConfig_dualStack(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesServiceExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ip_family_policy", "PreferDualStack"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ip_families.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ip_families.0", "IPv4"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ip_families.1", "IPv6"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesService_stateUpgradeV0_loadBalancerIngress(t *testing.T) {
 	var conf1, conf2 api.Service
 	name := acctest.RandomWithPrefix("tf-acc-test")
@@ -1301,3 +1434,92 @@ func testAccKubernetesServiceConfig_generatedName(prefix string) string {
 }
 `, prefix)
 }
+
+func testAccKubernetesServiceConfig_internalTrafficPolicy(name, policy, appProtocol string) string {
+	return fmt.Sprintf(`resource "kubernetes_service" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    internal_traffic_policy = "%s"
+
+    port {
+      port         = 8080
+      target_port  = 80
+      app_protocol = "%s"
+    }
+  }
+}
+`, name, policy, appProtocol)
+}
+
+func testAccKubernetesServiceConfig_loadBalancerClass(name, class string) string {
+	return fmt.Sprintf(`resource "kubernetes_service" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    type                              = "LoadBalancer"
+    load_balancer_class               = "%s"
+    allocate_load_balancer_node_ports = false
+
+    port {
+      port        = 8080
+      target_port = 80
+    }
+  }
+
+  wait_for_load_balancer = false
+}
+`, name, class)
+}
+
+func testAccKubernetesServiceConfig_dualStack(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    ip_family_policy = "PreferDualStack"
+    ip_families      = ["IPv4"]
+
+    port {
+      port        = 8080
+      target_port = 80
+    }
+  }
+}
+`, name)
+}
+
+func testAccKubernetesServiceConfig_typeLoadBalancer(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_service" "test" {
+  metadata {
+    annotations = {
+      TestAnnotationOne = "one"
+      TestAnnotationTwo = "two"
+    }
+
+    labels = {
+      TestLabelOne   = "one"
+      TestLabelTwo   = "two"
+      TestLabelThree = "three"
+    }
+
+    name = "%s"
+  }
+
+  spec {
+    type = "LoadBalancer"
+
+    port {
+      port        = 8080
+      target_port = 80
+    }
+  }
+}
+`, name)
+}
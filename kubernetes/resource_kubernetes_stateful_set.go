@@ -10,14 +10,12 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
-	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
 func resourceKubernetesStatefulSet() *schema.Resource {
@@ -26,6 +24,7 @@ func resourceKubernetesStatefulSet() *schema.Resource {
 		ReadContext:   resourceKubernetesStatefulSetRead,
 		UpdateContext: resourceKubernetesStatefulSetUpdate,
 		DeleteContext: resourceKubernetesStatefulSetDelete,
+		CustomizeDiff: resourceKubernetesStatefulSetCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -66,7 +65,58 @@ func resourceKubernetesStatefulSetSchemaV1() map[string]*schema.Schema {
 			Default:     true,
 			Optional:    true,
 		},
+		"recreate_strategy": {
+			Type:         schema.TypeString,
+			Description:  "How to apply changes to fields that cannot be updated in place (`service_name`, `pod_management_policy` and `volume_claim_template`). When set to `orphan`, the stateful set is deleted leaving its pods running, recreated with the new spec and the pods are adopted by the new stateful set. By default, the resource is replaced.",
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{statefulSetRecreateStrategyOrphan}, false),
+		},
+	}
+}
+
+const statefulSetRecreateStrategyOrphan = "orphan"
+
+// statefulSetRecreateKeys are the spec fields the API server does not allow
+// to update.
+var statefulSetRecreateKeys = []string{
+	"spec.0.service_name",
+	"spec.0.pod_management_policy",
+	"spec.0.volume_claim_template",
+}
+
+// resourceKubernetesStatefulSetCustomizeDiff replaces the stateful set when
+// immutable fields change, unless they are recreated by orphaning the pods.
+func resourceKubernetesStatefulSetCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := podSpecCustomizeDiff("spec.0.template.0.spec")(ctx, d, meta); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return nil
+	}
+
+	var changed []string
+	for _, key := range statefulSetRecreateKeys {
+		if d.HasChange(key) {
+			changed = append(changed, key)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	if d.Get("recreate_strategy").(string) != statefulSetRecreateStrategyOrphan {
+		for _, key := range changed {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if d.HasChange("spec.0.selector") {
+		return fmt.Errorf("recreate_strategy %q cannot be used when spec.0.selector changes, the existing pods could not be adopted by the new stateful set", statefulSetRecreateStrategyOrphan)
 	}
+	return nil
 }
 
 func resourceKubernetesStatefulSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -102,8 +152,7 @@ func resourceKubernetesStatefulSetCreate(ctx context.Context, d *schema.Resource
 		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", id)
 		namespace := out.ObjectMeta.Namespace
 		name := out.ObjectMeta.Name
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
+		err = waitForStatefulSetRollout(ctx, conn, namespace, name, d.Timeout(schema.TimeoutCreate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -167,18 +216,19 @@ func resourceKubernetesStatefulSetRead(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 	log.Printf("[INFO] Received stateful set: %#v", statefulSet)
-	if d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d)) != nil {
+	if d.Set("metadata", flattenMetadata(statefulSet.ObjectMeta, d, meta)) != nil {
 		return diag.Errorf("Error setting `metadata`: %+v", err)
 	}
-	sss, err := flattenStatefulSetSpec(statefulSet.Spec, d)
+	sss, err := flattenStatefulSetSpec(statefulSet.Spec, d, meta)
 	if err != nil {
 		return diag.Errorf("Error flattening `spec`: %+v", err)
 	}
+	diags := keepDroppedResourceClaims(d, "spec", sss, "0.template.0.spec.0")
 	err = d.Set("spec", sss)
 	if err != nil {
 		return diag.Errorf("Error setting `spec`: %+v", err)
 	}
-	return nil
+	return diags
 }
 
 func resourceKubernetesStatefulSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -191,6 +241,15 @@ func resourceKubernetesStatefulSetUpdate(ctx context.Context, d *schema.Resource
 	if err != nil {
 		return diag.Errorf("Error parsing resource ID: %#v", err)
 	}
+	if d.HasChanges(statefulSetRecreateKeys...) {
+		// The CustomizeDiff replaces the resource on these changes unless
+		// the orphan recreate strategy is used.
+		if err := recreateStatefulSetOrphaningPods(ctx, conn, d); err != nil {
+			return diag.FromErr(err)
+		}
+		return resourceKubernetesStatefulSetRead(ctx, d, meta)
+	}
+
 	ops := patchMetadata("metadata.0.", "/metadata/", d)
 
 	if d.HasChange("spec") {
@@ -215,12 +274,10 @@ func resourceKubernetesStatefulSetUpdate(ctx context.Context, d *schema.Resource
 
 	if d.Get("wait_for_rollout").(bool) {
 		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", d.Id())
-		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate),
-			retryUntilStatefulSetRolloutComplete(ctx, conn, namespace, name))
+		err = waitForStatefulSetRollout(ctx, conn, namespace, name, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		return diag.Diagnostics{}
 	}
 
 	return resourceKubernetesStatefulSetRead(ctx, d, meta)
@@ -265,49 +322,142 @@ func resourceKubernetesStatefulSetDelete(ctx context.Context, d *schema.Resource
 	return nil
 }
 
-// retryUntilStatefulSetRolloutComplete checks if a given job finished its execution and is either in 'Complete' or 'Failed' state.
-func retryUntilStatefulSetRolloutComplete(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
-	return func() *resource.RetryError {
-		res, err := conn.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
-		if err != nil {
-			return resource.NonRetryableError(err)
-		}
+// recreateStatefulSetOrphaningPods deletes the StatefulSet leaving its pods
+// running, creates it again from the configuration and waits until the new
+// StatefulSet adopted the pods.
+func recreateStatefulSetOrphaningPods(ctx context.Context, conn *kubernetes.Clientset, d *schema.ResourceData) error {
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
 
-		if res.Status.ReadyReplicas != *res.Spec.Replicas {
-			return resource.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out", ns, name))
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	metadata.Name = name
+	metadata.Namespace = namespace
+	spec, err := expandStatefulSetSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		return err
+	}
+	orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &spec.Template.Spec)
+	// Keep the replica count of the live StatefulSet when it is left to
+	// other controllers, e.g. a HorizontalPodAutoscaler, so the recreated
+	// one does not scale the orphaned pods.
+	if !isConfigured(d, "spec", "replicas") {
+		live, err := conn.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("Failed to read StatefulSet %s: %s", d.Id(), err)
+		}
+		if err == nil {
+			spec.Replicas = live.Spec.Replicas
 		}
+	}
 
-		// NOTE: This is what kubectl uses to determine if a rollout is done.
-		// We are using this here because the logic for determining if a StatefulSet
-		// is done is gnarly and we don't want to duplicate it in the provider.
-		gvk := appsv1.SchemeGroupVersion.WithKind("StatefulSet")
-		gk := gvk.GroupKind()
-		statusViewer, err := polymorphichelpers.StatusViewerFor(gk)
+	log.Printf("[INFO] Deleting StatefulSet %s and orphaning its pods", d.Id())
+	orphan := metav1.DeletePropagationOrphan
+	err = conn.AppsV1().StatefulSets(namespace).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &orphan})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		_, err := conn.AppsV1().StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
 			return resource.NonRetryableError(err)
 		}
+		return resource.RetryableError(fmt.Errorf("StatefulSet %s still exists", d.Id()))
+	})
+	if err != nil {
+		return err
+	}
+
+	statefulSet := appsv1.StatefulSet{
+		ObjectMeta: metadata,
+		Spec:       *spec,
+	}
+	log.Printf("[INFO] Recreating StatefulSet: %#v", statefulSet)
+	out, err := conn.AppsV1().StatefulSets(namespace).Create(ctx, &statefulSet, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("Failed to recreate StatefulSet %s, its pods are left running without a controller: %s", d.Id(), err)
+	}
+	log.Printf("[INFO] Submitted recreated StatefulSet: %#v", out)
+
+	err = waitForStatefulSetPodsAdoption(ctx, conn, out, timeout)
+	if err != nil {
+		return err
+	}
+
+	if d.Get("wait_for_rollout").(bool) {
+		log.Printf("[INFO] Waiting for StatefulSet %s to rollout", d.Id())
+		return waitForStatefulSetRollout(ctx, conn, namespace, name, timeout)
+	}
+	return nil
+}
 
-		obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(res)
+// waitForStatefulSetPodsAdoption waits until all the running pods matching
+// the selector of the StatefulSet are controlled by it.
+func waitForStatefulSetPodsAdoption(ctx context.Context, conn *kubernetes.Clientset, sts *appsv1.StatefulSet, timeout time.Duration) error {
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return err
+	}
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		pods, err := conn.CoreV1().Pods(sts.Namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
+		orphans := 0
+		for _, pod := range pods.Items {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+			if ref := metav1.GetControllerOf(&pod); ref == nil || ref.UID != sts.UID {
+				orphans++
+			}
+		}
+		if orphans > 0 {
+			return resource.RetryableError(fmt.Errorf("Waiting for StatefulSet %s/%s to adopt %d pods", sts.Namespace, sts.Name, orphans))
+		}
+		return nil
+	})
+}
 
-		// NOTE: For some reason, the Kind and apiVersion get lost when converting to unstructured.
-		obj["apiVersion"] = gvk.GroupVersion().String()
-		obj["kind"] = gvk.Kind
-		u := unstructured.Unstructured{Object: obj}
+// waitForStatefulSetRollout waits until the StatefulSet finished rolling
+// out, honoring partitioned rolling updates.
+func waitForStatefulSetRollout(ctx context.Context, conn *kubernetes.Clientset, ns, name string, timeout time.Duration) error {
+	start := time.Now()
+	err := resource.RetryContext(ctx, timeout, retryUntilStatefulSetRolloutComplete(ctx, conn, ns, name))
+	if !rolloutTimedOut(ctx, start, timeout, err) {
+		return err
+	}
 
-		// NOTE: the revision parameter of the Status function below is not actually used.
-		// for StatefulSet so it is set to 0 here
-		_, done, err := statusViewer.Status(&u, 0)
+	// The context of the wait expires together with the timeout, so the pods
+	// are looked up with a fresh one.
+	lctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	sts, gerr := conn.AppsV1().StatefulSets(ns).Get(lctx, name, metav1.GetOptions{})
+	if gerr != nil {
+		return err
+	}
+	return rolloutTimeoutError(lctx, conn, ns, sts.UID, sts.Spec.Selector, err)
+}
+
+// retryUntilStatefulSetRolloutComplete checks if the rollout of the StatefulSet is complete.
+func retryUntilStatefulSetRolloutComplete(ctx context.Context, conn *kubernetes.Clientset, ns, name string) resource.RetryFunc {
+	return func() *resource.RetryError {
+		res, err := conn.AppsV1().StatefulSets(ns).Get(ctx, name, metav1.GetOptions{})
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
 
+		done, progress := statefulSetRolloutComplete(res)
+		logRolloutProgress(ctx, "StatefulSet", ns, name, progress)
 		if done {
 			return nil
 		}
 
-		return resource.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out", ns, name))
+		return resource.RetryableError(fmt.Errorf("StatefulSet %s/%s is not finished rolling out: %s", ns, name, progress))
 	}
 }
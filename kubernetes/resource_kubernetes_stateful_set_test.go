@@ -3,13 +3,17 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	api "k8s.io/api/apps/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,6 +39,51 @@ func TestAccKubernetesStatefulSet_minimal(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesStatefulSet_horizontalPodAutoscaler(t *testing.T) {
+	var conf api.StatefulSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_stateful_set_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfigHorizontalPodAutoscaler(name, nginxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists(resourceName, &conf),
+					testAccWaitForScale(func() (int32, error) {
+						conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+						if err != nil {
+							return 0, err
+						}
+						scale, err := conn.AppsV1().StatefulSets(conf.Namespace).GetScale(context.Background(), conf.Name, metav1.GetOptions{})
+						if err != nil {
+							return 0, err
+						}
+						return scale.Spec.Replicas, nil
+					}, 2),
+				),
+			},
+			{
+				Config: testAccKubernetesStatefulSetConfigHorizontalPodAutoscaler(name, nginxImageVersion1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "2"),
+					func(s *terraform.State) error {
+						if *conf.Spec.Replicas != 2 {
+							return fmt.Errorf("expected the replicas set by the autoscaler to be kept, got %d", *conf.Spec.Replicas)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccKubernetesStatefulSet_basic(t *testing.T) {
 	var conf api.StatefulSet
 	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
@@ -316,6 +365,145 @@ func TestAccKubernetesStatefulSet_regression(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesStatefulSet_persistentVolumeClaimRetentionPolicy(t *testing.T) {
+	var conf1, conf2 api.StatefulSet
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_stateful_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.27.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfigRetentionPolicy(name, "2", "Retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists(resourceName, &conf1),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.ordinals.0.start", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_claim_retention_policy.0.when_deleted", "Delete"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_claim_retention_policy.0.when_scaled", "Retain"),
+					testAccCheckKubernetesPersistentVolumeClaimEventually(fmt.Sprintf("default/ss-test-%s-2", name), true),
+				),
+			},
+			{
+				Config: testAccKubernetesStatefulSetConfigRetentionPolicy(name, "2", "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.persistent_volume_claim_retention_policy.0.when_scaled", "Delete"),
+					testAccCheckKubernetesStatefulSetForceNew(&conf1, &conf2, false),
+				),
+			},
+			{
+				Config: testAccKubernetesStatefulSetConfigRetentionPolicy(name, "1", "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.replicas", "1"),
+					testAccCheckKubernetesPersistentVolumeClaimEventually(fmt.Sprintf("default/ss-test-%s-1", name), true),
+					testAccCheckKubernetesPersistentVolumeClaimEventually(fmt.Sprintf("default/ss-test-%s-2", name), false),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesStatefulSet_recreateStrategyOrphan(t *testing.T) {
+	var conf1, conf2 api.StatefulSet
+	var pod1, pod2 corev1.Pod
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_stateful_set.test"
+	podID := fmt.Sprintf("default/%s-0", name)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesStatefulSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStatefulSetConfigRecreateStrategy(name, "ss-test-service", "ss-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists(resourceName, &conf1),
+					testAccCheckKubernetesStatefulSetPodExists(podID, &pod1),
+				),
+			},
+			{
+				Config: testAccKubernetesStatefulSetConfigRecreateStrategy(name, "ss-test-service-renamed", "ss-test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStatefulSetExists(resourceName, &conf2),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.service_name", "ss-test-service-renamed"),
+					testAccCheckKubernetesStatefulSetForceNew(&conf1, &conf2, true),
+					testAccCheckKubernetesStatefulSetPodExists(podID, &pod2),
+					func(s *terraform.State) error {
+						if pod1.UID != pod2.UID {
+							return fmt.Errorf("Expecting pod %s to be kept: got UID %s, want %s", podID, pod2.UID, pod1.UID)
+						}
+						if ref := metav1.GetControllerOf(&pod2); ref == nil || ref.UID != conf2.UID {
+							return fmt.Errorf("Expecting pod %s to be adopted by the recreated stateful set", podID)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:      testAccKubernetesStatefulSetConfigRecreateStrategy(name, "ss-test-service", "ss-test-changed"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cannot be used when spec.0.selector changes`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesStatefulSetPodExists(id string, obj *corev1.Pod) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		namespace, name, err := idParts(id)
+		if err != nil {
+			return err
+		}
+		out, err := conn.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		*obj = *out
+		return nil
+	}
+}
+
+// testAccCheckKubernetesPersistentVolumeClaimEventually waits for the
+// persistent volume claim with the given ID to exist or to be gone, as the
+// StatefulSet controller creates and reclaims them asynchronously.
+func testAccCheckKubernetesPersistentVolumeClaimEventually(id string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(id)
+		if err != nil {
+			return err
+		}
+
+		return resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+			_, err := conn.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return resource.NonRetryableError(err)
+			}
+			if found := err == nil; found != exists {
+				return resource.RetryableError(fmt.Errorf("Waiting for persistent volume claim %s, exists: %t", id, found))
+			}
+			return nil
+		})
+	}
+}
+
 func testAccCheckKubernetesStatefulSetForceNew(old, new *api.StatefulSet, wantNew bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if wantNew {
@@ -427,6 +615,67 @@ func testAccKubernetesStatefulSetConfigMinimal(name, imageName string) string {
 `, name, imageName)
 }
 
+func testAccKubernetesStatefulSetConfigHorizontalPodAutoscaler(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_stateful_set_v1" "test" {
+  metadata {
+    name = "%s"
+  }
+  wait_for_rollout = false
+  spec {
+    selector {
+      match_labels = {
+        app = "ss-test"
+      }
+    }
+    service_name = "ss-test-service"
+    template {
+      metadata {
+        labels = {
+          app = "ss-test"
+        }
+      }
+      spec {
+        container {
+          name  = "ss-test"
+          image = "%s"
+          resources {
+            requests = {
+              cpu = "10m"
+            }
+          }
+        }
+      }
+    }
+  }
+}
+
+resource "kubernetes_horizontal_pod_autoscaler_v2" "test" {
+  metadata {
+    name = "%s"
+  }
+  spec {
+    min_replicas = 2
+    max_replicas = 4
+    scale_target_ref {
+      api_version = "apps/v1"
+      kind        = "StatefulSet"
+      name        = kubernetes_stateful_set_v1.test.metadata.0.name
+    }
+    metric {
+      type = "Resource"
+      resource {
+        name = "cpu"
+        target {
+          type                = "Utilization"
+          average_utilization = 80
+        }
+      }
+    }
+  }
+}
+`, name, imageName, name)
+}
+
 func testAccKubernetesStatefulSetConfigBasic(name string) string {
 	return fmt.Sprintf(`resource "kubernetes_stateful_set" "test" {
   metadata {
@@ -1193,3 +1442,108 @@ func testAccKubernetesStatefulSetConfig_afterUpdate(name, imageName string) stri
 }
 `, name, imageName)
 }
+
+func testAccKubernetesStatefulSetConfigRetentionPolicy(name, replicas, whenScaled string) string {
+	return fmt.Sprintf(`resource "kubernetes_stateful_set" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    pod_management_policy = "Parallel"
+    replicas              = %q
+
+    ordinals {
+      start = 1
+    }
+
+    persistent_volume_claim_retention_policy {
+      when_deleted = "Delete"
+      when_scaled  = %q
+    }
+
+    selector {
+      match_labels = {
+        app = "ss-test"
+      }
+    }
+
+    service_name = "ss-test-service"
+
+    template {
+      metadata {
+        labels = {
+          app = "ss-test"
+        }
+      }
+
+      spec {
+        container {
+          name  = "ss-test"
+          image = "registry.k8s.io/pause:3.9"
+
+          volume_mount {
+            name       = "ss-test"
+            mount_path = "/work-dir"
+          }
+        }
+      }
+    }
+
+    volume_claim_template {
+      metadata {
+        name = "ss-test"
+      }
+
+      spec {
+        access_modes = ["ReadWriteOnce"]
+
+        resources {
+          requests = {
+            storage = "1Gi"
+          }
+        }
+      }
+    }
+  }
+
+  wait_for_rollout = false
+}
+`, name, replicas, whenScaled)
+}
+
+func testAccKubernetesStatefulSetConfigRecreateStrategy(name, serviceName, app string) string {
+	return fmt.Sprintf(`resource "kubernetes_stateful_set" "test" {
+  metadata {
+    name = "%s"
+  }
+
+  spec {
+    service_name = %q
+
+    selector {
+      match_labels = {
+        app = %q
+      }
+    }
+
+    template {
+      metadata {
+        labels = {
+          app = %q
+        }
+      }
+
+      spec {
+        container {
+          name  = "ss-test"
+          image = "registry.k8s.io/pause:3.9"
+        }
+      }
+    }
+  }
+
+  recreate_strategy = "orphan"
+}
+`, name, serviceName, app, app)
+}
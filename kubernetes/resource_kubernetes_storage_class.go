@@ -24,17 +24,22 @@ func resourceKubernetesStorageClass() *schema.Resource {
 		UpdateContext: resourceKubernetesStorageClassUpdate,
 		DeleteContext: resourceKubernetesStorageClassDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceKubernetesStorageClassImportState,
 		},
 
 		Schema: map[string]*schema.Schema{
 			"metadata": metadataSchema("storage class", true),
 			"parameters": {
 				Type:        schema.TypeMap,
-				Description: "The parameters for the provisioner that should create volumes of this storage class",
+				Description: "The parameters for the provisioner that should create volumes of this storage class. Parameters added by the provisioner are ignored unless `reconcile_parameters` is set.",
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"reconcile_parameters": {
+				Type:        schema.TypeBool,
+				Description: "When set, all parameters of the storage class are tracked, including the ones added by the provisioner, so that any of them not present in the configuration shows up as a diff.",
+				Optional:    true,
+			},
 			"storage_provisioner": {
 				Type:        schema.TypeString,
 				Description: "Indicates the type of the provisioner",
@@ -79,10 +84,9 @@ func resourceKubernetesStorageClass() *schema.Resource {
 			},
 			"allowed_topologies": {
 				Type:        schema.TypeList,
-				Description: "Restrict the node topologies where volumes can be dynamically provisioned.",
+				Description: "Restrict the node topologies where volumes can be dynamically provisioned. Each block is a topology selector term, the terms are ORed.",
 				Optional:    true,
 				ForceNew:    true,
-				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"match_label_expressions": {
@@ -178,12 +182,15 @@ func resourceKubernetesStorageClassRead(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] Received storage class: %#v", storageClass)
 
-	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(storageClass.ObjectMeta, d, meta))
 	if err != nil {
 		diags = append(diags, diag.FromErr(err)[0])
 	}
 
 	sc := flattenStorageClass(*storageClass)
+	if !d.Get("reconcile_parameters").(bool) {
+		sc["parameters"] = filterStorageClassParameters(storageClass.Parameters, d.Get("parameters").(map[string]interface{}))
+	}
 	for k, v := range sc {
 		err = d.Set(k, v)
 		if err != nil {
@@ -250,6 +257,21 @@ func resourceKubernetesStorageClassDelete(ctx context.Context, d *schema.Resourc
 	return nil
 }
 
+// resourceKubernetesStorageClassImportState tracks all the parameters of an imported storage class,
+// since there is no way to tell the ones set by the user from the ones added by the provisioner.
+func resourceKubernetesStorageClassImportState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return nil, err
+	}
+	storageClass, err := conn.StorageV1().StorageClasses().Get(ctx, d.Id(), metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	d.Set("parameters", storageClass.Parameters)
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceKubernetesStorageClassExists(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	conn, err := meta.(KubeClientsets).MainClientset()
 	if err != nil {
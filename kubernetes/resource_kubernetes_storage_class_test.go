@@ -10,8 +10,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v1 "k8s.io/api/core/v1"
 	api "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func TestAccKubernetesStorageClass_minikube(t *testing.T) {
@@ -211,6 +213,42 @@ func TestAccKubernetesStorageClass_allowedTopologies_minikube(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesStorageClass_allowedTopologies_kind(t *testing.T) {
+	var conf api.StorageClass
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_storage_class.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfNotRunningInKind(t)
+			testAccLabelNodesWithFakeZones(t, "zone-a", "zone-b")
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesStorageClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesStorageClassConfig_allowedTopologiesKind(name, busyboxImageVersion),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesStorageClassExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "allowed_topologies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_topologies.0.match_label_expressions.0.key", "topology.kubernetes.io/zone"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_topologies.0.match_label_expressions.0.values.0", "zone-a"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_topologies.1.match_label_expressions.0.key", "kubernetes.io/hostname"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_topologies.1.match_label_expressions.0.values.0", "does-not-exist"),
+					testAccCheckKubernetesPodScheduledInZone("kubernetes_pod.test", "zone-a"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+		},
+	})
+}
+
 func TestAccKubernetesStorageClass_generatedName(t *testing.T) {
 	var conf api.StorageClass
 	prefix := "tf-acc-test-gen-"
@@ -394,6 +432,72 @@ func testAccKubernetesStorageClassConfig_generatedName(prefix, provisioner strin
 `, prefix, provisioner)
 }
 
+// testAccLabelNodesWithFakeZones spreads the nodes over the given zones through the
+// well-known zone label, and restores the original labels when the test completes.
+func testAccLabelNodesWithFakeZones(t *testing.T, zones ...string) {
+	conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.TODO()
+
+	nodes, err := conn.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, node := range nodes.Items {
+		name := node.Name
+		original, labeled := node.Labels[v1.LabelTopologyZone]
+		patch := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, v1.LabelTopologyZone, zones[i%len(zones)])
+		_, err := conn.CoreV1().Nodes().Patch(ctx, name, types.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			restore := fmt.Sprintf(`{"metadata":{"labels":{%q:null}}}`, v1.LabelTopologyZone)
+			if labeled {
+				restore = fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, v1.LabelTopologyZone, original)
+			}
+			_, err := conn.CoreV1().Nodes().Patch(context.TODO(), name, types.MergePatchType, []byte(restore), metav1.PatchOptions{})
+			if err != nil {
+				t.Errorf("Failed to restore the zone label of node %s: %s", name, err)
+			}
+		})
+	}
+}
+
+func testAccCheckKubernetesPodScheduledInZone(n, zone string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn, err := testAccProvider.Meta().(KubeClientsets).MainClientset()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		pod, err := conn.CoreV1().Pods(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		node, err := conn.CoreV1().Nodes().Get(ctx, pod.Spec.NodeName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if node.Labels[v1.LabelTopologyZone] != zone {
+			return fmt.Errorf("Expected pod %s to be scheduled in zone %q, node %s is in zone %q", rs.Primary.ID, zone, node.Name, node.Labels[v1.LabelTopologyZone])
+		}
+		return nil
+	}
+}
+
 func testAccKubernetesStorageClassConfig_allowedTopologies(name, provisioner string) string {
 	return fmt.Sprintf(`resource "kubernetes_storage_class" "test" {
   metadata {
@@ -413,3 +517,65 @@ func testAccKubernetesStorageClassConfig_allowedTopologies(name, provisioner str
 }
 `, name, provisioner)
 }
+
+func testAccKubernetesStorageClassConfig_allowedTopologiesKind(name, imageName string) string {
+	return fmt.Sprintf(`resource "kubernetes_storage_class" "test" {
+  metadata {
+    name = %[1]q
+  }
+  storage_provisioner = "rancher.io/local-path"
+  volume_binding_mode = "WaitForFirstConsumer"
+  allowed_topologies {
+    match_label_expressions {
+      key    = "topology.kubernetes.io/zone"
+      values = ["zone-a"]
+    }
+  }
+  allowed_topologies {
+    match_label_expressions {
+      key    = "kubernetes.io/hostname"
+      values = ["does-not-exist"]
+    }
+  }
+}
+
+resource "kubernetes_persistent_volume_claim" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    access_modes       = ["ReadWriteOnce"]
+    storage_class_name = kubernetes_storage_class.test.metadata.0.name
+    resources {
+      requests = {
+        storage = "64Mi"
+      }
+    }
+  }
+  wait_until_bound = false
+}
+
+resource "kubernetes_pod" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    container {
+      name    = "test"
+      image   = %[2]q
+      command = ["sleep", "3600"]
+      volume_mount {
+        name       = "data"
+        mount_path = "/data"
+      }
+    }
+    volume {
+      name = "data"
+      persistent_volume_claim {
+        claim_name = kubernetes_persistent_volume_claim.test.metadata.0.name
+      }
+    }
+  }
+}
+`, name, imageName)
+}
@@ -0,0 +1,160 @@
+package kubernetes
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1() *schema.Resource {
+	apiDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesValidatingAdmissionPolicyBindingV1Create,
+		ReadContext:   resourceKubernetesValidatingAdmissionPolicyBindingV1Read,
+		UpdateContext: resourceKubernetesValidatingAdmissionPolicyBindingV1Update,
+		DeleteContext: resourceKubernetesValidatingAdmissionPolicyBindingV1Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("validating admission policy binding", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: apiDoc["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: validatingAdmissionPolicyBindingV1SpecFields(),
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	binding := admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationV1GroupVersion,
+			Kind:       "ValidatingAdmissionPolicyBinding",
+		},
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandValidatingAdmissionPolicyBindingV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&binding)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new ValidatingAdmissionPolicyBinding: %#v", obj)
+	out, err := dc.Resource(validatingAdmissionPolicyBindingV1Resource).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create ValidatingAdmissionPolicyBinding %q because: %s", binding.Name, err)
+	}
+	log.Printf("[INFO] Submitted new ValidatingAdmissionPolicyBinding: %#v", out)
+
+	d.SetId(out.GetName())
+
+	return resourceKubernetesValidatingAdmissionPolicyBindingV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading ValidatingAdmissionPolicyBinding %s", name)
+	out, err := dc.Resource(validatingAdmissionPolicyBindingV1Resource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] ValidatingAdmissionPolicyBinding %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read ValidatingAdmissionPolicyBinding %q because: %s", name, err)
+	}
+	binding := admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}
+	if err := fromUnstructuredObject(out, &binding); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received ValidatingAdmissionPolicyBinding: %#v", binding)
+
+	err = d.Set("metadata", flattenMetadata(binding.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenValidatingAdmissionPolicyBindingV1Spec(binding.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandValidatingAdmissionPolicyBindingV1Spec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Updating ValidatingAdmissionPolicyBinding %q: %v", name, string(data))
+	out, err := dc.Resource(validatingAdmissionPolicyBindingV1Resource).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update ValidatingAdmissionPolicyBinding %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated ValidatingAdmissionPolicyBinding: %#v", out)
+
+	return resourceKubernetesValidatingAdmissionPolicyBindingV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyBindingV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting ValidatingAdmissionPolicyBinding: %#v", name)
+	err = dc.Resource(validatingAdmissionPolicyBindingV1Resource).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete ValidatingAdmissionPolicyBinding %q because: %s", name, err)
+	}
+
+	log.Printf("[INFO] ValidatingAdmissionPolicyBinding %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
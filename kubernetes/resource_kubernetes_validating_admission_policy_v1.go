@@ -0,0 +1,211 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesValidatingAdmissionPolicyV1() *schema.Resource {
+	apiDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicy{}.SwaggerDoc()
+	statusDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicyStatus{}.SwaggerDoc()
+	warningDoc := admissionregistrationv1beta1.ExpressionWarning{}.SwaggerDoc()
+
+	return &schema.Resource{
+		CreateContext: resourceKubernetesValidatingAdmissionPolicyV1Create,
+		ReadContext:   resourceKubernetesValidatingAdmissionPolicyV1Read,
+		UpdateContext: resourceKubernetesValidatingAdmissionPolicyV1Update,
+		DeleteContext: resourceKubernetesValidatingAdmissionPolicyV1Delete,
+		CustomizeDiff: resourceKubernetesValidatingAdmissionPolicyV1CustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("validating admission policy", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: apiDoc["spec"],
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: validatingAdmissionPolicyV1SpecFields(),
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: apiDoc["status"],
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"observed_generation": {
+							Type:        schema.TypeInt,
+							Description: statusDoc["observedGeneration"],
+							Computed:    true,
+						},
+						"expression_warning": {
+							Type:        schema.TypeList,
+							Description: statusDoc["typeChecking"],
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field_ref": {
+										Type:        schema.TypeString,
+										Description: warningDoc["fieldRef"],
+										Computed:    true,
+									},
+									"warning": {
+										Type:        schema.TypeString,
+										Description: warningDoc["warning"],
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1CustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("spec.0.validation") || !d.NewValueKnown("spec.0.audit_annotation") {
+		return nil
+	}
+	if len(d.Get("spec.0.validation").([]interface{})) == 0 && len(d.Get("spec.0.audit_annotation").([]interface{})) == 0 {
+		return fmt.Errorf("spec.0: at least one `validation` or `audit_annotation` must be specified")
+	}
+	return nil
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policy := admissionregistrationv1beta1.ValidatingAdmissionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationV1GroupVersion,
+			Kind:       "ValidatingAdmissionPolicy",
+		},
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandValidatingAdmissionPolicyV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&policy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new ValidatingAdmissionPolicy: %#v", obj)
+	out, err := dc.Resource(validatingAdmissionPolicyV1Resource).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create ValidatingAdmissionPolicy %q because: %s", policy.Name, err)
+	}
+	log.Printf("[INFO] Submitted new ValidatingAdmissionPolicy: %#v", out)
+
+	d.SetId(out.GetName())
+
+	return resourceKubernetesValidatingAdmissionPolicyV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading ValidatingAdmissionPolicy %s", name)
+	out, err := dc.Resource(validatingAdmissionPolicyV1Resource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] ValidatingAdmissionPolicy %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read ValidatingAdmissionPolicy %q because: %s", name, err)
+	}
+	policy := admissionregistrationv1beta1.ValidatingAdmissionPolicy{}
+	if err := fromUnstructuredObject(out, &policy); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received ValidatingAdmissionPolicy: %#v", policy)
+
+	err = d.Set("metadata", flattenMetadata(policy.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenValidatingAdmissionPolicyV1Spec(policy.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenValidatingAdmissionPolicyV1Status(policy.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("spec") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec",
+			Value: expandValidatingAdmissionPolicyV1Spec(d.Get("spec").([]interface{})),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Updating ValidatingAdmissionPolicy %q: %v", name, string(data))
+	out, err := dc.Resource(validatingAdmissionPolicyV1Resource).Patch(ctx, name, types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update ValidatingAdmissionPolicy %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated ValidatingAdmissionPolicy: %#v", out)
+
+	return resourceKubernetesValidatingAdmissionPolicyV1Read(ctx, d, meta)
+}
+
+func resourceKubernetesValidatingAdmissionPolicyV1Delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting ValidatingAdmissionPolicy: %#v", name)
+	err = dc.Resource(validatingAdmissionPolicyV1Resource).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete ValidatingAdmissionPolicy %q because: %s", name, err)
+	}
+
+	log.Printf("[INFO] ValidatingAdmissionPolicy %s deleted", name)
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,199 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesValidatingAdmissionPolicyV1_basic(t *testing.T) {
+	name := fmt.Sprintf("acc-test-%v.terraform.io", acctest.RandString(10))
+	resourceName := "kubernetes_validating_admission_policy_v1.test"
+	bindingName := "kubernetes_validating_admission_policy_binding_v1.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			skipIfClusterVersionLessThan(t, "1.30.0")
+		},
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesValidatingAdmissionPolicyV1Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesValidatingAdmissionPolicyV1Config_basic(name, 5, "Deny"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesValidatingAdmissionPolicyV1Exists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.generation"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.resource_version"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata.0.uid"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.failure_policy", "Fail"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.match_policy", "Equivalent"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.resource_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.match_constraints.0.resource_rule.0.resources.0", "deployments"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.0.expression", "object.spec.replicas <= 5"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.variable.0.name", "replicas"),
+					resource.TestCheckResourceAttr(bindingName, "spec.0.policy_name", name),
+					resource.TestCheckResourceAttr(bindingName, "spec.0.validation_actions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      bindingName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesValidatingAdmissionPolicyV1Config_basic(name, 3, "Warn"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "spec.0.validation.0.expression", "object.spec.replicas <= 3"),
+					resource.TestCheckResourceAttr(bindingName, "spec.0.validation_actions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(bindingName, "spec.0.validation_actions.*", "Warn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesValidatingAdmissionPolicyV1_emptyExpression(t *testing.T) {
+	name := fmt.Sprintf("acc-test-%v.terraform.io", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesValidatingAdmissionPolicyV1Config_emptyExpression(name),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`to not be an empty string or whitespace`),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesValidatingAdmissionPolicyV1Destroy(s *terraform.State) error {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		var gvr = validatingAdmissionPolicyV1Resource
+		switch rs.Type {
+		case "kubernetes_validating_admission_policy_v1":
+		case "kubernetes_validating_admission_policy_binding_v1":
+			gvr = validatingAdmissionPolicyBindingV1Resource
+		default:
+			continue
+		}
+
+		_, err := dc.Resource(gvr).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("%s still exists: %s", rs.Type, rs.Primary.ID)
+		}
+		if statusErr, ok := err.(*errors.StatusError); !ok || !errors.IsNotFound(statusErr) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesValidatingAdmissionPolicyV1Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = dc.Resource(validatingAdmissionPolicyV1Resource).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesValidatingAdmissionPolicyV1Config_basic(name string, replicas int, action string) string {
+	return fmt.Sprintf(`resource "kubernetes_validating_admission_policy_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    match_constraints {
+      resource_rule {
+        api_groups   = ["apps"]
+        api_versions = ["v1"]
+        operations   = ["CREATE", "UPDATE"]
+        resources    = ["deployments"]
+      }
+    }
+    variable {
+      name       = "replicas"
+      expression = "object.spec.replicas"
+    }
+    validation {
+      expression = "object.spec.replicas <= %[2]d"
+      message    = "too many replicas"
+    }
+  }
+}
+
+resource "kubernetes_validating_admission_policy_binding_v1" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    policy_name        = kubernetes_validating_admission_policy_v1.test.metadata.0.name
+    validation_actions = [%[3]q]
+    match_resources {
+      namespace_selector {
+        match_labels = {
+          environment = "test"
+        }
+      }
+    }
+  }
+}
+`, name, replicas, action)
+}
+
+func testAccKubernetesValidatingAdmissionPolicyV1Config_emptyExpression(name string) string {
+	return fmt.Sprintf(`resource "kubernetes_validating_admission_policy_v1" "test" {
+  metadata {
+    name = %q
+  }
+  spec {
+    match_constraints {
+      resource_rule {
+        api_groups   = ["apps"]
+        api_versions = ["v1"]
+        operations   = ["CREATE"]
+        resources    = ["deployments"]
+      }
+    }
+    validation {
+      expression = " "
+    }
+  }
+}
+`, name)
+}
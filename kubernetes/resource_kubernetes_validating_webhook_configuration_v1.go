@@ -73,6 +73,15 @@ func resourceKubernetesValidatingWebhookConfigurationV1() *schema.Resource {
 								string(admissionregistrationv1.Exact),
 							}, false),
 						},
+						"match_condition": {
+							Type:        schema.TypeList,
+							Description: webhookDoc["matchConditions"],
+							Optional:    true,
+							MaxItems:    64,
+							Elem: &schema.Resource{
+								Schema: matchConditionFields(),
+							},
+						},
 						"name": {
 							Type:        schema.TypeString,
 							Description: webhookDoc["name"],
@@ -204,19 +213,21 @@ func resourceKubernetesValidatingWebhookConfigurationV1Read(ctx context.Context,
 		return diag.FromErr(err)
 	}
 
-	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d))
+	err = d.Set("metadata", flattenMetadata(cfg.ObjectMeta, d, meta))
 	if err != nil {
 		return nil
 	}
 
 	log.Printf("[DEBUG] Setting webhook to: %#v", cfg.Webhooks)
 
-	err = d.Set("webhook", flattenValidatingWebhooks(cfg.Webhooks))
+	webhooks := flattenValidatingWebhooks(cfg.Webhooks)
+	diags := preserveWebhookMatchConditions(d, webhooks)
+	err = d.Set("webhook", webhooks)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
 
 func resourceKubernetesValidatingWebhookConfigurationV1Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
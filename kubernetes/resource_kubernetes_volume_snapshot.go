@@ -0,0 +1,320 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesVolumeSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesVolumeSnapshotCreate,
+		ReadContext:   resourceKubernetesVolumeSnapshotRead,
+		UpdateContext: resourceKubernetesVolumeSnapshotUpdate,
+		DeleteContext: resourceKubernetesVolumeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("wait_until_ready", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": namespacedMetadataSchema("volume snapshot", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the desired characteristics of the snapshot. It cannot be changed after creation.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source": {
+							Type:        schema.TypeList,
+							Description: "The source of the snapshot, either an existing persistent volume claim to take a snapshot of, or a pre-provisioned volume snapshot content.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"persistent_volume_claim_name": {
+										Type:         schema.TypeString,
+										Description:  "The name of the persistent volume claim, in the namespace of the snapshot, to dynamically take a snapshot of.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.persistent_volume_claim_name", "spec.0.source.0.volume_snapshot_content_name"},
+									},
+									"volume_snapshot_content_name": {
+										Type:         schema.TypeString,
+										Description:  "The name of a pre-existing volume snapshot content representing an existing snapshot.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.persistent_volume_claim_name", "spec.0.source.0.volume_snapshot_content_name"},
+									},
+								},
+							},
+						},
+						"volume_snapshot_class_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the volume snapshot class requested by the snapshot. When not set, the snapshot controller uses the default class of the CSI driver.",
+							Optional:    true,
+							Computed:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"wait_until_ready": {
+				Type:        schema.TypeBool,
+				Description: "Terraform will wait for the snapshot to report `status.readyToUse` before considering the resource created.",
+				Optional:    true,
+				Default:     true,
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The current status of the snapshot, as reported by the snapshot controller.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bound_volume_snapshot_content_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the volume snapshot content the snapshot is bound to.",
+							Computed:    true,
+						},
+						"creation_time": {
+							Type:        schema.TypeString,
+							Description: "The time the point-in-time snapshot was taken by the storage system, in RFC3339 format.",
+							Computed:    true,
+						},
+						"ready_to_use": {
+							Type:        schema.TypeBool,
+							Description: "Whether the snapshot is ready to be used to restore a volume.",
+							Computed:    true,
+						},
+						"restore_size": {
+							Type:        schema.TypeString,
+							Description: "The minimum size of a volume restored from the snapshot, e.g. `10Gi`.",
+							Computed:    true,
+						},
+						"error": volumeSnapshotErrorSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesVolumeSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkVolumeSnapshotV1Installed(conn, volumeSnapshotV1Resource.Resource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := expandMetadata(d.Get("metadata").([]interface{}))
+	snapshot := volumeSnapshotV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volumeSnapshotV1GroupVersion,
+			Kind:       "VolumeSnapshot",
+		},
+		ObjectMeta: metadata,
+		Spec:       expandVolumeSnapshotV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&snapshot)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new volume snapshot: %#v", obj)
+	out, err := dc.Resource(volumeSnapshotV1Resource).Namespace(metadata.Namespace).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create VolumeSnapshot %q because: %s", buildId(metadata), err)
+	}
+	log.Printf("[INFO] Submitted new volume snapshot: %#v", out)
+	d.SetId(buildId(metav1.ObjectMeta{Namespace: out.GetNamespace(), Name: out.GetName()}))
+
+	if d.Get("wait_until_ready").(bool) {
+		if diags := waitForVolumeSnapshotV1Ready(ctx, meta, d.Id(), d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceKubernetesVolumeSnapshotRead(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Reading volume snapshot %s", name)
+	out, err := dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] VolumeSnapshot %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read VolumeSnapshot %q because: %s", d.Id(), err)
+	}
+	snapshot := volumeSnapshotV1{}
+	if err := fromUnstructuredObject(out, &snapshot); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received volume snapshot: %#v", snapshot)
+
+	err = d.Set("metadata", flattenMetadata(snapshot.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenVolumeSnapshotV1Spec(snapshot.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenVolumeSnapshotV1Status(snapshot.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// The spec of a volume snapshot is immutable, only the metadata is updated in place.
+func resourceKubernetesVolumeSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+	log.Printf("[INFO] Updating volume snapshot %q: %v", name, string(data))
+	out, err := dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update VolumeSnapshot %q because: %s", d.Id(), err)
+	}
+	log.Printf("[INFO] Submitted updated volume snapshot: %#v", out)
+
+	return resourceKubernetesVolumeSnapshotRead(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Deleting volume snapshot: %#v", name)
+	err = dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete VolumeSnapshot %q because: %s", d.Id(), err)
+	}
+
+	// The snapshot controller removes its finalizers once the bound content
+	// has been deleted or released, according to the deletion policy.
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		out, err := dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("VolumeSnapshot (%s) still exists with finalizers: %v", d.Id(), out.GetFinalizers())
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] VolumeSnapshot %s deleted", name)
+	d.SetId("")
+	return nil
+}
+
+func waitForVolumeSnapshotV1Ready(ctx context.Context, meta interface{}, id string, timeout time.Duration) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	namespace, name, err := idParts(id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Waiting for volume snapshot %s to be ready to use", id)
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		out, err := dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			// NOTE it is possible in some HA apiserver setups that are eventually consistent
+			// that we could get a 404 when doing a Get immediately after a Create
+			if errors.IsNotFound(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		snapshot := volumeSnapshotV1{}
+		if err := fromUnstructuredObject(out, &snapshot); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if snapshot.Status == nil {
+			return resource.RetryableError(fmt.Errorf("Waiting for VolumeSnapshot %q to be ready to use (status not reported yet)", id))
+		}
+		if snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse {
+			return nil
+		}
+		// Errors reported by the snapshot controller may be transient, the
+		// last one is surfaced when the timeout is reached.
+		if msg := volumeSnapshotErrorMessage(snapshot.Status.Error); msg != "" {
+			return resource.RetryableError(fmt.Errorf("Waiting for VolumeSnapshot %q to be ready to use (last error: %s)", id, msg))
+		}
+		return resource.RetryableError(fmt.Errorf("Waiting for VolumeSnapshot %q to be ready to use", id))
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
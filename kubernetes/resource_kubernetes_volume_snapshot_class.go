@@ -0,0 +1,197 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesVolumeSnapshotClass() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesVolumeSnapshotClassCreate,
+		ReadContext:   resourceKubernetesVolumeSnapshotClassRead,
+		UpdateContext: resourceKubernetesVolumeSnapshotClassUpdate,
+		DeleteContext: resourceKubernetesVolumeSnapshotClassDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("volume snapshot class", true),
+			"driver": {
+				Type:        schema.TypeString,
+				Description: "The name of the CSI driver that handles snapshots of this class.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Description: "Driver specific parameters passed to the CSI driver when creating snapshots of this class.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"deletion_policy": {
+				Type:         schema.TypeString,
+				Description:  "Whether the snapshot content and the physical snapshot on the storage system are kept when a volume snapshot of this class is deleted. Valid values are `Delete` and `Retain`.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(volumeSnapshotDeletionPolicies, false),
+			},
+		},
+	}
+}
+
+func resourceKubernetesVolumeSnapshotClassCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkVolumeSnapshotV1Installed(conn, volumeSnapshotClassV1Resource.Resource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	class := volumeSnapshotClassV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volumeSnapshotV1GroupVersion,
+			Kind:       "VolumeSnapshotClass",
+		},
+		ObjectMeta:     expandMetadata(d.Get("metadata").([]interface{})),
+		Driver:         d.Get("driver").(string),
+		Parameters:     expandStringMap(d.Get("parameters").(map[string]interface{})),
+		DeletionPolicy: d.Get("deletion_policy").(string),
+	}
+	obj, err := toUnstructuredObject(&class)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new volume snapshot class: %#v", obj)
+	out, err := dc.Resource(volumeSnapshotClassV1Resource).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create VolumeSnapshotClass %q because: %s", class.Name, err)
+	}
+	log.Printf("[INFO] Submitted new volume snapshot class: %#v", out)
+
+	d.SetId(out.GetName())
+
+	return resourceKubernetesVolumeSnapshotClassRead(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotClassRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading volume snapshot class %s", name)
+	out, err := dc.Resource(volumeSnapshotClassV1Resource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] VolumeSnapshotClass %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read VolumeSnapshotClass %q because: %s", name, err)
+	}
+	class := volumeSnapshotClassV1{}
+	if err := fromUnstructuredObject(out, &class); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received volume snapshot class: %#v", class)
+
+	err = d.Set("metadata", flattenMetadata(class.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.Set("driver", class.Driver)
+	d.Set("parameters", class.Parameters)
+	d.Set("deletion_policy", class.DeletionPolicy)
+
+	return nil
+}
+
+func resourceKubernetesVolumeSnapshotClassUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	if d.HasChange("deletion_policy") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/deletionPolicy",
+			Value: d.Get("deletion_policy").(string),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Updating volume snapshot class %q: %v", name, string(data))
+	out, err := dc.Resource(volumeSnapshotClassV1Resource).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update VolumeSnapshotClass %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated volume snapshot class: %#v", out)
+
+	return resourceKubernetesVolumeSnapshotClassRead(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotClassDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting volume snapshot class: %#v", name)
+	err = dc.Resource(volumeSnapshotClassV1Resource).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete VolumeSnapshotClass %q because: %s", name, err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, err := dc.Resource(volumeSnapshotClassV1Resource).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("VolumeSnapshotClass (%s) still exists", name)
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] VolumeSnapshotClass %s deleted", name)
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAccKubernetesVolumeSnapshotClass_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_volume_snapshot_class.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfNoVolumeSnapshotAPI(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesVolumeSnapshotClassDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVolumeSnapshotClassConfig_basic(name, "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesVolumeSnapshotClassExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "driver", "hostpath.csi.k8s.io"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.type", "fast"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_policy", "Delete"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version"},
+			},
+			{
+				Config: testAccKubernetesVolumeSnapshotClassConfig_basic(name, "Retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesVolumeSnapshotClassExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "deletion_policy", "Retain"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesVolumeSnapshotClass_notInstalled(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfVolumeSnapshotAPI(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccKubernetesVolumeSnapshotClassConfig_basic(name, "Delete"),
+				ExpectError: regexp.MustCompile("Volume snapshot API not installed"),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesVolumeSnapshotClassDestroy(s *terraform.State) error {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "kubernetes_volume_snapshot_class" {
+			continue
+		}
+
+		_, err = dc.Resource(volumeSnapshotClassV1Resource).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		if err == nil {
+			return fmt.Errorf("VolumeSnapshotClass still exists: %s", rs.Primary.ID)
+		}
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesVolumeSnapshotClassExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		_, err = dc.Resource(volumeSnapshotClassV1Resource).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesVolumeSnapshotClassConfig_basic(name, deletionPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_snapshot_class" "test" {
+  metadata {
+    name = %q
+  }
+  driver          = "hostpath.csi.k8s.io"
+  deletion_policy = %q
+  parameters = {
+    type = "fast"
+  }
+}
+`, name, deletionPolicy)
+}
@@ -0,0 +1,297 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	pkgApi "k8s.io/apimachinery/pkg/types"
+)
+
+func resourceKubernetesVolumeSnapshotContent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKubernetesVolumeSnapshotContentCreate,
+		ReadContext:   resourceKubernetesVolumeSnapshotContentRead,
+		UpdateContext: resourceKubernetesVolumeSnapshotContentUpdate,
+		DeleteContext: resourceKubernetesVolumeSnapshotContentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"metadata": metadataSchema("volume snapshot content", true),
+			"spec": {
+				Type:        schema.TypeList,
+				Description: "Spec defines the properties of the snapshot on the storage system. Only the deletion policy can be changed after creation.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"driver": {
+							Type:        schema.TypeString,
+							Description: "The name of the CSI driver used to create the physical snapshot on the storage system.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"deletion_policy": {
+							Type:         schema.TypeString,
+							Description:  "Whether the physical snapshot on the storage system is deleted when the volume snapshot content is deleted. Valid values are `Delete` and `Retain`.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(volumeSnapshotDeletionPolicies, false),
+						},
+						"volume_snapshot_ref": {
+							Type:        schema.TypeList,
+							Description: "The volume snapshot this content is bound to. For a pre-existing snapshot the volume snapshot must refer back to this content for the binding to happen.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Description: "The name of the volume snapshot.",
+										Required:    true,
+										ForceNew:    true,
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Description: "The namespace of the volume snapshot.",
+										Required:    true,
+										ForceNew:    true,
+									},
+								},
+							},
+						},
+						"source": {
+							Type:        schema.TypeList,
+							Description: "The source of the snapshot, either a volume to take a snapshot of or the handle of an existing snapshot on the storage system.",
+							Required:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"volume_handle": {
+										Type:         schema.TypeString,
+										Description:  "The CSI handle of the volume to dynamically take a snapshot of.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.volume_handle", "spec.0.source.0.snapshot_handle"},
+									},
+									"snapshot_handle": {
+										Type:         schema.TypeString,
+										Description:  "The CSI handle of a pre-existing snapshot on the storage system.",
+										Optional:     true,
+										ForceNew:     true,
+										ExactlyOneOf: []string{"spec.0.source.0.volume_handle", "spec.0.source.0.snapshot_handle"},
+									},
+								},
+							},
+						},
+						"volume_snapshot_class_name": {
+							Type:        schema.TypeString,
+							Description: "The name of the volume snapshot class from which this content was created.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"source_volume_mode": {
+							Type:         schema.TypeString,
+							Description:  "The mode of the volume whose snapshot is taken. Valid values are `Filesystem` and `Block`.",
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Filesystem", "Block"}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeList,
+				Description: "The current status of the snapshot content, as reported by the snapshot controller.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_handle": {
+							Type:        schema.TypeString,
+							Description: "The CSI handle of the physical snapshot on the storage system.",
+							Computed:    true,
+						},
+						"creation_time": {
+							Type:        schema.TypeString,
+							Description: "The time the point-in-time snapshot was taken by the storage system, in RFC3339 format.",
+							Computed:    true,
+						},
+						"ready_to_use": {
+							Type:        schema.TypeBool,
+							Description: "Whether the snapshot is ready to be used to restore a volume.",
+							Computed:    true,
+						},
+						"restore_size": {
+							Type:        schema.TypeInt,
+							Description: "The minimum size in bytes of a volume restored from the snapshot.",
+							Computed:    true,
+						},
+						"error": volumeSnapshotErrorSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceKubernetesVolumeSnapshotContentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn, err := meta.(KubeClientsets).MainClientset()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := checkVolumeSnapshotV1Installed(conn, volumeSnapshotContentV1Resource.Resource); err != nil {
+		return diag.FromErr(err)
+	}
+
+	content := volumeSnapshotContentV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volumeSnapshotV1GroupVersion,
+			Kind:       "VolumeSnapshotContent",
+		},
+		ObjectMeta: expandMetadata(d.Get("metadata").([]interface{})),
+		Spec:       expandVolumeSnapshotContentV1Spec(d.Get("spec").([]interface{})),
+	}
+	obj, err := toUnstructuredObject(&content)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] Creating new volume snapshot content: %#v", obj)
+	out, err := dc.Resource(volumeSnapshotContentV1Resource).Create(ctx, obj, metav1.CreateOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to create VolumeSnapshotContent %q because: %s", content.Name, err)
+	}
+	log.Printf("[INFO] Submitted new volume snapshot content: %#v", out)
+
+	d.SetId(out.GetName())
+
+	return resourceKubernetesVolumeSnapshotContentRead(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotContentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Reading volume snapshot content %s", name)
+	out, err := dc.Resource(volumeSnapshotContentV1Resource).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			log.Printf("[WARN] VolumeSnapshotContent %s not found, removing from state", name)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Received error: %#v", err)
+		return diag.Errorf("Failed to read VolumeSnapshotContent %q because: %s", name, err)
+	}
+	content := volumeSnapshotContentV1{}
+	if err := fromUnstructuredObject(out, &content); err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Received volume snapshot content: %#v", content)
+
+	err = d.Set("metadata", flattenMetadata(content.ObjectMeta, d, meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("spec", flattenVolumeSnapshotContentV1Spec(content.Spec))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("status", flattenVolumeSnapshotContentV1Status(content.Status))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKubernetesVolumeSnapshotContentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ops := patchMetadata("metadata.0.", "/metadata/", d)
+	// spec.deletionPolicy is the only editable field in Spec.
+	if d.HasChange("spec.0.deletion_policy") {
+		ops = append(ops, &ReplaceOperation{
+			Path:  "/spec/deletionPolicy",
+			Value: d.Get("spec.0.deletion_policy").(string),
+		})
+	}
+	data, err := ops.MarshalJSON()
+	if err != nil {
+		return diag.Errorf("Failed to marshal update operations: %s", err)
+	}
+
+	name := d.Id()
+	log.Printf("[INFO] Updating volume snapshot content %q: %v", name, string(data))
+	out, err := dc.Resource(volumeSnapshotContentV1Resource).Patch(ctx, name, pkgApi.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		return diag.Errorf("Failed to update VolumeSnapshotContent %q because: %s", name, err)
+	}
+	log.Printf("[INFO] Submitted updated volume snapshot content: %#v", out)
+
+	return resourceKubernetesVolumeSnapshotContentRead(ctx, d, meta)
+}
+
+func resourceKubernetesVolumeSnapshotContentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	dc, err := meta.(KubeClientsets).DynamicClient()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Id()
+
+	log.Printf("[INFO] Deleting volume snapshot content: %#v", name)
+	err = dc.Resource(volumeSnapshotContentV1Resource).Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil {
+		if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+			return nil
+		}
+		return diag.Errorf("Failed to delete VolumeSnapshotContent %q because: %s", name, err)
+	}
+
+	// With the `Delete` policy the snapshot controller removes its finalizer
+	// only after the CSI driver has deleted the physical snapshot.
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		out, err := dc.Resource(volumeSnapshotContentV1Resource).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if statusErr, ok := err.(*errors.StatusError); ok && errors.IsNotFound(statusErr) {
+				return nil
+			}
+			return resource.NonRetryableError(err)
+		}
+
+		e := fmt.Errorf("VolumeSnapshotContent (%s) still exists with finalizers: %v", name, out.GetFinalizers())
+		return resource.RetryableError(e)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[INFO] VolumeSnapshotContent %s deleted", name)
+	d.SetId("")
+	return nil
+}
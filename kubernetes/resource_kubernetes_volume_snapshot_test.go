@@ -0,0 +1,150 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// The snapshot is pre-provisioned from a content pointing at a fake handle,
+// so that the binding can be tested without a CSI driver supporting snapshots.
+func TestAccKubernetesVolumeSnapshot_preProvisioned(t *testing.T) {
+	name := fmt.Sprintf("tf-acc-test-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resourceName := "kubernetes_volume_snapshot.test"
+	contentName := "kubernetes_volume_snapshot_content.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t); skipIfNoVolumeSnapshotAPI(t) },
+		IDRefreshName:     resourceName,
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckKubernetesVolumeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesVolumeSnapshotConfig_preProvisioned(name, "Retain"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKubernetesVolumeSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "metadata.0.name", name),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.source.0.volume_snapshot_content_name", name),
+					resource.TestCheckResourceAttr(contentName, "spec.0.deletion_policy", "Retain"),
+					resource.TestCheckResourceAttr(contentName, "spec.0.source.0.snapshot_handle", name),
+					resource.TestCheckResourceAttr(contentName, "spec.0.volume_snapshot_ref.0.name", name),
+					resource.TestCheckResourceAttr(contentName, "spec.0.volume_snapshot_ref.0.namespace", "default"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "wait_until_ready", "status"},
+			},
+			{
+				ResourceName:            contentName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"metadata.0.resource_version", "status"},
+			},
+			{
+				Config: testAccKubernetesVolumeSnapshotConfig_preProvisioned(name, "Delete"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(contentName, "spec.0.deletion_policy", "Delete"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKubernetesVolumeSnapshotDestroy(s *terraform.State) error {
+	dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.TODO()
+
+	for _, rs := range s.RootModule().Resources {
+		switch rs.Type {
+		case "kubernetes_volume_snapshot":
+			namespace, name, err := idParts(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			_, err = dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err == nil {
+				return fmt.Errorf("VolumeSnapshot still exists: %s", rs.Primary.ID)
+			}
+			if !errors.IsNotFound(err) {
+				return err
+			}
+		case "kubernetes_volume_snapshot_content":
+			_, err = dc.Resource(volumeSnapshotContentV1Resource).Get(ctx, rs.Primary.ID, metav1.GetOptions{})
+			if err == nil {
+				return fmt.Errorf("VolumeSnapshotContent still exists: %s", rs.Primary.ID)
+			}
+			if !errors.IsNotFound(err) {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKubernetesVolumeSnapshotExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		dc, err := testAccProvider.Meta().(KubeClientsets).DynamicClient()
+		if err != nil {
+			return err
+		}
+		ctx := context.TODO()
+
+		namespace, name, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = dc.Resource(volumeSnapshotV1Resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+		return err
+	}
+}
+
+func testAccKubernetesVolumeSnapshotConfig_preProvisioned(name, deletionPolicy string) string {
+	return fmt.Sprintf(`resource "kubernetes_volume_snapshot_content" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    driver          = "hostpath.csi.k8s.io"
+    deletion_policy = %[2]q
+    source {
+      snapshot_handle = %[1]q
+    }
+    volume_snapshot_ref {
+      name      = %[1]q
+      namespace = "default"
+    }
+  }
+}
+
+resource "kubernetes_volume_snapshot" "test" {
+  metadata {
+    name = %[1]q
+  }
+  spec {
+    source {
+      volume_snapshot_content_name = kubernetes_volume_snapshot_content.test.metadata.0.name
+    }
+  }
+  wait_until_ready = false
+}
+`, name, deletionPolicy)
+}
@@ -0,0 +1,226 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// daemonSetRolloutComplete reports whether all the pods of the DaemonSet run
+// the current template and are ready, along with a progress message.
+func daemonSetRolloutComplete(ds *appsv1.DaemonSet) (bool, string) {
+	if ds.Generation > ds.Status.ObservedGeneration {
+		return false, "waiting for the rollout to be observed by the controller"
+	}
+	desired := ds.Status.DesiredNumberScheduled
+	if ds.Spec.UpdateStrategy.Type == appsv1.RollingUpdateDaemonSetStrategyType && ds.Status.UpdatedNumberScheduled < desired {
+		return false, fmt.Sprintf("%d of %d updated pods scheduled", ds.Status.UpdatedNumberScheduled, desired)
+	}
+	if ds.Status.NumberReady < desired {
+		return false, fmt.Sprintf("%d of %d pods ready", ds.Status.NumberReady, desired)
+	}
+	if ds.Spec.MinReadySeconds > 0 && ds.Status.NumberAvailable < desired {
+		return false, fmt.Sprintf("%d of %d pods available", ds.Status.NumberAvailable, desired)
+	}
+	return true, fmt.Sprintf("%d of %d pods ready", ds.Status.NumberReady, desired)
+}
+
+// daemonSetSurges reports whether the rolling update of the DaemonSet starts
+// the updated pod of a node before stopping the old one.
+func daemonSetSurges(ds *appsv1.DaemonSet) bool {
+	ru := ds.Spec.UpdateStrategy.RollingUpdate
+	if ds.Spec.UpdateStrategy.Type != appsv1.RollingUpdateDaemonSetStrategyType || ru == nil || ru.MaxSurge == nil {
+		return false
+	}
+	return !equivalentIntOrStrings(ru.MaxSurge.String(), "0")
+}
+
+// daemonSetSurgeComplete reports whether the old pods of a surging rollout
+// were stopped. While a node runs both its old and its updated pod, the old
+// pod keeps the node counted as ready in the status of the DaemonSet.
+func daemonSetSurgeComplete(ds *appsv1.DaemonSet, pods []corev1.Pod) (bool, string) {
+	running := int32(0)
+	for _, p := range pods {
+		if !isOwnedBy(p.ObjectMeta, ds.UID) || p.DeletionTimestamp != nil {
+			continue
+		}
+		if p.Status.Phase == corev1.PodSucceeded || p.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		running++
+	}
+	desired := ds.Status.DesiredNumberScheduled
+	if running > desired {
+		return false, fmt.Sprintf("%d of %d pods ready, %d old pods still running", ds.Status.NumberReady, desired, running-desired)
+	}
+	return true, fmt.Sprintf("%d of %d pods ready", ds.Status.NumberReady, desired)
+}
+
+// statefulSetRolloutComplete reports whether the StatefulSet finished rolling
+// out, along with a progress message. With a partitioned rolling update only
+// the pods with an ordinal at or above the partition are expected to be
+// updated, so the current and update revisions are only compared when the
+// partition is zero.
+func statefulSetRolloutComplete(sts *appsv1.StatefulSet) (bool, string) {
+	if sts.Generation > sts.Status.ObservedGeneration {
+		return false, "waiting for the rollout to be observed by the controller"
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if sts.Status.ReadyReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d pods ready", sts.Status.ReadyReplicas, replicas)
+	}
+	if sts.Spec.UpdateStrategy.Type != appsv1.RollingUpdateStatefulSetStrategyType {
+		return true, fmt.Sprintf("%d of %d pods ready", sts.Status.ReadyReplicas, replicas)
+	}
+	partition := int32(0)
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		partition = *ru.Partition
+	}
+	if partition > 0 {
+		expected := replicas - partition
+		if sts.Status.UpdatedReplicas < expected {
+			return false, fmt.Sprintf("%d of %d pods at or above partition %d updated", sts.Status.UpdatedReplicas, expected, partition)
+		}
+		return true, fmt.Sprintf("partitioned rollout complete, %d pods updated", sts.Status.UpdatedReplicas)
+	}
+	if sts.Status.UpdatedReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d pods updated", sts.Status.UpdatedReplicas, replicas)
+	}
+	if sts.Status.UpdateRevision != sts.Status.CurrentRevision {
+		return false, fmt.Sprintf("waiting for revision %s to become current", sts.Status.UpdateRevision)
+	}
+	return true, fmt.Sprintf("%d of %d pods ready", sts.Status.ReadyReplicas, replicas)
+}
+
+// replicaSetRolloutComplete reports whether the ReplicaSet runs the desired
+// number of pods and all of them are available, along with a progress
+// message. Pods left over after scaling down are counted until they are gone.
+func replicaSetRolloutComplete(rs *appsv1.ReplicaSet) (bool, string) {
+	if rs.Generation > rs.Status.ObservedGeneration {
+		return false, "waiting for the rollout to be observed by the controller"
+	}
+	replicas := int32(1)
+	if rs.Spec.Replicas != nil {
+		replicas = *rs.Spec.Replicas
+	}
+	if rs.Status.Replicas > replicas {
+		return false, fmt.Sprintf("%d old pods pending termination", rs.Status.Replicas-replicas)
+	}
+	if rs.Status.Replicas < replicas {
+		return false, fmt.Sprintf("%d of %d pods created", rs.Status.Replicas, replicas)
+	}
+	if rs.Status.ReadyReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d pods ready", rs.Status.ReadyReplicas, replicas)
+	}
+	if rs.Status.AvailableReplicas < replicas {
+		return false, fmt.Sprintf("%d of %d pods available", rs.Status.AvailableReplicas, replicas)
+	}
+	return true, fmt.Sprintf("%d of %d pods available", rs.Status.AvailableReplicas, replicas)
+}
+
+// rolloutTimedOut reports whether the resource.RetryContext wait on ctx
+// started at start ended with err because it ran for timeout or ctx expired.
+// RetryContext returns the last error of the retried function rather than a
+// *resource.TimeoutError when it times out after retryable errors.
+func rolloutTimedOut(ctx context.Context, start time.Time, timeout time.Duration, err error) bool {
+	if err == nil {
+		return false
+	}
+	if _, ok := err.(*resource.TimeoutError); ok {
+		return true
+	}
+	return ctx.Err() != nil || time.Since(start) >= timeout
+}
+
+// rolloutTimeoutError adds the pods of the workload which are not ready to
+// err, the error returned when waiting for a rollout timed out. The context
+// of the wait expires together with the timeout, so ctx must be a fresh one.
+func rolloutTimeoutError(ctx context.Context, conn kubernetes.Interface, ns string, owner types.UID, selector *metav1.LabelSelector, err error) error {
+	pods, perr := getPodsNotReady(ctx, conn, ns, owner, selector)
+	if perr != nil || len(pods) == 0 {
+		return err
+	}
+	return fmt.Errorf("%s\n\nThe following pods are not ready:\n  %s", err, strings.Join(pods, "\n  "))
+}
+
+// getPodsNotReady returns the names of the pods owned by the given object
+// which are not ready, with the reason reported by their containers.
+func getPodsNotReady(ctx context.Context, conn kubernetes.Interface, ns string, owner types.UID, selector *metav1.LabelSelector) ([]string, error) {
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := conn.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: s.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	var notReady []string
+	for _, p := range pods.Items {
+		if !isOwnedBy(p.ObjectMeta, owner) || isPodReady(p) {
+			continue
+		}
+		msg := p.Name
+		if reason := podNotReadyReason(p); reason != "" {
+			msg = fmt.Sprintf("%s (%s)", p.Name, reason)
+		}
+		notReady = append(notReady, msg)
+	}
+	sort.Strings(notReady)
+	return notReady, nil
+}
+
+func isOwnedBy(meta metav1.ObjectMeta, owner types.UID) bool {
+	for _, r := range meta.OwnerReferences {
+		if r.UID == owner {
+			return true
+		}
+	}
+	return false
+}
+
+func isPodReady(p corev1.Pod) bool {
+	for _, c := range p.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func podNotReadyReason(p corev1.Pod) string {
+	for _, cs := range p.Status.ContainerStatuses {
+		if w := cs.State.Waiting; w != nil && w.Reason != "" {
+			return fmt.Sprintf("container %s: %s", cs.Name, w.Reason)
+		}
+		if t := cs.State.Terminated; t != nil && t.Reason != "" {
+			return fmt.Sprintf("container %s: %s", cs.Name, t.Reason)
+		}
+	}
+	if p.Status.Phase == corev1.PodPending {
+		for _, c := range p.Status.Conditions {
+			if c.Type == corev1.PodScheduled && c.Status != corev1.ConditionTrue && c.Reason != "" {
+				return c.Reason
+			}
+		}
+	}
+	return string(p.Status.Phase)
+}
+
+func logRolloutProgress(ctx context.Context, kind, ns, name, progress string) {
+	tflog.Info(ctx, fmt.Sprintf("Waiting for %s %s/%s to roll out: %s", kind, ns, name, progress),
+		"namespace", ns, "name", name)
+}
@@ -0,0 +1,282 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestDaemonSetRolloutComplete(t *testing.T) {
+	ds := func(generation, observed int64, desired, updated, ready int32) *appsv1.DaemonSet {
+		d := &appsv1.DaemonSet{}
+		d.Generation = generation
+		d.Spec.UpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
+		d.Status = appsv1.DaemonSetStatus{
+			ObservedGeneration:     observed,
+			DesiredNumberScheduled: desired,
+			UpdatedNumberScheduled: updated,
+			NumberReady:            ready,
+		}
+		return d
+	}
+
+	cases := map[string]struct {
+		ds       *appsv1.DaemonSet
+		expected bool
+	}{
+		"not observed":      {ds(2, 1, 3, 3, 3), false},
+		"not updated":       {ds(2, 2, 3, 1, 3), false},
+		"not ready":         {ds(2, 2, 3, 3, 2), false},
+		"complete":          {ds(2, 2, 3, 3, 3), true},
+		"no nodes selected": {ds(1, 1, 0, 0, 0), true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, _ := daemonSetRolloutComplete(tc.ds)
+			if done != tc.expected {
+				t.Errorf("Expected rollout complete to be %t, got %t", tc.expected, done)
+			}
+		})
+	}
+}
+
+func TestDaemonSetRolloutComplete_minReadySeconds(t *testing.T) {
+	ds := &appsv1.DaemonSet{}
+	ds.Spec.MinReadySeconds = 30
+	ds.Spec.UpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
+	ds.Status = appsv1.DaemonSetStatus{
+		DesiredNumberScheduled: 3,
+		UpdatedNumberScheduled: 3,
+		NumberReady:            3,
+		NumberAvailable:        2,
+	}
+	if done, _ := daemonSetRolloutComplete(ds); done {
+		t.Errorf("Expected rollout to wait for the pods to be available")
+	}
+	ds.Status.NumberAvailable = 3
+	if done, _ := daemonSetRolloutComplete(ds); !done {
+		t.Errorf("Expected rollout to be complete")
+	}
+}
+
+func TestDaemonSetSurgeComplete(t *testing.T) {
+	surge := intstr.FromString("10%")
+	zero := intstr.FromInt(0)
+	ds := &appsv1.DaemonSet{}
+	ds.UID = "ds"
+	ds.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+		Type:          appsv1.RollingUpdateDaemonSetStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDaemonSet{MaxSurge: &surge},
+	}
+	ds.Status.DesiredNumberScheduled = 2
+	if !daemonSetSurges(ds) {
+		t.Fatalf("Expected a max surge of %s to surge", surge.String())
+	}
+
+	pod := func(owner types.UID, phase corev1.PodPhase, deleting bool) corev1.Pod {
+		p := corev1.Pod{}
+		p.OwnerReferences = []metav1.OwnerReference{{UID: owner}}
+		p.Status.Phase = phase
+		if deleting {
+			p.DeletionTimestamp = &metav1.Time{}
+		}
+		return p
+	}
+	cases := map[string]struct {
+		pods     []corev1.Pod
+		expected bool
+	}{
+		"old pod running": {[]corev1.Pod{
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodRunning, false),
+		}, false},
+		"old pod terminating": {[]corev1.Pod{
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodRunning, true),
+		}, true},
+		"other owner": {[]corev1.Pod{
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodRunning, false),
+			pod("other", corev1.PodRunning, false),
+		}, true},
+		"completed pod": {[]corev1.Pod{
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodRunning, false),
+			pod("ds", corev1.PodFailed, false),
+		}, true},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, _ := daemonSetSurgeComplete(ds, tc.pods)
+			if done != tc.expected {
+				t.Errorf("Expected surge complete to be %t, got %t", tc.expected, done)
+			}
+		})
+	}
+
+	ds.Spec.UpdateStrategy.RollingUpdate.MaxSurge = &zero
+	if daemonSetSurges(ds) {
+		t.Errorf("Expected a max surge of 0 not to surge")
+	}
+}
+
+func TestStatefulSetRolloutComplete(t *testing.T) {
+	sts := func(replicas, partition, updated, ready int32, current, update string) *appsv1.StatefulSet {
+		s := &appsv1.StatefulSet{}
+		s.Spec.Replicas = ptrToInt32(replicas)
+		s.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{
+			Type: appsv1.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+				Partition: ptrToInt32(partition),
+			},
+		}
+		s.Status = appsv1.StatefulSetStatus{
+			UpdatedReplicas: updated,
+			ReadyReplicas:   ready,
+			CurrentRevision: current,
+			UpdateRevision:  update,
+		}
+		return s
+	}
+
+	cases := map[string]struct {
+		sts      *appsv1.StatefulSet
+		expected bool
+	}{
+		"not ready":               {sts(3, 0, 3, 2, "a", "a"), false},
+		"not updated":             {sts(3, 0, 2, 3, "a", "b"), false},
+		"revision not current":    {sts(3, 0, 3, 3, "a", "b"), false},
+		"complete":                {sts(3, 0, 3, 3, "b", "b"), true},
+		"partition not updated":   {sts(3, 1, 1, 3, "a", "b"), false},
+		"partition updated":       {sts(3, 1, 2, 3, "a", "b"), true},
+		"partition above replica": {sts(3, 5, 0, 3, "a", "b"), true},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, _ := statefulSetRolloutComplete(tc.sts)
+			if done != tc.expected {
+				t.Errorf("Expected rollout complete to be %t, got %t", tc.expected, done)
+			}
+		})
+	}
+
+	t.Run("on delete", func(t *testing.T) {
+		s := sts(3, 0, 0, 3, "a", "b")
+		s.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+		if done, _ := statefulSetRolloutComplete(s); !done {
+			t.Error("Expected an OnDelete StatefulSet with ready pods to be rolled out")
+		}
+	})
+}
+
+func TestReplicaSetRolloutComplete(t *testing.T) {
+	rs := func(replicas, current, ready, available int32) *appsv1.ReplicaSet {
+		r := &appsv1.ReplicaSet{}
+		r.Spec.Replicas = ptrToInt32(replicas)
+		r.Status = appsv1.ReplicaSetStatus{
+			Replicas:          current,
+			ReadyReplicas:     ready,
+			AvailableReplicas: available,
+		}
+		return r
+	}
+
+	cases := map[string]struct {
+		rs       *appsv1.ReplicaSet
+		expected bool
+	}{
+		"not created":    {rs(3, 2, 2, 2), false},
+		"not ready":      {rs(3, 3, 2, 2), false},
+		"not available":  {rs(3, 3, 3, 2), false},
+		"scaling down":   {rs(2, 3, 3, 3), false},
+		"complete":       {rs(3, 3, 3, 3), true},
+		"scaled to zero": {rs(0, 0, 0, 0), true},
+		"unobserved":     {func() *appsv1.ReplicaSet { r := rs(3, 3, 3, 3); r.Generation = 2; return r }(), false},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			done, _ := replicaSetRolloutComplete(tc.rs)
+			if done != tc.expected {
+				t.Errorf("Expected rollout complete to be %t, got %t", tc.expected, done)
+			}
+		})
+	}
+}
+
+func TestRolloutTimedOut(t *testing.T) {
+	timeout := 100 * time.Millisecond
+
+	start := time.Now()
+	err := resource.RetryContext(context.Background(), timeout, func() *resource.RetryError {
+		return resource.RetryableError(errors.New("1 of 3 pods ready"))
+	})
+	if _, ok := err.(*resource.TimeoutError); ok {
+		t.Fatal("Expected the last error of the retried function, got a timeout error")
+	}
+	if !rolloutTimedOut(context.Background(), start, timeout, err) {
+		t.Errorf("Expected %q to be reported as a timeout", err)
+	}
+
+	start = time.Now()
+	err = resource.RetryContext(context.Background(), time.Minute, func() *resource.RetryError {
+		return resource.NonRetryableError(errors.New("forbidden"))
+	})
+	if rolloutTimedOut(context.Background(), start, time.Minute, err) {
+		t.Errorf("Expected %q not to be reported as a timeout", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if !rolloutTimedOut(ctx, time.Now(), time.Minute, errors.New("1 of 3 pods ready")) {
+		t.Error("Expected an error after the context expired to be reported as a timeout")
+	}
+	if rolloutTimedOut(ctx, time.Now(), time.Minute, nil) {
+		t.Error("Expected a successful wait not to be reported as a timeout")
+	}
+}
+
+func TestRolloutTimeoutError(t *testing.T) {
+	owner := types.UID("5fd5f1a6")
+	pod := func(name string, uid types.UID, ready corev1.ConditionStatus, waiting string) *corev1.Pod {
+		p := &corev1.Pod{}
+		p.Name = name
+		p.Namespace = "default"
+		p.Labels = map[string]string{"app": "web"}
+		p.OwnerReferences = []metav1.OwnerReference{{UID: uid}}
+		p.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}}
+		if waiting != "" {
+			p.Status.ContainerStatuses = []corev1.ContainerStatus{{
+				Name:  "web",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waiting}},
+			}}
+		}
+		return p
+	}
+	conn := fake.NewSimpleClientset(
+		pod("web-0", owner, corev1.ConditionTrue, ""),
+		pod("web-1", owner, corev1.ConditionFalse, "ImagePullBackOff"),
+		pod("other-0", "9c1e2b7d", corev1.ConditionFalse, "CrashLoopBackOff"),
+	)
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+
+	err := rolloutTimeoutError(context.Background(), conn, "default", owner, selector, errors.New("1 of 2 pods ready"))
+	expected := "1 of 2 pods ready\n\nThe following pods are not ready:\n  web-1 (container web: ImagePullBackOff)"
+	if err.Error() != expected {
+		t.Errorf("Expected error %q, got %q", expected, err)
+	}
+}
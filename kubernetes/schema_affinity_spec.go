@@ -156,6 +156,15 @@ func podAffinityTermFields() map[string]*schema.Schema {
 				Schema: labelSelectorFields(true),
 			},
 		},
+		"namespace_selector": {
+			Type:        schema.TypeList,
+			Description: "A label query over the set of namespaces that the term applies to. The term is applied to the union of the namespaces selected by this field and the ones listed in the namespaces field. An empty selector matches all namespaces, while leaving it out together with namespaces means 'this pod's namespace'.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: labelSelectorFields(true),
+			},
+		},
 		"namespaces": {
 			Type:        schema.TypeSet,
 			Description: "namespaces specifies which namespaces the labelSelector applies to (matches against); null or empty list means 'this pod's namespace'",
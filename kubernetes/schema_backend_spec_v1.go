@@ -22,7 +22,7 @@ func backendSpecFieldsV1(description string) *schema.Schema {
 							"api_group": {
 								Type:        schema.TypeString,
 								Description: "APIGroup is the group for the resource being referenced. If APIGroup is not specified, the specified Kind must be in the core API group. For any other third-party types, APIGroup is required.",
-								Required:    true,
+								Optional:    true,
 							},
 							"kind": {
 								Type:        schema.TypeString,
@@ -31,17 +31,18 @@ func backendSpecFieldsV1(description string) *schema.Schema {
 							},
 							"name": {
 								Type:        schema.TypeString,
-								Description: "The name of the User to bind to.",
+								Description: "The name of the resource.",
 								Required:    true,
 							},
 						},
 					},
-					Description: "Resource is an ObjectRef to another Kubernetes resource in the namespace of the Ingress object. If resource is specified, a service.Name and service.Port must not be specified.",
+					Description: "Resource is an ObjectRef to another Kubernetes resource in the namespace of the Ingress object. Exactly one of `resource` or `service` must be specified.",
 				},
 				"service": {
-					Type:     schema.TypeList,
-					MaxItems: 1,
-					Optional: true,
+					Type:        schema.TypeList,
+					Description: "Service references a Service as a backend. Exactly one of `resource` or `service` must be specified.",
+					MaxItems:    1,
+					Optional:    true,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"name": {
@@ -1,6 +1,8 @@
 package kubernetes
 
 import (
+	"strings"
+
 	api "k8s.io/api/core/v1"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -100,6 +102,20 @@ func handlerFields() map[string]*schema.Schema {
 
 func resourcesFieldV1() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
+		"claims": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The resource claims of the pod used by this container, for Dynamic Resource Allocation. Requires the DynamicResourceAllocation feature gate.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The name of an entry of the pod's resource_claims.",
+					},
+				},
+			},
+		},
 		"limits": {
 			Type:        schema.TypeMap,
 			Optional:    true,
@@ -265,9 +281,10 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 			Description: "Entrypoint array. Not executed within a shell. The docker image's ENTRYPOINT is used if this is not provided. Variable references $(VAR_NAME) are expanded using the container's environment. If a variable cannot be resolved, the reference in the input string will be unchanged. The $(VAR_NAME) syntax can be escaped with a double $$, ie: $$(VAR_NAME). Escaped references will never be expanded, regardless of whether the variable exists or not. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/containers#containers-and-commands",
 		},
 		"env": {
-			Type:        schema.TypeList,
-			Optional:    true,
-			Description: "List of environment variables to set in the container. Cannot be updated.",
+			Type:             schema.TypeList,
+			Optional:         true,
+			Description:      "List of environment variables to set in the container. Cannot be updated.",
+			DiffSuppressFunc: suppressReorderedContainerEnv,
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"name": {
@@ -571,6 +588,37 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 			Description: "Periodic probe of container service readiness. Container will be removed from service endpoints if the probe fails. Cannot be updated. More info: http://kubernetes.io/docs/user-guide/pod-states#container-probes",
 			Elem:        probeSchema(),
 		},
+		"resize_policy": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    !isUpdatable,
+			Description: "Resources resize policy for the container. Only applies to clusters with the InPlacePodVerticalScaling feature enabled.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"resource_name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Description: "Name of the resource to which this resize policy applies. Supported values: cpu, memory.",
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.ResourceCPU),
+							string(api.ResourceMemory),
+						}, false),
+					},
+					"restart_policy": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Description: "Restart policy to apply when the specified resource is resized. Supported values: NotRequired, RestartContainer.",
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.NotRequired),
+							string(api.RestartContainer),
+						}, false),
+					},
+				},
+			},
+		},
 		"resources": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -656,8 +704,47 @@ func containerFields(isUpdatable bool) map[string]*schema.Schema {
 	return s
 }
 
+// initContainerFields is the container schema with the restart policy which
+// turns an init container into a native sidecar.
+func initContainerFields(isUpdatable bool) map[string]*schema.Schema {
+	s := containerFields(isUpdatable)
+	s["restart_policy"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ForceNew:         !isUpdatable,
+		Description:      "Restart policy of the init container. The only allowed value is Always, which makes the init container a sidecar that keeps running for the lifetime of the pod. Requires Kubernetes 1.28 or later.",
+		DiffSuppressFunc: suppressDroppedInitContainerRestartPolicy,
+		ValidateFunc: validation.StringInSlice([]string{
+			string(api.ContainerRestartPolicyAlways),
+		}, false),
+	}
+	return s
+}
+
 func probeSchema() *schema.Resource {
 	h := handlerFields()
+	h["grpc"] = &schema.Schema{
+		Type:             schema.TypeList,
+		Optional:         true,
+		MaxItems:         1,
+		Description:      "GRPC specifies an action involving a GRPC port.",
+		DiffSuppressFunc: suppressDroppedGRPCProbe,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"port": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IsPortNumber,
+					Description:  "Number of the port to access on the container. Number must be in the range 1 to 65535.",
+				},
+				"service": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the service to place in the gRPC HealthCheckRequest (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md). If this is not specified, the default behavior is defined by gRPC.",
+				},
+			},
+		},
+	}
 	h["failure_threshold"] = &schema.Schema{
 		Type:         schema.TypeInt,
 		Optional:     true,
@@ -769,6 +856,7 @@ func securityContextSchema(isUpdatable bool) *schema.Resource {
 			Type:        schema.TypeList,
 			Description: "The seccomp options to use by the containers in this pod. Note that this field cannot be set when spec.os.name is windows.",
 			Optional:    true,
+			Computed:    true,
 			MaxItems:    1,
 			Elem: &schema.Resource{
 				Schema: seccompProfileField(isUpdatable),
@@ -789,3 +877,26 @@ func securityContextSchema(isUpdatable bool) *schema.Resource {
 		Schema: m,
 	}
 }
+
+// suppressDroppedGRPCProbe hides the grpc handler of a probe when the cluster
+// does not support gRPC probes and dropped it, which leaves the stored probe
+// without any handler.
+func suppressDroppedGRPCProbe(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	i := strings.LastIndex(k, ".grpc")
+	if i < 0 {
+		return false
+	}
+	probe := k[:i]
+	if o, _ := d.GetChange(probe); len(o.([]interface{})) == 0 {
+		return false
+	}
+	for _, h := range []string{"exec", "grpc", "http_get", "tcp_socket"} {
+		if o, _ := d.GetChange(probe + "." + h); len(o.([]interface{})) > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -2,6 +2,11 @@ package kubernetes
 
 import (
 	"fmt"
+	"strings"
+	"time"
+	// Embed the IANA time zone database so that time_zone validation does
+	// not depend on the tzdata installed on the host running Terraform.
+	_ "time/tzdata"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -72,6 +77,17 @@ func cronJobSpecFields() map[string]*schema.Schema {
 	return s
 }
 
+func cronJobSpecFieldsV1() map[string]*schema.Schema {
+	s := cronJobSpecFields()
+	s["time_zone"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validateCronJobTimeZone,
+		Description:  "The time zone name for the given schedule, see https://en.wikipedia.org/wiki/List_of_tz_database_time_zones. If not specified, this will default to the time zone of the kube-controller-manager process. Requires Kubernetes 1.27 or later.",
+	}
+	return s
+}
+
 func validateCronExpression() schema.SchemaValidateFunc {
 	return func(i interface{}, k string) (s []string, es []error) {
 		v, ok := i.(string)
@@ -79,10 +95,32 @@ func validateCronExpression() schema.SchemaValidateFunc {
 			es = append(es, fmt.Errorf("expected type of '%s' to be string", k))
 			return
 		}
+		if strings.Contains(v, "TZ") {
+			es = append(es, fmt.Errorf("'%s' must not contain TZ or CRON_TZ, use the time_zone field instead", k))
+			return
+		}
 		_, err := cron.ParseStandard(v)
 		if err != nil {
-			es = append(es, fmt.Errorf("'%s' should be an valid Cron expression", k))
+			es = append(es, fmt.Errorf("'%s' should be an valid Cron expression: %s", k, err))
 		}
 		return
 	}
 }
+
+// validateCronJobTimeZone checks the time zone the same way the API server
+// does: it must be an explicit IANA time zone name.
+func validateCronJobTimeZone(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of '%s' to be string", k))
+		return
+	}
+	if strings.EqualFold(v, "Local") {
+		es = append(es, fmt.Errorf("'%s' must be an explicit time zone as defined in https://www.iana.org/time-zones", k))
+		return
+	}
+	if _, err := time.LoadLocation(v); err != nil {
+		es = append(es, fmt.Errorf("'%s' is not a valid time zone: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,146 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	api "k8s.io/api/core/v1"
+)
+
+func schemaEndpointSliceV1Endpoint() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:        schema.TypeList,
+				Description: "The addresses of this endpoint. The format of each address must match the `address_type` of the slice. Consumers must treat the addresses as interchangeable and may use only the first one.",
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    100,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"condition": {
+				Type:        schema.TypeList,
+				Description: "The current state of the endpoint.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ready": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that this endpoint is prepared to receive traffic. Defaults to `true`.",
+							Optional:    true,
+							Default:     true,
+						},
+						"serving": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that this endpoint is able to receive traffic, regardless of whether it is terminating. Defaults to `true`.",
+							Optional:    true,
+							Default:     true,
+						},
+						"terminating": {
+							Type:        schema.TypeBool,
+							Description: "Indicates that this endpoint is terminating. Defaults to `false`.",
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+			"hostname": {
+				Type:         schema.TypeString,
+				Description:  "The hostname of this endpoint. Must be a lowercase RFC 1123 label.",
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+			"node_name": {
+				Type:        schema.TypeString,
+				Description: "The name of the node hosting this endpoint. This can be used to determine endpoints local to a node.",
+				Optional:    true,
+			},
+			"target_ref": {
+				Type:        schema.TypeList,
+				Description: "A reference to the Kubernetes object that represents this endpoint.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Description: "API version of the referent.",
+							Optional:    true,
+						},
+						"field_path": {
+							Type:        schema.TypeString,
+							Description: "If referring to a piece of an object instead of an entire object, this string should contain a valid JSON/Go field access statement, such as `spec.containers{name}`.",
+							Optional:    true,
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Description: "Kind of the referent.",
+							Optional:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the referent.",
+							Required:    true,
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Description: "Namespace of the referent.",
+							Optional:    true,
+						},
+						"resource_version": {
+							Type:        schema.TypeString,
+							Description: "Specific resourceVersion to which this reference is made, if any.",
+							Optional:    true,
+						},
+						"uid": {
+							Type:        schema.TypeString,
+							Description: "UID of the referent.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The name of the zone this endpoint exists in.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func schemaEndpointSliceV1Port() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"app_protocol": {
+				Type:        schema.TypeString,
+				Description: "The application protocol for this port. This is used as a hint for implementations to offer richer behavior for protocols that they understand.",
+				Optional:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of this port. All ports in a slice must have a unique name. If the slice is consumed by a Service, this must match the name of the corresponding service port.",
+				Optional:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "The port number of the endpoint.",
+				Required:     true,
+				ValidateFunc: validation.IsPortNumber,
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Description: "The IP protocol for this port. Supports `TCP`, `UDP` and `SCTP`. Default is `TCP`.",
+				Optional:    true,
+				Default:     string(api.ProtocolTCP),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(api.ProtocolTCP),
+					string(api.ProtocolUDP),
+					string(api.ProtocolSCTP),
+				}, false),
+			},
+		},
+	}
+}
@@ -0,0 +1,152 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaGatewayV1Listener() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the listener. This name must be unique within the Gateway.",
+				Required:    true,
+			},
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "The virtual hostname to match for protocol types that define this concept, a leading wildcard label (e.g. `*.example.com`) is allowed. When unspecified, all hostnames are matched.",
+				Optional:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "The network port the listener is bound to.",
+				Required:     true,
+				ValidateFunc: validatePortNum,
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Description: "The network protocol this listener expects to receive, e.g. `HTTP`, `HTTPS`, `TLS`, `TCP` or `UDP`. Implementations may support additional protocols.",
+				Required:    true,
+			},
+			"tls": {
+				Type:        schema.TypeList,
+				Description: "The TLS configuration for the listener. Required when the protocol is `HTTPS`, or `TLS` with the `Terminate` mode.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"mode": {
+							Type:         schema.TypeString,
+							Description:  "The TLS behavior of the listener, either `Terminate` or `Passthrough`. Defaults to `Terminate`.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"Terminate", "Passthrough"}, false),
+						},
+						"certificate_ref": {
+							Type:        schema.TypeList,
+							Description: "References to the Kubernetes objects that contain the TLS certificate and private key, typically a `kubernetes_secret_v1` of type `kubernetes.io/tls`.",
+							Optional:    true,
+							MaxItems:    64,
+							Elem:        schemaGatewayV1ObjectReference("Secret"),
+						},
+						"options": {
+							Type:        schema.TypeMap,
+							Description: "Implementation-specific TLS settings.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"allowed_routes": {
+				Type:        schema.TypeList,
+				Description: "The types of routes that may be attached to the listener and the namespaces they may come from. Defaults to routes of the protocol's kind from the namespace of the Gateway.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"namespaces": {
+							Type:        schema.TypeList,
+							Description: "The namespaces from which routes may be attached to the listener.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"from": {
+										Type:         schema.TypeString,
+										Description:  "Where routes may be attached from, one of `All`, `Same` or `Selector`. Defaults to `Same`.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"All", "Same", "Selector"}, false),
+									},
+									"selector": {
+										Type:        schema.TypeList,
+										Description: "The label selector of the namespaces routes may be attached from when `from` is `Selector`.",
+										Optional:    true,
+										MaxItems:    1,
+										Elem: &schema.Resource{
+											Schema: labelSelectorFields(true),
+										},
+									},
+								},
+							},
+						},
+						"kind": {
+							Type:        schema.TypeList,
+							Description: "The kinds of routes that are allowed to attach to the listener.",
+							Optional:    true,
+							MaxItems:    8,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group": {
+										Type:        schema.TypeString,
+										Description: "The API group of the route kind. Defaults to `gateway.networking.k8s.io`.",
+										Optional:    true,
+										Computed:    true,
+									},
+									"kind": {
+										Type:        schema.TypeString,
+										Description: "The kind of the route, e.g. `HTTPRoute`.",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaGatewayV1ObjectReference(defaultKind string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Description: "The API group of the referent. Empty for the core API group.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "The kind of the referent. Defaults to `" + defaultKind + "`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the referent.",
+				Required:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The namespace of the referent. Defaults to the namespace of the referring object. A reference to another namespace requires a ReferenceGrant in that namespace.",
+				Optional:    true,
+			},
+		},
+	}
+}
@@ -1,6 +1,9 @@
 package kubernetes
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 func metricTargetFields() *schema.Resource {
 	return &schema.Resource{
@@ -48,6 +51,30 @@ func resourceMetricSourceFields() *schema.Resource {
 	}
 }
 
+func containerResourceMetricSourceFields() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "name is the name of the resource in question.",
+			},
+			"container": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "container is the name of the container in the pods of the scaling target.",
+			},
+			"target": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        metricTargetFields(),
+				Description: "target specifies the target value for the given metric",
+			},
+		},
+	}
+}
+
 func metricIdentifierFields() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -163,6 +190,13 @@ func objectMetricSourceFields() *schema.Resource {
 func metricSpecFields() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			"container_resource": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        containerResourceMetricSourceFields(),
+				Description: "",
+			},
 			"external": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -194,7 +228,7 @@ func metricSpecFields() *schema.Resource {
 			"type": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: `type is the type of metric source. It should be one of "Object", "Pods", "External" or "Resource", each mapping to a matching field in the object.`,
+				Description: `type is the type of metric source. It should be one of "ContainerResource", "External", "Object", "Pods" or "Resource", each mapping to a matching field in the object.`,
 			},
 		},
 	}
@@ -205,20 +239,25 @@ func scalingRulesSpecFields() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"policy": {
 				Type:        schema.TypeList,
-				Required:    true,
+				Optional:    true,
+				Computed:    true,
 				MinItems:    1,
 				Elem:        scalingPolicySpecFields(),
-				Description: "List of potential scaling polices which can be used during scaling. At least one policy must be specified, otherwise the scaling rule will be discarded as invalid.",
+				Description: "List of potential scaling polices which can be used during scaling. If not set, the default policies of the API server are used.",
 			},
 			"select_policy": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Used to specify which policy should be used. If not set, the default value Max is used.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Max", "Min", "Disabled"}, false),
+				Description:  "Used to specify which policy should be used. If not set, the default value Max is used.",
 			},
 			"stabilization_window_seconds": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Number of seconds for which past recommendations should be considered while scaling up or scaling down. This value must be greater than or equal to zero and less than or equal to 3600 (one hour). If not set, use the default values: - For scale up: 0 (i.e. no stabilization is done). - For scale down: 300 (i.e. the stabilization window is 300 seconds long).",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 3600),
+				Description:  "Number of seconds for which past recommendations should be considered while scaling up or scaling down. This value must be greater than or equal to zero and less than or equal to 3600 (one hour). If not set, use the default values: - For scale up: 0 (i.e. no stabilization is done). - For scale down: 300 (i.e. the stabilization window is 300 seconds long).",
 			},
 		},
 	}
@@ -228,19 +267,22 @@ func scalingPolicySpecFields() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"period_seconds": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Period specifies the window of time for which the policy should hold true. PeriodSeconds must be greater than zero and less than or equal to 1800 (30 min).",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 1800),
+				Description:  "Period specifies the window of time for which the policy should hold true. PeriodSeconds must be greater than zero and less than or equal to 1800 (30 min).",
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Type is used to specify the scaling policy: Percent or Pods",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Percent", "Pods"}, false),
+				Description:  "Type is used to specify the scaling policy: Percent or Pods",
 			},
 			"value": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "Value contains the amount of change which is permitted by the policy. It must be greater than zero.",
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Value contains the amount of change which is permitted by the policy. It must be greater than zero.",
 			},
 		},
 	}
@@ -0,0 +1,343 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaGatewayV1ParentReference() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"group": {
+				Type:        schema.TypeString,
+				Description: "The API group of the parent. Defaults to `gateway.networking.k8s.io`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"kind": {
+				Type:        schema.TypeString,
+				Description: "The kind of the parent. Defaults to `Gateway`.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Description: "The namespace of the parent. Defaults to the namespace of the route.",
+				Optional:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the parent.",
+				Required:    true,
+			},
+			"section_name": {
+				Type:        schema.TypeString,
+				Description: "The name of a section within the parent, e.g. the name of a Gateway listener. When unspecified the route attaches to all listeners that allow it.",
+				Optional:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "The network port of the parent the route attaches to.",
+				Optional:     true,
+				ValidateFunc: validatePortNum,
+			},
+		},
+	}
+}
+
+func schemaHTTPRouteV1Rule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"match": {
+				Type:        schema.TypeList,
+				Description: "Conditions used for matching the rule against incoming HTTP requests. A request matches the rule if any of the matches is satisfied. Defaults to a `PathPrefix` match on `/`.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    64,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:        schema.TypeList,
+							Description: "The HTTP request path matcher.",
+							Optional:    true,
+							Computed:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:         schema.TypeString,
+										Description:  "How to match against the path value, one of `Exact`, `PathPrefix` or `RegularExpression`. Defaults to `PathPrefix`.",
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice([]string{"Exact", "PathPrefix", "RegularExpression"}, false),
+									},
+									"value": {
+										Type:        schema.TypeString,
+										Description: "The HTTP path to match against. Defaults to `/`.",
+										Optional:    true,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"header": {
+							Type:        schema.TypeList,
+							Description: "HTTP request header matchers. Multiple matchers are ANDed together.",
+							Optional:    true,
+							MaxItems:    16,
+							Elem:        schemaHTTPRouteV1ValueMatch("header"),
+						},
+						"query_param": {
+							Type:        schema.TypeList,
+							Description: "HTTP query parameter matchers. Multiple matchers are ANDed together.",
+							Optional:    true,
+							MaxItems:    16,
+							Elem:        schemaHTTPRouteV1ValueMatch("query parameter"),
+						},
+						"method": {
+							Type:        schema.TypeString,
+							Description: "The HTTP method to match, e.g. `GET`.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"filter": {
+				Type:        schema.TypeList,
+				Description: "Filters applied to requests that match the rule.",
+				Optional:    true,
+				MaxItems:    16,
+				Elem:        schemaHTTPRouteV1Filter(),
+			},
+			"backend_ref": {
+				Type:        schema.TypeList,
+				Description: "The backends matching requests are sent to. When no backend is specified the requests receive a 500 response, unless a filter produces the response.",
+				Optional:    true,
+				MaxItems:    16,
+				Elem:        schemaHTTPRouteV1BackendRef(true),
+			},
+		},
+	}
+}
+
+func schemaHTTPRouteV1ValueMatch(objectName string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "How to match against the value of the " + objectName + ", either `Exact` or `RegularExpression`. Defaults to `Exact`.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Exact", "RegularExpression"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the " + objectName + " to match.",
+				Required:    true,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "The value of the " + objectName + " to match.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func schemaHTTPRouteV1Filter() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the filter, one of `RequestHeaderModifier`, `ResponseHeaderModifier`, `RequestRedirect`, `URLRewrite` or `RequestMirror`. The block of the same name configures the filter.",
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"RequestHeaderModifier",
+					"ResponseHeaderModifier",
+					"RequestRedirect",
+					"URLRewrite",
+					"RequestMirror",
+				}, false),
+			},
+			"request_header_modifier": {
+				Type:        schema.TypeList,
+				Description: "Modifies the headers of the request before it is sent to the backend.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        schemaHTTPRouteV1HeaderFilter(),
+			},
+			"response_header_modifier": {
+				Type:        schema.TypeList,
+				Description: "Modifies the headers of the response before it is sent to the client.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem:        schemaHTTPRouteV1HeaderFilter(),
+			},
+			"request_redirect": {
+				Type:        schema.TypeList,
+				Description: "Responds to the request with a redirect.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"scheme": {
+							Type:         schema.TypeString,
+							Description:  "The scheme of the `Location` header, either `http` or `https`. Defaults to the scheme of the request.",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"http", "https"}, false),
+						},
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "The hostname of the `Location` header. Defaults to the hostname of the request.",
+							Optional:    true,
+						},
+						"path": {
+							Type:        schema.TypeList,
+							Description: "Modifies the path of the `Location` header. Defaults to the path of the request.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        schemaHTTPRouteV1PathModifier(),
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Description:  "The port of the `Location` header.",
+							Optional:     true,
+							ValidateFunc: validatePortNum,
+						},
+						"status_code": {
+							Type:         schema.TypeInt,
+							Description:  "The HTTP status code of the redirect, either `301` or `302`. Defaults to `302`.",
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{301, 302}),
+						},
+					},
+				},
+			},
+			"url_rewrite": {
+				Type:        schema.TypeList,
+				Description: "Modifies the request before it is sent to the backend.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "The value of the `Host` header sent to the backend.",
+							Optional:    true,
+						},
+						"path": {
+							Type:        schema.TypeList,
+							Description: "Modifies the path of the request sent to the backend.",
+							Optional:    true,
+							MaxItems:    1,
+							Elem:        schemaHTTPRouteV1PathModifier(),
+						},
+					},
+				},
+			},
+			"request_mirror": {
+				Type:        schema.TypeList,
+				Description: "Mirrors the request to an additional backend. Responses from the mirrored backend are ignored.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backend_ref": {
+							Type:        schema.TypeList,
+							Description: "The backend the request is mirrored to.",
+							Required:    true,
+							MaxItems:    1,
+							Elem:        schemaHTTPRouteV1BackendRef(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaHTTPRouteV1HeaderFilter() *schema.Resource {
+	header := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the HTTP header.",
+				Required:    true,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "The value of the HTTP header.",
+				Required:    true,
+			},
+		},
+	}
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"set": {
+				Type:        schema.TypeList,
+				Description: "Headers to set, overwriting the existing values.",
+				Optional:    true,
+				MaxItems:    16,
+				Elem:        header,
+			},
+			"add": {
+				Type:        schema.TypeList,
+				Description: "Headers to add, appending to the existing values.",
+				Optional:    true,
+				MaxItems:    16,
+				Elem:        header,
+			},
+			"remove": {
+				Type:        schema.TypeList,
+				Description: "Names of the headers to remove.",
+				Optional:    true,
+				MaxItems:    16,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func schemaHTTPRouteV1PathModifier() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Description:  "The type of the path modifier, either `ReplaceFullPath` or `ReplacePrefixMatch`.",
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ReplaceFullPath", "ReplacePrefixMatch"}, false),
+			},
+			"replace_full_path": {
+				Type:        schema.TypeString,
+				Description: "The path that replaces the full path of the request, when `type` is `ReplaceFullPath`.",
+				Optional:    true,
+			},
+			"replace_prefix_match": {
+				Type:        schema.TypeString,
+				Description: "The value that replaces the matched path prefix of the request, when `type` is `ReplacePrefixMatch`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func schemaHTTPRouteV1BackendRef(weighted bool) *schema.Resource {
+	s := schemaGatewayV1ObjectReference("Service").Schema
+	s["port"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "The port of the backend. Required when the backend is a Service.",
+		Optional:     true,
+		ValidateFunc: validatePortNum,
+	}
+	if weighted {
+		s["weight"] = &schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "The proportion of requests sent to the backend, relative to the other backends of the rule. A weight of `0` sends no requests to the backend.",
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntBetween(0, 1000000),
+		}
+	}
+	return &schema.Resource{Schema: s}
+}
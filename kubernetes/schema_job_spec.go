@@ -88,6 +88,100 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 			Description:  "Specifies the maximum desired number of pods the job should run at any given time. The actual number of pods running in steady state will be less than this number when ((.spec.completions - .status.successful) < .spec.parallelism), i.e. when the work left to do is less than max parallelism. More info: https://kubernetes.io/docs/concepts/workloads/controllers/jobs-run-to-completion/",
 		},
 		// This field is immutable in Jobs.
+		"pod_failure_policy": {
+			Type:        schema.TypeList,
+			Description: "Specifies the policy of handling failed pods. In particular, it allows to specify the set of actions and conditions which need to be satisfied to take the associated action. If empty, the default behaviour applies - the counter of failed pods, represented by the job's .status.failed field, is incremented and it is checked against the backoffLimit.",
+			Optional:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"rule": {
+						Type:        schema.TypeList,
+						Description: "A list of pod failure policy rules. The rules are evaluated in order. Once a rule matches a Pod failure, the remaining of the rules are ignored.",
+						Required:    true,
+						ForceNew:    true,
+						MinItems:    1,
+						MaxItems:    20,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"action": {
+									Type:        schema.TypeString,
+									Description: "Specifies the action taken on a pod failure when the requirements are satisfied. Possible values are `FailJob`, `Ignore` and `Count`.",
+									Required:    true,
+									ForceNew:    true,
+									ValidateFunc: validation.StringInSlice([]string{
+										string(batchv1.PodFailurePolicyActionFailJob),
+										string(batchv1.PodFailurePolicyActionIgnore),
+										string(batchv1.PodFailurePolicyActionCount),
+									}, false),
+								},
+								"on_exit_codes": {
+									Type:        schema.TypeList,
+									Description: "Represents the requirement on the container exit codes.",
+									Optional:    true,
+									ForceNew:    true,
+									MaxItems:    1,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"container_name": {
+												Type:        schema.TypeString,
+												Description: "Restricts the check for exit codes to the container with the specified name. When unset, the rule applies to all containers.",
+												Optional:    true,
+												ForceNew:    true,
+											},
+											"operator": {
+												Type:        schema.TypeString,
+												Description: "Represents the relationship between the container exit code(s) and the specified values. Possible values are `In` and `NotIn`.",
+												Required:    true,
+												ForceNew:    true,
+												ValidateFunc: validation.StringInSlice([]string{
+													string(batchv1.PodFailurePolicyOnExitCodesOpIn),
+													string(batchv1.PodFailurePolicyOnExitCodesOpNotIn),
+												}, false),
+											},
+											"values": {
+												Type:        schema.TypeList,
+												Description: "Specifies the set of values. Each returned container exit code (might be multiple in case of multiple containers) is checked against this set of values with respect to the operator.",
+												Required:    true,
+												ForceNew:    true,
+												MinItems:    1,
+												MaxItems:    255,
+												Elem:        &schema.Schema{Type: schema.TypeInt},
+											},
+										},
+									},
+								},
+								"on_pod_condition": {
+									Type:        schema.TypeList,
+									Description: "Represents the requirement on the pod conditions. The requirement is represented as a list of pod condition patterns. The requirement is satisfied if at least one pattern matches an actual pod condition.",
+									Optional:    true,
+									ForceNew:    true,
+									Elem: &schema.Resource{
+										Schema: map[string]*schema.Schema{
+											"type": {
+												Type:        schema.TypeString,
+												Description: "Specifies the required Pod condition type. To match a pod condition it is required that specified type equals the pod condition type.",
+												Required:    true,
+												ForceNew:    true,
+											},
+											"status": {
+												Type:        schema.TypeString,
+												Description: "Specifies the required Pod condition status. To match a pod condition it is required that the specified status equals the pod condition status. Defaults to True.",
+												Optional:    true,
+												ForceNew:    true,
+												Default:     "True",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// This field is immutable in Jobs.
 		"selector": {
 			Type:        schema.TypeList,
 			Description: "A label query over volumes to consider for binding.",
@@ -136,6 +230,13 @@ func jobSpecFields(specUpdatable bool) map[string]*schema.Schema {
 				},
 			},
 		},
+		// This field can be edited in place.
+		"suspend": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    false,
+			Description: "Specifies whether the Job controller should create Pods or not. If a Job is created with suspend set to true, no Pods are created by the Job controller. If a Job is suspended after creation, the Job controller will delete all active Pods associated with this Job.",
+		},
 		// PodTemplate fields are immutable in Jobs.
 		"template": {
 			Type:        schema.TypeList,
@@ -0,0 +1,24 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+func matchConditionFields() map[string]*schema.Schema {
+	apiDoc := admissionregistrationv1.MatchCondition{}.SwaggerDoc()
+	return map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Description: apiDoc["name"],
+			Required:    true,
+		},
+		"expression": {
+			Type:         schema.TypeString,
+			Description:  apiDoc["expression"],
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	}
+}
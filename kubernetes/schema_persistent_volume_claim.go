@@ -85,5 +85,59 @@ func persistentVolumeClaimSpecFields() map[string]*schema.Schema {
 			Computed:    true,
 			ForceNew:    true,
 		},
+		"data_source": {
+			Type:        schema.TypeList,
+			Description: "The source to populate the volume from, either an existing VolumeSnapshot or PersistentVolumeClaim, or a custom resource handled by a volume populator. More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes/#volume-snapshot-and-restore-volume-from-snapshot-support",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: persistentVolumeClaimDataSourceFields(false),
+			},
+		},
+		"data_source_ref": {
+			Type:        schema.TypeList,
+			Description: "The object to populate the volume from, it may live in another namespace when the CrossNamespaceVolumeDataSource feature gate is enabled. When only one of `data_source` and `data_source_ref` is set, the API server copies it to the other one.",
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: persistentVolumeClaimDataSourceFields(true),
+			},
+		},
+	}
+}
+
+func persistentVolumeClaimDataSourceFields(withNamespace bool) map[string]*schema.Schema {
+	fields := map[string]*schema.Schema{
+		"api_group": {
+			Type:        schema.TypeString,
+			Description: "The group for the resource being referenced. If not specified, the kind must be in the core API group.",
+			Optional:    true,
+			ForceNew:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Description: "The type of resource being referenced.",
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Description: "The name of resource being referenced.",
+			Required:    true,
+			ForceNew:    true,
+		},
+	}
+	if withNamespace {
+		fields["namespace"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The namespace of resource being referenced. Defaults to the namespace of the claim.",
+			Optional:    true,
+			ForceNew:    true,
+		}
 	}
+	return fields
 }
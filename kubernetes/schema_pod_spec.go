@@ -65,7 +65,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "List of init containers belonging to the pod. Init containers always run to completion and each must complete successfully before the next is started. More info: https://kubernetes.io/docs/concepts/workloads/pods/init-containers/",
 			Elem: &schema.Resource{
-				Schema: containerFields(isUpdatable),
+				Schema: initContainerFields(isUpdatable),
 			},
 		},
 		"dns_policy": {
@@ -113,7 +113,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 								},
 								"value": {
 									Type:        schema.TypeString,
-									Description: "Value of the option. Optional: Defaults to empty.",
+									Description: "Value of the option. Options such as `use-vc` have no value. The value of `ndots` has to be an integer. Optional: Defaults to empty.",
 									Optional:    true,
 									ForceNew:    !isUpdatable,
 								},
@@ -141,29 +141,13 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Description: "Enables generating environment variables for service discovery. Defaults to true.",
 		},
 		"host_aliases": {
-			Type:        schema.TypeList,
+			Type:        schema.TypeSet,
 			Optional:    true,
 			ForceNew:    !isUpdatable,
 			Computed:    isComputed,
-			Description: "List of hosts and IPs that will be injected into the pod's hosts file if specified. Optional: Defaults to empty.",
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"hostnames": {
-						Type:        schema.TypeList,
-						Required:    true,
-						ForceNew:    !isUpdatable,
-						Description: "Hostnames for the IP address.",
-						Elem:        &schema.Schema{Type: schema.TypeString},
-					},
-					"ip": {
-						Type:         schema.TypeString,
-						Required:     true,
-						ForceNew:     !isUpdatable,
-						Description:  "IP address of the host file entry.",
-						ValidateFunc: validation.IsIPAddress,
-					},
-				},
-			},
+			Description: "Set of hosts and IPs that will be injected into the pod's hosts file if specified. Optional: Defaults to empty.",
+			Elem:        hostAliasSchema(isUpdatable),
+			Set:         hashHostAlias(),
 		},
 		"host_ipc": {
 			Type:        schema.TypeBool,
@@ -190,6 +174,14 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Default:     conditionalDefault(!isComputed, false),
 			Description: "Use the host's pid namespace.",
 		},
+		"host_users": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			Default:     conditionalDefault(!isComputed, true),
+			Description: "Use the host's user namespace. When set to false, a new user namespace is created for the pod, which requires the UserNamespacesSupport feature gate. Optional: Defaults to true.",
+		},
 
 		"hostname": {
 			Type:        schema.TypeString,
@@ -228,6 +220,25 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: http://kubernetes.io/docs/user-guide/node-selection.",
 		},
+		"os": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    isComputed,
+			ForceNew:    !isUpdatable,
+			MaxItems:    1,
+			Description: "Specifies the OS of the containers in the pod, which is validated against the containers and used by the scheduler.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						Description:  "Name of the operating system. The currently supported values are linux and windows.",
+						ValidateFunc: validation.StringInSlice([]string{string(api.Linux), string(api.Windows)}, false),
+					},
+				},
+			},
+		},
 		"priority_class_name": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -235,6 +246,46 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: `If specified, indicates the pod's priority. "system-node-critical" and "system-cluster-critical" are two special keywords which indicate the highest priorities with the former being the highest priority. Any other name must be defined by creating a PriorityClass object with that name. If not specified, the pod priority will be default or zero if there is no default.`,
 		},
+		"resource_claims": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "The resource claims which must be allocated before the pod is started, for Dynamic Resource Allocation. Containers use them by name in `resources.claims`. Requires the DynamicResourceAllocation feature gate.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ForceNew:     !isUpdatable,
+						Description:  "Name of the resource claim inside the pod, as used by the containers.",
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"source": {
+						Type:        schema.TypeList,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						MaxItems:    1,
+						Description: "Where to find the resource claim. Exactly one of `resource_claim_name` and `resource_claim_template_name` must be set.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"resource_claim_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									ForceNew:    !isUpdatable,
+									Description: "The name of an existing ResourceClaim in the namespace of the pod.",
+								},
+								"resource_claim_template_name": {
+									Type:        schema.TypeString,
+									Optional:    true,
+									ForceNew:    !isUpdatable,
+									Description: "The name of a ResourceClaimTemplate in the namespace of the pod, from which a ResourceClaim is created for the pod.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"restart_policy": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -248,6 +299,22 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 				string(api.RestartPolicyNever),
 			}, false),
 		},
+		"scheduling_gate": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    !isUpdatable,
+			Description: "Scheduling gates block the scheduling of the pod until they are removed. Gates can only be removed once the pod has been created, which releases the pod to the scheduler once it has none left.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    !isUpdatable,
+						Description: "Name of the scheduling gate.",
+					},
+				},
+			},
+		},
 		"security_context": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -287,6 +354,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 						Type:        schema.TypeList,
 						Description: "The seccomp options to use by the containers in this pod. Note that this field cannot be set when spec.os.name is windows.",
 						Optional:    true,
+						Computed:    true,
 						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: seccompProfileField(isUpdatable),
@@ -341,6 +409,13 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			ForceNew:    !isUpdatable,
 			Description: "ServiceAccountName is the name of the ServiceAccount to use to run this pod. More info: http://releases.k8s.io/HEAD/docs/design/service_accounts.md.",
 		},
+		"set_hostname_as_fqdn": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			ForceNew:    !isUpdatable,
+			Description: "If true the pod's hostname will be configured as the pod's FQDN, rather than the leaf name. Optional: Defaults to false.",
+		},
 		"share_process_namespace": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -368,52 +443,7 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Optional:    true,
 			Description: "If specified, the pod's toleration. Optional: Defaults to empty",
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"effect": {
-						Type:        schema.TypeString,
-						Description: "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-						ValidateFunc: validation.StringInSlice([]string{
-							string(api.TaintEffectNoSchedule),
-							string(api.TaintEffectPreferNoSchedule),
-							string(api.TaintEffectNoExecute),
-						}, false),
-					},
-					"key": {
-						Type:        schema.TypeString,
-						Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-					},
-					"operator": {
-						Type:        schema.TypeString,
-						Description: "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
-						Default:     string(api.TolerationOpEqual),
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-						ValidateFunc: validation.StringInSlice([]string{
-							string(api.TolerationOpExists),
-							string(api.TolerationOpEqual),
-						}, false),
-					},
-					"toleration_seconds": {
-						// Use TypeString to allow an "unspecified" value,
-						Type:         schema.TypeString,
-						Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system.",
-						Optional:     true,
-						ForceNew:     !isUpdatable,
-						ValidateFunc: validateTypeStringNullableInt,
-					},
-					"value": {
-						Type:        schema.TypeString,
-						Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value should be empty, otherwise just a regular string.",
-						Optional:    true,
-						ForceNew:    !isUpdatable,
-					},
-				},
-			},
+			Elem:        tolerationSchema(isUpdatable),
 		},
 		"topology_spread_constraint": {
 			Type:        schema.TypeList,
@@ -451,6 +481,33 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 							Schema: labelSelectorFields(true),
 						},
 					},
+					"min_domains": {
+						Type:         schema.TypeInt,
+						Description:  "indicates a minimum number of eligible domains. Can only be set when when_unsatisfiable is DoNotSchedule.",
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+					"node_affinity_policy": {
+						Type:        schema.TypeString,
+						Description: "indicates how to treat the pod's node affinity and node selector when calculating the pod topology spread skew.",
+						Optional:    true,
+						Computed:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.NodeInclusionPolicyHonor),
+							string(api.NodeInclusionPolicyIgnore),
+						}, false),
+					},
+					"node_taints_policy": {
+						Type:        schema.TypeString,
+						Description: "indicates how to treat node taints when calculating the pod topology spread skew.",
+						Optional:    true,
+						Computed:    true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(api.NodeInclusionPolicyHonor),
+							string(api.NodeInclusionPolicyIgnore),
+						}, false),
+					},
 				},
 			},
 		},
@@ -465,6 +522,84 @@ func podSpecFields(isUpdatable, isComputed bool) map[string]*schema.Schema {
 	return s
 }
 
+// tolerationSchema is shared by pod specs and the scheduling constraints of
+// runtime classes.
+func tolerationSchema(isUpdatable bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"effect": {
+				Type:        schema.TypeString,
+				Description: "Effect indicates the taint effect to match. Empty means match all taint effects. When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.",
+				Optional:    true,
+				ForceNew:    !isUpdatable,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(api.TaintEffectNoSchedule),
+					string(api.TaintEffectPreferNoSchedule),
+					string(api.TaintEffectNoExecute),
+				}, false),
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Description: "Key is the taint key that the toleration applies to. Empty means match all taint keys. If the key is empty, operator must be Exists; this combination means to match all values and all keys.",
+				Optional:    true,
+				ForceNew:    !isUpdatable,
+			},
+			"operator": {
+				Type:        schema.TypeString,
+				Description: "Operator represents a key's relationship to the value. Valid operators are Exists and Equal. Defaults to Equal. Exists is equivalent to wildcard for value, so that a pod can tolerate all taints of a particular category.",
+				Default:     string(api.TolerationOpEqual),
+				Optional:    true,
+				ForceNew:    !isUpdatable,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(api.TolerationOpExists),
+					string(api.TolerationOpEqual),
+				}, false),
+			},
+			"toleration_seconds": {
+				// Use TypeString to allow an "unspecified" value,
+				Type:         schema.TypeString,
+				Description:  "TolerationSeconds represents the period of time the toleration (which must be of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default, it is not set, which means tolerate the taint forever (do not evict). Zero and negative values will be treated as 0 (evict immediately) by the system. Unlike an empty string, \"0\" is sent to the API server.",
+				Optional:     true,
+				ForceNew:     !isUpdatable,
+				ValidateFunc: validateTypeStringNullableInt,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Description: "Value is the taint value the toleration matches to. If the operator is Exists, the value must be empty, otherwise just a regular string.",
+				Optional:    true,
+				ForceNew:    !isUpdatable,
+			},
+		},
+	}
+}
+
+func hostAliasSchema(isUpdatable bool) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"hostnames": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    !isUpdatable,
+				Description: "Hostnames for the IP address.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     !isUpdatable,
+				Description:  "IP address of the host file entry.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+// hashHostAlias keeps the order in which the API server returns the host
+// aliases out of the plan.
+func hashHostAlias() schema.SchemaSetFunc {
+	return schema.HashResource(hostAliasSchema(true))
+}
+
 func volumeSchema(isUpdatable bool) *schema.Resource {
 	v := commonVolumeSources()
 
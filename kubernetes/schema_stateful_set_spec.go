@@ -3,15 +3,62 @@ package kubernetes
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	appsv1 "k8s.io/api/apps/v1"
 )
 
+var statefulSetPersistentVolumeClaimRetentionPolicyTypes = []string{
+	string(appsv1.RetainPersistentVolumeClaimRetentionPolicyType),
+	string(appsv1.DeletePersistentVolumeClaimRetentionPolicyType),
+}
+
 func statefulSetSpecFields() map[string]*schema.Schema {
 	s := map[string]*schema.Schema{
+		"ordinals": {
+			Type:        schema.TypeList,
+			Description: "Controls the numbering of replica indices in a StatefulSet. The default ordinals behavior assigns a \"0\" index to the first replica and increments the index by one for each additional replica requested.",
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"start": {
+						Type:         schema.TypeInt,
+						Description:  "The number representing the first replica's index. It may be used to number replicas from an alternate index (eg: 1-indexed) over the default 0-indexed names, or to orchestrate progressive movement of replicas from one StatefulSet to another.",
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+					},
+				},
+			},
+		},
+		"persistent_volume_claim_retention_policy": {
+			Type:        schema.TypeList,
+			Description: "Describes the lifecycle of persistent volume claims created from volume_claim_template. By default, all persistent volume claims are created as needed and retained until manually deleted.",
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"when_deleted": {
+						Type:         schema.TypeString,
+						Description:  "Specifies what happens to PVCs created from StatefulSet VolumeClaimTemplates when the StatefulSet is deleted. The default policy of `Retain` causes PVCs to not be affected by StatefulSet deletion. The `Delete` policy causes those PVCs to be deleted.",
+						Optional:     true,
+						Default:      string(appsv1.RetainPersistentVolumeClaimRetentionPolicyType),
+						ValidateFunc: validation.StringInSlice(statefulSetPersistentVolumeClaimRetentionPolicyTypes, false),
+					},
+					"when_scaled": {
+						Type:         schema.TypeString,
+						Description:  "Specifies what happens to PVCs created from StatefulSet VolumeClaimTemplates when the StatefulSet is scaled down. The default policy of `Retain` causes PVCs to not be affected by a scaledown. The `Delete` policy causes the associated PVCs for any excess pods above the replica count to be deleted.",
+						Optional:     true,
+						Default:      string(appsv1.RetainPersistentVolumeClaimRetentionPolicyType),
+						ValidateFunc: validation.StringInSlice(statefulSetPersistentVolumeClaimRetentionPolicyTypes, false),
+					},
+				},
+			},
+		},
 		"pod_management_policy": {
 			Type:        schema.TypeString,
 			Description: "Controls how pods are created during initial scale up, when replacing pods on nodes, or when scaling down.",
 			Optional:    true,
-			ForceNew:    true,
 			Computed:    true,
 			ValidateFunc: validation.StringInSlice([]string{
 				"OrderedReady",
@@ -47,7 +94,6 @@ func statefulSetSpecFields() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Description: "The name of the service that governs this StatefulSet. This service must exist before the StatefulSet, and is responsible for the network identity of the set.",
 			Required:    true,
-			ForceNew:    true,
 		},
 		"template": {
 			Type:        schema.TypeList,
@@ -95,12 +141,25 @@ func statefulSetSpecFields() map[string]*schema.Schema {
 		"volume_claim_template": {
 			Type:        schema.TypeList,
 			Optional:    true,
-			ForceNew:    true,
 			Description: "A list of claims that pods are allowed to reference. Every claim in this list must have at least one matching (by name) volumeMount in one container in the template.",
 			Elem: &schema.Resource{
-				Schema: persistentVolumeClaimFields(),
+				Schema: unsetForceNew(persistentVolumeClaimFields()),
 			},
 		},
 	}
 	return s
 }
+
+// unsetForceNew clears ForceNew in the given schema and all its nested
+// blocks. The StatefulSet fields that are immutable server-side are replaced
+// by the CustomizeDiff of the resource instead, depending on its
+// recreate_strategy.
+func unsetForceNew(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for _, v := range s {
+		v.ForceNew = false
+		if r, ok := v.Elem.(*schema.Resource); ok {
+			unsetForceNew(r.Schema)
+		}
+	}
+	return s
+}
@@ -0,0 +1,283 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+)
+
+func validatingAdmissionPolicyV1SpecFields() map[string]*schema.Schema {
+	specDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicySpec{}.SwaggerDoc()
+	validationDoc := admissionregistrationv1beta1.Validation{}.SwaggerDoc()
+	auditAnnotationDoc := admissionregistrationv1beta1.AuditAnnotation{}.SwaggerDoc()
+	matchConditionDoc := admissionregistrationv1beta1.MatchCondition{}.SwaggerDoc()
+	variableDoc := admissionregistrationv1beta1.Variable{}.SwaggerDoc()
+	paramKindDoc := admissionregistrationv1beta1.ParamKind{}.SwaggerDoc()
+
+	return map[string]*schema.Schema{
+		"param_kind": {
+			Type:        schema.TypeList,
+			Description: specDoc["paramKind"],
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"api_version": {
+						Type:        schema.TypeString,
+						Description: paramKindDoc["apiVersion"],
+						Required:    true,
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Description: paramKindDoc["kind"],
+						Required:    true,
+					},
+				},
+			},
+		},
+		"match_constraints": {
+			Type:        schema.TypeList,
+			Description: specDoc["matchConstraints"],
+			Required:    true,
+			MaxItems:    1,
+			Elem:        schemaValidatingAdmissionPolicyV1MatchResources(),
+		},
+		"validation": {
+			Type:        schema.TypeList,
+			Description: specDoc["validations"],
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"expression": {
+						Type:         schema.TypeString,
+						Description:  validationDoc["expression"],
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"message": {
+						Type:        schema.TypeString,
+						Description: validationDoc["message"],
+						Optional:    true,
+					},
+					"reason": {
+						Type:        schema.TypeString,
+						Description: validationDoc["reason"],
+						Optional:    true,
+					},
+					"message_expression": {
+						Type:        schema.TypeString,
+						Description: validationDoc["messageExpression"],
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"failure_policy": {
+			Type:        schema.TypeString,
+			Description: specDoc["failurePolicy"],
+			Optional:    true,
+			Default:     string(admissionregistrationv1beta1.Fail),
+			ValidateFunc: validation.StringInSlice([]string{
+				string(admissionregistrationv1beta1.Fail),
+				string(admissionregistrationv1beta1.Ignore),
+			}, false),
+		},
+		"audit_annotation": {
+			Type:        schema.TypeList,
+			Description: specDoc["auditAnnotations"],
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:        schema.TypeString,
+						Description: auditAnnotationDoc["key"],
+						Required:    true,
+					},
+					"value_expression": {
+						Type:         schema.TypeString,
+						Description:  auditAnnotationDoc["valueExpression"],
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+		},
+		"match_condition": {
+			Type:        schema.TypeList,
+			Description: specDoc["matchConditions"],
+			Optional:    true,
+			MaxItems:    64,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: matchConditionDoc["name"],
+						Required:    true,
+					},
+					"expression": {
+						Type:         schema.TypeString,
+						Description:  matchConditionDoc["expression"],
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+		},
+		"variable": {
+			Type:        schema.TypeList,
+			Description: specDoc["variables"],
+			Optional:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Description: variableDoc["name"],
+						Required:    true,
+					},
+					"expression": {
+						Type:         schema.TypeString,
+						Description:  variableDoc["expression"],
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+		},
+	}
+}
+
+func validatingAdmissionPolicyBindingV1SpecFields() map[string]*schema.Schema {
+	specDoc := admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec{}.SwaggerDoc()
+	paramRefDoc := admissionregistrationv1beta1.ParamRef{}.SwaggerDoc()
+
+	return map[string]*schema.Schema{
+		"policy_name": {
+			Type:        schema.TypeString,
+			Description: specDoc["policyName"],
+			Required:    true,
+		},
+		"param_ref": {
+			Type:        schema.TypeList,
+			Description: specDoc["paramRef"],
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:          schema.TypeString,
+						Description:   paramRefDoc["name"],
+						Optional:      true,
+						ConflictsWith: []string{"spec.0.param_ref.0.selector"},
+					},
+					"namespace": {
+						Type:        schema.TypeString,
+						Description: paramRefDoc["namespace"],
+						Optional:    true,
+					},
+					"selector": {
+						Type:          schema.TypeList,
+						Description:   paramRefDoc["selector"],
+						Optional:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"spec.0.param_ref.0.name"},
+						Elem: &schema.Resource{
+							Schema: labelSelectorFields(true),
+						},
+					},
+					"parameter_not_found_action": {
+						Type:        schema.TypeString,
+						Description: paramRefDoc["parameterNotFoundAction"],
+						Optional:    true,
+						Default:     string(admissionregistrationv1beta1.DenyAction),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(admissionregistrationv1beta1.AllowAction),
+							string(admissionregistrationv1beta1.DenyAction),
+						}, false),
+					},
+				},
+			},
+		},
+		"match_resources": {
+			Type:        schema.TypeList,
+			Description: specDoc["matchResources"],
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        schemaValidatingAdmissionPolicyV1MatchResources(),
+		},
+		"validation_actions": {
+			Type:        schema.TypeSet,
+			Description: specDoc["validationActions"],
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(admissionregistrationv1beta1.Deny),
+					string(admissionregistrationv1beta1.Warn),
+					string(admissionregistrationv1beta1.Audit),
+				}, false),
+			},
+		},
+	}
+}
+
+func schemaValidatingAdmissionPolicyV1MatchResources() *schema.Resource {
+	matchDoc := admissionregistrationv1beta1.MatchResources{}.SwaggerDoc()
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace_selector": {
+				Type:        schema.TypeList,
+				Description: matchDoc["namespaceSelector"],
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: labelSelectorFields(true),
+				},
+			},
+			"object_selector": {
+				Type:        schema.TypeList,
+				Description: matchDoc["objectSelector"],
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: labelSelectorFields(true),
+				},
+			},
+			"resource_rule": {
+				Type:        schema.TypeList,
+				Description: matchDoc["resourceRules"],
+				Optional:    true,
+				Elem:        schemaValidatingAdmissionPolicyV1NamedRule(),
+			},
+			"exclude_resource_rule": {
+				Type:        schema.TypeList,
+				Description: matchDoc["excludeResourceRules"],
+				Optional:    true,
+				Elem:        schemaValidatingAdmissionPolicyV1NamedRule(),
+			},
+			"match_policy": {
+				Type:        schema.TypeString,
+				Description: matchDoc["matchPolicy"],
+				Optional:    true,
+				Default:     string(admissionregistrationv1.Equivalent),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(admissionregistrationv1.Equivalent),
+					string(admissionregistrationv1.Exact),
+				}, false),
+			},
+		},
+	}
+}
+
+func schemaValidatingAdmissionPolicyV1NamedRule() *schema.Resource {
+	fields := ruleWithOperationsFields()
+	fields["resource_names"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: admissionregistrationv1beta1.NamedRuleWithOperations{}.SwaggerDoc()["resourceNames"],
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	return &schema.Resource{Schema: fields}
+}
@@ -0,0 +1,84 @@
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// writeOnlyDataSchema returns the schema of wo_data. Only a SHA-256 hash of
+// each value is kept in state: the values are read from the configuration
+// when they are sent, and the diff compares the hash of the configured value
+// with the one in state.
+func writeOnlyDataSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeMap,
+		Description:      "A map of the secret data, as plain text, which is sent to the API server but not stored in state. The state only holds the SHA-256 hash of each value, which is compared with the hash of the data of the live secret on read, so changes made outside of Terraform are planned to be overwritten. Bump `wo_revision` to send all values again.",
+		Optional:         true,
+		Sensitive:        true,
+		Elem:             &schema.Schema{Type: schema.TypeString},
+		ConflictsWith:    []string{"data"},
+		DiffSuppressFunc: suppressWriteOnlyDataDiff,
+	}
+}
+
+func writeOnlyRevisionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Description:  "An arbitrary number to change when all values of `wo_data` have to be sent again, e.g. after they were rotated at their source.",
+		Optional:     true,
+		RequiredWith: []string{"wo_data"},
+	}
+}
+
+// hashSecretValue returns the hash of a write-only value stored in state.
+func hashSecretValue(v []byte) string {
+	sum := sha256.Sum256(v)
+	return hex.EncodeToString(sum[:])
+}
+
+// suppressWriteOnlyDataDiff suppresses the diff of a wo_data value whose hash
+// is the one stored in state.
+func suppressWriteOnlyDataDiff(k, old, new string, d *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return old == new
+	}
+	return old != "" && old == hashSecretValue([]byte(new))
+}
+
+// expandWriteOnlyData returns the wo_data values of the configuration, as the
+// planned values of unchanged keys are their hashes.
+func expandWriteOnlyData(config cty.Value) map[string]string {
+	result := map[string]string{}
+	if config.IsNull() || !config.IsKnown() || !config.Type().HasAttribute("wo_data") {
+		return result
+	}
+	v := config.GetAttr("wo_data")
+	if v.IsNull() || !v.IsKnown() {
+		return result
+	}
+	for it := v.ElementIterator(); it.Next(); {
+		k, vv := it.Element()
+		if vv.IsNull() || !vv.IsKnown() {
+			continue
+		}
+		result[k.AsString()] = vv.AsString()
+	}
+	return result
+}
+
+// flattenWriteOnlyData returns the hashes of the live values of the wo_data
+// keys. Keys which are missing from the secret are left out, so they are
+// planned to be added again.
+func flattenWriteOnlyData(in map[string][]byte, keys map[string]interface{}) map[string]string {
+	result := map[string]string{}
+	for k := range keys {
+		if v, ok := in[k]; ok {
+			result[k] = hashSecretValue(v)
+		}
+	}
+	return result
+}
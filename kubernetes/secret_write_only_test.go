@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestSuppressWriteOnlyDataDiff(t *testing.T) {
+	testCases := []struct {
+		name     string
+		key      string
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			name:     "unchanged value",
+			key:      "wo_data.password",
+			old:      hashSecretValue([]byte("secret")),
+			new:      "secret",
+			suppress: true,
+		},
+		{
+			name: "changed value",
+			key:  "wo_data.password",
+			old:  hashSecretValue([]byte("secret")),
+			new:  "rotated",
+		},
+		{
+			name: "added value",
+			key:  "wo_data.password",
+			old:  "",
+			new:  "secret",
+		},
+		{
+			name: "removed value",
+			key:  "wo_data.password",
+			old:  hashSecretValue([]byte("secret")),
+			new:  "",
+		},
+		{
+			name:     "same count",
+			key:      "wo_data.%",
+			old:      "2",
+			new:      "2",
+			suppress: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := suppressWriteOnlyDataDiff(tc.key, tc.old, tc.new, nil); got != tc.suppress {
+				t.Fatalf("expected %t, got %t", tc.suppress, got)
+			}
+		})
+	}
+}
+
+func TestExpandWriteOnlyData(t *testing.T) {
+	config := cty.ObjectVal(map[string]cty.Value{
+		"wo_data": cty.MapVal(map[string]cty.Value{
+			"password": cty.StringVal("secret"),
+			"token":    cty.UnknownVal(cty.String),
+		}),
+	})
+	expected := map[string]string{"password": "secret"}
+	if diff := cmp.Diff(expected, expandWriteOnlyData(config)); diff != "" {
+		t.Fatalf("unexpected values (-want +got):\n%s", diff)
+	}
+
+	if got := expandWriteOnlyData(cty.NullVal(config.Type())); len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
+
+func TestFlattenWriteOnlyData(t *testing.T) {
+	in := map[string][]byte{
+		"password": []byte("secret"),
+		"other":    []byte("not managed"),
+	}
+	keys := map[string]interface{}{
+		"password": hashSecretValue([]byte("secret")),
+		"missing":  hashSecretValue([]byte("gone")),
+	}
+	expected := map[string]string{"password": hashSecretValue([]byte("secret"))}
+	if diff := cmp.Diff(expected, flattenWriteOnlyData(in, keys)); diff != "" {
+		t.Fatalf("unexpected hashes (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,224 @@
+package kubernetes
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/ssa"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// defaultFieldManagerName is the field manager used for server-side apply
+// when the user does not configure one.
+const defaultFieldManagerName = "Terraform"
+
+// isResourceVersionConflict reports whether err is the conflict returned when
+// the resourceVersion sent with a server-side apply patch is not the current
+// one, rather than a conflict with other field managers.
+func isResourceVersionConflict(err error) bool {
+	statusErr, ok := err.(*errors.StatusError)
+	if !ok || !errors.IsConflict(err) {
+		return false
+	}
+	return len(ssa.FieldConflicts(statusErr.ErrStatus)) == 0
+}
+
+// applyErrorDiagnostics turns an error returned by a server-side apply patch
+// into diagnostics. Field manager conflicts get one diagnostic per conflicting
+// field naming the manager which owns it; any other error is returned as is.
+func applyErrorDiagnostics(err error, fieldManager string) diag.Diagnostics {
+	statusErr, ok := err.(*errors.StatusError)
+	if !ok || !errors.IsConflict(err) {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	for _, c := range ssa.FieldConflicts(statusErr.ErrStatus) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Field manager conflict",
+			Detail: fmt.Sprintf(`The field %q is managed by %s, so Terraform (as field manager %q) cannot update it. `+
+				`Set "force" to true to take ownership of the field, or change "field_manager" to the manager which owns it.`,
+				c.Field, c.Owner(), fieldManager),
+		})
+	}
+	if len(diags) == 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Field manager conflict",
+			Detail:   fmt.Sprintf(`Another client is managing a field Terraform tried to update. Set "force" to true to override: %v`, err),
+		})
+	}
+	return diags
+}
+
+// getManagedFieldsMap returns the fields owned by the given field manager
+// below the top-level field key (e.g. "f:data") of an object's managed fields.
+func getManagedFieldsMap(managedFields []metav1.ManagedFieldsEntry, manager, field string) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	for _, m := range managedFields {
+		if m.Manager != manager || m.FieldsV1 == nil {
+			continue
+		}
+		var mm map[string]interface{}
+		err := json.Unmarshal(m.FieldsV1.Raw, &mm)
+		if err != nil {
+			return nil, err
+		}
+		if f, ok := mm[field].(map[string]interface{}); ok {
+			for k, v := range f {
+				fields[k] = v
+			}
+		}
+	}
+	return fields, nil
+}
+
+// serverSideApplySchema returns the schema of the per-resource
+// server_side_apply override.
+func serverSideApplySchema(objectName string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: fmt.Sprintf("Use server-side apply to manage the %s, with the field manager %q. Defaults to the provider's `server_side_apply` setting.", objectName, defaultFieldManagerName),
+		Optional:    true,
+		Computed:    true,
+	}
+}
+
+// useServerSideApply reports whether the resource should be managed with
+// server-side apply. The resource's own setting takes precedence over the
+// provider-level one.
+func useServerSideApply(d *schema.ResourceData, meta interface{}) bool {
+	if c := d.GetRawConfig(); !c.IsNull() && c.IsKnown() {
+		if v := c.GetAttr("server_side_apply"); !v.IsNull() && v.IsKnown() {
+			return v.True()
+		}
+	}
+	if v, ok := d.GetOkExists("server_side_apply"); ok {
+		return v.(bool)
+	}
+	if k, ok := meta.(kubeClientsets); ok {
+		return k.serverSideApply
+	}
+	return false
+}
+
+// isConfigured reports whether the attribute at the given path is set in
+// the configuration. It is only meaningful during create and update.
+func isConfigured(d *schema.ResourceData, path ...string) bool {
+	v := d.GetRawConfig()
+	for _, p := range path {
+		if v.IsNull() || !v.IsKnown() {
+			return false
+		}
+		if v.Type().IsListType() {
+			if v.LengthInt() == 0 {
+				return false
+			}
+			v = v.Index(cty.NumberIntVal(0))
+		}
+		if !v.Type().IsObjectType() || !v.Type().HasAttribute(p) {
+			return false
+		}
+		v = v.GetAttr(p)
+	}
+	return !v.IsNull()
+}
+
+// serverSideApplyPatch builds the body of an apply patch from a typed object.
+// Status and other read-only fields are dropped so that Terraform never
+// claims ownership of them.
+func serverSideApplyPatch(obj runtime.Object, apiVersion, kind string) ([]byte, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	u["apiVersion"] = apiVersion
+	u["kind"] = kind
+	delete(u, "status")
+	if m, ok := u["metadata"].(map[string]interface{}); ok {
+		for _, k := range []string{"creationTimestamp", "resourceVersion", "uid", "generation", "managedFields"} {
+			delete(m, k)
+		}
+	}
+	return json.Marshal(u)
+}
+
+// getManagedFields returns the fields owned through apply operations by the
+// given field manager, merged into a single FieldsV1 tree.
+func getManagedFields(managedFields []metav1.ManagedFieldsEntry, manager string) (map[string]interface{}, error) {
+	fields := map[string]interface{}{}
+	for _, m := range managedFields {
+		if m.Manager != manager || m.Operation != metav1.ManagedFieldsOperationApply || m.FieldsV1 == nil {
+			continue
+		}
+		var mm map[string]interface{}
+		err := json.Unmarshal(m.FieldsV1.Raw, &mm)
+		if err != nil {
+			return nil, err
+		}
+		mergeManagedFields(fields, mm)
+	}
+	return fields, nil
+}
+
+func mergeManagedFields(dst, src map[string]interface{}) {
+	for k, v := range src {
+		sm, ok := v.(map[string]interface{})
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		dm, ok := dst[k].(map[string]interface{})
+		if !ok {
+			dm = map[string]interface{}{}
+			dst[k] = dm
+		}
+		mergeManagedFields(dm, sm)
+	}
+}
+
+// managedFieldAt returns the subtree of managed fields at the given path of
+// field names, e.g. ("spec", "replicas"), and whether it is owned.
+func managedFieldAt(fields map[string]interface{}, path ...string) (map[string]interface{}, bool) {
+	for _, p := range path {
+		f, ok := fields["f:"+p].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		fields = f
+	}
+	return fields, true
+}
+
+// filterManagedKeys removes the keys of a flattened map attribute, such as
+// labels, which are not owned by the field manager.
+func filterManagedKeys(m map[string]string, fields map[string]interface{}, path ...string) map[string]string {
+	owned, _ := managedFieldAt(fields, path...)
+	out := map[string]string{}
+	for k, v := range m {
+		if _, ok := owned["f:"+k]; ok {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// reconcileManagedMetadata drops the labels and annotations of flattened
+// metadata which are not owned by the field manager.
+func reconcileManagedMetadata(metadata []interface{}, fields map[string]interface{}, path ...string) {
+	if len(metadata) == 0 || metadata[0] == nil {
+		return
+	}
+	m := metadata[0].(map[string]interface{})
+	for _, k := range []string{"labels", "annotations"} {
+		if v, ok := m[k].(map[string]string); ok {
+			m[k] = filterManagedKeys(v, fields, append(path, k)...)
+		}
+	}
+}
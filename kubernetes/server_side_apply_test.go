@@ -0,0 +1,144 @@
+package kubernetes
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyErrorDiagnostics(t *testing.T) {
+	conflict := func(causes ...metav1.StatusCause) error {
+		return &errors.StatusError{ErrStatus: metav1.Status{
+			Status: metav1.StatusFailure,
+			Code:   http.StatusConflict,
+			Reason: metav1.StatusReasonConflict,
+			Details: &metav1.StatusDetails{
+				Name:   "test",
+				Kind:   "configmaps",
+				Causes: causes,
+			},
+			Message: "Apply failed with 1 conflict",
+		}}
+	}
+
+	cases := map[string]struct {
+		err      error
+		expected []string
+	}{
+		"single conflict": {
+			err: conflict(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "kubectl-edit" using v1`,
+				Field:   ".data.one",
+			}),
+			expected: []string{
+				`The field ".data.one" is managed by field manager "kubectl-edit"`,
+			},
+		},
+		"multiple conflicts": {
+			err: conflict(
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kubectl-edit" using v1`,
+					Field:   ".data.one",
+				},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "not a field manager conflict",
+					Field:   ".data.ignored",
+				},
+				metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "helm"`,
+					Field:   ".metadata.labels.app",
+				},
+			),
+			expected: []string{
+				`The field ".data.one" is managed by field manager "kubectl-edit"`,
+				`The field ".metadata.labels.app" is managed by field manager "helm"`,
+			},
+		},
+		"unparseable manager": {
+			err: conflict(metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: "conflict",
+				Field:   ".data.one",
+			}),
+			expected: []string{
+				`The field ".data.one" is managed by another field manager`,
+			},
+		},
+		"conflict without causes": {
+			err: conflict(),
+			expected: []string{
+				"Another client is managing a field Terraform tried to update",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			diags := applyErrorDiagnostics(tc.err, "tftest")
+			if len(diags) != len(tc.expected) {
+				t.Fatalf("Expected %d diagnostics, got %d: %#v", len(tc.expected), len(diags), diags)
+			}
+			for i, d := range diags {
+				if d.Severity != diag.Error {
+					t.Errorf("Expected diagnostic %d to be an error", i)
+				}
+				if d.Summary != "Field manager conflict" {
+					t.Errorf("Unexpected summary for diagnostic %d: %q", i, d.Summary)
+				}
+				if !strings.Contains(d.Detail, tc.expected[i]) {
+					t.Errorf("Expected diagnostic %d to contain %q, got %q", i, tc.expected[i], d.Detail)
+				}
+			}
+		})
+	}
+
+	t.Run("not a conflict", func(t *testing.T) {
+		diags := applyErrorDiagnostics(fmt.Errorf("boom"), "tftest")
+		if len(diags) != 1 || diags[0].Summary != "boom" {
+			t.Fatalf("Expected the error to be passed through, got %#v", diags)
+		}
+	})
+}
+
+func TestGetManagedFields(t *testing.T) {
+	entry := func(manager string, op metav1.ManagedFieldsOperationType, raw string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:   manager,
+			Operation: op,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(raw)},
+		}
+	}
+	managedFields := []metav1.ManagedFieldsEntry{
+		entry("Terraform", metav1.ManagedFieldsOperationApply, `{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:template":{}}}`),
+		entry("Terraform", metav1.ManagedFieldsOperationUpdate, `{"f:spec":{"f:paused":{}}}`),
+		entry("kube-controller-manager", metav1.ManagedFieldsOperationUpdate, `{"f:spec":{"f:replicas":{}}}`),
+	}
+
+	fields, err := getManagedFields(managedFields, "Terraform")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := managedFieldAt(fields, "spec", "template"); !ok {
+		t.Error("Expected spec.template to be managed")
+	}
+	if _, ok := managedFieldAt(fields, "spec", "paused"); ok {
+		t.Error("Expected spec.paused not to be managed by an apply operation")
+	}
+	if _, ok := managedFieldAt(fields, "spec", "replicas"); ok {
+		t.Error("Expected spec.replicas not to be managed")
+	}
+
+	labels := filterManagedKeys(map[string]string{"app": "test", "injected": "true"}, fields, "metadata", "labels")
+	if len(labels) != 1 || labels["app"] != "test" {
+		t.Errorf("Unexpected managed labels: %#v", labels)
+	}
+}
@@ -6,7 +6,7 @@ import (
 	batch "k8s.io/api/batch/v1"
 )
 
-func flattenCronJobSpecV1(in batch.CronJobSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenCronJobSpecV1(in batch.CronJobSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	att["concurrency_policy"] = in.ConcurrencyPolicy
@@ -17,7 +17,7 @@ func flattenCronJobSpecV1(in batch.CronJobSpec, d *schema.ResourceData) ([]inter
 
 	att["schedule"] = in.Schedule
 
-	jobTemplate, err := flattenJobTemplateV1(in.JobTemplate, d)
+	jobTemplate, err := flattenJobTemplateV1(in.JobTemplate, d, meta)
 	if err != nil {
 		return nil, err
 	}
@@ -33,16 +33,19 @@ func flattenCronJobSpecV1(in batch.CronJobSpec, d *schema.ResourceData) ([]inter
 
 	att["suspend"] = in.Suspend
 
+	if in.TimeZone != nil {
+		att["time_zone"] = *in.TimeZone
+	}
+
 	return []interface{}{att}, nil
 }
 
-func flattenJobTemplateV1(in batch.JobTemplateSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenJobTemplateV1(in batch.JobTemplateSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
-	meta := flattenMetadata(in.ObjectMeta, d)
-	att["metadata"] = meta
+	att["metadata"] = flattenMetadata(in.ObjectMeta, d, meta)
 
-	jobSpec, err := flattenJobSpec(in.Spec, d, "spec.0.job_template.0.spec.0.template.0.")
+	jobSpec, err := flattenJobSpec(in.Spec, d, meta, "spec.0.job_template.0.spec.0.template.0.")
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +93,10 @@ func expandCronJobSpecV1(j []interface{}) (batch.CronJobSpec, error) {
 		obj.Suspend = ptrToBool(v)
 	}
 
+	if v, ok := in["time_zone"].(string); ok && v != "" {
+		obj.TimeZone = ptrToString(v)
+	}
+
 	return obj, nil
 }
 
@@ -24,6 +24,27 @@ func expandCSIDriverV1Spec(l []interface{}) storage.CSIDriverSpec {
 		obj.VolumeLifecycleModes = expandCSIDriverV1VolumeLifecycleModes(v)
 	}
 
+	if v, ok := in["storage_capacity"].(bool); ok {
+		obj.StorageCapacity = ptrToBool(v)
+	}
+
+	if v, ok := in["fs_group_policy"].(string); ok && v != "" {
+		policy := storage.FSGroupPolicy(v)
+		obj.FSGroupPolicy = &policy
+	}
+
+	if v, ok := in["token_requests"].([]interface{}); ok && len(v) > 0 {
+		obj.TokenRequests = expandCSIDriverV1TokenRequests(v)
+	}
+
+	if v, ok := in["requires_republish"].(bool); ok {
+		obj.RequiresRepublish = ptrToBool(v)
+	}
+
+	if v, ok := in["se_linux_mount"].(bool); ok {
+		obj.SELinuxMount = ptrToBool(v)
+	}
+
 	return obj
 }
 
@@ -35,22 +56,80 @@ func expandCSIDriverV1VolumeLifecycleModes(l []interface{}) []storage.VolumeLife
 	return lifecycleModes
 }
 
+func expandCSIDriverV1TokenRequests(l []interface{}) []storage.TokenRequest {
+	tokenRequests := make([]storage.TokenRequest, 0, len(l))
+	for _, v := range l {
+		tokenRequest := storage.TokenRequest{}
+		if v == nil {
+			tokenRequests = append(tokenRequests, tokenRequest)
+			continue
+		}
+		in := v.(map[string]interface{})
+		tokenRequest.Audience = in["audience"].(string)
+		if s, ok := in["expiration_seconds"].(int); ok && s > 0 {
+			tokenRequest.ExpirationSeconds = ptrToInt64(int64(s))
+		}
+		tokenRequests = append(tokenRequests, tokenRequest)
+	}
+	return tokenRequests
+}
+
 func flattenCSIDriverV1Spec(in storage.CSIDriverSpec) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
-	att["attach_required"] = in.AttachRequired
+	if in.AttachRequired != nil {
+		att["attach_required"] = *in.AttachRequired
+	}
 
 	if in.PodInfoOnMount != nil {
-		att["pod_info_on_mount"] = in.PodInfoOnMount
+		att["pod_info_on_mount"] = *in.PodInfoOnMount
 	}
 
 	if len(in.VolumeLifecycleModes) > 0 {
-		att["volume_lifecycle_modes"] = in.VolumeLifecycleModes
+		modes := make([]interface{}, len(in.VolumeLifecycleModes))
+		for i, m := range in.VolumeLifecycleModes {
+			modes[i] = string(m)
+		}
+		att["volume_lifecycle_modes"] = modes
+	}
+
+	if in.StorageCapacity != nil {
+		att["storage_capacity"] = *in.StorageCapacity
+	}
+
+	if in.FSGroupPolicy != nil {
+		att["fs_group_policy"] = string(*in.FSGroupPolicy)
+	}
+
+	if len(in.TokenRequests) > 0 {
+		att["token_requests"] = flattenCSIDriverV1TokenRequests(in.TokenRequests)
+	}
+
+	if in.RequiresRepublish != nil {
+		att["requires_republish"] = *in.RequiresRepublish
+	}
+
+	if in.SELinuxMount != nil {
+		att["se_linux_mount"] = *in.SELinuxMount
 	}
 
 	return []interface{}{att}, nil
 }
 
+func flattenCSIDriverV1TokenRequests(in []storage.TokenRequest) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{
+			"audience": v.Audience,
+		}
+		if v.ExpirationSeconds != nil {
+			m["expiration_seconds"] = int(*v.ExpirationSeconds)
+		}
+		att[i] = m
+	}
+	return att
+}
+
 func patchCSIDriverV1Spec(keyPrefix, pathPrefix string, d *schema.ResourceData) (*PatchOperations, error) {
 	ops := make(PatchOperations, 0, 0)
 	if d.HasChange(keyPrefix + "attach_required") {
@@ -74,5 +153,42 @@ func patchCSIDriverV1Spec(keyPrefix, pathPrefix string, d *schema.ResourceData)
 		})
 	}
 
+	// The remaining mutable fields may be missing from drivers created by
+	// older clusters, so they are added rather than replaced.
+	if d.HasChange(keyPrefix + "storage_capacity") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/storageCapacity",
+			Value: d.Get(keyPrefix + "storage_capacity").(bool),
+		})
+	}
+
+	if d.HasChange(keyPrefix + "token_requests") {
+		v := d.Get(keyPrefix + "token_requests").([]interface{})
+		if len(v) == 0 {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/tokenRequests",
+			})
+		} else {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/tokenRequests",
+				Value: expandCSIDriverV1TokenRequests(v),
+			})
+		}
+	}
+
+	if d.HasChange(keyPrefix + "requires_republish") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/requiresRepublish",
+			Value: d.Get(keyPrefix + "requires_republish").(bool),
+		})
+	}
+
+	if d.HasChange(keyPrefix + "se_linux_mount") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/seLinuxMount",
+			Value: d.Get(keyPrefix + "se_linux_mount").(bool),
+		})
+	}
+
 	return &ops, nil
 }
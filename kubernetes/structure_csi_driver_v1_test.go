@@ -0,0 +1,34 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	storage "k8s.io/api/storage/v1"
+)
+
+func TestExpandThenFlatten_csi_driver_v1_spec(t *testing.T) {
+	policy := storage.FileFSGroupPolicy
+	in := storage.CSIDriverSpec{
+		AttachRequired:       ptrToBool(true),
+		PodInfoOnMount:       ptrToBool(false),
+		VolumeLifecycleModes: []storage.VolumeLifecycleMode{storage.VolumeLifecyclePersistent},
+		StorageCapacity:      ptrToBool(true),
+		FSGroupPolicy:        &policy,
+		TokenRequests: []storage.TokenRequest{
+			{Audience: "vault", ExpirationSeconds: ptrToInt64(3600)},
+			{Audience: ""},
+		},
+		RequiresRepublish: ptrToBool(true),
+		SELinuxMount:      ptrToBool(false),
+	}
+
+	flattened, err := flattenCSIDriverV1Spec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := expandCSIDriverV1Spec(flattened)
+	if diff := cmp.Diff(in, out); diff != "" {
+		t.Fatalf("Unexpected CSI driver spec round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,78 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	storage "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Expanders
+
+func expandCSIStorageCapacity(d *schema.ResourceData) (*storage.CSIStorageCapacity, error) {
+	obj := &storage.CSIStorageCapacity{
+		ObjectMeta:       expandMetadata(d.Get("metadata").([]interface{})),
+		StorageClassName: d.Get("storage_class_name").(string),
+	}
+
+	// A missing node topology means the storage is not accessible from any
+	// node, while an empty one makes it accessible from all nodes.
+	if v, ok := d.Get("node_topology").([]interface{}); ok && len(v) > 0 {
+		obj.NodeTopology = expandLabelSelector(v)
+	}
+
+	var err error
+	if v, ok := d.Get("capacity").(string); ok && v != "" {
+		obj.Capacity, err = expandCSIStorageCapacityQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if v, ok := d.Get("maximum_volume_size").(string); ok && v != "" {
+		obj.MaximumVolumeSize, err = expandCSIStorageCapacityQuantity(v)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+func expandCSIStorageCapacityQuantity(v string) (*resource.Quantity, error) {
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// Flatteners
+
+func flattenCSIStorageCapacityNodeTopology(in *metav1.LabelSelector) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	return flattenLabelSelector(in)
+}
+
+func flattenCSIStorageCapacityQuantity(in *resource.Quantity) string {
+	if in == nil {
+		return ""
+	}
+	return in.String()
+}
+
+// Patchers
+
+func patchCSIStorageCapacityQuantity(key, path string, d *schema.ResourceData) (PatchOperation, error) {
+	v := d.Get(key).(string)
+	if v == "" {
+		return &RemoveOperation{Path: path}, nil
+	}
+	q, err := expandCSIStorageCapacityQuantity(v)
+	if err != nil {
+		return nil, err
+	}
+	// The field may be missing on the object, so it is added rather than
+	// replaced.
+	return &AddOperation{Path: path, Value: q}, nil
+}
@@ -0,0 +1,41 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFlattenCSIStorageCapacityNodeTopology(t *testing.T) {
+	cases := map[string]struct {
+		In       *metav1.LabelSelector
+		Expected []interface{}
+	}{
+		"not accessible from any node": {
+			In:       nil,
+			Expected: []interface{}{},
+		},
+		"accessible from all nodes": {
+			In:       &metav1.LabelSelector{},
+			Expected: []interface{}{map[string]interface{}{}},
+		},
+		"accessible from matching nodes": {
+			In: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+			},
+			Expected: []interface{}{map[string]interface{}{
+				"match_labels": map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+			}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := flattenCSIStorageCapacityNodeTopology(tc.In)
+			if diff := cmp.Diff(tc.Expected, out); diff != "" {
+				t.Fatalf("Unexpected node topology: mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	api "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Expanders
+
+func expandEndpointSliceV1Endpoints(in []interface{}) []discovery.Endpoint {
+	endpoints := make([]discovery.Endpoint, 0, len(in))
+	for _, e := range in {
+		cfg, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		r := discovery.Endpoint{
+			Addresses: sliceOfString(cfg["addresses"].([]interface{})),
+		}
+		if v, ok := cfg["condition"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			r.Conditions = expandEndpointSliceV1Conditions(v[0].(map[string]interface{}))
+		}
+		if v, ok := cfg["hostname"].(string); ok && v != "" {
+			r.Hostname = ptrToString(v)
+		}
+		if v, ok := cfg["node_name"].(string); ok && v != "" {
+			r.NodeName = ptrToString(v)
+		}
+		if v, ok := cfg["target_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			r.TargetRef = expandEndpointSliceV1TargetRef(v[0].(map[string]interface{}))
+		}
+		if v, ok := cfg["zone"].(string); ok && v != "" {
+			r.Zone = ptrToString(v)
+		}
+		endpoints = append(endpoints, r)
+	}
+	return endpoints
+}
+
+func expandEndpointSliceV1Conditions(in map[string]interface{}) discovery.EndpointConditions {
+	c := discovery.EndpointConditions{}
+	if v, ok := in["ready"].(bool); ok {
+		c.Ready = ptrToBool(v)
+	}
+	if v, ok := in["serving"].(bool); ok {
+		c.Serving = ptrToBool(v)
+	}
+	if v, ok := in["terminating"].(bool); ok {
+		c.Terminating = ptrToBool(v)
+	}
+	return c
+}
+
+func expandEndpointSliceV1TargetRef(in map[string]interface{}) *api.ObjectReference {
+	ref := &api.ObjectReference{}
+	if v, ok := in["api_version"].(string); ok {
+		ref.APIVersion = v
+	}
+	if v, ok := in["field_path"].(string); ok {
+		ref.FieldPath = v
+	}
+	if v, ok := in["kind"].(string); ok {
+		ref.Kind = v
+	}
+	if v, ok := in["name"].(string); ok {
+		ref.Name = v
+	}
+	if v, ok := in["namespace"].(string); ok {
+		ref.Namespace = v
+	}
+	if v, ok := in["resource_version"].(string); ok {
+		ref.ResourceVersion = v
+	}
+	if v, ok := in["uid"].(string); ok {
+		ref.UID = types.UID(v)
+	}
+	return ref
+}
+
+func expandEndpointSliceV1Ports(in []interface{}) []discovery.EndpointPort {
+	ports := make([]discovery.EndpointPort, 0, len(in))
+	for _, p := range in {
+		cfg, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		r := discovery.EndpointPort{}
+		if v, ok := cfg["app_protocol"].(string); ok && v != "" {
+			r.AppProtocol = ptrToString(v)
+		}
+		if v, ok := cfg["name"].(string); ok && v != "" {
+			r.Name = ptrToString(v)
+		}
+		if v, ok := cfg["port"].(int); ok {
+			r.Port = ptrToInt32(int32(v))
+		}
+		if v, ok := cfg["protocol"].(string); ok && v != "" {
+			protocol := api.Protocol(v)
+			r.Protocol = &protocol
+		}
+		ports = append(ports, r)
+	}
+	return ports
+}
+
+// Flatteners
+
+func flattenEndpointSliceV1Endpoints(in []discovery.Endpoint) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, e := range in {
+		addresses := make([]interface{}, len(e.Addresses))
+		for j, a := range e.Addresses {
+			addresses[j] = a
+		}
+		m := map[string]interface{}{
+			"addresses": addresses,
+			"condition": flattenEndpointSliceV1Conditions(e.Conditions),
+		}
+		if e.Hostname != nil {
+			m["hostname"] = *e.Hostname
+		}
+		if e.NodeName != nil {
+			m["node_name"] = *e.NodeName
+		}
+		if e.TargetRef != nil {
+			m["target_ref"] = flattenEndpointSliceV1TargetRef(*e.TargetRef)
+		}
+		if e.Zone != nil {
+			m["zone"] = *e.Zone
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// flattenEndpointSliceV1Conditions reports unset conditions with the values
+// the API interprets them as: unknown readiness is treated as ready and
+// serving defaults to the readiness of the endpoint.
+func flattenEndpointSliceV1Conditions(in discovery.EndpointConditions) []interface{} {
+	ready := in.Ready == nil || *in.Ready
+	serving := ready
+	if in.Serving != nil {
+		serving = *in.Serving
+	}
+	return []interface{}{map[string]interface{}{
+		"ready":       ready,
+		"serving":     serving,
+		"terminating": in.Terminating != nil && *in.Terminating,
+	}}
+}
+
+func flattenEndpointSliceV1TargetRef(in api.ObjectReference) []interface{} {
+	m := map[string]interface{}{
+		"name": in.Name,
+	}
+	if in.APIVersion != "" {
+		m["api_version"] = in.APIVersion
+	}
+	if in.FieldPath != "" {
+		m["field_path"] = in.FieldPath
+	}
+	if in.Kind != "" {
+		m["kind"] = in.Kind
+	}
+	if in.Namespace != "" {
+		m["namespace"] = in.Namespace
+	}
+	if in.ResourceVersion != "" {
+		m["resource_version"] = in.ResourceVersion
+	}
+	if in.UID != "" {
+		m["uid"] = string(in.UID)
+	}
+	return []interface{}{m}
+}
+
+func flattenEndpointSliceV1Ports(in []discovery.EndpointPort) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, p := range in {
+		m := make(map[string]interface{})
+		if p.AppProtocol != nil {
+			m["app_protocol"] = *p.AppProtocol
+		}
+		if p.Name != nil {
+			m["name"] = *p.Name
+		}
+		if p.Port != nil {
+			m["port"] = int(*p.Port)
+		}
+		if p.Protocol != nil {
+			m["protocol"] = string(*p.Protocol)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+// flattenServiceEndpointSlicesV1 merges the endpoints and ports of all the
+// endpoint slices of a service. An endpoint can briefly appear in more than one
+// slice while the controller moves it, so endpoints are deduplicated by their
+// addresses. Endpoints, ports and addresses are sorted so the order the slices
+// are listed in does not cause a diff.
+func flattenServiceEndpointSlicesV1(slices []discovery.EndpointSlice, includeNotReady bool) (endpoints []interface{}, ports []interface{}, addresses []string) {
+	type endpoint struct {
+		key string
+		att map[string]interface{}
+	}
+	var eps []endpoint
+	seenEndpoints := make(map[string]bool)
+	var allPorts []discovery.EndpointPort
+	seenPorts := make(map[string]bool)
+
+	for _, s := range slices {
+		for _, e := range s.Endpoints {
+			// A nil ready condition means the endpoint is ready.
+			ready := e.Conditions.Ready == nil || *e.Conditions.Ready
+			if !ready && !includeNotReady {
+				continue
+			}
+			addrs := sortedStrings(e.Addresses)
+			key := string(s.AddressType) + "/" + strings.Join(addrs, ",")
+			if seenEndpoints[key] {
+				continue
+			}
+			seenEndpoints[key] = true
+
+			att := map[string]interface{}{
+				"addresses":    addrs,
+				"address_type": string(s.AddressType),
+				"ready":        ready,
+				"serving":      e.Conditions.Serving == nil || *e.Conditions.Serving,
+				"terminating":  e.Conditions.Terminating != nil && *e.Conditions.Terminating,
+			}
+			if e.Hostname != nil {
+				att["hostname"] = *e.Hostname
+			}
+			if e.NodeName != nil {
+				att["node_name"] = *e.NodeName
+			}
+			if e.Zone != nil {
+				att["zone"] = *e.Zone
+			}
+			eps = append(eps, endpoint{key: key, att: att})
+			addresses = append(addresses, e.Addresses...)
+		}
+		for _, p := range s.Ports {
+			key := endpointSliceV1PortKey(p)
+			if seenPorts[key] {
+				continue
+			}
+			seenPorts[key] = true
+			allPorts = append(allPorts, p)
+		}
+	}
+
+	sort.Slice(eps, func(i, j int) bool {
+		return eps[i].key < eps[j].key
+	})
+	endpoints = make([]interface{}, len(eps))
+	for i, e := range eps {
+		endpoints[i] = e.att
+	}
+
+	sort.Slice(allPorts, func(i, j int) bool {
+		return endpointSliceV1PortKey(allPorts[i]) < endpointSliceV1PortKey(allPorts[j])
+	})
+	ports = flattenEndpointSliceV1Ports(allPorts)
+
+	addresses = sortedStrings(addresses)
+	return endpoints, ports, addresses
+}
+
+// endpointSliceV1PortKey identifies a port by its name, number and protocol.
+// The number is zero padded so that keys sort numerically.
+func endpointSliceV1PortKey(p discovery.EndpointPort) string {
+	var name, protocol string
+	var port int32
+	if p.Name != nil {
+		name = *p.Name
+	}
+	if p.Port != nil {
+		port = *p.Port
+	}
+	if p.Protocol != nil {
+		protocol = string(*p.Protocol)
+	}
+	return fmt.Sprintf("%s/%05d/%s", name, port, protocol)
+}
+
+func sortedStrings(in []string) []string {
+	out := make([]string, len(in))
+	copy(out, in)
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,185 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "k8s.io/api/core/v1"
+	discovery "k8s.io/api/discovery/v1"
+)
+
+func TestValidateEndpointSliceV1Address(t *testing.T) {
+	cases := []struct {
+		AddressType string
+		Address     string
+		Valid       bool
+	}{
+		{"IPv4", "10.0.0.4", true},
+		{"IPv4", "fd00::1", false},
+		{"IPv4", "backend.example.com", false},
+		{"IPv6", "fd00::1", true},
+		{"IPv6", "10.0.0.4", false},
+		{"FQDN", "backend.example.com", true},
+		{"FQDN", "Backend_0", false},
+	}
+
+	for _, tc := range cases {
+		err := validateEndpointSliceV1Address(tc.AddressType, tc.Address)
+		if tc.Valid && err != nil {
+			t.Errorf("Expected %q to be a valid %s address, got: %s", tc.Address, tc.AddressType, err)
+		}
+		if !tc.Valid && err == nil {
+			t.Errorf("Expected %q to be an invalid %s address", tc.Address, tc.AddressType)
+		}
+	}
+}
+
+func TestExpandThenFlatten_endpoint_slice_v1(t *testing.T) {
+	endpoints := []interface{}{
+		map[string]interface{}{
+			"addresses": []interface{}{"10.0.0.4"},
+			"condition": []interface{}{map[string]interface{}{
+				"ready":       false,
+				"serving":     true,
+				"terminating": true,
+			}},
+			"hostname":  "backend-0",
+			"node_name": "node-a",
+			"target_ref": []interface{}{map[string]interface{}{
+				"kind":      "Pod",
+				"name":      "backend-0",
+				"namespace": "default",
+			}},
+			"zone": "zone-a",
+		},
+	}
+	ports := []interface{}{
+		map[string]interface{}{
+			"app_protocol": "http",
+			"name":         "http",
+			"port":         80,
+			"protocol":     "TCP",
+		},
+	}
+
+	if diff := cmp.Diff(endpoints, flattenEndpointSliceV1Endpoints(expandEndpointSliceV1Endpoints(endpoints))); diff != "" {
+		t.Fatalf("Unexpected endpoints round trip: mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(ports, flattenEndpointSliceV1Ports(expandEndpointSliceV1Ports(ports))); diff != "" {
+		t.Fatalf("Unexpected ports round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenEndpointSliceV1Conditions(t *testing.T) {
+	f := false
+	expected := []interface{}{map[string]interface{}{
+		"ready":       false,
+		"serving":     false,
+		"terminating": false,
+	}}
+	if diff := cmp.Diff(expected, flattenEndpointSliceV1Conditions(discovery.EndpointConditions{Ready: &f})); diff != "" {
+		t.Fatalf("Expected serving to default to ready: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenServiceEndpointSlicesV1(t *testing.T) {
+	http := "http"
+	tcp := api.ProtocolTCP
+	slices := []discovery.EndpointSlice{
+		{
+			AddressType: discovery.AddressTypeIPv4,
+			Endpoints: []discovery.Endpoint{
+				{
+					Addresses:  []string{"10.0.0.2"},
+					Conditions: discovery.EndpointConditions{Ready: ptrToBool(true)},
+					NodeName:   ptrToString("node-b"),
+					Zone:       ptrToString("zone-b"),
+				},
+				{
+					Addresses: []string{"10.0.0.3"},
+					Conditions: discovery.EndpointConditions{
+						Ready:       ptrToBool(false),
+						Serving:     ptrToBool(true),
+						Terminating: ptrToBool(true),
+					},
+					NodeName: ptrToString("node-c"),
+				},
+			},
+			Ports: []discovery.EndpointPort{
+				{Name: &http, Port: ptrToInt32(8080), Protocol: &tcp},
+			},
+		},
+		{
+			AddressType: discovery.AddressTypeIPv4,
+			Endpoints: []discovery.Endpoint{
+				{
+					// Ready is unset, which means the endpoint is ready.
+					Addresses: []string{"10.0.0.1"},
+					NodeName:  ptrToString("node-a"),
+				},
+				{
+					// Also listed in the first slice while it is moved.
+					Addresses:  []string{"10.0.0.2"},
+					Conditions: discovery.EndpointConditions{Ready: ptrToBool(true)},
+					NodeName:   ptrToString("node-b"),
+					Zone:       ptrToString("zone-b"),
+				},
+			},
+			Ports: []discovery.EndpointPort{
+				{Name: &http, Port: ptrToInt32(8080), Protocol: &tcp},
+				{Name: ptrToString("admin"), Port: ptrToInt32(9090), Protocol: &tcp},
+			},
+		},
+	}
+
+	expectedPorts := []interface{}{
+		map[string]interface{}{"name": "admin", "port": 9090, "protocol": "TCP"},
+		map[string]interface{}{"name": "http", "port": 8080, "protocol": "TCP"},
+	}
+	readyEndpoints := []interface{}{
+		map[string]interface{}{
+			"addresses":    []string{"10.0.0.1"},
+			"address_type": "IPv4",
+			"ready":        true,
+			"serving":      true,
+			"terminating":  false,
+			"node_name":    "node-a",
+		},
+		map[string]interface{}{
+			"addresses":    []string{"10.0.0.2"},
+			"address_type": "IPv4",
+			"ready":        true,
+			"serving":      true,
+			"terminating":  false,
+			"node_name":    "node-b",
+			"zone":         "zone-b",
+		},
+	}
+
+	endpoints, ports, addresses := flattenServiceEndpointSlicesV1(slices, false)
+	if diff := cmp.Diff(readyEndpoints, endpoints); diff != "" {
+		t.Errorf("Unexpected ready endpoints (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(expectedPorts, ports); diff != "" {
+		t.Errorf("Unexpected ports (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.1", "10.0.0.2"}, addresses); diff != "" {
+		t.Errorf("Unexpected ready addresses (-want +got):\n%s", diff)
+	}
+
+	allEndpoints := append(readyEndpoints, map[string]interface{}{
+		"addresses":    []string{"10.0.0.3"},
+		"address_type": "IPv4",
+		"ready":        false,
+		"serving":      true,
+		"terminating":  true,
+		"node_name":    "node-c",
+	})
+	endpoints, _, addresses = flattenServiceEndpointSlicesV1(slices, true)
+	if diff := cmp.Diff(allEndpoints, endpoints); diff != "" {
+		t.Errorf("Unexpected endpoints including not ready ones (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, addresses); diff != "" {
+		t.Errorf("Unexpected addresses including not ready ones (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,340 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The vendored client-go predates the flowcontrol.apiserver.k8s.io/v1 API.
+// The v1beta3 types are identical to the v1 ones apart from the limited
+// priority level configuration, whose nominal concurrency shares became
+// optional in v1, so they are used as the data model and sent to the v1
+// endpoints through the dynamic client.
+const flowControlV1GroupVersion = "flowcontrol.apiserver.k8s.io/v1"
+
+var (
+	flowSchemaV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "flowcontrol.apiserver.k8s.io",
+		Version:  "v1",
+		Resource: "flowschemas",
+	}
+	priorityLevelConfigurationV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "flowcontrol.apiserver.k8s.io",
+		Version:  "v1",
+		Resource: "prioritylevelconfigurations",
+	}
+)
+
+type priorityLevelConfigurationV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec priorityLevelConfigurationV1Spec `json:"spec"`
+}
+
+type priorityLevelConfigurationV1Spec struct {
+	Type    flowcontrolv1beta3.PriorityLevelEnablement           `json:"type"`
+	Limited *limitedPriorityLevelConfigurationV1                 `json:"limited,omitempty"`
+	Exempt  *flowcontrolv1beta3.ExemptPriorityLevelConfiguration `json:"exempt,omitempty"`
+}
+
+// limitedPriorityLevelConfigurationV1 differs from the v1beta3 type in that an
+// unset nominal concurrency shares is omitted, letting the API server default
+// it, rather than sent as zero.
+type limitedPriorityLevelConfigurationV1 struct {
+	NominalConcurrencyShares *int32                           `json:"nominalConcurrencyShares,omitempty"`
+	LimitResponse            flowcontrolv1beta3.LimitResponse `json:"limitResponse"`
+	LendablePercent          *int32                           `json:"lendablePercent,omitempty"`
+	BorrowingLimitPercent    *int32                           `json:"borrowingLimitPercent,omitempty"`
+}
+
+// Expanders
+
+func expandFlowSchemaV1Spec(l []interface{}) flowcontrolv1beta3.FlowSchemaSpec {
+	obj := flowcontrolv1beta3.FlowSchemaSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["priority_level_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.PriorityLevelConfiguration.Name = v[0].(map[string]interface{})["name"].(string)
+	}
+	// A zero matching precedence is defaulted by the API server.
+	if v, ok := in["matching_precedence"].(int); ok {
+		obj.MatchingPrecedence = int32(v)
+	}
+	if v, ok := in["distinguisher_method"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.DistinguisherMethod = &flowcontrolv1beta3.FlowDistinguisherMethod{
+			Type: flowcontrolv1beta3.FlowDistinguisherMethodType(v[0].(map[string]interface{})["type"].(string)),
+		}
+	}
+	if v, ok := in["rule"].([]interface{}); ok {
+		for _, r := range v {
+			if r == nil {
+				continue
+			}
+			obj.Rules = append(obj.Rules, expandFlowSchemaV1Rule(r.(map[string]interface{})))
+		}
+	}
+	return obj
+}
+
+func expandFlowSchemaV1Rule(in map[string]interface{}) flowcontrolv1beta3.PolicyRulesWithSubjects {
+	obj := flowcontrolv1beta3.PolicyRulesWithSubjects{}
+	if v, ok := in["subject"].([]interface{}); ok {
+		for _, s := range v {
+			if s == nil {
+				continue
+			}
+			obj.Subjects = append(obj.Subjects, expandFlowSchemaV1Subject(s.(map[string]interface{})))
+		}
+	}
+	if v, ok := in["resource_rule"].([]interface{}); ok {
+		for _, r := range v {
+			if r == nil {
+				continue
+			}
+			m := r.(map[string]interface{})
+			rule := flowcontrolv1beta3.ResourcePolicyRule{
+				Verbs:        schemaSetToStringArray(m["verbs"].(*schema.Set)),
+				APIGroups:    schemaSetToStringArray(m["api_groups"].(*schema.Set)),
+				Resources:    schemaSetToStringArray(m["resources"].(*schema.Set)),
+				ClusterScope: m["cluster_scope"].(bool),
+			}
+			if ns, ok := m["namespaces"].(*schema.Set); ok && ns.Len() > 0 {
+				rule.Namespaces = schemaSetToStringArray(ns)
+			}
+			obj.ResourceRules = append(obj.ResourceRules, rule)
+		}
+	}
+	if v, ok := in["non_resource_rule"].([]interface{}); ok {
+		for _, r := range v {
+			if r == nil {
+				continue
+			}
+			m := r.(map[string]interface{})
+			obj.NonResourceRules = append(obj.NonResourceRules, flowcontrolv1beta3.NonResourcePolicyRule{
+				Verbs:           schemaSetToStringArray(m["verbs"].(*schema.Set)),
+				NonResourceURLs: schemaSetToStringArray(m["non_resource_urls"].(*schema.Set)),
+			})
+		}
+	}
+	return obj
+}
+
+func expandFlowSchemaV1Subject(in map[string]interface{}) flowcontrolv1beta3.Subject {
+	obj := flowcontrolv1beta3.Subject{
+		Kind: flowcontrolv1beta3.SubjectKind(in["kind"].(string)),
+	}
+	if v, ok := in["user"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.User = &flowcontrolv1beta3.UserSubject{
+			Name: v[0].(map[string]interface{})["name"].(string),
+		}
+	}
+	if v, ok := in["group"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.Group = &flowcontrolv1beta3.GroupSubject{
+			Name: v[0].(map[string]interface{})["name"].(string),
+		}
+	}
+	if v, ok := in["service_account"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		obj.ServiceAccount = &flowcontrolv1beta3.ServiceAccountSubject{
+			Namespace: m["namespace"].(string),
+			Name:      m["name"].(string),
+		}
+	}
+	return obj
+}
+
+// expandPriorityLevelConfigurationV1Spec only expands the block matching the
+// type. The other block and the queuing parameters are computed, so the prior
+// state may still hold values the API server would reject for the new type.
+func expandPriorityLevelConfigurationV1Spec(l []interface{}) priorityLevelConfigurationV1Spec {
+	obj := priorityLevelConfigurationV1Spec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	obj.Type = flowcontrolv1beta3.PriorityLevelEnablement(in["type"].(string))
+
+	switch obj.Type {
+	case flowcontrolv1beta3.PriorityLevelEnablementLimited:
+		if v, ok := in["limited"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			obj.Limited = expandLimitedPriorityLevelConfigurationV1(v[0].(map[string]interface{}))
+		}
+	case flowcontrolv1beta3.PriorityLevelEnablementExempt:
+		if v, ok := in["exempt"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			m := v[0].(map[string]interface{})
+			obj.Exempt = &flowcontrolv1beta3.ExemptPriorityLevelConfiguration{}
+			if v, ok := m["nominal_concurrency_shares"].(int); ok && v > 0 {
+				obj.Exempt.NominalConcurrencyShares = ptrToInt32(int32(v))
+			}
+			if v, ok := m["lendable_percent"].(int); ok && v > 0 {
+				obj.Exempt.LendablePercent = ptrToInt32(int32(v))
+			}
+		}
+	}
+	return obj
+}
+
+func expandLimitedPriorityLevelConfigurationV1(in map[string]interface{}) *limitedPriorityLevelConfigurationV1 {
+	obj := &limitedPriorityLevelConfigurationV1{}
+	// Zero values are left to the API server defaults.
+	if v, ok := in["nominal_concurrency_shares"].(int); ok && v > 0 {
+		obj.NominalConcurrencyShares = ptrToInt32(int32(v))
+	}
+	if v, ok := in["lendable_percent"].(int); ok && v > 0 {
+		obj.LendablePercent = ptrToInt32(int32(v))
+	}
+	if v, ok := in["borrowing_limit_percent"].(int); ok && v > 0 {
+		obj.BorrowingLimitPercent = ptrToInt32(int32(v))
+	}
+	if v, ok := in["limit_response"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		m := v[0].(map[string]interface{})
+		obj.LimitResponse.Type = flowcontrolv1beta3.LimitResponseType(m["type"].(string))
+		if q, ok := m["queuing"].([]interface{}); ok && len(q) > 0 && q[0] != nil && obj.LimitResponse.Type == flowcontrolv1beta3.LimitResponseTypeQueue {
+			qm := q[0].(map[string]interface{})
+			obj.LimitResponse.Queuing = &flowcontrolv1beta3.QueuingConfiguration{
+				Queues:           int32(qm["queues"].(int)),
+				HandSize:         int32(qm["hand_size"].(int)),
+				QueueLengthLimit: int32(qm["queue_length_limit"].(int)),
+			}
+		}
+	}
+	return obj
+}
+
+// Flatteners
+
+func flattenFlowSchemaV1Spec(in flowcontrolv1beta3.FlowSchemaSpec) []interface{} {
+	att := map[string]interface{}{
+		"priority_level_configuration": []interface{}{
+			map[string]interface{}{
+				"name": in.PriorityLevelConfiguration.Name,
+			},
+		},
+		"matching_precedence": int(in.MatchingPrecedence),
+	}
+	if in.DistinguisherMethod != nil {
+		att["distinguisher_method"] = []interface{}{
+			map[string]interface{}{
+				"type": string(in.DistinguisherMethod.Type),
+			},
+		}
+	}
+	rules := make([]interface{}, len(in.Rules))
+	for i, r := range in.Rules {
+		rules[i] = flattenFlowSchemaV1Rule(r)
+	}
+	att["rule"] = rules
+	return []interface{}{att}
+}
+
+func flattenFlowSchemaV1Rule(in flowcontrolv1beta3.PolicyRulesWithSubjects) map[string]interface{} {
+	subjects := make([]interface{}, len(in.Subjects))
+	for i, s := range in.Subjects {
+		subjects[i] = flattenFlowSchemaV1Subject(s)
+	}
+	resourceRules := make([]interface{}, len(in.ResourceRules))
+	for i, r := range in.ResourceRules {
+		resourceRules[i] = map[string]interface{}{
+			"verbs":         newStringSet(schema.HashString, r.Verbs),
+			"api_groups":    newStringSet(schema.HashString, r.APIGroups),
+			"resources":     newStringSet(schema.HashString, r.Resources),
+			"cluster_scope": r.ClusterScope,
+			"namespaces":    newStringSet(schema.HashString, r.Namespaces),
+		}
+	}
+	nonResourceRules := make([]interface{}, len(in.NonResourceRules))
+	for i, r := range in.NonResourceRules {
+		nonResourceRules[i] = map[string]interface{}{
+			"verbs":             newStringSet(schema.HashString, r.Verbs),
+			"non_resource_urls": newStringSet(schema.HashString, r.NonResourceURLs),
+		}
+	}
+	return map[string]interface{}{
+		"subject":           subjects,
+		"resource_rule":     resourceRules,
+		"non_resource_rule": nonResourceRules,
+	}
+}
+
+func flattenFlowSchemaV1Subject(in flowcontrolv1beta3.Subject) map[string]interface{} {
+	att := map[string]interface{}{
+		"kind": string(in.Kind),
+	}
+	if in.User != nil {
+		att["user"] = []interface{}{
+			map[string]interface{}{
+				"name": in.User.Name,
+			},
+		}
+	}
+	if in.Group != nil {
+		att["group"] = []interface{}{
+			map[string]interface{}{
+				"name": in.Group.Name,
+			},
+		}
+	}
+	if in.ServiceAccount != nil {
+		att["service_account"] = []interface{}{
+			map[string]interface{}{
+				"namespace": in.ServiceAccount.Namespace,
+				"name":      in.ServiceAccount.Name,
+			},
+		}
+	}
+	return att
+}
+
+func flattenPriorityLevelConfigurationV1Spec(in priorityLevelConfigurationV1Spec) []interface{} {
+	att := map[string]interface{}{
+		"type": string(in.Type),
+	}
+	if in.Limited != nil {
+		limited := map[string]interface{}{
+			"limit_response": flattenPriorityLevelConfigurationV1LimitResponse(in.Limited.LimitResponse),
+		}
+		if in.Limited.NominalConcurrencyShares != nil {
+			limited["nominal_concurrency_shares"] = int(*in.Limited.NominalConcurrencyShares)
+		}
+		if in.Limited.LendablePercent != nil {
+			limited["lendable_percent"] = int(*in.Limited.LendablePercent)
+		}
+		if in.Limited.BorrowingLimitPercent != nil {
+			limited["borrowing_limit_percent"] = int(*in.Limited.BorrowingLimitPercent)
+		}
+		att["limited"] = []interface{}{limited}
+	}
+	if in.Exempt != nil {
+		exempt := map[string]interface{}{}
+		if in.Exempt.NominalConcurrencyShares != nil {
+			exempt["nominal_concurrency_shares"] = int(*in.Exempt.NominalConcurrencyShares)
+		}
+		if in.Exempt.LendablePercent != nil {
+			exempt["lendable_percent"] = int(*in.Exempt.LendablePercent)
+		}
+		att["exempt"] = []interface{}{exempt}
+	}
+	return []interface{}{att}
+}
+
+func flattenPriorityLevelConfigurationV1LimitResponse(in flowcontrolv1beta3.LimitResponse) []interface{} {
+	att := map[string]interface{}{
+		"type": string(in.Type),
+	}
+	if in.Queuing != nil {
+		att["queuing"] = []interface{}{
+			map[string]interface{}{
+				"queues":             int(in.Queuing.Queues),
+				"hand_size":          int(in.Queuing.HandSize),
+				"queue_length_limit": int(in.Queuing.QueueLengthLimit),
+			},
+		}
+	}
+	return []interface{}{att}
+}
@@ -0,0 +1,277 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	flowcontrolv1beta3 "k8s.io/api/flowcontrol/v1beta3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExpandThenFlatten_flow_schema_v1(t *testing.T) {
+	spec := []interface{}{map[string]interface{}{
+		"priority_level_configuration": []interface{}{map[string]interface{}{
+			"name": "workload-low",
+		}},
+		"matching_precedence": 500,
+		"distinguisher_method": []interface{}{map[string]interface{}{
+			"type": "ByNamespace",
+		}},
+		"rule": []interface{}{map[string]interface{}{
+			"subject": []interface{}{
+				map[string]interface{}{
+					"kind": "ServiceAccount",
+					"service_account": []interface{}{map[string]interface{}{
+						"namespace": "ci",
+						"name":      "*",
+					}},
+				},
+				map[string]interface{}{
+					"kind": "Group",
+					"group": []interface{}{map[string]interface{}{
+						"name": "system:authenticated",
+					}},
+				},
+			},
+			"resource_rule": []interface{}{map[string]interface{}{
+				"verbs":         schema.NewSet(schema.HashString, []interface{}{"list", "watch"}),
+				"api_groups":    schema.NewSet(schema.HashString, []interface{}{""}),
+				"resources":     schema.NewSet(schema.HashString, []interface{}{"pods"}),
+				"cluster_scope": false,
+				"namespaces":    schema.NewSet(schema.HashString, []interface{}{"*"}),
+			}},
+			"non_resource_rule": []interface{}{map[string]interface{}{
+				"verbs":             schema.NewSet(schema.HashString, []interface{}{"get"}),
+				"non_resource_urls": schema.NewSet(schema.HashString, []interface{}{"/healthz"}),
+			}},
+		}},
+	}}
+
+	expected := flowcontrolv1beta3.FlowSchemaSpec{
+		PriorityLevelConfiguration: flowcontrolv1beta3.PriorityLevelConfigurationReference{Name: "workload-low"},
+		MatchingPrecedence:         500,
+		DistinguisherMethod:        &flowcontrolv1beta3.FlowDistinguisherMethod{Type: flowcontrolv1beta3.FlowDistinguisherMethodByNamespaceType},
+		Rules: []flowcontrolv1beta3.PolicyRulesWithSubjects{{
+			Subjects: []flowcontrolv1beta3.Subject{
+				{
+					Kind:           flowcontrolv1beta3.SubjectKindServiceAccount,
+					ServiceAccount: &flowcontrolv1beta3.ServiceAccountSubject{Namespace: "ci", Name: "*"},
+				},
+				{
+					Kind:  flowcontrolv1beta3.SubjectKindGroup,
+					Group: &flowcontrolv1beta3.GroupSubject{Name: "system:authenticated"},
+				},
+			},
+			ResourceRules: []flowcontrolv1beta3.ResourcePolicyRule{{
+				Verbs:      []string{"list", "watch"},
+				APIGroups:  []string{""},
+				Resources:  []string{"pods"},
+				Namespaces: []string{"*"},
+			}},
+			NonResourceRules: []flowcontrolv1beta3.NonResourcePolicyRule{{
+				Verbs:           []string{"get"},
+				NonResourceURLs: []string{"/healthz"},
+			}},
+		}},
+	}
+
+	out := expandFlowSchemaV1Spec(spec)
+	sortVerbs := cmpopts.SortSlices(func(a, b string) bool { return a < b })
+	if diff := cmp.Diff(expected, out, sortVerbs); diff != "" {
+		t.Errorf("Unexpected output from expander: %s", diff)
+	}
+
+	roundTrip := expandFlowSchemaV1Spec(flattenFlowSchemaV1Spec(out))
+	if diff := cmp.Diff(out, roundTrip, sortVerbs); diff != "" {
+		t.Errorf("Unexpected output after flattening and expanding again: %s", diff)
+	}
+}
+
+func TestExpandPriorityLevelConfigurationV1Spec(t *testing.T) {
+	cases := map[string]struct {
+		Input          []interface{}
+		ExpectedOutput priorityLevelConfigurationV1Spec
+	}{
+		"limited with server defaults": {
+			Input: []interface{}{map[string]interface{}{
+				"type": "Limited",
+				"limited": []interface{}{map[string]interface{}{
+					"nominal_concurrency_shares": 0,
+					"lendable_percent":           0,
+					"borrowing_limit_percent":    0,
+					"limit_response": []interface{}{map[string]interface{}{
+						"type":    "Queue",
+						"queuing": []interface{}{},
+					}},
+				}},
+			}},
+			ExpectedOutput: priorityLevelConfigurationV1Spec{
+				Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+				Limited: &limitedPriorityLevelConfigurationV1{
+					LimitResponse: flowcontrolv1beta3.LimitResponse{Type: flowcontrolv1beta3.LimitResponseTypeQueue},
+				},
+			},
+		},
+		"limited with queuing": {
+			Input: []interface{}{map[string]interface{}{
+				"type": "Limited",
+				"limited": []interface{}{map[string]interface{}{
+					"nominal_concurrency_shares": 10,
+					"lendable_percent":           50,
+					"borrowing_limit_percent":    20,
+					"limit_response": []interface{}{map[string]interface{}{
+						"type": "Queue",
+						"queuing": []interface{}{map[string]interface{}{
+							"queues":             16,
+							"hand_size":          4,
+							"queue_length_limit": 100,
+						}},
+					}},
+				}},
+			}},
+			ExpectedOutput: priorityLevelConfigurationV1Spec{
+				Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+				Limited: &limitedPriorityLevelConfigurationV1{
+					NominalConcurrencyShares: ptrToInt32(10),
+					LendablePercent:          ptrToInt32(50),
+					BorrowingLimitPercent:    ptrToInt32(20),
+					LimitResponse: flowcontrolv1beta3.LimitResponse{
+						Type: flowcontrolv1beta3.LimitResponseTypeQueue,
+						Queuing: &flowcontrolv1beta3.QueuingConfiguration{
+							Queues:           16,
+							HandSize:         4,
+							QueueLengthLimit: 100,
+						},
+					},
+				},
+			},
+		},
+		"reject drops queuing from prior state": {
+			Input: []interface{}{map[string]interface{}{
+				"type": "Limited",
+				"limited": []interface{}{map[string]interface{}{
+					"nominal_concurrency_shares": 30,
+					"limit_response": []interface{}{map[string]interface{}{
+						"type": "Reject",
+						"queuing": []interface{}{map[string]interface{}{
+							"queues":             64,
+							"hand_size":          8,
+							"queue_length_limit": 50,
+						}},
+					}},
+				}},
+			}},
+			ExpectedOutput: priorityLevelConfigurationV1Spec{
+				Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+				Limited: &limitedPriorityLevelConfigurationV1{
+					NominalConcurrencyShares: ptrToInt32(30),
+					LimitResponse:            flowcontrolv1beta3.LimitResponse{Type: flowcontrolv1beta3.LimitResponseTypeReject},
+				},
+			},
+		},
+		"limited drops exempt from prior state": {
+			Input: []interface{}{map[string]interface{}{
+				"type": "Limited",
+				"limited": []interface{}{map[string]interface{}{
+					"limit_response": []interface{}{map[string]interface{}{
+						"type": "Reject",
+					}},
+				}},
+				"exempt": []interface{}{map[string]interface{}{
+					"nominal_concurrency_shares": 0,
+					"lendable_percent":           0,
+				}},
+			}},
+			ExpectedOutput: priorityLevelConfigurationV1Spec{
+				Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+				Limited: &limitedPriorityLevelConfigurationV1{
+					LimitResponse: flowcontrolv1beta3.LimitResponse{Type: flowcontrolv1beta3.LimitResponseTypeReject},
+				},
+			},
+		},
+		"exempt": {
+			Input: []interface{}{map[string]interface{}{
+				"type": "Exempt",
+				"exempt": []interface{}{map[string]interface{}{
+					"nominal_concurrency_shares": 5,
+					"lendable_percent":           0,
+				}},
+			}},
+			ExpectedOutput: priorityLevelConfigurationV1Spec{
+				Type: flowcontrolv1beta3.PriorityLevelEnablementExempt,
+				Exempt: &flowcontrolv1beta3.ExemptPriorityLevelConfiguration{
+					NominalConcurrencyShares: ptrToInt32(5),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := expandPriorityLevelConfigurationV1Spec(tc.Input)
+			if diff := cmp.Diff(tc.ExpectedOutput, out); diff != "" {
+				t.Errorf("Unexpected output from expander: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPriorityLevelConfigurationV1OmitsUnsetShares(t *testing.T) {
+	plc := priorityLevelConfigurationV1{
+		Spec: priorityLevelConfigurationV1Spec{
+			Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+			Limited: &limitedPriorityLevelConfigurationV1{
+				LimitResponse: flowcontrolv1beta3.LimitResponse{Type: flowcontrolv1beta3.LimitResponseTypeReject},
+			},
+		},
+	}
+	obj, err := toUnstructuredObject(&plc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, found, err := unstructured.NestedFieldNoCopy(obj.Object, "spec", "limited", "nominalConcurrencyShares")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Errorf("Expected an unset nominalConcurrencyShares to be omitted so the API server defaults it")
+	}
+}
+
+func TestFlattenPriorityLevelConfigurationV1Spec(t *testing.T) {
+	in := priorityLevelConfigurationV1Spec{
+		Type: flowcontrolv1beta3.PriorityLevelEnablementLimited,
+		Limited: &limitedPriorityLevelConfigurationV1{
+			NominalConcurrencyShares: ptrToInt32(30),
+			LendablePercent:          ptrToInt32(0),
+			LimitResponse: flowcontrolv1beta3.LimitResponse{
+				Type: flowcontrolv1beta3.LimitResponseTypeQueue,
+				Queuing: &flowcontrolv1beta3.QueuingConfiguration{
+					Queues:           64,
+					HandSize:         8,
+					QueueLengthLimit: 50,
+				},
+			},
+		},
+	}
+	expected := []interface{}{map[string]interface{}{
+		"type": "Limited",
+		"limited": []interface{}{map[string]interface{}{
+			"nominal_concurrency_shares": 30,
+			"lendable_percent":           0,
+			"limit_response": []interface{}{map[string]interface{}{
+				"type": "Queue",
+				"queuing": []interface{}{map[string]interface{}{
+					"queues":             64,
+					"hand_size":          8,
+					"queue_length_limit": 50,
+				}},
+			}},
+		}},
+	}}
+	if diff := cmp.Diff(expected, flattenPriorityLevelConfigurationV1Spec(in)); diff != "" {
+		t.Errorf("Unexpected output from flattener: %s", diff)
+	}
+}
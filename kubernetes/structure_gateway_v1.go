@@ -0,0 +1,258 @@
+package kubernetes
+
+// Expanders
+
+func expandGatewayV1Spec(l []interface{}) gatewayV1Spec {
+	obj := gatewayV1Spec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.GatewayClassName = in["gateway_class_name"].(string)
+	if v, ok := in["listener"].([]interface{}); ok {
+		obj.Listeners = expandGatewayV1Listeners(v)
+	}
+	if v, ok := in["address"].([]interface{}); ok && len(v) > 0 {
+		obj.Addresses = expandGatewayV1Addresses(v)
+	}
+	return obj
+}
+
+func expandGatewayV1Listeners(l []interface{}) []gatewayV1Listener {
+	obj := make([]gatewayV1Listener, 0, len(l))
+	for _, li := range l {
+		in, ok := li.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		listener := gatewayV1Listener{
+			Name:     in["name"].(string),
+			Port:     int32(in["port"].(int)),
+			Protocol: in["protocol"].(string),
+		}
+		if v, ok := in["hostname"].(string); ok && v != "" {
+			listener.Hostname = ptrToString(v)
+		}
+		if v, ok := in["tls"].([]interface{}); ok && len(v) > 0 {
+			listener.TLS = expandGatewayV1TLSConfig(v)
+		}
+		if v, ok := in["allowed_routes"].([]interface{}); ok && len(v) > 0 {
+			listener.AllowedRoutes = expandGatewayV1AllowedRoutes(v)
+		}
+		obj = append(obj, listener)
+	}
+	return obj
+}
+
+func expandGatewayV1TLSConfig(l []interface{}) *gatewayV1TLSConfig {
+	obj := &gatewayV1TLSConfig{}
+	if l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["mode"].(string); ok && v != "" {
+		obj.Mode = ptrToString(v)
+	}
+	if v, ok := in["certificate_ref"].([]interface{}); ok {
+		for _, ri := range v {
+			r, ok := ri.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ref := gatewayV1SecretObjectReference{
+				Name: r["name"].(string),
+			}
+			if v, ok := r["group"].(string); ok && v != "" {
+				ref.Group = ptrToString(v)
+			}
+			if v, ok := r["kind"].(string); ok && v != "" {
+				ref.Kind = ptrToString(v)
+			}
+			if v, ok := r["namespace"].(string); ok && v != "" {
+				ref.Namespace = ptrToString(v)
+			}
+			obj.CertificateRefs = append(obj.CertificateRefs, ref)
+		}
+	}
+	if v, ok := in["options"].(map[string]interface{}); ok && len(v) > 0 {
+		obj.Options = expandStringMap(v)
+	}
+	return obj
+}
+
+func expandGatewayV1AllowedRoutes(l []interface{}) *gatewayV1AllowedRoutes {
+	obj := &gatewayV1AllowedRoutes{}
+	if l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["namespaces"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ns := v[0].(map[string]interface{})
+		obj.Namespaces = &gatewayV1RouteNamespaces{}
+		if v, ok := ns["from"].(string); ok && v != "" {
+			obj.Namespaces.From = ptrToString(v)
+		}
+		if v, ok := ns["selector"].([]interface{}); ok && len(v) > 0 {
+			obj.Namespaces.Selector = expandLabelSelector(v)
+		}
+	}
+	if v, ok := in["kind"].([]interface{}); ok {
+		for _, ki := range v {
+			k, ok := ki.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			kind := gatewayV1RouteGroupKind{
+				Kind: k["kind"].(string),
+			}
+			if v, ok := k["group"].(string); ok && v != "" {
+				kind.Group = ptrToString(v)
+			}
+			obj.Kinds = append(obj.Kinds, kind)
+		}
+	}
+	return obj
+}
+
+func expandGatewayV1Addresses(l []interface{}) []gatewayV1Address {
+	obj := make([]gatewayV1Address, 0, len(l))
+	for _, ai := range l {
+		in, ok := ai.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		addr := gatewayV1Address{
+			Value: in["value"].(string),
+		}
+		if v, ok := in["type"].(string); ok && v != "" {
+			addr.Type = ptrToString(v)
+		}
+		obj = append(obj, addr)
+	}
+	return obj
+}
+
+// Flatteners
+
+func flattenGatewayV1Spec(in gatewayV1Spec) []interface{} {
+	att := map[string]interface{}{
+		"gateway_class_name": in.GatewayClassName,
+		"listener":           flattenGatewayV1Listeners(in.Listeners),
+	}
+	if len(in.Addresses) > 0 {
+		att["address"] = flattenGatewayV1Addresses(in.Addresses)
+	}
+	return []interface{}{att}
+}
+
+func flattenGatewayV1Listeners(in []gatewayV1Listener) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, l := range in {
+		m := map[string]interface{}{
+			"name":     l.Name,
+			"port":     int(l.Port),
+			"protocol": l.Protocol,
+		}
+		if l.Hostname != nil {
+			m["hostname"] = *l.Hostname
+		}
+		if l.TLS != nil {
+			m["tls"] = flattenGatewayV1TLSConfig(l.TLS)
+		}
+		if l.AllowedRoutes != nil {
+			m["allowed_routes"] = flattenGatewayV1AllowedRoutes(l.AllowedRoutes)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenGatewayV1TLSConfig(in *gatewayV1TLSConfig) []interface{} {
+	att := map[string]interface{}{}
+	if in.Mode != nil {
+		att["mode"] = *in.Mode
+	}
+	if len(in.CertificateRefs) > 0 {
+		refs := make([]interface{}, len(in.CertificateRefs))
+		for i, r := range in.CertificateRefs {
+			m := map[string]interface{}{
+				"name": r.Name,
+			}
+			if r.Group != nil {
+				m["group"] = *r.Group
+			}
+			if r.Kind != nil {
+				m["kind"] = *r.Kind
+			}
+			if r.Namespace != nil {
+				m["namespace"] = *r.Namespace
+			}
+			refs[i] = m
+		}
+		att["certificate_ref"] = refs
+	}
+	if len(in.Options) > 0 {
+		att["options"] = in.Options
+	}
+	return []interface{}{att}
+}
+
+func flattenGatewayV1AllowedRoutes(in *gatewayV1AllowedRoutes) []interface{} {
+	att := map[string]interface{}{}
+	if in.Namespaces != nil {
+		ns := map[string]interface{}{}
+		if in.Namespaces.From != nil {
+			ns["from"] = *in.Namespaces.From
+		}
+		if in.Namespaces.Selector != nil {
+			ns["selector"] = flattenLabelSelector(in.Namespaces.Selector)
+		}
+		att["namespaces"] = []interface{}{ns}
+	}
+	if len(in.Kinds) > 0 {
+		kinds := make([]interface{}, len(in.Kinds))
+		for i, k := range in.Kinds {
+			m := map[string]interface{}{
+				"kind": k.Kind,
+			}
+			if k.Group != nil {
+				m["group"] = *k.Group
+			}
+			kinds[i] = m
+		}
+		att["kind"] = kinds
+	}
+	return []interface{}{att}
+}
+
+func flattenGatewayV1Addresses(in []gatewayV1Address) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, a := range in {
+		m := map[string]interface{}{
+			"value": a.Value,
+		}
+		if a.Type != nil {
+			m["type"] = *a.Type
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenGatewayV1Status(in gatewayV1Status) []interface{} {
+	listeners := make([]interface{}, len(in.Listeners))
+	for i, l := range in.Listeners {
+		listeners[i] = map[string]interface{}{
+			"name":            l.Name,
+			"attached_routes": int(l.AttachedRoutes),
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"address":   flattenGatewayV1Addresses(in.Addresses),
+		"condition": flattenGatewayAPIV1Conditions(in.Conditions),
+		"listener":  listeners,
+	}}
+}
@@ -0,0 +1,51 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandThenFlatten_gateway_v1(t *testing.T) {
+	spec := []interface{}{map[string]interface{}{
+		"gateway_class_name": "example",
+		"listener": []interface{}{
+			map[string]interface{}{
+				"name":     "http",
+				"port":     80,
+				"protocol": "HTTP",
+				"allowed_routes": []interface{}{map[string]interface{}{
+					"namespaces": []interface{}{map[string]interface{}{
+						"from": "All",
+					}},
+					"kind": []interface{}{map[string]interface{}{
+						"group": "gateway.networking.k8s.io",
+						"kind":  "HTTPRoute",
+					}},
+				}},
+			},
+			map[string]interface{}{
+				"name":     "https",
+				"hostname": "*.example.com",
+				"port":     443,
+				"protocol": "HTTPS",
+				"tls": []interface{}{map[string]interface{}{
+					"mode": "Terminate",
+					"certificate_ref": []interface{}{map[string]interface{}{
+						"kind":      "Secret",
+						"name":      "example-tls",
+						"namespace": "certs",
+					}},
+				}},
+			},
+		},
+		"address": []interface{}{map[string]interface{}{
+			"type":  "IPAddress",
+			"value": "10.0.0.10",
+		}},
+	}}
+
+	if diff := cmp.Diff(spec, flattenGatewayV1Spec(expandGatewayV1Spec(spec))); diff != "" {
+		t.Fatalf("Unexpected gateway spec round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
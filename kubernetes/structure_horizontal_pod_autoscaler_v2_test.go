@@ -0,0 +1,161 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestExpandHorizontalPodAutoscalerV2Spec(t *testing.T) {
+	in := []interface{}{map[string]interface{}{
+		"max_replicas": 10,
+		"min_replicas": 2,
+		"scale_target_ref": []interface{}{map[string]interface{}{
+			"api_version": "apps/v1",
+			"kind":        "Deployment",
+			"name":        "example",
+		}},
+		"metric": []interface{}{map[string]interface{}{
+			"type": "ContainerResource",
+			"container_resource": []interface{}{map[string]interface{}{
+				"name":      "cpu",
+				"container": "application",
+				"target": []interface{}{map[string]interface{}{
+					"type":                "Utilization",
+					"average_utilization": 60,
+				}},
+			}},
+		}},
+		"behavior": []interface{}{map[string]interface{}{
+			"scale_down": []interface{}{map[string]interface{}{
+				"stabilization_window_seconds": 120,
+				"select_policy":                "Min",
+				"policy": []interface{}{map[string]interface{}{
+					"type":           "Pods",
+					"value":          1,
+					"period_seconds": 60,
+				}},
+			}},
+		}},
+	}}
+
+	spec, err := expandHorizontalPodAutoscalerV2Spec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	minPolicy := autoscalingv2.MinChangePolicySelect
+	expected := &autoscalingv2.HorizontalPodAutoscalerSpec{
+		MaxReplicas: 10,
+		MinReplicas: ptrToInt32(2),
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Name:       "example",
+		},
+		Metrics: []autoscalingv2.MetricSpec{{
+			Type: autoscalingv2.ContainerResourceMetricSourceType,
+			ContainerResource: &autoscalingv2.ContainerResourceMetricSource{
+				Name:      "cpu",
+				Container: "application",
+				Target: autoscalingv2.MetricTarget{
+					Type:               autoscalingv2.UtilizationMetricType,
+					AverageUtilization: ptrToInt32(60),
+				},
+			},
+		}},
+		Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptrToInt32(120),
+				SelectPolicy:               &minPolicy,
+				Policies: []autoscalingv2.HPAScalingPolicy{{
+					Type:          autoscalingv2.PodsScalingPolicy,
+					Value:         1,
+					PeriodSeconds: 60,
+				}},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, spec); diff != "" {
+		t.Fatalf("Unexpected horizontal pod autoscaler spec: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenHorizontalPodAutoscalerV2Spec(t *testing.T) {
+	maxPolicy := autoscalingv2.MaxChangePolicySelect
+	averageValue := resource.MustParse("500m")
+	spec := autoscalingv2.HorizontalPodAutoscalerSpec{
+		MaxReplicas: 5,
+		MinReplicas: ptrToInt32(1),
+		ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+			Kind: "Deployment",
+			Name: "example",
+		},
+		Metrics: []autoscalingv2.MetricSpec{{
+			Type: autoscalingv2.ContainerResourceMetricSourceType,
+			ContainerResource: &autoscalingv2.ContainerResourceMetricSource{
+				Name:      "cpu",
+				Container: "sidecar",
+				Target: autoscalingv2.MetricTarget{
+					Type:         autoscalingv2.AverageValueMetricType,
+					AverageValue: &averageValue,
+				},
+			},
+		}},
+		// The API server defaults both directions once behavior is set.
+		Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+			ScaleUp: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptrToInt32(0),
+				SelectPolicy:               &maxPolicy,
+				Policies: []autoscalingv2.HPAScalingPolicy{{
+					Type:          autoscalingv2.PercentScalingPolicy,
+					Value:         100,
+					PeriodSeconds: 15,
+				}},
+			},
+			ScaleDown: &autoscalingv2.HPAScalingRules{
+				StabilizationWindowSeconds: ptrToInt32(300),
+				SelectPolicy:               &maxPolicy,
+				Policies: []autoscalingv2.HPAScalingPolicy{{
+					Type:          autoscalingv2.PercentScalingPolicy,
+					Value:         100,
+					PeriodSeconds: 15,
+				}},
+			},
+		},
+	}
+
+	out := flattenHorizontalPodAutoscalerV2Spec(spec)[0].(map[string]interface{})
+
+	expectedMetric := []interface{}{map[string]interface{}{
+		"type": autoscalingv2.ContainerResourceMetricSourceType,
+		"container_resource": []interface{}{map[string]interface{}{
+			"name":      v1.ResourceCPU,
+			"container": "sidecar",
+			"target": []interface{}{map[string]interface{}{
+				"type":          autoscalingv2.AverageValueMetricType,
+				"average_value": "500m",
+			}},
+		}},
+	}}
+	if diff := cmp.Diff(expectedMetric, out["metric"]); diff != "" {
+		t.Fatalf("Unexpected metric: mismatch (-want +got):\n%s", diff)
+	}
+
+	scaleDown := out["behavior"].([]interface{})[0].(map[string]interface{})["scale_down"].([]interface{})[0].(map[string]interface{})
+	expectedScaleDown := map[string]interface{}{
+		"stabilization_window_seconds": 300,
+		"select_policy":                "Max",
+		"policy": []interface{}{map[string]interface{}{
+			"type":           "Percent",
+			"value":          100,
+			"period_seconds": 15,
+		}},
+	}
+	if diff := cmp.Diff(expectedScaleDown, scaleDown); diff != "" {
+		t.Fatalf("Unexpected scale_down: mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -1,8 +1,11 @@
 package kubernetes
 
-import "k8s.io/api/core/v1"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/api/core/v1"
+)
 
-func flattenHostaliases(in []v1.HostAlias) []interface{} {
+func flattenHostaliases(in []v1.HostAlias) *schema.Set {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
 		ha := make(map[string]interface{})
@@ -12,7 +15,7 @@ func flattenHostaliases(in []v1.HostAlias) []interface{} {
 		}
 		att[i] = ha
 	}
-	return att
+	return schema.NewSet(hashHostAlias(), att)
 }
 func expandHostaliases(hostalias []interface{}) ([]v1.HostAlias, error) {
 	if len(hostalias) == 0 {
@@ -0,0 +1,501 @@
+package kubernetes
+
+// Expanders
+
+func expandHTTPRouteV1Spec(l []interface{}) httpRouteV1Spec {
+	obj := httpRouteV1Spec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["parent_ref"].([]interface{}); ok && len(v) > 0 {
+		obj.ParentRefs = expandGatewayV1ParentReferences(v)
+	}
+	if v, ok := in["hostnames"].([]interface{}); ok && len(v) > 0 {
+		obj.Hostnames = sliceOfString(v)
+	}
+	if v, ok := in["rule"].([]interface{}); ok && len(v) > 0 {
+		obj.Rules = expandHTTPRouteV1Rules(v)
+	}
+	return obj
+}
+
+func expandGatewayV1ParentReferences(l []interface{}) []gatewayV1ParentReference {
+	obj := make([]gatewayV1ParentReference, 0, len(l))
+	for _, pi := range l {
+		in, ok := pi.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ref := gatewayV1ParentReference{
+			Name: in["name"].(string),
+		}
+		if v, ok := in["group"].(string); ok && v != "" {
+			ref.Group = ptrToString(v)
+		}
+		if v, ok := in["kind"].(string); ok && v != "" {
+			ref.Kind = ptrToString(v)
+		}
+		if v, ok := in["namespace"].(string); ok && v != "" {
+			ref.Namespace = ptrToString(v)
+		}
+		if v, ok := in["section_name"].(string); ok && v != "" {
+			ref.SectionName = ptrToString(v)
+		}
+		if v, ok := in["port"].(int); ok && v != 0 {
+			ref.Port = ptrToInt32(int32(v))
+		}
+		obj = append(obj, ref)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1Rules(l []interface{}) []httpRouteV1Rule {
+	obj := make([]httpRouteV1Rule, 0, len(l))
+	for _, ri := range l {
+		in, ok := ri.(map[string]interface{})
+		if !ok {
+			obj = append(obj, httpRouteV1Rule{})
+			continue
+		}
+		rule := httpRouteV1Rule{}
+		if v, ok := in["match"].([]interface{}); ok && len(v) > 0 {
+			rule.Matches = expandHTTPRouteV1Matches(v)
+		}
+		if v, ok := in["filter"].([]interface{}); ok && len(v) > 0 {
+			rule.Filters = expandHTTPRouteV1Filters(v)
+		}
+		if v, ok := in["backend_ref"].([]interface{}); ok && len(v) > 0 {
+			for _, b := range v {
+				if m, ok := b.(map[string]interface{}); ok {
+					rule.BackendRefs = append(rule.BackendRefs, expandHTTPRouteV1BackendRef(m))
+				}
+			}
+		}
+		obj = append(obj, rule)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1Matches(l []interface{}) []httpRouteV1Match {
+	obj := make([]httpRouteV1Match, 0, len(l))
+	for _, mi := range l {
+		in, ok := mi.(map[string]interface{})
+		if !ok {
+			obj = append(obj, httpRouteV1Match{})
+			continue
+		}
+		match := httpRouteV1Match{}
+		if v, ok := in["path"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			p := v[0].(map[string]interface{})
+			match.Path = &httpRouteV1PathMatch{}
+			if v, ok := p["type"].(string); ok && v != "" {
+				match.Path.Type = ptrToString(v)
+			}
+			if v, ok := p["value"].(string); ok && v != "" {
+				match.Path.Value = ptrToString(v)
+			}
+		}
+		if v, ok := in["header"].([]interface{}); ok && len(v) > 0 {
+			match.Headers = expandHTTPRouteV1ValueMatches(v)
+		}
+		if v, ok := in["query_param"].([]interface{}); ok && len(v) > 0 {
+			match.QueryParams = expandHTTPRouteV1ValueMatches(v)
+		}
+		if v, ok := in["method"].(string); ok && v != "" {
+			match.Method = ptrToString(v)
+		}
+		obj = append(obj, match)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1ValueMatches(l []interface{}) []httpRouteV1ValueMatch {
+	obj := make([]httpRouteV1ValueMatch, 0, len(l))
+	for _, mi := range l {
+		in, ok := mi.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		m := httpRouteV1ValueMatch{
+			Name:  in["name"].(string),
+			Value: in["value"].(string),
+		}
+		if v, ok := in["type"].(string); ok && v != "" {
+			m.Type = ptrToString(v)
+		}
+		obj = append(obj, m)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1Filters(l []interface{}) []httpRouteV1Filter {
+	obj := make([]httpRouteV1Filter, 0, len(l))
+	for _, fi := range l {
+		in, ok := fi.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		f := httpRouteV1Filter{
+			Type: in["type"].(string),
+		}
+		if v, ok := in["request_header_modifier"].([]interface{}); ok && len(v) > 0 {
+			f.RequestHeaderModifier = expandHTTPRouteV1HeaderFilter(v)
+		}
+		if v, ok := in["response_header_modifier"].([]interface{}); ok && len(v) > 0 {
+			f.ResponseHeaderModifier = expandHTTPRouteV1HeaderFilter(v)
+		}
+		if v, ok := in["request_redirect"].([]interface{}); ok && len(v) > 0 {
+			f.RequestRedirect = &httpRouteV1RequestRedirect{}
+			if v[0] != nil {
+				r := v[0].(map[string]interface{})
+				if v, ok := r["scheme"].(string); ok && v != "" {
+					f.RequestRedirect.Scheme = ptrToString(v)
+				}
+				if v, ok := r["hostname"].(string); ok && v != "" {
+					f.RequestRedirect.Hostname = ptrToString(v)
+				}
+				if v, ok := r["path"].([]interface{}); ok && len(v) > 0 {
+					f.RequestRedirect.Path = expandHTTPRouteV1PathModifier(v)
+				}
+				if v, ok := r["port"].(int); ok && v != 0 {
+					f.RequestRedirect.Port = ptrToInt32(int32(v))
+				}
+				if v, ok := r["status_code"].(int); ok && v != 0 {
+					f.RequestRedirect.StatusCode = ptrToInt32(int32(v))
+				}
+			}
+		}
+		if v, ok := in["url_rewrite"].([]interface{}); ok && len(v) > 0 {
+			f.URLRewrite = &httpRouteV1URLRewrite{}
+			if v[0] != nil {
+				r := v[0].(map[string]interface{})
+				if v, ok := r["hostname"].(string); ok && v != "" {
+					f.URLRewrite.Hostname = ptrToString(v)
+				}
+				if v, ok := r["path"].([]interface{}); ok && len(v) > 0 {
+					f.URLRewrite.Path = expandHTTPRouteV1PathModifier(v)
+				}
+			}
+		}
+		if v, ok := in["request_mirror"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			r := v[0].(map[string]interface{})
+			f.RequestMirror = &httpRouteV1RequestMirror{}
+			if v, ok := r["backend_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				f.RequestMirror.BackendRef = expandHTTPRouteV1BackendRef(v[0].(map[string]interface{}))
+			}
+		}
+		obj = append(obj, f)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1HeaderFilter(l []interface{}) *httpRouteV1HeaderFilter {
+	obj := &httpRouteV1HeaderFilter{}
+	if l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["set"].([]interface{}); ok {
+		obj.Set = expandHTTPRouteV1Headers(v)
+	}
+	if v, ok := in["add"].([]interface{}); ok {
+		obj.Add = expandHTTPRouteV1Headers(v)
+	}
+	if v, ok := in["remove"].([]interface{}); ok && len(v) > 0 {
+		obj.Remove = sliceOfString(v)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1Headers(l []interface{}) []httpRouteV1Header {
+	if len(l) == 0 {
+		return nil
+	}
+	obj := make([]httpRouteV1Header, 0, len(l))
+	for _, hi := range l {
+		in, ok := hi.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		obj = append(obj, httpRouteV1Header{
+			Name:  in["name"].(string),
+			Value: in["value"].(string),
+		})
+	}
+	return obj
+}
+
+func expandHTTPRouteV1PathModifier(l []interface{}) *httpRouteV1PathModifier {
+	obj := &httpRouteV1PathModifier{}
+	if l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Type = in["type"].(string)
+	if v, ok := in["replace_full_path"].(string); ok && v != "" {
+		obj.ReplaceFullPath = ptrToString(v)
+	}
+	if v, ok := in["replace_prefix_match"].(string); ok && v != "" {
+		obj.ReplacePrefixMatch = ptrToString(v)
+	}
+	return obj
+}
+
+func expandHTTPRouteV1BackendRef(in map[string]interface{}) httpRouteV1BackendRef {
+	obj := httpRouteV1BackendRef{
+		Name: in["name"].(string),
+	}
+	if v, ok := in["group"].(string); ok && v != "" {
+		obj.Group = ptrToString(v)
+	}
+	if v, ok := in["kind"].(string); ok && v != "" {
+		obj.Kind = ptrToString(v)
+	}
+	if v, ok := in["namespace"].(string); ok && v != "" {
+		obj.Namespace = ptrToString(v)
+	}
+	if v, ok := in["port"].(int); ok && v != 0 {
+		obj.Port = ptrToInt32(int32(v))
+	}
+	// Mirrored backends have no weight in the schema.
+	if v, ok := in["weight"].(int); ok {
+		obj.Weight = ptrToInt32(int32(v))
+	}
+	return obj
+}
+
+// Flatteners
+
+func flattenHTTPRouteV1Spec(in httpRouteV1Spec) []interface{} {
+	att := map[string]interface{}{}
+	if len(in.ParentRefs) > 0 {
+		att["parent_ref"] = flattenGatewayV1ParentReferences(in.ParentRefs)
+	}
+	if len(in.Hostnames) > 0 {
+		att["hostnames"] = flattenListOfStrings(in.Hostnames)
+	}
+	if len(in.Rules) > 0 {
+		att["rule"] = flattenHTTPRouteV1Rules(in.Rules)
+	}
+	return []interface{}{att}
+}
+
+func flattenGatewayV1ParentReferences(in []gatewayV1ParentReference) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, r := range in {
+		m := map[string]interface{}{
+			"name": r.Name,
+		}
+		if r.Group != nil {
+			m["group"] = *r.Group
+		}
+		if r.Kind != nil {
+			m["kind"] = *r.Kind
+		}
+		if r.Namespace != nil {
+			m["namespace"] = *r.Namespace
+		}
+		if r.SectionName != nil {
+			m["section_name"] = *r.SectionName
+		}
+		if r.Port != nil {
+			m["port"] = int(*r.Port)
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenHTTPRouteV1Rules(in []httpRouteV1Rule) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, r := range in {
+		m := map[string]interface{}{}
+		if len(r.Matches) > 0 {
+			m["match"] = flattenHTTPRouteV1Matches(r.Matches)
+		}
+		if len(r.Filters) > 0 {
+			m["filter"] = flattenHTTPRouteV1Filters(r.Filters)
+		}
+		if len(r.BackendRefs) > 0 {
+			refs := make([]interface{}, len(r.BackendRefs))
+			for j, b := range r.BackendRefs {
+				refs[j] = flattenHTTPRouteV1BackendRef(b)
+			}
+			m["backend_ref"] = refs
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenHTTPRouteV1Matches(in []httpRouteV1Match) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, match := range in {
+		m := map[string]interface{}{}
+		if match.Path != nil {
+			p := map[string]interface{}{}
+			if match.Path.Type != nil {
+				p["type"] = *match.Path.Type
+			}
+			if match.Path.Value != nil {
+				p["value"] = *match.Path.Value
+			}
+			m["path"] = []interface{}{p}
+		}
+		if len(match.Headers) > 0 {
+			m["header"] = flattenHTTPRouteV1ValueMatches(match.Headers)
+		}
+		if len(match.QueryParams) > 0 {
+			m["query_param"] = flattenHTTPRouteV1ValueMatches(match.QueryParams)
+		}
+		if match.Method != nil {
+			m["method"] = *match.Method
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenHTTPRouteV1ValueMatches(in []httpRouteV1ValueMatch) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		m := map[string]interface{}{
+			"name":  v.Name,
+			"value": v.Value,
+		}
+		if v.Type != nil {
+			m["type"] = *v.Type
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenHTTPRouteV1Filters(in []httpRouteV1Filter) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, f := range in {
+		m := map[string]interface{}{
+			"type": f.Type,
+		}
+		if f.RequestHeaderModifier != nil {
+			m["request_header_modifier"] = flattenHTTPRouteV1HeaderFilter(f.RequestHeaderModifier)
+		}
+		if f.ResponseHeaderModifier != nil {
+			m["response_header_modifier"] = flattenHTTPRouteV1HeaderFilter(f.ResponseHeaderModifier)
+		}
+		if f.RequestRedirect != nil {
+			r := map[string]interface{}{}
+			if f.RequestRedirect.Scheme != nil {
+				r["scheme"] = *f.RequestRedirect.Scheme
+			}
+			if f.RequestRedirect.Hostname != nil {
+				r["hostname"] = *f.RequestRedirect.Hostname
+			}
+			if f.RequestRedirect.Path != nil {
+				r["path"] = flattenHTTPRouteV1PathModifier(f.RequestRedirect.Path)
+			}
+			if f.RequestRedirect.Port != nil {
+				r["port"] = int(*f.RequestRedirect.Port)
+			}
+			if f.RequestRedirect.StatusCode != nil {
+				r["status_code"] = int(*f.RequestRedirect.StatusCode)
+			}
+			m["request_redirect"] = []interface{}{r}
+		}
+		if f.URLRewrite != nil {
+			r := map[string]interface{}{}
+			if f.URLRewrite.Hostname != nil {
+				r["hostname"] = *f.URLRewrite.Hostname
+			}
+			if f.URLRewrite.Path != nil {
+				r["path"] = flattenHTTPRouteV1PathModifier(f.URLRewrite.Path)
+			}
+			m["url_rewrite"] = []interface{}{r}
+		}
+		if f.RequestMirror != nil {
+			m["request_mirror"] = []interface{}{map[string]interface{}{
+				"backend_ref": []interface{}{flattenHTTPRouteV1BackendRef(f.RequestMirror.BackendRef)},
+			}}
+		}
+		att[i] = m
+	}
+	return att
+}
+
+func flattenHTTPRouteV1HeaderFilter(in *httpRouteV1HeaderFilter) []interface{} {
+	att := map[string]interface{}{}
+	if len(in.Set) > 0 {
+		att["set"] = flattenHTTPRouteV1Headers(in.Set)
+	}
+	if len(in.Add) > 0 {
+		att["add"] = flattenHTTPRouteV1Headers(in.Add)
+	}
+	if len(in.Remove) > 0 {
+		att["remove"] = flattenListOfStrings(in.Remove)
+	}
+	return []interface{}{att}
+}
+
+func flattenHTTPRouteV1Headers(in []httpRouteV1Header) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, h := range in {
+		att[i] = map[string]interface{}{
+			"name":  h.Name,
+			"value": h.Value,
+		}
+	}
+	return att
+}
+
+func flattenHTTPRouteV1PathModifier(in *httpRouteV1PathModifier) []interface{} {
+	att := map[string]interface{}{
+		"type": in.Type,
+	}
+	if in.ReplaceFullPath != nil {
+		att["replace_full_path"] = *in.ReplaceFullPath
+	}
+	if in.ReplacePrefixMatch != nil {
+		att["replace_prefix_match"] = *in.ReplacePrefixMatch
+	}
+	return []interface{}{att}
+}
+
+func flattenHTTPRouteV1BackendRef(in httpRouteV1BackendRef) map[string]interface{} {
+	att := map[string]interface{}{
+		"name": in.Name,
+	}
+	if in.Group != nil {
+		att["group"] = *in.Group
+	}
+	if in.Kind != nil {
+		att["kind"] = *in.Kind
+	}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
+	}
+	if in.Port != nil {
+		att["port"] = int(*in.Port)
+	}
+	if in.Weight != nil {
+		att["weight"] = int(*in.Weight)
+	}
+	return att
+}
+
+func flattenHTTPRouteV1Status(in httpRouteV1Status) []interface{} {
+	parents := make([]interface{}, len(in.Parents))
+	for i, p := range in.Parents {
+		parents[i] = map[string]interface{}{
+			"parent_ref":      flattenGatewayV1ParentReferences([]gatewayV1ParentReference{p.ParentRef}),
+			"controller_name": p.ControllerName,
+			"condition":       flattenGatewayAPIV1Conditions(p.Conditions),
+		}
+	}
+	return []interface{}{map[string]interface{}{
+		"parent": parents,
+	}}
+}
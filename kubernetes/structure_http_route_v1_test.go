@@ -0,0 +1,89 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpandThenFlatten_http_route_v1(t *testing.T) {
+	spec := []interface{}{map[string]interface{}{
+		"parent_ref": []interface{}{map[string]interface{}{
+			"name":         "example",
+			"namespace":    "gateways",
+			"section_name": "https",
+		}},
+		"hostnames": []interface{}{"www.example.com"},
+		"rule": []interface{}{
+			map[string]interface{}{
+				"match": []interface{}{map[string]interface{}{
+					"path": []interface{}{map[string]interface{}{
+						"type":  "PathPrefix",
+						"value": "/api",
+					}},
+					"header": []interface{}{map[string]interface{}{
+						"type":  "Exact",
+						"name":  "X-Version",
+						"value": "2",
+					}},
+					"method": "GET",
+				}},
+				"filter": []interface{}{
+					map[string]interface{}{
+						"type": "RequestHeaderModifier",
+						"request_header_modifier": []interface{}{map[string]interface{}{
+							"set": []interface{}{map[string]interface{}{
+								"name":  "X-Env",
+								"value": "prod",
+							}},
+							"remove": []interface{}{"X-Debug"},
+						}},
+					},
+					map[string]interface{}{
+						"type": "URLRewrite",
+						"url_rewrite": []interface{}{map[string]interface{}{
+							"path": []interface{}{map[string]interface{}{
+								"type":                 "ReplacePrefixMatch",
+								"replace_prefix_match": "/",
+							}},
+						}},
+					},
+					map[string]interface{}{
+						"type": "RequestMirror",
+						"request_mirror": []interface{}{map[string]interface{}{
+							"backend_ref": []interface{}{map[string]interface{}{
+								"name": "shadow",
+								"port": 8080,
+							}},
+						}},
+					},
+				},
+				"backend_ref": []interface{}{
+					map[string]interface{}{
+						"name":   "api-v1",
+						"port":   8080,
+						"weight": 90,
+					},
+					map[string]interface{}{
+						"name":   "api-v2",
+						"port":   8080,
+						"weight": 0,
+					},
+				},
+			},
+			map[string]interface{}{
+				"filter": []interface{}{map[string]interface{}{
+					"type": "RequestRedirect",
+					"request_redirect": []interface{}{map[string]interface{}{
+						"scheme":      "https",
+						"status_code": 301,
+					}},
+				}},
+			},
+		},
+	}}
+
+	if diff := cmp.Diff(spec, flattenHTTPRouteV1Spec(expandHTTPRouteV1Spec(spec))); diff != "" {
+		t.Fatalf("Unexpected HTTP route spec round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -88,6 +88,24 @@ func flattenIngressTLS(in []v1beta1.IngressTLS) []interface{} {
 	return att
 }
 
+func flattenIngressLoadBalancerStatus(in v1beta1.IngressLoadBalancerStatus) []interface{} {
+	out := make([]interface{}, len(in.Ingress), len(in.Ingress))
+	for i, ingress := range in.Ingress {
+		att := make(map[string]interface{})
+
+		att["ip"] = ingress.IP
+		att["hostname"] = ingress.Hostname
+
+		out[i] = att
+	}
+
+	return []interface{}{
+		map[string][]interface{}{
+			"ingress": out,
+		},
+	}
+}
+
 // Expanders
 
 func expandIngressRule(l []interface{}) []v1beta1.IngressRule {
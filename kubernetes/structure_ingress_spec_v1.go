@@ -28,9 +28,12 @@ func flattenIngressV1RuleHttp(in *networking.HTTPIngressRuleValue) []interface{}
 	pathAtts := make([]interface{}, len(in.Paths), len(in.Paths))
 	for i, p := range in.Paths {
 		path := map[string]interface{}{
-			"path":    p.Path,
-			"backend": flattenIngressV1Backend(&p.Backend),
+			"path":      p.Path,
+			"path_type": string(networking.PathTypeImplementationSpecific),
+			"backend":   flattenIngressV1Backend(&p.Backend),
 		}
+		// The API server defaults pathType, so a missing one is read as the
+		// default of the schema.
 		if p.PathType != nil {
 			path["path_type"] = string(*p.PathType)
 		}
@@ -115,6 +118,35 @@ func flattenIngressV1TLS(in []networking.IngressTLS) []interface{} {
 	return att
 }
 
+func flattenIngressV1LoadBalancerStatus(in networking.IngressLoadBalancerStatus) []interface{} {
+	out := make([]interface{}, len(in.Ingress), len(in.Ingress))
+	for i, ingress := range in.Ingress {
+		att := make(map[string]interface{})
+
+		att["ip"] = ingress.IP
+		att["hostname"] = ingress.Hostname
+
+		out[i] = att
+	}
+
+	return []interface{}{
+		map[string][]interface{}{
+			"ingress": out,
+		},
+	}
+}
+
+// isIngressV1LoadBalancerReady reports whether any load balancer endpoint of
+// the ingress has an IP or a hostname.
+func isIngressV1LoadBalancerReady(in networking.IngressLoadBalancerStatus) bool {
+	for _, ing := range in.Ingress {
+		if ing.IP != "" || ing.Hostname != "" {
+			return true
+		}
+	}
+	return false
+}
+
 // Expanders
 
 func expandIngressV1Rule(l []interface{}) []networking.IngressRule {
@@ -198,7 +230,7 @@ func expandIngressV1Backend(l []interface{}) *networking.IngressBackend {
 	if ok && len(r) != 0 && r[0] != nil {
 		obj.Resource = &v1.TypedLocalObjectReference{}
 		resource := r[0].(map[string]interface{})
-		if v, ok := resource["api_group"].(string); ok {
+		if v, ok := resource["api_group"].(string); ok && v != "" {
 			obj.Resource.APIGroup = &v
 		}
 		if v, ok := resource["kind"].(string); ok {
@@ -4,6 +4,7 @@ import (
 	"reflect"
 	"testing"
 
+	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 )
 
@@ -50,7 +51,8 @@ func TestFlattenIngressV1Rule(t *testing.T) {
 				map[string]interface{}{
 					"path": []interface{}{
 						map[string]interface{}{
-							"path": "/foo/bar",
+							"path":      "/foo/bar",
+							"path_type": "ImplementationSpecific",
 							"backend": []interface{}{
 								map[string]interface{}{
 									"service": []interface{}{
@@ -86,3 +88,137 @@ func TestFlattenIngressV1Rule(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenIngressV1ResourceBackend(t *testing.T) {
+	prefix := networking.PathTypePrefix
+	in := &networking.HTTPIngressRuleValue{
+		Paths: []networking.HTTPIngressPath{
+			{
+				Path:     "/icons",
+				PathType: &prefix,
+				Backend: networking.IngressBackend{
+					Resource: &v1.TypedLocalObjectReference{
+						APIGroup: ptrToString("k8s.example.com"),
+						Kind:     "StorageBucket",
+						Name:     "icon-assets",
+					},
+				},
+			},
+		},
+	}
+	out := []interface{}{
+		map[string]interface{}{
+			"path": []interface{}{
+				map[string]interface{}{
+					"path":      "/icons",
+					"path_type": "Prefix",
+					"backend": []interface{}{
+						map[string]interface{}{
+							"resource": []interface{}{
+								map[string]interface{}{
+									"api_group": "k8s.example.com",
+									"kind":      "StorageBucket",
+									"name":      "icon-assets",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if got := flattenIngressV1RuleHttp(in); !reflect.DeepEqual(out, got) {
+		t.Errorf("Unexpected result:\n\texpected:%#v\n\tactual:%#v\n", out, got)
+	}
+}
+
+func TestExpandIngressV1Backend(t *testing.T) {
+	cases := map[string]struct {
+		in       []interface{}
+		expected *networking.IngressBackend
+	}{
+		"resource in a group": {
+			in: []interface{}{
+				map[string]interface{}{
+					"resource": []interface{}{
+						map[string]interface{}{
+							"api_group": "k8s.example.com",
+							"kind":      "StorageBucket",
+							"name":      "static-assets",
+						},
+					},
+					"service": []interface{}{},
+				},
+			},
+			expected: &networking.IngressBackend{
+				Resource: &v1.TypedLocalObjectReference{
+					APIGroup: ptrToString("k8s.example.com"),
+					Kind:     "StorageBucket",
+					Name:     "static-assets",
+				},
+			},
+		},
+		"resource in the core group": {
+			in: []interface{}{
+				map[string]interface{}{
+					"resource": []interface{}{
+						map[string]interface{}{
+							"api_group": "",
+							"kind":      "ConfigMap",
+							"name":      "static-assets",
+						},
+					},
+					"service": []interface{}{},
+				},
+			},
+			expected: &networking.IngressBackend{
+				Resource: &v1.TypedLocalObjectReference{
+					Kind: "ConfigMap",
+					Name: "static-assets",
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := expandIngressV1Backend(tc.in); !reflect.DeepEqual(tc.expected, got) {
+				t.Errorf("Unexpected result:\n\texpected:%#v\n\tactual:%#v\n", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestIsIngressV1LoadBalancerReady(t *testing.T) {
+	cases := map[string]struct {
+		in       networking.IngressLoadBalancerStatus
+		expected bool
+	}{
+		"no endpoint": {
+			in: networking.IngressLoadBalancerStatus{},
+		},
+		"endpoint without address": {
+			in: networking.IngressLoadBalancerStatus{
+				Ingress: []networking.IngressLoadBalancerIngress{{}},
+			},
+		},
+		"ip": {
+			in: networking.IngressLoadBalancerStatus{
+				Ingress: []networking.IngressLoadBalancerIngress{{IP: "192.0.2.10"}},
+			},
+			expected: true,
+		},
+		"hostname": {
+			in: networking.IngressLoadBalancerStatus{
+				Ingress: []networking.IngressLoadBalancerIngress{{Hostname: "k8s-default-app-0123456789.us-east-1.elb.amazonaws.com"}},
+			},
+			expected: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := isIngressV1LoadBalancerReady(tc.in); got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
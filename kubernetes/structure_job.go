@@ -5,9 +5,10 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
-func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, prefix ...string) ([]interface{}, error) {
+func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, meta interface{}, prefix ...string) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
 	if in.ActiveDeadlineSeconds != nil {
@@ -34,9 +35,17 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, prefix ...string
 		att["parallelism"] = *in.Parallelism
 	}
 
+	if in.PodFailurePolicy != nil {
+		att["pod_failure_policy"] = flattenPodFailurePolicy(in.PodFailurePolicy)
+	}
+
 	if in.Selector != nil {
 		att["selector"] = flattenLabelSelector(in.Selector)
 	}
+
+	if in.Suspend != nil {
+		att["suspend"] = *in.Suspend
+	}
 	// Remove server-generated labels
 	labels := in.Template.ObjectMeta.Labels
 
@@ -48,7 +57,7 @@ func flattenJobSpec(in batchv1.JobSpec, d *schema.ResourceData, prefix ...string
 		delete(labels, "job-name")
 	}
 
-	podSpec, err := flattenPodTemplateSpec(in.Template, d, prefix...)
+	podSpec, err := flattenPodTemplateSpec(in.Template, d, meta, prefix...)
 	if err != nil {
 		return nil, err
 	}
@@ -95,10 +104,18 @@ func expandJobSpec(j []interface{}) (batchv1.JobSpec, error) {
 		obj.Parallelism = ptrToInt32(int32(v))
 	}
 
+	if v, ok := in["pod_failure_policy"].([]interface{}); ok && len(v) > 0 {
+		obj.PodFailurePolicy = expandPodFailurePolicy(v)
+	}
+
 	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
 		obj.Selector = expandLabelSelector(v)
 	}
 
+	if v, ok := in["suspend"].(bool); ok && v {
+		obj.Suspend = ptrToBool(v)
+	}
+
 	template, err := expandPodTemplate(in["template"].([]interface{}))
 	if err != nil {
 		return obj, err
@@ -151,5 +168,124 @@ func patchJobSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOpera
 		})
 	}
 
+	if d.HasChange(prefix + "suspend") {
+		v := d.Get(prefix + "suspend").(bool)
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "/suspend",
+			Value: v,
+		})
+	}
+
+	if d.HasChange(prefix + "ttl_seconds_after_finished") {
+		v := d.Get(prefix + "ttl_seconds_after_finished").(string)
+		if v == "" {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/ttlSecondsAfterFinished",
+			})
+		} else {
+			i, err := strconv.Atoi(v)
+			if err != nil {
+				return ops, err
+			}
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/ttlSecondsAfterFinished",
+				Value: i,
+			})
+		}
+	}
+
 	return ops, nil
 }
+
+func flattenPodFailurePolicy(in *batchv1.PodFailurePolicy) []interface{} {
+	rules := make([]interface{}, len(in.Rules))
+	for i, r := range in.Rules {
+		rule := map[string]interface{}{
+			"action": string(r.Action),
+		}
+		if r.OnExitCodes != nil {
+			values := make([]interface{}, len(r.OnExitCodes.Values))
+			for j, v := range r.OnExitCodes.Values {
+				values[j] = int(v)
+			}
+			exitCodes := map[string]interface{}{
+				"operator": string(r.OnExitCodes.Operator),
+				"values":   values,
+			}
+			if r.OnExitCodes.ContainerName != nil {
+				exitCodes["container_name"] = *r.OnExitCodes.ContainerName
+			}
+			rule["on_exit_codes"] = []interface{}{exitCodes}
+		}
+		conditions := make([]interface{}, len(r.OnPodConditions))
+		for j, c := range r.OnPodConditions {
+			conditions[j] = map[string]interface{}{
+				"type":   string(c.Type),
+				"status": string(c.Status),
+			}
+		}
+		rule["on_pod_condition"] = conditions
+		rules[i] = rule
+	}
+	return []interface{}{map[string]interface{}{
+		"rule": rules,
+	}}
+}
+
+func expandPodFailurePolicy(l []interface{}) *batchv1.PodFailurePolicy {
+	obj := &batchv1.PodFailurePolicy{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+	for _, r := range in["rule"].([]interface{}) {
+		if r == nil {
+			continue
+		}
+		rm := r.(map[string]interface{})
+		rule := batchv1.PodFailurePolicyRule{
+			Action:          batchv1.PodFailurePolicyAction(rm["action"].(string)),
+			OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{},
+		}
+		if v, ok := rm["on_exit_codes"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			ec := v[0].(map[string]interface{})
+			exitCodes := &batchv1.PodFailurePolicyOnExitCodesRequirement{
+				Operator: batchv1.PodFailurePolicyOnExitCodesOperator(ec["operator"].(string)),
+			}
+			if n, ok := ec["container_name"].(string); ok && n != "" {
+				exitCodes.ContainerName = &n
+			}
+			for _, c := range ec["values"].([]interface{}) {
+				exitCodes.Values = append(exitCodes.Values, int32(c.(int)))
+			}
+			rule.OnExitCodes = exitCodes
+		}
+		if v, ok := rm["on_pod_condition"].([]interface{}); ok {
+			for _, c := range v {
+				if c == nil {
+					continue
+				}
+				cm := c.(map[string]interface{})
+				rule.OnPodConditions = append(rule.OnPodConditions, batchv1.PodFailurePolicyOnPodConditionsPattern{
+					Type:   corev1.PodConditionType(cm["type"].(string)),
+					Status: corev1.ConditionStatus(cm["status"].(string)),
+				})
+			}
+		}
+		obj.Rules = append(obj.Rules, rule)
+	}
+	return obj
+}
+
+func flattenJobConditions(in []batchv1.JobCondition) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, c := range in {
+		att[i] = map[string]interface{}{
+			"type":    string(c.Type),
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		}
+	}
+	return att
+}
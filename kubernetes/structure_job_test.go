@@ -0,0 +1,73 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestPodFailurePolicyRoundTrip(t *testing.T) {
+	containerName := "main"
+	cases := []struct {
+		Flattened []interface{}
+		Expanded  *batchv1.PodFailurePolicy
+	}{
+		{
+			[]interface{}{map[string]interface{}{
+				"rule": []interface{}{
+					map[string]interface{}{
+						"action": "FailJob",
+						"on_exit_codes": []interface{}{map[string]interface{}{
+							"container_name": "main",
+							"operator":       "In",
+							"values":         []interface{}{1, 42},
+						}},
+						"on_pod_condition": []interface{}{},
+					},
+					map[string]interface{}{
+						"action": "Ignore",
+						"on_pod_condition": []interface{}{map[string]interface{}{
+							"type":   "DisruptionTarget",
+							"status": "True",
+						}},
+					},
+				},
+			}},
+			&batchv1.PodFailurePolicy{
+				Rules: []batchv1.PodFailurePolicyRule{
+					{
+						Action: batchv1.PodFailurePolicyActionFailJob,
+						OnExitCodes: &batchv1.PodFailurePolicyOnExitCodesRequirement{
+							ContainerName: &containerName,
+							Operator:      batchv1.PodFailurePolicyOnExitCodesOpIn,
+							Values:        []int32{1, 42},
+						},
+						OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{},
+					},
+					{
+						Action: batchv1.PodFailurePolicyActionIgnore,
+						OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+							{
+								Type:   corev1.DisruptionTarget,
+								Status: corev1.ConditionTrue,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		expanded := expandPodFailurePolicy(tc.Flattened)
+		if !reflect.DeepEqual(expanded, tc.Expanded) {
+			t.Fatalf("Unexpected expanded pod failure policy.\nExpected: %#v\nGiven:    %#v", tc.Expanded, expanded)
+		}
+		flattened := flattenPodFailurePolicy(tc.Expanded)
+		if !reflect.DeepEqual(flattened, tc.Flattened) {
+			t.Fatalf("Unexpected flattened pod failure policy.\nExpected: %#v\nGiven:    %#v", tc.Flattened, flattened)
+		}
+	}
+}
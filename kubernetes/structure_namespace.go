@@ -0,0 +1,30 @@
+package kubernetes
+
+import (
+	"sort"
+
+	api "k8s.io/api/core/v1"
+)
+
+// Flatteners
+
+// flattenNamespaces returns the namespaces sorted by name, so that the order
+// the API server lists them in does not cause a diff.
+func flattenNamespaces(in []api.Namespace) []interface{} {
+	namespaces := make([]api.Namespace, len(in))
+	copy(namespaces, in)
+	sort.Slice(namespaces, func(i, j int) bool {
+		return namespaces[i].Name < namespaces[j].Name
+	})
+
+	att := make([]interface{}, len(namespaces))
+	for i, ns := range namespaces {
+		att[i] = map[string]interface{}{
+			"name":        ns.Name,
+			"labels":      ns.Labels,
+			"annotations": ns.Annotations,
+			"phase":       string(ns.Status.Phase),
+		}
+	}
+	return att
+}
@@ -0,0 +1,52 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFlattenNamespaces(t *testing.T) {
+	in := []api.Namespace{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "team-b",
+				Labels: map[string]string{"team": "b"},
+			},
+			Status: api.NamespaceStatus{Phase: api.NamespaceTerminating},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "team-a",
+				Labels:      map[string]string{"team": "a"},
+				Annotations: map[string]string{"owner": "alice"},
+			},
+			Status: api.NamespaceStatus{Phase: api.NamespaceActive},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"name":        "team-a",
+			"labels":      map[string]string{"team": "a"},
+			"annotations": map[string]string{"owner": "alice"},
+			"phase":       "Active",
+		},
+		map[string]interface{}{
+			"name":        "team-b",
+			"labels":      map[string]string{"team": "b"},
+			"annotations": map[string]string(nil),
+			"phase":       "Terminating",
+		},
+	}
+
+	out := flattenNamespaces(in)
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Errorf("Unexpected flattened namespaces (-want +got):\n%s", diff)
+	}
+	if in[0].Name != "team-b" {
+		t.Error("Expected the input slice not to be reordered")
+	}
+}
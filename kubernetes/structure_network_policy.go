@@ -2,6 +2,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "k8s.io/api/core/v1"
@@ -63,6 +64,9 @@ func flattenNetworkPolicyPorts(in []v1.NetworkPolicyPort) []interface{} {
 		if port.Port != nil {
 			m["port"] = port.Port.String()
 		}
+		if port.EndPort != nil {
+			m["end_port"] = int(*port.EndPort)
+		}
 		if port.Protocol != nil {
 			m["protocol"] = string(*port.Protocol)
 		}
@@ -133,6 +137,9 @@ func expandNetworkPolicySpec(in []interface{}) (*v1.NetworkPolicySpec, error) {
 		return nil, err
 	}
 	spec.PolicyTypes = *policyTypes
+	if len(spec.PolicyTypes) == 0 {
+		spec.PolicyTypes = inferNetworkPolicyTypes(spec.Egress)
+	}
 
 	return &spec, nil
 }
@@ -210,10 +217,37 @@ func expandNetworkPolicyPorts(l []interface{}) (*[]v1.NetworkPolicyPort, error)
 			v := api.Protocol(in["protocol"].(string))
 			policyPorts[i].Protocol = &v
 		}
+		if err := validateNetworkPolicyPortRange(in); err != nil {
+			return nil, err
+		}
+		if v, ok := in["end_port"].(int); ok && v > 0 {
+			policyPorts[i].EndPort = ptrToInt32(int32(v))
+		}
 	}
 	return &policyPorts, nil
 }
 
+// validateNetworkPolicyPortRange checks that an end_port is only set
+// together with a numeric port and a protocol, and does not precede it.
+func validateNetworkPolicyPortRange(in map[string]interface{}) error {
+	endPort, ok := in["end_port"].(int)
+	if !ok || endPort == 0 {
+		return nil
+	}
+	p, _ := in["port"].(string)
+	port, err := strconv.Atoi(p)
+	if err != nil {
+		return fmt.Errorf("end_port can only be set together with a numerical port, got port %q", p)
+	}
+	if protocol, _ := in["protocol"].(string); protocol == "" {
+		return fmt.Errorf("end_port can only be set together with a protocol")
+	}
+	if endPort < port {
+		return fmt.Errorf("end_port %d must be greater than or equal to port %d", endPort, port)
+	}
+	return nil
+}
+
 func expandNetworkPolicyPeer(l []interface{}) (*[]v1.NetworkPolicyPeer, error) {
 	policyPeers := make([]v1.NetworkPolicyPeer, len(l), len(l))
 	for i, peer := range l {
@@ -267,6 +301,16 @@ func expandNetworkPolicyTypes(l []interface{}) (*[]v1.PolicyType, error) {
 	return &policyTypes, nil
 }
 
+// inferNetworkPolicyTypes returns the policy types the API server defaults
+// to when none are set: Ingress always, and Egress when there are egress rules.
+func inferNetworkPolicyTypes(egress []v1.NetworkPolicyEgressRule) []v1.PolicyType {
+	policyTypes := []v1.PolicyType{v1.PolicyTypeIngress}
+	if len(egress) > 0 {
+		policyTypes = append(policyTypes, v1.PolicyTypeEgress)
+	}
+	return policyTypes
+}
+
 // Patchers
 
 func patchNetworkPolicySpec(keyPrefix, pathPrefix string, d *schema.ResourceData) (*PatchOperations, error) {
@@ -318,6 +362,13 @@ func patchNetworkPolicySpec(keyPrefix, pathPrefix string, d *schema.ResourceData
 		if err != nil {
 			return nil, err
 		}
+		if len(*policyTypes) == 0 {
+			egress, err := expandNetworkPolicyEgress(d.Get(keyPrefix + "egress").([]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			*policyTypes = inferNetworkPolicyTypes(*egress)
+		}
 		ops = append(ops, &ReplaceOperation{
 			Path:  pathPrefix + "/policyTypes",
 			Value: *policyTypes,
@@ -15,6 +15,7 @@ var (
 	protoUDP      = api.ProtocolUDP
 	portName      = intstr.FromString("http")
 	portNumerical = intstr.FromInt(8125)
+	endPort       = int32(8135)
 )
 
 func TestFlattenNetworkPolicyIngressPorts(t *testing.T) {
@@ -57,6 +58,20 @@ func TestFlattenNetworkPolicyIngressPorts(t *testing.T) {
 				},
 			},
 		},
+		{
+			[]v1.NetworkPolicyPort{{
+				Port:     &portNumerical,
+				EndPort:  &endPort,
+				Protocol: &protoTCP,
+			}},
+			[]interface{}{
+				map[string]interface{}{
+					"port":     "8125",
+					"end_port": 8135,
+					"protocol": "TCP",
+				},
+			},
+		},
 		{
 			[]v1.NetworkPolicyPort{{}},
 			[]interface{}{map[string]interface{}{}},
@@ -116,6 +131,20 @@ func TestExpandNetworkPolicyIngressPorts(t *testing.T) {
 				Protocol: &protoUDP,
 			}},
 		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"port":     "8125",
+					"end_port": 8135,
+					"protocol": "TCP",
+				},
+			},
+			[]v1.NetworkPolicyPort{{
+				Port:     &portNumerical,
+				EndPort:  &endPort,
+				Protocol: &protoTCP,
+			}},
+		},
 		{
 			[]interface{}{map[string]interface{}{}},
 			[]v1.NetworkPolicyPort{{}},
@@ -134,3 +163,52 @@ func TestExpandNetworkPolicyIngressPorts(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateNetworkPolicyPortRange(t *testing.T) {
+	cases := []struct {
+		Input map[string]interface{}
+		Valid bool
+	}{
+		{map[string]interface{}{"port": "8125", "end_port": 8135, "protocol": "TCP"}, true},
+		{map[string]interface{}{"port": "8125", "end_port": 8125, "protocol": "UDP"}, true},
+		{map[string]interface{}{"port": "http", "end_port": 0, "protocol": "TCP"}, true},
+		{map[string]interface{}{"port": "http", "end_port": 8135, "protocol": "TCP"}, false},
+		{map[string]interface{}{"port": "", "end_port": 8135, "protocol": "TCP"}, false},
+		{map[string]interface{}{"port": "8125", "end_port": 8135, "protocol": ""}, false},
+		{map[string]interface{}{"port": "8125", "end_port": 8000, "protocol": "TCP"}, false},
+	}
+
+	for _, tc := range cases {
+		err := validateNetworkPolicyPortRange(tc.Input)
+		if tc.Valid && err != nil {
+			t.Errorf("Expected %#v to be valid, got: %s", tc.Input, err)
+		}
+		if !tc.Valid && err == nil {
+			t.Errorf("Expected %#v to be invalid", tc.Input)
+		}
+	}
+}
+
+func TestExpandNetworkPolicySpecInferredPolicyTypes(t *testing.T) {
+	cases := []struct {
+		Egress   []interface{}
+		Expected []v1.PolicyType
+	}{
+		{[]interface{}{}, []v1.PolicyType{v1.PolicyTypeIngress}},
+		{[]interface{}{map[string]interface{}{}}, []v1.PolicyType{v1.PolicyTypeIngress, v1.PolicyTypeEgress}},
+	}
+
+	for _, tc := range cases {
+		spec, err := expandNetworkPolicySpec([]interface{}{map[string]interface{}{
+			"pod_selector": []interface{}{},
+			"egress":       tc.Egress,
+			"policy_types": []interface{}{},
+		}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(spec.PolicyTypes, tc.Expected) {
+			t.Fatalf("Unexpected inferred policy types.\nExpected: %#v\nGiven:    %#v", tc.Expected, spec.PolicyTypes)
+		}
+	}
+}
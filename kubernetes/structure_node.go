@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"sort"
+
+	api "k8s.io/api/core/v1"
+)
+
+// Flatteners
+
+// flattenNodes returns the nodes sorted by name, so that the order the API
+// server lists them in does not cause a diff.
+func flattenNodes(in []api.Node) []interface{} {
+	nodes := make([]api.Node, len(in))
+	copy(nodes, in)
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	att := make([]interface{}, len(nodes))
+	for i, n := range nodes {
+		att[i] = map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{
+				"name":             n.Name,
+				"uid":              string(n.UID),
+				"resource_version": n.ResourceVersion,
+				"labels":           n.Labels,
+				"annotations":      n.Annotations,
+			}},
+			"taint":       flattenNodeTaints(n.Spec.Taints),
+			"address":     flattenNodeAddresses(n.Status.Addresses),
+			"allocatable": flattenResourceList(n.Status.Allocatable),
+			"capacity":    flattenResourceList(n.Status.Capacity),
+			"condition":   flattenNodeConditions(n.Status.Conditions),
+		}
+	}
+	return att
+}
+
+func flattenNodeAddresses(in []api.NodeAddress) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, a := range in {
+		att[i] = map[string]interface{}{
+			"type":    string(a.Type),
+			"address": a.Address,
+		}
+	}
+	return att
+}
+
+func flattenNodeConditions(in []api.NodeCondition) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, c := range in {
+		att[i] = map[string]interface{}{
+			"type":    string(c.Type),
+			"status":  string(c.Status),
+			"reason":  c.Reason,
+			"message": c.Message,
+		}
+	}
+	return att
+}
@@ -0,0 +1,96 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFlattenNodes(t *testing.T) {
+	in := []api.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-b",
+				Labels: map[string]string{"topology.kubernetes.io/zone": "zone-b"},
+			},
+			Status: api.NodeStatus{
+				Capacity: api.ResourceList{
+					api.ResourceMemory: resource.MustParse("1024Mi"),
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-a",
+				UID:    "a-uid",
+				Labels: map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+			},
+			Spec: api.NodeSpec{
+				Taints: []api.Taint{
+					{Key: "dedicated", Value: "gpu", Effect: api.TaintEffectNoSchedule},
+				},
+			},
+			Status: api.NodeStatus{
+				Addresses: []api.NodeAddress{
+					{Type: api.NodeInternalIP, Address: "10.0.0.1"},
+					{Type: api.NodeHostName, Address: "node-a"},
+				},
+				Allocatable: api.ResourceList{
+					api.ResourceCPU: resource.MustParse("0.5"),
+				},
+				Conditions: []api.NodeCondition{
+					{Type: api.NodeReady, Status: api.ConditionTrue, Reason: "KubeletReady", Message: "kubelet is posting ready status"},
+				},
+			},
+		},
+	}
+
+	expected := []interface{}{
+		map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{
+				"name":             "node-a",
+				"uid":              "a-uid",
+				"resource_version": "",
+				"labels":           map[string]string{"topology.kubernetes.io/zone": "zone-a"},
+				"annotations":      map[string]string(nil),
+			}},
+			"taint": []interface{}{
+				map[string]interface{}{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"},
+			},
+			"address": []interface{}{
+				map[string]interface{}{"type": "InternalIP", "address": "10.0.0.1"},
+				map[string]interface{}{"type": "Hostname", "address": "node-a"},
+			},
+			"allocatable": map[string]string{"cpu": "500m"},
+			"capacity":    map[string]string{},
+			"condition": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "KubeletReady", "message": "kubelet is posting ready status"},
+			},
+		},
+		map[string]interface{}{
+			"metadata": []interface{}{map[string]interface{}{
+				"name":             "node-b",
+				"uid":              "",
+				"resource_version": "",
+				"labels":           map[string]string{"topology.kubernetes.io/zone": "zone-b"},
+				"annotations":      map[string]string(nil),
+			}},
+			"taint":       []interface{}{},
+			"address":     []interface{}{},
+			"allocatable": map[string]string{},
+			"capacity":    map[string]string{"memory": "1Gi"},
+			"condition":   []interface{}{},
+		},
+	}
+
+	out := flattenNodes(in)
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Errorf("Unexpected flattened nodes (-want +got):\n%s", diff)
+	}
+	if in[0].Name != "node-b" {
+		t.Error("Expected the input slice not to be reordered")
+	}
+}
@@ -23,6 +23,46 @@ func flattenPersistentVolumeClaimSpec(in v1.PersistentVolumeClaimSpec) []interfa
 	if in.StorageClassName != nil {
 		att["storage_class_name"] = *in.StorageClassName
 	}
+	if in.DataSource != nil {
+		att["data_source"] = flattenPersistentVolumeClaimDataSource(*in.DataSource)
+	}
+	if in.DataSourceRef != nil {
+		att["data_source_ref"] = flattenPersistentVolumeClaimDataSourceRef(*in.DataSourceRef)
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimStatus(in v1.PersistentVolumeClaimStatus) []interface{} {
+	att := map[string]interface{}{
+		"phase":        string(in.Phase),
+		"access_modes": flattenPersistentVolumeAccessModes(in.AccessModes),
+		"capacity":     flattenResourceList(in.Capacity),
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimDataSource(in v1.TypedLocalObjectReference) []interface{} {
+	att := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	return []interface{}{att}
+}
+
+func flattenPersistentVolumeClaimDataSourceRef(in v1.TypedObjectReference) []interface{} {
+	att := map[string]interface{}{
+		"kind": in.Kind,
+		"name": in.Name,
+	}
+	if in.APIGroup != nil {
+		att["api_group"] = *in.APIGroup
+	}
+	if in.Namespace != nil {
+		att["namespace"] = *in.Namespace
+	}
 	return []interface{}{att}
 }
 
@@ -82,9 +122,42 @@ func expandPersistentVolumeClaimSpec(l []interface{}) (*v1.PersistentVolumeClaim
 	if v, ok := in["storage_class_name"].(string); ok && v != "" {
 		obj.StorageClassName = ptrToString(v)
 	}
+	if v, ok := in["data_source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.DataSource = expandPersistentVolumeClaimDataSource(v)
+	}
+	if v, ok := in["data_source_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.DataSourceRef = expandPersistentVolumeClaimDataSourceRef(v)
+	}
 	return obj, nil
 }
 
+func expandPersistentVolumeClaimDataSource(l []interface{}) *v1.TypedLocalObjectReference {
+	in := l[0].(map[string]interface{})
+	obj := &v1.TypedLocalObjectReference{
+		Kind: in["kind"].(string),
+		Name: in["name"].(string),
+	}
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = ptrToString(v)
+	}
+	return obj
+}
+
+func expandPersistentVolumeClaimDataSourceRef(l []interface{}) *v1.TypedObjectReference {
+	in := l[0].(map[string]interface{})
+	obj := &v1.TypedObjectReference{
+		Kind: in["kind"].(string),
+		Name: in["name"].(string),
+	}
+	if v, ok := in["api_group"].(string); ok && v != "" {
+		obj.APIGroup = ptrToString(v)
+	}
+	if v, ok := in["namespace"].(string); ok && v != "" {
+		obj.Namespace = ptrToString(v)
+	}
+	return obj
+}
+
 func expandResourceRequirements(l []interface{}) (*v1.ResourceRequirements, error) {
 	obj := &v1.ResourceRequirements{}
 	if len(l) == 0 || l[0] == nil {
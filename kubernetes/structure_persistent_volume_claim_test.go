@@ -0,0 +1,75 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestExpandThenFlatten_persistent_volume_claim_data_source(t *testing.T) {
+	cases := []struct {
+		Name  string
+		Input v1.PersistentVolumeClaimSpec
+	}{
+		{
+			Name: "snapshot",
+			Input: v1.PersistentVolumeClaimSpec{
+				DataSource: &v1.TypedLocalObjectReference{
+					APIGroup: ptrToString("snapshot.storage.k8s.io"),
+					Kind:     "VolumeSnapshot",
+					Name:     "example",
+				},
+			},
+		},
+		{
+			Name: "cross namespace reference",
+			Input: v1.PersistentVolumeClaimSpec{
+				DataSourceRef: &v1.TypedObjectReference{
+					Kind:      "PersistentVolumeClaim",
+					Name:      "example",
+					Namespace: ptrToString("source"),
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			flattened := flattenPersistentVolumeClaimSpec(tc.Input)[0].(map[string]interface{})
+			out := v1.PersistentVolumeClaimSpec{}
+			if v, ok := flattened["data_source"].([]interface{}); ok {
+				out.DataSource = expandPersistentVolumeClaimDataSource(v)
+			}
+			if v, ok := flattened["data_source_ref"].([]interface{}); ok {
+				out.DataSourceRef = expandPersistentVolumeClaimDataSourceRef(v)
+			}
+			if diff := cmp.Diff(tc.Input, out); diff != "" {
+				t.Fatalf("Unexpected data source round trip: mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenPersistentVolumeClaimStatus(t *testing.T) {
+	in := v1.PersistentVolumeClaimStatus{
+		Phase:       v1.ClaimBound,
+		AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce},
+		Capacity: v1.ResourceList{
+			v1.ResourceStorage: resource.MustParse("1024Mi"),
+		},
+	}
+	out := flattenPersistentVolumeClaimStatus(in)[0].(map[string]interface{})
+
+	if out["phase"] != "Bound" {
+		t.Errorf("Expected phase Bound, got %q", out["phase"])
+	}
+	if diff := cmp.Diff(map[string]string{"storage": "1Gi"}, out["capacity"]); diff != "" {
+		t.Errorf("Unexpected capacity (-want +got):\n%s", diff)
+	}
+	if modes := out["access_modes"].(*schema.Set); modes.Len() != 1 || !modes.Contains("ReadWriteOnce") {
+		t.Errorf("Unexpected access modes: %v", modes.List())
+	}
+}
@@ -403,6 +403,15 @@ func flattenPersistentVolumeSpec(in v1.PersistentVolumeSpec) []interface{} {
 	return []interface{}{att}
 }
 
+func flattenPersistentVolumeStatus(in v1.PersistentVolumeStatus) []interface{} {
+	att := map[string]interface{}{
+		"phase":   string(in.Phase),
+		"reason":  in.Reason,
+		"message": in.Message,
+	}
+	return []interface{}{att}
+}
+
 func flattenObjectRef(in *v1.ObjectReference) []interface{} {
 	att := make(map[string]interface{})
 	if in.Name != "" {
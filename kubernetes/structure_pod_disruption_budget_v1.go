@@ -26,6 +26,9 @@ func expandPodDisruptionBudgetV1Spec(in []interface{}) (*policy.PodDisruptionBud
 	if v, ok := m["selector"].([]interface{}); ok && len(v) > 0 {
 		spec.Selector = expandLabelSelector(v)
 	}
+	if v, ok := m["unhealthy_pod_eviction_policy"].(string); ok && len(v) > 0 {
+		spec.UnhealthyPodEvictionPolicy = (*policy.UnhealthyPodEvictionPolicyType)(&v)
+	}
 
 	return spec, nil
 }
@@ -41,11 +44,24 @@ func flattenPodDisruptionBudgetV1Spec(spec policy.PodDisruptionBudgetSpec) []int
 	if spec.Selector != nil {
 		m["selector"] = flattenLabelSelector(spec.Selector)
 	}
+	if spec.UnhealthyPodEvictionPolicy != nil {
+		m["unhealthy_pod_eviction_policy"] = string(*spec.UnhealthyPodEvictionPolicy)
+	}
 
 	return []interface{}{m}
 }
 
-// Currently unused, but will be useful for Kubernetes 1.15 when patching is allowed.
+func flattenPodDisruptionBudgetV1Status(status policy.PodDisruptionBudgetStatus) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"current_healthy":     int(status.CurrentHealthy),
+		"desired_healthy":     int(status.DesiredHealthy),
+		"disruptions_allowed": int(status.DisruptionsAllowed),
+		"expected_pods":       int(status.ExpectedPods),
+	}}
+}
+
+// The int-or-string values are patched as their parsed form, the API server
+// rejects integers sent as strings (e.g. "2" instead of 2).
 func patchPodDisruptionBudgetV1Spec(prefix string, pathPrefix string, d *schema.ResourceData) (*[]PatchOperation, error) {
 	ops := make([]PatchOperation, 0)
 
@@ -56,12 +72,12 @@ func patchPodDisruptionBudgetV1Spec(prefix string, pathPrefix string, d *schema.
 			if oldOk && len(oldV) > 0 {
 				ops = append(ops, &ReplaceOperation{
 					Path:  pathPrefix + "/maxUnavailable",
-					Value: newV,
+					Value: intstr.Parse(newV),
 				})
 			} else {
 				ops = append(ops, &AddOperation{
 					Path:  pathPrefix + "/maxUnavailable",
-					Value: newV,
+					Value: intstr.Parse(newV),
 				})
 			}
 		} else if oldOk && len(oldV) > 0 {
@@ -77,12 +93,12 @@ func patchPodDisruptionBudgetV1Spec(prefix string, pathPrefix string, d *schema.
 			if oldOk && len(oldV) > 0 {
 				ops = append(ops, &ReplaceOperation{
 					Path:  pathPrefix + "/minAvailable",
-					Value: newV,
+					Value: intstr.Parse(newV),
 				})
 			} else {
 				ops = append(ops, &AddOperation{
 					Path:  pathPrefix + "/minAvailable",
-					Value: newV,
+					Value: intstr.Parse(newV),
 				})
 			}
 		} else if oldOk && len(oldV) > 0 {
@@ -97,6 +113,18 @@ func patchPodDisruptionBudgetV1Spec(prefix string, pathPrefix string, d *schema.
 			Value: expandLabelSelector(d.Get(prefix + "selector").([]interface{})),
 		})
 	}
+	if d.HasChange(prefix + "unhealthy_pod_eviction_policy") {
+		if v := d.Get(prefix + "unhealthy_pod_eviction_policy").(string); v != "" {
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/unhealthyPodEvictionPolicy",
+				Value: v,
+			})
+		} else {
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/unhealthyPodEvictionPolicy",
+			})
+		}
+	}
 
 	return &ops, nil
 }
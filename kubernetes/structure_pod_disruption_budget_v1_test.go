@@ -0,0 +1,60 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	policy "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestExpandPodDisruptionBudgetV1Spec(t *testing.T) {
+	alwaysAllow := policy.AlwaysAllow
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput *policy.PodDisruptionBudgetSpec
+	}{
+		{
+			[]interface{}{map[string]interface{}{
+				"max_unavailable": "25%",
+				"selector": []interface{}{map[string]interface{}{
+					"match_labels": map[string]interface{}{"app": "foo"},
+				}},
+			}},
+			&policy.PodDisruptionBudgetSpec{
+				MaxUnavailable: &intstr.IntOrString{Type: intstr.String, StrVal: "25%"},
+				Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			},
+		},
+		{
+			[]interface{}{map[string]interface{}{
+				"min_available":                 "2",
+				"unhealthy_pod_eviction_policy": "AlwaysAllow",
+			}},
+			&policy.PodDisruptionBudgetSpec{
+				MinAvailable:               &intstr.IntOrString{Type: intstr.Int, IntVal: 2},
+				UnhealthyPodEvictionPolicy: &alwaysAllow,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output, err := expandPodDisruptionBudgetV1Spec(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff := cmp.Diff(tc.ExpectedOutput, output); diff != "" {
+			t.Fatalf("Unexpected output from expander: mismatch (-want +got):\n%s", diff)
+		}
+		flattened := flattenPodDisruptionBudgetV1Spec(*output)[0].(map[string]interface{})
+		for k, v := range tc.Input[0].(map[string]interface{}) {
+			if k == "selector" {
+				continue
+			}
+			if flattened[k] != v {
+				t.Fatalf("Unexpected %s after round trip: got %v, want %v", k, flattened[k], v)
+			}
+		}
+	}
+}
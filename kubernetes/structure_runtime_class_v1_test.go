@@ -0,0 +1,123 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestExpandRuntimeClassV1Overhead(t *testing.T) {
+	cases := map[string]struct {
+		Input          []interface{}
+		ExpectedOutput *nodev1.Overhead
+	}{
+		"unset": {
+			Input: []interface{}{},
+		},
+		"pod fixed": {
+			Input: []interface{}{map[string]interface{}{
+				"pod_fixed": map[string]interface{}{
+					"cpu":    "250m",
+					"memory": "120Mi",
+				},
+			}},
+			ExpectedOutput: &nodev1.Overhead{
+				PodFixed: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("250m"),
+					corev1.ResourceMemory: resource.MustParse("120Mi"),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out, err := expandRuntimeClassV1Overhead(tc.Input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.ExpectedOutput, out); diff != "" {
+				t.Errorf("Unexpected output from expander: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenRuntimeClassV1Overhead(t *testing.T) {
+	in := &nodev1.Overhead{
+		PodFixed: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("0.25"),
+			corev1.ResourceMemory: resource.MustParse("1024Mi"),
+		},
+	}
+	expected := []interface{}{map[string]interface{}{
+		"pod_fixed": map[string]string{
+			"cpu":    "250m",
+			"memory": "1Gi",
+		},
+	}}
+	if diff := cmp.Diff(expected, flattenRuntimeClassV1Overhead(in)); diff != "" {
+		t.Errorf("Unexpected output from flattener: %s", diff)
+	}
+	if diff := cmp.Diff([]interface{}{}, flattenRuntimeClassV1Overhead(nil)); diff != "" {
+		t.Errorf("Unexpected output from flattener: %s", diff)
+	}
+}
+
+func TestExpandAndFlattenRuntimeClassV1Scheduling(t *testing.T) {
+	in := []interface{}{map[string]interface{}{
+		"node_selector": map[string]interface{}{
+			"sandbox.gke.io/runtime": "gvisor",
+		},
+		"toleration": []interface{}{
+			map[string]interface{}{
+				"key":                "sandbox.gke.io/runtime",
+				"operator":           "Equal",
+				"value":              "gvisor",
+				"effect":             "NoSchedule",
+				"toleration_seconds": "",
+			},
+		},
+	}}
+	expected := &nodev1.Scheduling{
+		NodeSelector: map[string]string{
+			"sandbox.gke.io/runtime": "gvisor",
+		},
+		Tolerations: []corev1.Toleration{
+			{
+				Key:      "sandbox.gke.io/runtime",
+				Operator: corev1.TolerationOpEqual,
+				Value:    "gvisor",
+				Effect:   corev1.TaintEffectNoSchedule,
+			},
+		},
+	}
+
+	out, err := expandRuntimeClassV1Scheduling(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Errorf("Unexpected output from expander: %s", diff)
+	}
+
+	flattened := []interface{}{map[string]interface{}{
+		"node_selector": map[string]string{
+			"sandbox.gke.io/runtime": "gvisor",
+		},
+		"toleration": []interface{}{
+			map[string]interface{}{
+				"key":      "sandbox.gke.io/runtime",
+				"operator": "Equal",
+				"value":    "gvisor",
+				"effect":   "NoSchedule",
+			},
+		},
+	}}
+	if diff := cmp.Diff(flattened, flattenRuntimeClassV1Scheduling(out)); diff != "" {
+		t.Errorf("Unexpected output from flattener: %s", diff)
+	}
+}
@@ -0,0 +1,35 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	authv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Expanders
+
+func expandServiceAccountTokenRequest(d *schema.ResourceData) *authv1.TokenRequest {
+	req := &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			Audiences: expandStringSlice(d.Get("audiences").([]interface{})),
+		},
+	}
+	if v, ok := d.GetOk("expiration_seconds"); ok {
+		req.Spec.ExpirationSeconds = ptrToInt64(int64(v.(int)))
+	}
+	req.Spec.BoundObjectRef = expandServiceAccountTokenBoundObjectRef(d.Get("bound_object_ref").([]interface{}))
+	return req
+}
+
+func expandServiceAccountTokenBoundObjectRef(l []interface{}) *authv1.BoundObjectReference {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	in := l[0].(map[string]interface{})
+	return &authv1.BoundObjectReference{
+		Kind:       in["kind"].(string),
+		APIVersion: in["api_version"].(string),
+		Name:       in["name"].(string),
+		UID:        types.UID(in["uid"].(string)),
+	}
+}
@@ -0,0 +1,100 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	authv1 "k8s.io/api/authentication/v1"
+)
+
+func TestExpandServiceAccountTokenRequest(t *testing.T) {
+	cases := map[string]struct {
+		Input          map[string]interface{}
+		ExpectedOutput *authv1.TokenRequest
+	}{
+		"defaults": {
+			Input: map[string]interface{}{
+				"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+			},
+			ExpectedOutput: &authv1.TokenRequest{
+				Spec: authv1.TokenRequestSpec{
+					Audiences: []string{},
+				},
+			},
+		},
+		"all fields": {
+			Input: map[string]interface{}{
+				"metadata":           []interface{}{map[string]interface{}{"name": "test"}},
+				"audiences":          []interface{}{"one", "two"},
+				"expiration_seconds": 7200,
+				"bound_object_ref": []interface{}{map[string]interface{}{
+					"kind": "Pod",
+					"name": "test",
+					"uid":  "0a1b2c3d",
+				}},
+			},
+			ExpectedOutput: &authv1.TokenRequest{
+				Spec: authv1.TokenRequestSpec{
+					Audiences:         []string{"one", "two"},
+					ExpirationSeconds: ptrToInt64(7200),
+					BoundObjectRef: &authv1.BoundObjectReference{
+						Kind:       "Pod",
+						APIVersion: "v1",
+						Name:       "test",
+						UID:        "0a1b2c3d",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceKubernetesServiceAccountToken().Schema, tc.Input)
+			out := expandServiceAccountTokenRequest(d)
+			if diff := cmp.Diff(tc.ExpectedOutput, out); diff != "" {
+				t.Errorf("Unexpected output from expander: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenNeedsRotation(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cases := map[string]struct {
+		Expiration   time.Time
+		RotateBefore time.Duration
+		Expected     bool
+	}{
+		"outside window": {
+			Expiration:   now.Add(2 * time.Hour),
+			RotateBefore: time.Hour,
+			Expected:     false,
+		},
+		"inside window": {
+			Expiration:   now.Add(30 * time.Minute),
+			RotateBefore: time.Hour,
+			Expected:     true,
+		},
+		"at window start": {
+			Expiration:   now.Add(time.Hour),
+			RotateBefore: time.Hour,
+			Expected:     true,
+		},
+		"expired": {
+			Expiration:   now.Add(-time.Minute),
+			RotateBefore: 0,
+			Expected:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if out := serviceAccountTokenNeedsRotation(tc.Expiration, tc.RotateBefore, now); out != tc.Expected {
+				t.Errorf("Expected %t, got %t", tc.Expected, out)
+			}
+		})
+	}
+}
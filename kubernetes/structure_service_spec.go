@@ -19,6 +19,9 @@ func flattenServicePort(in []v1.ServicePort) []interface{} {
 		m["port"] = int(n.Port)
 		m["target_port"] = n.TargetPort.String()
 		m["node_port"] = int(n.NodePort)
+		if n.AppProtocol != nil {
+			m["app_protocol"] = *n.AppProtocol
+		}
 
 		att[i] = m
 	}
@@ -62,6 +65,26 @@ func flattenServiceSpec(in v1.ServiceSpec) []interface{} {
 
 	att["health_check_node_port"] = int(in.HealthCheckNodePort)
 
+	if in.AllocateLoadBalancerNodePorts != nil {
+		att["allocate_load_balancer_node_ports"] = *in.AllocateLoadBalancerNodePorts
+	}
+	if in.InternalTrafficPolicy != nil {
+		att["internal_traffic_policy"] = string(*in.InternalTrafficPolicy)
+	}
+	if len(in.IPFamilies) > 0 {
+		families := make([]interface{}, len(in.IPFamilies))
+		for i, f := range in.IPFamilies {
+			families[i] = string(f)
+		}
+		att["ip_families"] = families
+	}
+	if in.IPFamilyPolicy != nil {
+		att["ip_family_policy"] = string(*in.IPFamilyPolicy)
+	}
+	if in.LoadBalancerClass != nil {
+		att["load_balancer_class"] = *in.LoadBalancerClass
+	}
+
 	return []interface{}{att}
 }
 
@@ -72,6 +95,7 @@ func flattenLoadBalancerStatus(in v1.LoadBalancerStatus) []interface{} {
 
 		att["ip"] = ingress.IP
 		att["hostname"] = ingress.Hostname
+		att["ports"] = flattenPortStatus(ingress.Ports)
 
 		out[i] = att
 	}
@@ -83,6 +107,21 @@ func flattenLoadBalancerStatus(in v1.LoadBalancerStatus) []interface{} {
 	}
 }
 
+func flattenPortStatus(in []v1.PortStatus) []interface{} {
+	out := make([]interface{}, len(in))
+	for i, p := range in {
+		att := map[string]interface{}{
+			"port":     int(p.Port),
+			"protocol": string(p.Protocol),
+		}
+		if p.Error != nil {
+			att["error"] = *p.Error
+		}
+		out[i] = att
+	}
+	return out
+}
+
 // Expanders
 
 func expandServicePort(l []interface{}, removeNodePort bool) []v1.ServicePort {
@@ -105,6 +144,9 @@ func expandServicePort(l []interface{}, removeNodePort bool) []v1.ServicePort {
 		if v, ok := cfg["node_port"].(int); ok && !removeNodePort {
 			obj[i].NodePort = int32(v)
 		}
+		if v, ok := cfg["app_protocol"].(string); ok && v != "" {
+			obj[i].AppProtocol = ptrToString(v)
+		}
 	}
 	return obj
 }
@@ -152,10 +194,32 @@ func expandServiceSpec(l []interface{}) v1.ServiceSpec {
 	if v, ok := in["health_check_node_port"].(int); ok {
 		obj.HealthCheckNodePort = int32(v)
 	}
+	if v, ok := in["internal_traffic_policy"].(string); ok && v != "" {
+		p := v1.ServiceInternalTrafficPolicy(v)
+		obj.InternalTrafficPolicy = &p
+	}
+	if v, ok := in["ip_families"].([]interface{}); ok && len(v) > 0 {
+		obj.IPFamilies = expandIPFamilies(v)
+	}
+	if v, ok := in["ip_family_policy"].(string); ok && v != "" {
+		p := v1.IPFamilyPolicy(v)
+		obj.IPFamilyPolicy = &p
+	}
+	if v, ok := in["load_balancer_class"].(string); ok && v != "" {
+		obj.LoadBalancerClass = ptrToString(v)
+	}
 
 	return obj
 }
 
+func expandIPFamilies(in []interface{}) []v1.IPFamily {
+	families := make([]v1.IPFamily, len(in))
+	for i, f := range in {
+		families[i] = v1.IPFamily(f.(string))
+	}
+	return families
+}
+
 // Patch Ops
 
 func patchServiceSpec(keyPrefix, pathPrefix string, d *schema.ResourceData, v *version.Info) (PatchOperations, error) {
@@ -257,6 +321,30 @@ func patchServiceSpec(keyPrefix, pathPrefix string, d *schema.ResourceData, v *v
 			})
 		}
 	}
+	if d.HasChange(keyPrefix + "allocate_load_balancer_node_ports") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "allocateLoadBalancerNodePorts",
+			Value: d.Get(keyPrefix + "allocate_load_balancer_node_ports").(bool),
+		})
+	}
+	if d.HasChange(keyPrefix + "internal_traffic_policy") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "internalTrafficPolicy",
+			Value: d.Get(keyPrefix + "internal_traffic_policy").(string),
+		})
+	}
+	if d.HasChange(keyPrefix + "ip_family_policy") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "ipFamilyPolicy",
+			Value: d.Get(keyPrefix + "ip_family_policy").(string),
+		})
+	}
+	if d.HasChange(keyPrefix + "ip_families") {
+		ops = append(ops, &AddOperation{
+			Path:  pathPrefix + "ipFamilies",
+			Value: expandIPFamilies(d.Get(keyPrefix + "ip_families").([]interface{})),
+		})
+	}
 	if d.HasChange(keyPrefix + "health_check_node_port") {
 		ops = append(ops, &ReplaceOperation{
 			Path:  pathPrefix + "healthCheckNodePort",
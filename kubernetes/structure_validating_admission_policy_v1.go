@@ -0,0 +1,308 @@
+package kubernetes
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// The vendored client-go predates the admissionregistration.k8s.io/v1
+// ValidatingAdmissionPolicy API. The v1beta1 types are identical to the v1
+// ones, so they are used as the data model and sent to the v1 endpoints
+// through the dynamic client.
+const admissionregistrationV1GroupVersion = "admissionregistration.k8s.io/v1"
+
+var (
+	validatingAdmissionPolicyV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "admissionregistration.k8s.io",
+		Version:  "v1",
+		Resource: "validatingadmissionpolicies",
+	}
+	validatingAdmissionPolicyBindingV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "admissionregistration.k8s.io",
+		Version:  "v1",
+		Resource: "validatingadmissionpolicybindings",
+	}
+)
+
+func expandValidatingAdmissionPolicyV1Spec(l []interface{}) admissionregistrationv1beta1.ValidatingAdmissionPolicySpec {
+	obj := admissionregistrationv1beta1.ValidatingAdmissionPolicySpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["param_kind"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		obj.ParamKind = &admissionregistrationv1beta1.ParamKind{
+			APIVersion: p["api_version"].(string),
+			Kind:       p["kind"].(string),
+		}
+	}
+	if v, ok := in["match_constraints"].([]interface{}); ok && len(v) > 0 {
+		obj.MatchConstraints = expandValidatingAdmissionPolicyV1MatchResources(v)
+	}
+	if v, ok := in["validation"].([]interface{}); ok {
+		for _, val := range v {
+			m := val.(map[string]interface{})
+			obj.Validations = append(obj.Validations, admissionregistrationv1beta1.Validation{
+				Expression:        m["expression"].(string),
+				Message:           m["message"].(string),
+				Reason:            expandValidatingAdmissionPolicyV1Reason(m["reason"].(string)),
+				MessageExpression: m["message_expression"].(string),
+			})
+		}
+	}
+	if v, ok := in["failure_policy"].(string); ok && v != "" {
+		fp := admissionregistrationv1beta1.FailurePolicyType(v)
+		obj.FailurePolicy = &fp
+	}
+	if v, ok := in["audit_annotation"].([]interface{}); ok {
+		for _, a := range v {
+			m := a.(map[string]interface{})
+			obj.AuditAnnotations = append(obj.AuditAnnotations, admissionregistrationv1beta1.AuditAnnotation{
+				Key:             m["key"].(string),
+				ValueExpression: m["value_expression"].(string),
+			})
+		}
+	}
+	if v, ok := in["match_condition"].([]interface{}); ok {
+		for _, c := range v {
+			m := c.(map[string]interface{})
+			obj.MatchConditions = append(obj.MatchConditions, admissionregistrationv1beta1.MatchCondition{
+				Name:       m["name"].(string),
+				Expression: m["expression"].(string),
+			})
+		}
+	}
+	if v, ok := in["variable"].([]interface{}); ok {
+		for _, vr := range v {
+			m := vr.(map[string]interface{})
+			obj.Variables = append(obj.Variables, admissionregistrationv1beta1.Variable{
+				Name:       m["name"].(string),
+				Expression: m["expression"].(string),
+			})
+		}
+	}
+
+	return obj
+}
+
+func expandValidatingAdmissionPolicyV1Reason(in string) *metav1.StatusReason {
+	if in == "" {
+		return nil
+	}
+	r := metav1.StatusReason(in)
+	return &r
+}
+
+func flattenValidatingAdmissionPolicyV1Spec(in admissionregistrationv1beta1.ValidatingAdmissionPolicySpec) []interface{} {
+	att := map[string]interface{}{}
+
+	if in.ParamKind != nil {
+		att["param_kind"] = []interface{}{map[string]interface{}{
+			"api_version": in.ParamKind.APIVersion,
+			"kind":        in.ParamKind.Kind,
+		}}
+	}
+	if in.MatchConstraints != nil {
+		att["match_constraints"] = flattenValidatingAdmissionPolicyV1MatchResources(*in.MatchConstraints)
+	}
+	validations := make([]interface{}, len(in.Validations))
+	for i, v := range in.Validations {
+		m := map[string]interface{}{
+			"expression":         v.Expression,
+			"message":            v.Message,
+			"message_expression": v.MessageExpression,
+		}
+		if v.Reason != nil {
+			m["reason"] = string(*v.Reason)
+		}
+		validations[i] = m
+	}
+	att["validation"] = validations
+	if in.FailurePolicy != nil {
+		att["failure_policy"] = string(*in.FailurePolicy)
+	}
+	annotations := make([]interface{}, len(in.AuditAnnotations))
+	for i, a := range in.AuditAnnotations {
+		annotations[i] = map[string]interface{}{
+			"key":              a.Key,
+			"value_expression": a.ValueExpression,
+		}
+	}
+	att["audit_annotation"] = annotations
+	conditions := make([]interface{}, len(in.MatchConditions))
+	for i, c := range in.MatchConditions {
+		conditions[i] = map[string]interface{}{
+			"name":       c.Name,
+			"expression": c.Expression,
+		}
+	}
+	att["match_condition"] = conditions
+	variables := make([]interface{}, len(in.Variables))
+	for i, v := range in.Variables {
+		variables[i] = map[string]interface{}{
+			"name":       v.Name,
+			"expression": v.Expression,
+		}
+	}
+	att["variable"] = variables
+
+	return []interface{}{att}
+}
+
+func flattenValidatingAdmissionPolicyV1Status(in admissionregistrationv1beta1.ValidatingAdmissionPolicyStatus) []interface{} {
+	att := map[string]interface{}{
+		"observed_generation": int(in.ObservedGeneration),
+	}
+	warnings := []interface{}{}
+	if in.TypeChecking != nil {
+		for _, w := range in.TypeChecking.ExpressionWarnings {
+			warnings = append(warnings, map[string]interface{}{
+				"field_ref": w.FieldRef,
+				"warning":   w.Warning,
+			})
+		}
+	}
+	att["expression_warning"] = warnings
+	return []interface{}{att}
+}
+
+func expandValidatingAdmissionPolicyBindingV1Spec(l []interface{}) admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec {
+	obj := admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.PolicyName = in["policy_name"].(string)
+	if v, ok := in["param_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		p := v[0].(map[string]interface{})
+		ref := &admissionregistrationv1beta1.ParamRef{
+			Name:      p["name"].(string),
+			Namespace: p["namespace"].(string),
+		}
+		if s, ok := p["selector"].([]interface{}); ok && len(s) > 0 {
+			ref.Selector = expandLabelSelector(s)
+		}
+		if a, ok := p["parameter_not_found_action"].(string); ok && a != "" {
+			action := admissionregistrationv1beta1.ParameterNotFoundActionType(a)
+			ref.ParameterNotFoundAction = &action
+		}
+		obj.ParamRef = ref
+	}
+	if v, ok := in["match_resources"].([]interface{}); ok && len(v) > 0 {
+		obj.MatchResources = expandValidatingAdmissionPolicyV1MatchResources(v)
+	}
+	if v, ok := in["validation_actions"].(*schema.Set); ok {
+		for _, a := range sliceOfString(v.List()) {
+			obj.ValidationActions = append(obj.ValidationActions, admissionregistrationv1beta1.ValidationAction(a))
+		}
+	}
+
+	return obj
+}
+
+func flattenValidatingAdmissionPolicyBindingV1Spec(in admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec) []interface{} {
+	att := map[string]interface{}{
+		"policy_name": in.PolicyName,
+	}
+
+	if in.ParamRef != nil {
+		ref := map[string]interface{}{
+			"name":      in.ParamRef.Name,
+			"namespace": in.ParamRef.Namespace,
+		}
+		// An empty selector is meaningful here, it matches all resources of
+		// the parameter kind.
+		if in.ParamRef.Selector != nil {
+			ref["selector"] = flattenLabelSelector(in.ParamRef.Selector)
+		}
+		if in.ParamRef.ParameterNotFoundAction != nil {
+			ref["parameter_not_found_action"] = string(*in.ParamRef.ParameterNotFoundAction)
+		}
+		att["param_ref"] = []interface{}{ref}
+	}
+	if in.MatchResources != nil {
+		att["match_resources"] = flattenValidatingAdmissionPolicyV1MatchResources(*in.MatchResources)
+	}
+	actions := make([]string, len(in.ValidationActions))
+	for i, a := range in.ValidationActions {
+		actions[i] = string(a)
+	}
+	att["validation_actions"] = newStringSet(schema.HashString, actions)
+
+	return []interface{}{att}
+}
+
+func expandValidatingAdmissionPolicyV1MatchResources(l []interface{}) *admissionregistrationv1beta1.MatchResources {
+	obj := &admissionregistrationv1beta1.MatchResources{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["namespace_selector"].([]interface{}); ok && len(v) != 0 {
+		obj.NamespaceSelector = expandLabelSelector(v)
+	}
+	if v, ok := in["object_selector"].([]interface{}); ok && len(v) != 0 {
+		obj.ObjectSelector = expandLabelSelector(v)
+	}
+	if v, ok := in["resource_rule"].([]interface{}); ok {
+		obj.ResourceRules = expandValidatingAdmissionPolicyV1NamedRules(v)
+	}
+	if v, ok := in["exclude_resource_rule"].([]interface{}); ok {
+		obj.ExcludeResourceRules = expandValidatingAdmissionPolicyV1NamedRules(v)
+	}
+	if v, ok := in["match_policy"].(string); ok && v != "" {
+		policy := admissionregistrationv1beta1.MatchPolicyType(v)
+		obj.MatchPolicy = &policy
+	}
+
+	return obj
+}
+
+func flattenValidatingAdmissionPolicyV1MatchResources(in admissionregistrationv1beta1.MatchResources) []interface{} {
+	att := map[string]interface{}{}
+
+	if v := flattenWebhookLabelSelector(in.NamespaceSelector); v != nil {
+		att["namespace_selector"] = v
+	}
+	if v := flattenWebhookLabelSelector(in.ObjectSelector); v != nil {
+		att["object_selector"] = v
+	}
+	att["resource_rule"] = flattenValidatingAdmissionPolicyV1NamedRules(in.ResourceRules)
+	att["exclude_resource_rule"] = flattenValidatingAdmissionPolicyV1NamedRules(in.ExcludeResourceRules)
+	if in.MatchPolicy != nil {
+		att["match_policy"] = string(*in.MatchPolicy)
+	}
+
+	return []interface{}{att}
+}
+
+func expandValidatingAdmissionPolicyV1NamedRules(l []interface{}) []admissionregistrationv1beta1.NamedRuleWithOperations {
+	rules := []admissionregistrationv1beta1.NamedRuleWithOperations{}
+	for _, r := range l {
+		in := r.(map[string]interface{})
+		rule := admissionregistrationv1beta1.NamedRuleWithOperations{
+			RuleWithOperations: expandRuleWithOperations(in),
+		}
+		if v, ok := in["resource_names"].([]interface{}); ok {
+			rule.ResourceNames = expandStringSlice(v)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func flattenValidatingAdmissionPolicyV1NamedRules(in []admissionregistrationv1beta1.NamedRuleWithOperations) []interface{} {
+	rules := make([]interface{}, len(in))
+	for i, r := range in {
+		rule := flattenRuleWithOperations(r.RuleWithOperations)
+		rule["resource_names"] = r.ResourceNames
+		rules[i] = rule
+	}
+	return rules
+}
@@ -0,0 +1,195 @@
+package kubernetes
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExpandThenFlatten_validating_admission_policy_v1(t *testing.T) {
+	spec := []interface{}{map[string]interface{}{
+		"param_kind": []interface{}{map[string]interface{}{
+			"api_version": "v1",
+			"kind":        "ConfigMap",
+		}},
+		"match_constraints": []interface{}{map[string]interface{}{
+			"resource_rule": []interface{}{map[string]interface{}{
+				"api_groups":     []string{"apps"},
+				"api_versions":   []string{"v1"},
+				"operations":     []admissionregistrationv1beta1.OperationType{"CREATE", "UPDATE"},
+				"resources":      []string{"deployments"},
+				"scope":          admissionregistrationv1beta1.ScopeType("*"),
+				"resource_names": []string(nil),
+			}},
+			"exclude_resource_rule": []interface{}{map[string]interface{}{
+				"api_groups":     []string{"apps"},
+				"api_versions":   []string{"v1"},
+				"operations":     []admissionregistrationv1beta1.OperationType{"*"},
+				"resources":      []string{"deployments"},
+				"scope":          admissionregistrationv1beta1.ScopeType("Namespaced"),
+				"resource_names": []string{"ignored"},
+			}},
+			"match_policy": "Equivalent",
+		}},
+		"validation": []interface{}{map[string]interface{}{
+			"expression":         "object.spec.replicas <= 5",
+			"message":            "too many replicas",
+			"reason":             "Invalid",
+			"message_expression": "",
+		}},
+		"failure_policy": "Fail",
+		"audit_annotation": []interface{}{map[string]interface{}{
+			"key":              "replicas",
+			"value_expression": "string(object.spec.replicas)",
+		}},
+		"match_condition": []interface{}{map[string]interface{}{
+			"name":       "exclude-system",
+			"expression": "request.namespace != 'kube-system'",
+		}},
+		"variable": []interface{}{map[string]interface{}{
+			"name":       "replicas",
+			"expression": "object.spec.replicas",
+		}},
+	}}
+
+	// The rule fields are flattened to typed values, expand them from the
+	// matching untyped ones.
+	in := []interface{}{map[string]interface{}{}}
+	for k, v := range spec[0].(map[string]interface{}) {
+		in[0].(map[string]interface{})[k] = v
+	}
+	in[0].(map[string]interface{})["match_constraints"] = []interface{}{map[string]interface{}{
+		"resource_rule": []interface{}{map[string]interface{}{
+			"api_groups":   []interface{}{"apps"},
+			"api_versions": []interface{}{"v1"},
+			"operations":   []interface{}{"CREATE", "UPDATE"},
+			"resources":    []interface{}{"deployments"},
+			"scope":        "*",
+		}},
+		"exclude_resource_rule": []interface{}{map[string]interface{}{
+			"api_groups":     []interface{}{"apps"},
+			"api_versions":   []interface{}{"v1"},
+			"operations":     []interface{}{"*"},
+			"resources":      []interface{}{"deployments"},
+			"scope":          "Namespaced",
+			"resource_names": []interface{}{"ignored"},
+		}},
+		"match_policy": "Equivalent",
+	}}
+
+	if diff := cmp.Diff(spec, flattenValidatingAdmissionPolicyV1Spec(expandValidatingAdmissionPolicyV1Spec(in))); diff != "" {
+		t.Fatalf("Unexpected validating admission policy spec round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestExpandValidatingAdmissionPolicyBindingV1Spec(t *testing.T) {
+	spec := []interface{}{map[string]interface{}{
+		"policy_name": "example",
+		"param_ref": []interface{}{map[string]interface{}{
+			"name":      "",
+			"namespace": "default",
+			"selector": []interface{}{map[string]interface{}{
+				"match_labels": map[string]interface{}{"app": "example"},
+			}},
+			"parameter_not_found_action": "Allow",
+		}},
+		"validation_actions": schema.NewSet(schema.HashString, []interface{}{"Deny", "Audit"}),
+	}}
+
+	out := expandValidatingAdmissionPolicyBindingV1Spec(spec)
+
+	allow := admissionregistrationv1beta1.AllowAction
+	expected := admissionregistrationv1beta1.ValidatingAdmissionPolicyBindingSpec{
+		PolicyName: "example",
+		ParamRef: &admissionregistrationv1beta1.ParamRef{
+			Namespace:               "default",
+			Selector:                &metav1.LabelSelector{MatchLabels: map[string]string{"app": "example"}},
+			ParameterNotFoundAction: &allow,
+		},
+		ValidationActions: []admissionregistrationv1beta1.ValidationAction{"Deny", "Audit"},
+	}
+	if diff := cmp.Diff(expected, out, cmp.Transformer("sortActions", sortValidationActions)); diff != "" {
+		t.Fatalf("Unexpected binding spec: mismatch (-want +got):\n%s", diff)
+	}
+
+	flattened := flattenValidatingAdmissionPolicyBindingV1Spec(out)[0].(map[string]interface{})
+	if !flattened["validation_actions"].(*schema.Set).Equal(spec[0].(map[string]interface{})["validation_actions"]) {
+		t.Fatalf("Unexpected validation actions: %v", flattened["validation_actions"].(*schema.Set).List())
+	}
+	expectedParamRef := []interface{}{map[string]interface{}{
+		"name":      "",
+		"namespace": "default",
+		"selector": []interface{}{map[string]interface{}{
+			"match_labels": map[string]string{"app": "example"},
+		}},
+		"parameter_not_found_action": "Allow",
+	}}
+	if diff := cmp.Diff(expectedParamRef, flattened["param_ref"]); diff != "" {
+		t.Fatalf("Unexpected param_ref: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestValidatingAdmissionPolicyV1ToUnstructured(t *testing.T) {
+	policy := admissionregistrationv1beta1.ValidatingAdmissionPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: admissionregistrationV1GroupVersion,
+			Kind:       "ValidatingAdmissionPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: expandValidatingAdmissionPolicyV1Spec([]interface{}{map[string]interface{}{
+			"match_constraints": []interface{}{map[string]interface{}{
+				"resource_rule": []interface{}{map[string]interface{}{
+					"api_groups":   []interface{}{"apps"},
+					"api_versions": []interface{}{"v1"},
+					"operations":   []interface{}{"CREATE"},
+					"resources":    []interface{}{"deployments"},
+					"scope":        "*",
+				}},
+			}},
+			"validation": []interface{}{map[string]interface{}{
+				"expression":         "object.spec.replicas <= 5",
+				"message":            "",
+				"reason":             "",
+				"message_expression": "",
+			}},
+		}}),
+	}
+
+	u, err := toUnstructuredObject(&policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.GetAPIVersion() != "admissionregistration.k8s.io/v1" {
+		t.Fatalf("Unexpected apiVersion %q", u.GetAPIVersion())
+	}
+	// The embedded rule fields must be inlined into the named rule.
+	rules, _, err := unstructured.NestedSlice(u.Object, "spec", "matchConstraints", "resourceRules")
+	if err != nil || len(rules) != 1 {
+		t.Fatalf("Unexpected resource rules %v: %v", rules, err)
+	}
+	if _, ok := rules[0].(map[string]interface{})["apiGroups"]; !ok {
+		t.Fatalf("Expected apiGroups to be inlined in %v", rules[0])
+	}
+
+	out := admissionregistrationv1beta1.ValidatingAdmissionPolicy{}
+	if err := fromUnstructuredObject(u, &out); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(policy, out); diff != "" {
+		t.Fatalf("Unexpected policy round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func sortValidationActions(in []admissionregistrationv1beta1.ValidationAction) []string {
+	out := make([]string, len(in))
+	for i, a := range in {
+		out[i] = string(a)
+	}
+	sort.Strings(out)
+	return out
+}
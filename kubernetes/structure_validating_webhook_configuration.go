@@ -19,18 +19,16 @@ func flattenValidatingWebhook(in admissionregistrationv1.ValidatingWebhook) map[
 		att["match_policy"] = *in.MatchPolicy
 	}
 
+	att["match_condition"] = flattenMatchConditions(in.MatchConditions)
+
 	att["name"] = in.Name
 
-	if in.NamespaceSelector != nil {
-		if in.NamespaceSelector.MatchExpressions != nil || in.NamespaceSelector.MatchLabels != nil {
-			att["namespace_selector"] = flattenLabelSelector(in.NamespaceSelector)
-		}
+	if v := flattenWebhookLabelSelector(in.NamespaceSelector); v != nil {
+		att["namespace_selector"] = v
 	}
 
-	if in.ObjectSelector != nil {
-		if in.ObjectSelector.MatchExpressions != nil || in.ObjectSelector.MatchLabels != nil {
-			att["object_selector"] = flattenLabelSelector(in.ObjectSelector)
-		}
+	if v := flattenWebhookLabelSelector(in.ObjectSelector); v != nil {
+		att["object_selector"] = v
 	}
 
 	rules := []interface{}{}
@@ -71,6 +69,10 @@ func expandValidatingWebhook(in map[string]interface{}) admissionregistrationv1.
 		obj.MatchPolicy = &policy
 	}
 
+	if v, ok := in["match_condition"].([]interface{}); ok {
+		obj.MatchConditions = expandMatchConditions(v)
+	}
+
 	if v, ok := in["name"].(string); ok {
 		obj.Name = v
 	}
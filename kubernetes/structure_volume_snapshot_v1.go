@@ -0,0 +1,172 @@
+package kubernetes
+
+import (
+	"time"
+)
+
+// Expanders
+
+func expandVolumeSnapshotV1Spec(l []interface{}) volumeSnapshotV1Spec {
+	obj := volumeSnapshotV1Spec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	if v, ok := in["volume_snapshot_class_name"].(string); ok && v != "" {
+		obj.VolumeSnapshotClassName = ptrToString(v)
+	}
+	if v, ok := in["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s := v[0].(map[string]interface{})
+		if name, ok := s["persistent_volume_claim_name"].(string); ok && name != "" {
+			obj.Source.PersistentVolumeClaimName = ptrToString(name)
+		}
+		if name, ok := s["volume_snapshot_content_name"].(string); ok && name != "" {
+			obj.Source.VolumeSnapshotContentName = ptrToString(name)
+		}
+	}
+	return obj
+}
+
+func expandVolumeSnapshotContentV1Spec(l []interface{}) volumeSnapshotContentV1Spec {
+	obj := volumeSnapshotContentV1Spec{}
+	if len(l) == 0 || l[0] == nil {
+		return obj
+	}
+	in := l[0].(map[string]interface{})
+
+	obj.Driver = in["driver"].(string)
+	obj.DeletionPolicy = in["deletion_policy"].(string)
+	if v, ok := in["volume_snapshot_class_name"].(string); ok && v != "" {
+		obj.VolumeSnapshotClassName = ptrToString(v)
+	}
+	if v, ok := in["source_volume_mode"].(string); ok && v != "" {
+		obj.SourceVolumeMode = ptrToString(v)
+	}
+	if v, ok := in["volume_snapshot_ref"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		ref := v[0].(map[string]interface{})
+		obj.VolumeSnapshotRef = volumeSnapshotContentV1Reference{
+			Name:      ref["name"].(string),
+			Namespace: ref["namespace"].(string),
+		}
+	}
+	if v, ok := in["source"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		s := v[0].(map[string]interface{})
+		if h, ok := s["volume_handle"].(string); ok && h != "" {
+			obj.Source.VolumeHandle = ptrToString(h)
+		}
+		if h, ok := s["snapshot_handle"].(string); ok && h != "" {
+			obj.Source.SnapshotHandle = ptrToString(h)
+		}
+	}
+	return obj
+}
+
+// Flatteners
+
+func flattenVolumeSnapshotV1Spec(in volumeSnapshotV1Spec) []interface{} {
+	att := map[string]interface{}{}
+	if in.VolumeSnapshotClassName != nil {
+		att["volume_snapshot_class_name"] = *in.VolumeSnapshotClassName
+	}
+	source := map[string]interface{}{}
+	if in.Source.PersistentVolumeClaimName != nil {
+		source["persistent_volume_claim_name"] = *in.Source.PersistentVolumeClaimName
+	}
+	if in.Source.VolumeSnapshotContentName != nil {
+		source["volume_snapshot_content_name"] = *in.Source.VolumeSnapshotContentName
+	}
+	att["source"] = []interface{}{source}
+	return []interface{}{att}
+}
+
+func flattenVolumeSnapshotV1Status(in *volumeSnapshotV1Status) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{
+		"ready_to_use": in.ReadyToUse != nil && *in.ReadyToUse,
+		"error":        flattenVolumeSnapshotError(in.Error),
+	}
+	if in.BoundVolumeSnapshotContentName != nil {
+		att["bound_volume_snapshot_content_name"] = *in.BoundVolumeSnapshotContentName
+	}
+	if in.CreationTime != nil {
+		att["creation_time"] = in.CreationTime.UTC().Format(time.RFC3339)
+	}
+	if in.RestoreSize != nil {
+		att["restore_size"] = *in.RestoreSize
+	}
+	return []interface{}{att}
+}
+
+func flattenVolumeSnapshotContentV1Spec(in volumeSnapshotContentV1Spec) []interface{} {
+	att := map[string]interface{}{
+		"driver":          in.Driver,
+		"deletion_policy": in.DeletionPolicy,
+		"volume_snapshot_ref": []interface{}{map[string]interface{}{
+			"name":      in.VolumeSnapshotRef.Name,
+			"namespace": in.VolumeSnapshotRef.Namespace,
+		}},
+	}
+	if in.VolumeSnapshotClassName != nil {
+		att["volume_snapshot_class_name"] = *in.VolumeSnapshotClassName
+	}
+	if in.SourceVolumeMode != nil {
+		att["source_volume_mode"] = *in.SourceVolumeMode
+	}
+	source := map[string]interface{}{}
+	if in.Source.VolumeHandle != nil {
+		source["volume_handle"] = *in.Source.VolumeHandle
+	}
+	if in.Source.SnapshotHandle != nil {
+		source["snapshot_handle"] = *in.Source.SnapshotHandle
+	}
+	att["source"] = []interface{}{source}
+	return []interface{}{att}
+}
+
+func flattenVolumeSnapshotContentV1Status(in *volumeSnapshotContentV1Status) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{
+		"ready_to_use": in.ReadyToUse != nil && *in.ReadyToUse,
+		"error":        flattenVolumeSnapshotError(in.Error),
+	}
+	if in.SnapshotHandle != nil {
+		att["snapshot_handle"] = *in.SnapshotHandle
+	}
+	// The content reports the creation time in nanoseconds since the epoch
+	// and the restore size in bytes.
+	if in.CreationTime != nil {
+		att["creation_time"] = time.Unix(0, *in.CreationTime).UTC().Format(time.RFC3339)
+	}
+	if in.RestoreSize != nil {
+		att["restore_size"] = int(*in.RestoreSize)
+	}
+	return []interface{}{att}
+}
+
+func flattenVolumeSnapshotError(in *volumeSnapshotError) []interface{} {
+	if in == nil {
+		return []interface{}{}
+	}
+	att := map[string]interface{}{}
+	if in.Message != nil {
+		att["message"] = *in.Message
+	}
+	if in.Time != nil {
+		att["time"] = in.Time.UTC().Format(time.RFC3339)
+	}
+	return []interface{}{att}
+}
+
+// volumeSnapshotErrorMessage returns the error reported by the snapshot
+// controller or the CSI driver, if any.
+func volumeSnapshotErrorMessage(in *volumeSnapshotError) string {
+	if in == nil || in.Message == nil {
+		return ""
+	}
+	return *in.Message
+}
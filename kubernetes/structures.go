@@ -4,12 +4,15 @@ import (
 	"encoding/base64"
 	"fmt"
 	"net/url"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	api "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 func idParts(id string) (string, string, error) {
@@ -109,19 +112,24 @@ func expandStringSlice(s []interface{}) []string {
 	return result
 }
 
-func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, metaPrefix ...string) []interface{} {
+func flattenMetadata(meta metav1.ObjectMeta, d *schema.ResourceData, providerMetadata interface{}, metaPrefix ...string) []interface{} {
 	m := make(map[string]interface{})
 	prefix := ""
 	if len(metaPrefix) > 0 {
 		prefix = metaPrefix[0]
 	}
+	var ignoreAnnotations, ignoreLabels []*regexp.Regexp
+	if k, ok := providerMetadata.(kubeClientsets); ok {
+		ignoreAnnotations = k.ignoreAnnotations
+		ignoreLabels = k.ignoreLabels
+	}
 	configAnnotations := d.Get(prefix + "metadata.0.annotations").(map[string]interface{})
-	m["annotations"] = removeInternalKeys(meta.Annotations, configAnnotations)
+	m["annotations"] = removeKeys(removeInternalKeys(meta.Annotations, configAnnotations), configAnnotations, ignoreAnnotations)
 	if meta.GenerateName != "" {
 		m["generate_name"] = meta.GenerateName
 	}
 	configLabels := d.Get(prefix + "metadata.0.labels").(map[string]interface{})
-	m["labels"] = removeInternalKeys(meta.Labels, configLabels)
+	m["labels"] = removeKeys(removeInternalKeys(meta.Labels, configLabels), configLabels, ignoreLabels)
 	m["name"] = meta.Name
 	m["resource_version"] = meta.ResourceVersion
 	m["uid"] = fmt.Sprintf("%v", meta.UID)
@@ -143,6 +151,26 @@ func removeInternalKeys(m map[string]string, d map[string]interface{}) map[strin
 	return m
 }
 
+// removeKeys removes the keys matching any of the ignore patterns, unless
+// they are explicitly set in the configuration.
+func removeKeys(m map[string]string, d map[string]interface{}, ignore []*regexp.Regexp) map[string]string {
+	for k := range m {
+		if isIgnoredKey(k, ignore) && !isKeyInMap(k, d) {
+			delete(m, k)
+		}
+	}
+	return m
+}
+
+func isIgnoredKey(key string, ignore []*regexp.Regexp) bool {
+	for _, r := range ignore {
+		if r.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
 func isKeyInMap(key string, d map[string]interface{}) bool {
 	if d == nil {
 		return false
@@ -247,6 +275,10 @@ func base64DecodeStringMap(m map[string]interface{}) (map[string][]byte, error)
 	return mm, nil
 }
 
+// flattenResourceList returns the quantities in the canonical form the API
+// server stores them in, e.g. "1Gi" for "1024Mi" and "500m" for "0.5". Schemas
+// holding resource lists pair it with suppressEquivalentResourceQuantity so the
+// form used in the configuration does not produce a diff.
 func flattenResourceList(l api.ResourceList) map[string]string {
 	m := make(map[string]string)
 	for k, v := range l {
@@ -310,6 +342,14 @@ func flattenResourceQuotaSpec(in api.ResourceQuotaSpec) []interface{} {
 	return out
 }
 
+func flattenResourceQuotaStatus(in api.ResourceQuotaStatus) []interface{} {
+	m := map[string]interface{}{
+		"hard": flattenResourceList(in.Hard),
+		"used": flattenResourceList(in.Used),
+	}
+	return []interface{}{m}
+}
+
 func expandResourceQuotaSpec(s []interface{}) (*api.ResourceQuotaSpec, error) {
 	out := &api.ResourceQuotaSpec{}
 	if len(s) < 1 {
@@ -402,7 +442,7 @@ func flattenResourceQuotaScopeSelectorMatchExpressions(in []api.ScopedResourceSe
 	if len(in) == 0 {
 		return []interface{}{}
 	}
-	out := make([]interface{}, 1)
+	out := make([]interface{}, len(in))
 
 	for i, l := range in {
 		m := make(map[string]interface{}, 0)
@@ -463,83 +503,133 @@ func expandLimitRangeSpec(s []interface{}, isNew bool) (*api.LimitRangeSpec, err
 	m := s[0].(map[string]interface{})
 
 	if limits, ok := m["limit"].([]interface{}); ok {
-		newLimits := make([]api.LimitRangeItem, len(limits), len(limits))
+		items, err := expandLimitRangeItems(limits, isNew)
+		if err != nil {
+			return out, err
+		}
+		out.Limits = items
+	}
 
-		for i, l := range limits {
-			lrItem := api.LimitRangeItem{}
-			limit := l.(map[string]interface{})
+	return out, nil
+}
 
-			if v, ok := limit["type"]; ok {
-				lrItem.Type = api.LimitType(v.(string))
-			}
+func expandLimitRangeItems(limits []interface{}, isNew bool) ([]api.LimitRangeItem, error) {
+	newLimits := make([]api.LimitRangeItem, len(limits), len(limits))
 
-			// defaultRequest is forbidden for Pod limits, even though it's set & returned by API
-			// this is how we avoid sending it back
-			if v, ok := limit["default_request"]; ok {
-				drm := v.(map[string]interface{})
-				if lrItem.Type == api.LimitTypePod && len(drm) > 0 {
-					if isNew {
-						return out, fmt.Errorf("limit.%d.default_request cannot be set for Pod limit", i)
-					}
-				} else {
-					el, err := expandMapToResourceList(drm)
-					if err != nil {
-						return out, err
-					}
-					lrItem.DefaultRequest = *el
-				}
-			}
+	for i, l := range limits {
+		lrItem := api.LimitRangeItem{}
+		if l == nil {
+			newLimits[i] = lrItem
+			continue
+		}
+		limit := l.(map[string]interface{})
 
-			if v, ok := limit["default"]; ok {
-				el, err := expandMapToResourceList(v.(map[string]interface{}))
-				if err != nil {
-					return out, err
+		if v, ok := limit["type"]; ok {
+			lrItem.Type = api.LimitType(v.(string))
+		}
+
+		// defaultRequest is forbidden for Pod limits, even though it's set & returned by API
+		// this is how we avoid sending it back
+		if v, ok := limit["default_request"]; ok {
+			drm := v.(map[string]interface{})
+			if lrItem.Type == api.LimitTypePod && len(drm) > 0 {
+				if isNew {
+					return newLimits, fmt.Errorf("limit.%d.default_request cannot be set for Pod limit", i)
 				}
-				lrItem.Default = *el
-			}
-			if v, ok := limit["max"]; ok {
-				el, err := expandMapToResourceList(v.(map[string]interface{}))
+			} else {
+				el, err := expandMapToResourceList(drm)
 				if err != nil {
-					return out, err
+					return newLimits, err
 				}
-				lrItem.Max = *el
+				lrItem.DefaultRequest = *el
 			}
-			if v, ok := limit["max_limit_request_ratio"]; ok {
-				el, err := expandMapToResourceList(v.(map[string]interface{}))
-				if err != nil {
-					return out, err
-				}
-				lrItem.MaxLimitRequestRatio = *el
+		}
+
+		if v, ok := limit["default"]; ok {
+			el, err := expandMapToResourceList(v.(map[string]interface{}))
+			if err != nil {
+				return newLimits, err
 			}
-			if v, ok := limit["min"]; ok {
-				el, err := expandMapToResourceList(v.(map[string]interface{}))
-				if err != nil {
-					return out, err
-				}
-				lrItem.Min = *el
+			lrItem.Default = *el
+		}
+		if v, ok := limit["max"]; ok {
+			el, err := expandMapToResourceList(v.(map[string]interface{}))
+			if err != nil {
+				return newLimits, err
 			}
-
-			newLimits[i] = lrItem
+			lrItem.Max = *el
+		}
+		if v, ok := limit["max_limit_request_ratio"]; ok {
+			el, err := expandMapToResourceList(v.(map[string]interface{}))
+			if err != nil {
+				return newLimits, err
+			}
+			lrItem.MaxLimitRequestRatio = *el
+		}
+		if v, ok := limit["min"]; ok {
+			el, err := expandMapToResourceList(v.(map[string]interface{}))
+			if err != nil {
+				return newLimits, err
+			}
+			lrItem.Min = *el
 		}
 
-		out.Limits = newLimits
+		newLimits[i] = lrItem
 	}
 
-	return out, nil
+	return newLimits, nil
 }
 
-func flattenLimitRangeSpec(in api.LimitRangeSpec) []interface{} {
+// flattenLimitRangeSpec flattens the spec returned by the API. The prior
+// spec, as kept in state, is used to leave out the defaults the API server
+// fills in for container limits, see flattenLimitRangeItems.
+func flattenLimitRangeSpec(in api.LimitRangeSpec, prior []interface{}) []interface{} {
 	if len(in.Limits) == 0 {
 		return []interface{}{}
 	}
 
+	var priorLimits []interface{}
+	if len(prior) > 0 && prior[0] != nil {
+		priorLimits, _ = prior[0].(map[string]interface{})["limit"].([]interface{})
+	}
+
 	out := make([]interface{}, 1)
-	limits := make([]interface{}, len(in.Limits), len(in.Limits))
+	out[0] = map[string]interface{}{
+		"limit": flattenLimitRangeItems(in.Limits, priorLimits),
+	}
+	return out
+}
+
+// flattenLimitRangeItems keeps the items in the order the API returns them,
+// which is the order they were submitted in. For container limits the API
+// server defaults `default` to `max` and `default_request` to `default` or
+// `min`, one resource at a time. When the prior item sets some resources of
+// these maps but not others, the resources the server filled in are left out
+// so they don't show up as a diff. When the prior item does not set the map
+// at all every value is kept and the map is reported as computed.
+func flattenLimitRangeItems(in []api.LimitRangeItem, prior []interface{}) []interface{} {
+	limits := make([]interface{}, len(in), len(in))
+
+	for i, l := range in {
+		var priorItem map[string]interface{}
+		if i < len(prior) && prior[i] != nil {
+			priorItem = prior[i].(map[string]interface{})
+		}
+
+		def := l.Default
+		defRequest := l.DefaultRequest
+		if l.Type == api.LimitTypeContainer && priorItem != nil {
+			if p, ok := priorItem["default"].(map[string]interface{}); ok {
+				def = withoutLimitRangeServerDefaults(l.Default, p, l.Max)
+			}
+			if p, ok := priorItem["default_request"].(map[string]interface{}); ok {
+				defRequest = withoutLimitRangeServerDefaults(l.DefaultRequest, p, l.Default, l.Min)
+			}
+		}
 
-	for i, l := range in.Limits {
 		m := make(map[string]interface{}, 0)
-		m["default"] = flattenResourceList(l.Default)
-		m["default_request"] = flattenResourceList(l.DefaultRequest)
+		m["default"] = flattenResourceList(def)
+		m["default_request"] = flattenResourceList(defRequest)
 		m["max"] = flattenResourceList(l.Max)
 		m["max_limit_request_ratio"] = flattenResourceList(l.MaxLimitRequestRatio)
 		m["min"] = flattenResourceList(l.Min)
@@ -547,12 +637,36 @@ func flattenLimitRangeSpec(in api.LimitRangeSpec) []interface{} {
 
 		limits[i] = m
 	}
-	out[0] = map[string]interface{}{
-		"limit": limits,
+	return limits
+}
+
+// withoutLimitRangeServerDefaults removes from the list the resources that
+// are not in the prior map and whose value matches one of the lists the API
+// server defaults them from. The list is returned unchanged when the prior
+// map is empty.
+func withoutLimitRangeServerDefaults(l api.ResourceList, prior map[string]interface{}, sources ...api.ResourceList) api.ResourceList {
+	if len(prior) == 0 {
+		return l
+	}
+	out := make(api.ResourceList, len(l))
+	for k, v := range l {
+		if _, ok := prior[string(k)]; !ok && isLimitRangeServerDefault(k, v, sources) {
+			continue
+		}
+		out[k] = v
 	}
 	return out
 }
 
+func isLimitRangeServerDefault(k api.ResourceName, v resource.Quantity, sources []api.ResourceList) bool {
+	for _, source := range sources {
+		if s, ok := source[k]; ok && s.Cmp(v) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func schemaSetToStringArray(set *schema.Set) []string {
 	array := make([]string, 0, set.Len())
 	for _, elem := range set.List() {
@@ -719,3 +833,19 @@ func flattenPersistentVolumeMountOptions(in []string) *schema.Set {
 	}
 	return schema.NewSet(schema.HashString, out)
 }
+
+// toUnstructuredObject converts a typed object for use with the dynamic client.
+func toUnstructuredObject(obj interface{}) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	// The status is owned by the API server or a controller and cannot be
+	// set by clients.
+	delete(content, "status")
+	return &unstructured.Unstructured{Object: content}, nil
+}
+
+func fromUnstructuredObject(u *unstructured.Unstructured, obj interface{}) error {
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), obj)
+}
@@ -1,7 +1,12 @@
 package kubernetes
 
 import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 func flattenServiceReference(in admissionregistrationv1.ServiceReference) []interface{} {
@@ -136,3 +141,67 @@ func expandRuleWithOperations(in map[string]interface{}) admissionregistrationv1
 
 	return obj
 }
+
+// flattenWebhookLabelSelector flattens a webhook selector, leaving out the
+// empty selector the API server defaults to when none is configured.
+func flattenWebhookLabelSelector(in *metav1.LabelSelector) []interface{} {
+	if in == nil || (len(in.MatchLabels) == 0 && len(in.MatchExpressions) == 0) {
+		return nil
+	}
+	return flattenLabelSelector(in)
+}
+
+func flattenMatchConditions(in []admissionregistrationv1.MatchCondition) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, c := range in {
+		att[i] = map[string]interface{}{
+			"name":       c.Name,
+			"expression": c.Expression,
+		}
+	}
+	return att
+}
+
+func expandMatchConditions(in []interface{}) []admissionregistrationv1.MatchCondition {
+	if len(in) == 0 {
+		return nil
+	}
+	obj := make([]admissionregistrationv1.MatchCondition, 0, len(in))
+	for _, c := range in {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		obj = append(obj, admissionregistrationv1.MatchCondition{
+			Name:       m["name"].(string),
+			Expression: m["expression"].(string),
+		})
+	}
+	return obj
+}
+
+// preserveWebhookMatchConditions keeps the configured match conditions of
+// the flattened webhooks when the API server dropped them, which clusters
+// without the AdmissionWebhookMatchConditions feature (before 1.27) do
+// silently. Fighting the server would only produce a perpetual diff, so a
+// warning is returned instead.
+func preserveWebhookMatchConditions(d *schema.ResourceData, webhooks []interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, w := range webhooks {
+		webhook := w.(map[string]interface{})
+		if len(webhook["match_condition"].([]interface{})) != 0 {
+			continue
+		}
+		configured, ok := d.Get(fmt.Sprintf("webhook.%d.match_condition", i)).([]interface{})
+		if !ok || len(configured) == 0 {
+			continue
+		}
+		webhook["match_condition"] = configured
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Match conditions of webhook %q were dropped by the API server", webhook["name"]),
+			Detail:   "The cluster does not support webhook match conditions, they require Kubernetes 1.27 or newer with the AdmissionWebhookMatchConditions feature gate enabled. The conditions are kept in the Terraform state but are not enforced.",
+		})
+	}
+	return diags
+}
@@ -0,0 +1,89 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandThenFlattenMatchConditions(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"name":       "z-exclude-leases",
+			"expression": "request.resource.resource != 'leases'",
+		},
+		map[string]interface{}{
+			"name":       "a-exclude-kubelet",
+			"expression": "!('system:nodes' in request.userInfo.groups)",
+		},
+	}
+
+	// The order of the conditions must be preserved.
+	if diff := cmp.Diff(in, flattenMatchConditions(expandMatchConditions(in))); diff != "" {
+		t.Fatalf("Unexpected match conditions round trip: mismatch (-want +got):\n%s", diff)
+	}
+	if out := expandMatchConditions([]interface{}{}); out != nil {
+		t.Fatalf("Expected no match conditions, got %#v", out)
+	}
+}
+
+func TestFlattenWebhookLabelSelector(t *testing.T) {
+	cases := []struct {
+		in       *metav1.LabelSelector
+		expected []interface{}
+	}{
+		{nil, nil},
+		{&metav1.LabelSelector{}, nil},
+		{&metav1.LabelSelector{MatchLabels: map[string]string{}}, nil},
+		{
+			&metav1.LabelSelector{MatchLabels: map[string]string{"app": "example"}},
+			[]interface{}{map[string]interface{}{"match_labels": map[string]string{"app": "example"}}},
+		},
+	}
+	for _, tc := range cases {
+		if diff := cmp.Diff(tc.expected, flattenWebhookLabelSelector(tc.in)); diff != "" {
+			t.Errorf("Unexpected selector for %#v: mismatch (-want +got):\n%s", tc.in, diff)
+		}
+	}
+}
+
+func TestPreserveWebhookMatchConditions(t *testing.T) {
+	conditions := []interface{}{map[string]interface{}{
+		"name":       "exclude-leases",
+		"expression": "request.resource.resource != 'leases'",
+	}}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesValidatingWebhookConfigurationV1().Schema, map[string]interface{}{
+		"webhook": []interface{}{
+			map[string]interface{}{"name": "with-conditions", "match_condition": conditions},
+			map[string]interface{}{"name": "without-conditions"},
+		},
+	})
+
+	// Server that does not support match conditions.
+	webhooks := flattenValidatingWebhooks([]admissionregistrationv1.ValidatingWebhook{
+		{Name: "with-conditions"},
+		{Name: "without-conditions"},
+	})
+	diags := preserveWebhookMatchConditions(d, webhooks)
+	if len(diags) != 1 || diags.HasError() {
+		t.Fatalf("Expected a single warning, got %#v", diags)
+	}
+	if diff := cmp.Diff(conditions, webhooks[0].(map[string]interface{})["match_condition"]); diff != "" {
+		t.Fatalf("Expected the configured match conditions to be kept: mismatch (-want +got):\n%s", diff)
+	}
+	if v := webhooks[1].(map[string]interface{})["match_condition"].([]interface{}); len(v) != 0 {
+		t.Fatalf("Expected no match conditions, got %#v", v)
+	}
+
+	// Server that supports match conditions.
+	webhooks = flattenValidatingWebhooks([]admissionregistrationv1.ValidatingWebhook{
+		{Name: "with-conditions", MatchConditions: expandMatchConditions(conditions)},
+		{Name: "without-conditions"},
+	})
+	if diags := preserveWebhookMatchConditions(d, webhooks); len(diags) != 0 {
+		t.Fatalf("Expected no diagnostics, got %#v", diags)
+	}
+}
@@ -19,6 +19,9 @@ func expandCertificateSigningRequestV1Spec(csr []interface{}) (*certificates.Cer
 	if v, ok := in["signer_name"].(string); ok && v != "" {
 		obj.SignerName = v
 	}
+	if v, ok := in["expiration_seconds"].(int); ok && v > 0 {
+		obj.ExpirationSeconds = ptrToInt32(int32(v))
+	}
 	return obj, nil
 }
 
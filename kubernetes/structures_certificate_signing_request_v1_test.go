@@ -0,0 +1,80 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	certificates "k8s.io/api/certificates/v1"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestExpandCertificateSigningRequestV1Spec(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"request":            "request",
+			"signer_name":        "kubernetes.io/kube-apiserver-client",
+			"usages":             schema.NewSet(schema.HashString, []interface{}{"client auth"}),
+			"expiration_seconds": 3600,
+		},
+	}
+	expected := &certificates.CertificateSigningRequestSpec{
+		Request:           []byte("request"),
+		SignerName:        "kubernetes.io/kube-apiserver-client",
+		Usages:            []certificates.KeyUsage{certificates.UsageClientAuth},
+		ExpirationSeconds: ptrToInt32(3600),
+	}
+	out, err := expandCertificateSigningRequestV1Spec(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Errorf("Unexpected output from expander: %s", diff)
+	}
+}
+
+func TestCertificateSigningRequestV1FailedCondition(t *testing.T) {
+	cases := map[string]struct {
+		Conditions []certificates.CertificateSigningRequestCondition
+		Expected   *certificates.CertificateSigningRequestCondition
+	}{
+		"pending": {
+			Conditions: nil,
+			Expected:   nil,
+		},
+		"approved": {
+			Conditions: []certificates.CertificateSigningRequestCondition{
+				{Type: certificates.CertificateApproved, Status: v1.ConditionTrue},
+			},
+			Expected: nil,
+		},
+		"denied": {
+			Conditions: []certificates.CertificateSigningRequestCondition{
+				{Type: certificates.CertificateDenied, Status: v1.ConditionTrue, Reason: "Denied", Message: "not allowed"},
+			},
+			Expected: &certificates.CertificateSigningRequestCondition{Type: certificates.CertificateDenied, Status: v1.ConditionTrue, Reason: "Denied", Message: "not allowed"},
+		},
+		"failed after approval": {
+			Conditions: []certificates.CertificateSigningRequestCondition{
+				{Type: certificates.CertificateApproved, Status: v1.ConditionTrue},
+				{Type: certificates.CertificateFailed, Status: v1.ConditionTrue, Reason: "SignerValidationFailure", Message: "invalid usage"},
+			},
+			Expected: &certificates.CertificateSigningRequestCondition{Type: certificates.CertificateFailed, Status: v1.ConditionTrue, Reason: "SignerValidationFailure", Message: "invalid usage"},
+		},
+		"failed condition not true": {
+			Conditions: []certificates.CertificateSigningRequestCondition{
+				{Type: certificates.CertificateFailed, Status: v1.ConditionFalse},
+			},
+			Expected: nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := certificateSigningRequestV1FailedCondition(certificates.CertificateSigningRequestStatus{Conditions: tc.Conditions})
+			if diff := cmp.Diff(tc.Expected, out); diff != "" {
+				t.Errorf("Unexpected condition: %s", diff)
+			}
+		})
+	}
+}
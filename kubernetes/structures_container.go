@@ -1,10 +1,15 @@
 package kubernetes
 
 import (
+	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"regexp"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -65,7 +70,7 @@ func flattenSecurityCapabilities(in *v1.Capabilities) []interface{} {
 	return []interface{}{att}
 }
 
-func flattenHandler(in *v1.Handler) []interface{} {
+func flattenHandler(in *v1.LifecycleHandler) []interface{} {
 	att := make(map[string]interface{})
 
 	if in.Exec != nil {
@@ -161,10 +166,22 @@ func flattenProbe(in *v1.Probe) []interface{} {
 	if in.TCPSocket != nil {
 		att["tcp_socket"] = flattenTCPSocket(in.TCPSocket)
 	}
+	if in.GRPC != nil {
+		att["grpc"] = flattenGRPC(in.GRPC)
+	}
 
 	return []interface{}{att}
 }
 
+func flattenGRPC(in *v1.GRPCAction) []interface{} {
+	att := make(map[string]interface{})
+	att["port"] = int(in.Port)
+	if in.Service != nil {
+		att["service"] = *in.Service
+	}
+	return []interface{}{att}
+}
+
 func flattenConfigMapRef(in *v1.ConfigMapEnvSource) []interface{} {
 	att := make(map[string]interface{})
 
@@ -195,6 +212,9 @@ func flattenConfigMapKeyRef(in *v1.ConfigMapKeySelector) []interface{} {
 func flattenObjectFieldSelector(in *v1.ObjectFieldSelector) []interface{} {
 	att := make(map[string]interface{})
 
+	// The API server defaults the version, it is only missing from objects
+	// which were not read back from the API.
+	att["api_version"] = "v1"
 	if in.APIVersion != "" {
 		att["api_version"] = in.APIVersion
 	}
@@ -356,9 +376,29 @@ func flattenContainerResourceRequirements(in v1.ResourceRequirements) ([]interfa
 	if len(in.Requests) > 0 {
 		att["requests"] = flattenResourceList(in.Requests)
 	}
+	if len(in.Claims) > 0 {
+		claims := make([]interface{}, len(in.Claims))
+		for i, c := range in.Claims {
+			claims[i] = map[string]interface{}{
+				"name": c.Name,
+			}
+		}
+		att["claims"] = claims
+	}
 	return []interface{}{att}, nil
 }
 
+func flattenContainerResizePolicy(in []v1.ContainerResizePolicy) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"resource_name":  string(v.ResourceName),
+			"restart_policy": string(v.RestartPolicy),
+		}
+	}
+	return att
+}
+
 func flattenContainers(in []v1.Container, serviceAccountRegex string) ([]interface{}, error) {
 	att := make([]interface{}, len(in))
 	for i, v := range in {
@@ -373,6 +413,9 @@ func flattenContainers(in []v1.Container, serviceAccountRegex string) ([]interfa
 		}
 
 		c["image_pull_policy"] = v.ImagePullPolicy
+		if v.RestartPolicy != nil {
+			c["restart_policy"] = string(*v.RestartPolicy)
+		}
 		c["termination_message_path"] = v.TerminationMessagePath
 		c["termination_message_policy"] = v.TerminationMessagePolicy
 		c["stdin"] = v.Stdin
@@ -385,6 +428,9 @@ func flattenContainers(in []v1.Container, serviceAccountRegex string) ([]interfa
 		}
 
 		c["resources"] = res
+		if len(v.ResizePolicy) > 0 {
+			c["resize_policy"] = flattenContainerResizePolicy(v.ResizePolicy)
+		}
 		if v.LivenessProbe != nil {
 			c["liveness_probe"] = flattenProbe(v.LivenessProbe)
 		}
@@ -477,6 +523,15 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 			cs[i].Resources = *crr
 		}
 
+		if v, ok := ctr["restart_policy"].(string); ok && v != "" {
+			policy := v1.ContainerRestartPolicy(v)
+			cs[i].RestartPolicy = &policy
+		}
+
+		if v, ok := ctr["resize_policy"].([]interface{}); ok && len(v) > 0 {
+			cs[i].ResizePolicy = expandContainerResizePolicy(v)
+		}
+
 		if v, ok := ctr["port"].([]interface{}); ok && len(v) > 0 {
 			cp, err := expandContainerPort(v)
 			if err != nil {
@@ -510,14 +565,26 @@ func expandContainers(ctrs []interface{}) ([]v1.Container, error) {
 		}
 
 		if v, ok := ctr["liveness_probe"].([]interface{}); ok && len(v) > 0 {
-			cs[i].LivenessProbe = expandProbe(v)
+			p, err := expandProbe(v)
+			if err != nil {
+				return cs, fmt.Errorf("liveness_probe: %s", err)
+			}
+			cs[i].LivenessProbe = p
 		}
 
 		if v, ok := ctr["readiness_probe"].([]interface{}); ok && len(v) > 0 {
-			cs[i].ReadinessProbe = expandProbe(v)
+			p, err := expandProbe(v)
+			if err != nil {
+				return cs, fmt.Errorf("readiness_probe: %s", err)
+			}
+			cs[i].ReadinessProbe = p
 		}
 		if v, ok := ctr["startup_probe"].([]interface{}); ok && len(v) > 0 {
-			cs[i].StartupProbe = expandProbe(v)
+			p, err := expandProbe(v)
+			if err != nil {
+				return cs, fmt.Errorf("startup_probe: %s", err)
+			}
+			cs[i].StartupProbe = p
 		}
 		if v, ok := ctr["stdin"]; ok {
 			cs[i].Stdin = v.(bool)
@@ -621,7 +688,11 @@ func expandContainerSecurityContext(l []interface{}) (*v1.SecurityContext, error
 		obj.RunAsUser = ptrToInt64(int64(i))
 	}
 	if v, ok := in["seccomp_profile"].([]interface{}); ok && len(v) > 0 {
-		obj.SeccompProfile = expandSeccompProfile(v)
+		sp, err := expandSeccompProfile(v)
+		if err != nil {
+			return &obj, err
+		}
+		obj.SeccompProfile = sp
 	}
 	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
 		obj.SELinuxOptions = expandSeLinuxOptions(v)
@@ -691,20 +762,31 @@ func expandHTTPGet(l []interface{}) *v1.HTTPGetAction {
 	return &obj
 }
 
-func expandProbe(l []interface{}) *v1.Probe {
+func expandProbe(l []interface{}) (*v1.Probe, error) {
 	if len(l) == 0 || l[0] == nil {
-		return &v1.Probe{}
+		return &v1.Probe{}, nil
 	}
 	in := l[0].(map[string]interface{})
 	obj := v1.Probe{}
+	handlers := 0
 	if v, ok := in["exec"].([]interface{}); ok && len(v) > 0 {
 		obj.Exec = expandExec(v)
+		handlers++
 	}
 	if v, ok := in["http_get"].([]interface{}); ok && len(v) > 0 {
 		obj.HTTPGet = expandHTTPGet(v)
+		handlers++
 	}
 	if v, ok := in["tcp_socket"].([]interface{}); ok && len(v) > 0 {
 		obj.TCPSocket = expandTCPSocket(v)
+		handlers++
+	}
+	if v, ok := in["grpc"].([]interface{}); ok && len(v) > 0 {
+		obj.GRPC = expandGRPC(v)
+		handlers++
+	}
+	if handlers != 1 {
+		return nil, fmt.Errorf("exactly one of exec, grpc, http_get or tcp_socket must be set, got %d", handlers)
 	}
 	if v, ok := in["failure_threshold"].(int); ok {
 		obj.FailureThreshold = int32(v)
@@ -722,15 +804,30 @@ func expandProbe(l []interface{}) *v1.Probe {
 		obj.TimeoutSeconds = int32(v)
 	}
 
+	return &obj, nil
+}
+
+func expandGRPC(l []interface{}) *v1.GRPCAction {
+	if len(l) == 0 || l[0] == nil {
+		return &v1.GRPCAction{}
+	}
+	in := l[0].(map[string]interface{})
+	obj := v1.GRPCAction{}
+	if v, ok := in["port"].(int); ok {
+		obj.Port = int32(v)
+	}
+	if v, ok := in["service"].(string); ok && v != "" {
+		obj.Service = ptrToString(v)
+	}
 	return &obj
 }
 
-func expandHandlers(l []interface{}) *v1.Handler {
+func expandHandlers(l []interface{}) *v1.LifecycleHandler {
 	if len(l) == 0 || l[0] == nil {
-		return &v1.Handler{}
+		return &v1.LifecycleHandler{}
 	}
 	in := l[0].(map[string]interface{})
-	obj := v1.Handler{}
+	obj := v1.LifecycleHandler{}
 	if v, ok := in["exec"].([]interface{}); ok && len(v) > 0 {
 		obj.Exec = expandExec(v)
 	}
@@ -815,6 +912,76 @@ func expandContainerEnv(in []interface{}) ([]v1.EnvVar, error) {
 	return envs, nil
 }
 
+// orderContainerEnvAsConfigured sorts the environment variables of the
+// containers of the pod spec at key, a dotted list of attributes and indexes,
+// in the order of the configuration. When only their order changed, the diff
+// is suppressed by suppressReorderedContainerEnv and d.Get returns the order
+// of the server, which would otherwise be sent back with other changes.
+func orderContainerEnvAsConfigured(d *schema.ResourceData, key string, spec *v1.PodSpec) {
+	v := d.GetRawConfig()
+	for _, p := range strings.Split(key, ".") {
+		if v.IsNull() || !v.IsKnown() {
+			return
+		}
+		if i, err := strconv.Atoi(p); err == nil {
+			if !v.Type().IsListType() || v.LengthInt() <= i {
+				return
+			}
+			v = v.Index(cty.NumberIntVal(int64(i)))
+			continue
+		}
+		if !v.Type().IsObjectType() || !v.Type().HasAttribute(p) {
+			return
+		}
+		v = v.GetAttr(p)
+	}
+	orderEnvAsConfigured(v, "container", spec.Containers)
+	orderEnvAsConfigured(v, "init_container", spec.InitContainers)
+}
+
+func orderEnvAsConfigured(spec cty.Value, attr string, containers []v1.Container) {
+	if spec.IsNull() || !spec.IsKnown() || !spec.Type().IsObjectType() || !spec.Type().HasAttribute(attr) {
+		return
+	}
+	configured := spec.GetAttr(attr)
+	if configured.IsNull() || !configured.IsKnown() || !configured.CanIterateElements() {
+		return
+	}
+	for it := configured.ElementIterator(); it.Next(); {
+		_, c := it.Element()
+		name, env := c.GetAttr("name"), c.GetAttr("env")
+		if name.IsNull() || !name.IsKnown() || env.IsNull() || !env.IsKnown() {
+			continue
+		}
+		order := map[string]int{}
+		for eit := env.ElementIterator(); eit.Next(); {
+			i, e := eit.Element()
+			if n := e.GetAttr("name"); !n.IsNull() && n.IsKnown() {
+				if _, ok := order[n.AsString()]; !ok {
+					idx, _ := i.AsBigFloat().Int64()
+					order[n.AsString()] = int(idx)
+				}
+			}
+		}
+		for j := range containers {
+			if containers[j].Name != name.AsString() {
+				continue
+			}
+			sort.SliceStable(containers[j].Env, func(a, b int) bool {
+				oa, ok := order[containers[j].Env[a].Name]
+				if !ok {
+					oa = len(order)
+				}
+				ob, ok := order[containers[j].Env[b].Name]
+				if !ok {
+					ob = len(order)
+				}
+				return oa < ob
+			})
+		}
+	}
+}
+
 func expandContainerEnvFrom(in []interface{}) ([]v1.EnvFromSource, error) {
 	if len(in) == 0 {
 		return []v1.EnvFromSource{}, nil
@@ -1016,6 +1183,21 @@ func expandConfigMapRef(r []interface{}) (*v1.ConfigMapEnvSource, error) {
 	return obj, nil
 }
 
+func expandContainerResizePolicy(l []interface{}) []v1.ContainerResizePolicy {
+	obj := make([]v1.ContainerResizePolicy, 0, len(l))
+	for _, p := range l {
+		in, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		obj = append(obj, v1.ContainerResizePolicy{
+			ResourceName:  v1.ResourceName(in["resource_name"].(string)),
+			RestartPolicy: v1.ResourceResizeRestartPolicy(in["restart_policy"].(string)),
+		})
+	}
+	return obj
+}
+
 func expandContainerResourceRequirements(l []interface{}) (*v1.ResourceRequirements, error) {
 	obj := &v1.ResourceRequirements{}
 	if len(l) == 0 || l[0] == nil {
@@ -1039,5 +1221,13 @@ func expandContainerResourceRequirements(l []interface{}) (*v1.ResourceRequireme
 		obj.Requests = *r
 	}
 
+	if v, ok := in["claims"].([]interface{}); ok && len(v) > 0 {
+		for _, c := range v {
+			if claim, ok := c.(map[string]interface{}); ok {
+				obj.Claims = append(obj.Claims, v1.ResourceClaim{Name: claim["name"].(string)})
+			}
+		}
+	}
+
 	return obj, nil
 }
@@ -4,6 +4,9 @@ import (
 	"reflect"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	v1 "k8s.io/api/core/v1"
 )
 
@@ -57,6 +60,45 @@ func TestFlattenSecretKeyRef(t *testing.T) {
 	}
 }
 
+func TestFlattenObjectFieldSelector(t *testing.T) {
+	cases := []struct {
+		Input          *v1.ObjectFieldSelector
+		ExpectedOutput []interface{}
+	}{
+		{
+			&v1.ObjectFieldSelector{
+				APIVersion: "v1",
+				FieldPath:  "metadata.name",
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"api_version": "v1",
+					"field_path":  "metadata.name",
+				},
+			},
+		},
+		{
+			&v1.ObjectFieldSelector{
+				FieldPath: "status.podIP",
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"api_version": "v1",
+					"field_path":  "status.podIP",
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		output := flattenObjectFieldSelector(tc.Input)
+		if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, output)
+		}
+	}
+}
+
 func TestExpandSecretKeyRef(t *testing.T) {
 	cases := []struct {
 		Input          []interface{}
@@ -257,3 +299,172 @@ func TestExpandContainerEnv(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandProbe(t *testing.T) {
+	cases := map[string]struct {
+		Input          []interface{}
+		ExpectedOutput *v1.Probe
+		ExpectError    bool
+	}{
+		"grpc": {
+			Input: []interface{}{map[string]interface{}{
+				"grpc": []interface{}{map[string]interface{}{
+					"port":    2379,
+					"service": "etcd",
+				}},
+				"period_seconds": 10,
+			}},
+			ExpectedOutput: &v1.Probe{
+				ProbeHandler: v1.ProbeHandler{
+					GRPC: &v1.GRPCAction{
+						Port:    2379,
+						Service: ptrToString("etcd"),
+					},
+				},
+				PeriodSeconds: 10,
+			},
+		},
+		"no handler": {
+			Input: []interface{}{map[string]interface{}{
+				"period_seconds": 10,
+			}},
+			ExpectError: true,
+		},
+		"several handlers": {
+			Input: []interface{}{map[string]interface{}{
+				"grpc": []interface{}{map[string]interface{}{
+					"port": 2379,
+				}},
+				"tcp_socket": []interface{}{map[string]interface{}{
+					"port": "2379",
+				}},
+			}},
+			ExpectError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			output, err := expandProbe(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+				t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc.ExpectedOutput, output)
+			}
+			flattened := flattenProbe(output)
+			if !reflect.DeepEqual(flattened[0].(map[string]interface{})["grpc"], tc.Input[0].(map[string]interface{})["grpc"]) {
+				t.Fatalf("Unexpected output from flattener.\nGiven: %#v", flattened)
+			}
+		})
+	}
+}
+
+func TestExpandThenFlatten_resize_policy(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"resource_name":  "cpu",
+			"restart_policy": "NotRequired",
+		},
+		map[string]interface{}{
+			"resource_name":  "memory",
+			"restart_policy": "RestartContainer",
+		},
+	}
+	expected := []v1.ContainerResizePolicy{
+		{ResourceName: v1.ResourceCPU, RestartPolicy: v1.NotRequired},
+		{ResourceName: v1.ResourceMemory, RestartPolicy: v1.RestartContainer},
+	}
+
+	expanded := expandContainerResizePolicy(in)
+	if !reflect.DeepEqual(expanded, expected) {
+		t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", expected, expanded)
+	}
+	flattened := flattenContainerResizePolicy(expanded)
+	if !reflect.DeepEqual(flattened, in) {
+		t.Fatalf("Unexpected output from flattener.\nExpected: %#v\nGiven:    %#v", in, flattened)
+	}
+}
+
+func TestExpandThenFlatten_init_container_restart_policy(t *testing.T) {
+	in := []interface{}{
+		map[string]interface{}{
+			"name":           "sidecar",
+			"image":          "busybox",
+			"restart_policy": "Always",
+		},
+	}
+	ctrs, err := expandContainers(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := ctrs[0].RestartPolicy; p == nil || *p != v1.ContainerRestartPolicyAlways {
+		t.Fatalf("Expected restart policy Always, got %#v", p)
+	}
+	flattened, err := flattenContainers(ctrs, "^$")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := flattened[0].(map[string]interface{})["restart_policy"]; p != "Always" {
+		t.Fatalf("Expected flattened restart policy Always, got %#v", p)
+	}
+}
+
+func TestOrderContainerEnvAsConfigured(t *testing.T) {
+	r := &schema.Resource{Schema: map[string]*schema.Schema{
+		"spec": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     &schema.Resource{Schema: podSpecFields(true, false)},
+		},
+	}}
+	config, err := ctyjson.Unmarshal([]byte(`{"spec": [{"container": [{
+		"name": "app",
+		"image": "app:2",
+		"env": [
+			{"name": "HOST", "value": "db"},
+			{"name": "URL", "value": "postgres://$(HOST)"}
+		]
+	}]}]}`), r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The diff of the order was suppressed, so state holds the order of
+	// the server.
+	d := r.Data(&terraform.InstanceState{
+		ID: "test",
+		Attributes: map[string]string{
+			"spec.#":                         "1",
+			"spec.0.container.#":             "1",
+			"spec.0.container.0.name":        "app",
+			"spec.0.container.0.image":       "app:2",
+			"spec.0.container.0.env.#":       "2",
+			"spec.0.container.0.env.0.name":  "URL",
+			"spec.0.container.0.env.0.value": "postgres://$(HOST)",
+			"spec.0.container.0.env.1.name":  "HOST",
+			"spec.0.container.0.env.1.value": "db",
+		},
+		RawConfig: config,
+	})
+
+	spec, err := expandPodSpec(d.Get("spec").([]interface{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	orderContainerEnvAsConfigured(d, "spec.0", spec)
+
+	var names []string
+	for _, e := range spec.Containers[0].Env {
+		names = append(names, e.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"HOST", "URL"}) {
+		t.Errorf("Expected the environment variables in the order of the configuration, got %v", names)
+	}
+}
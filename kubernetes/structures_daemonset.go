@@ -6,7 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
 	att := make(map[string]interface{})
 	att["min_ready_seconds"] = in.MinReadySeconds
 
@@ -26,7 +26,7 @@ func flattenDaemonSetSpec(in appsv1.DaemonSetSpec, d *schema.ResourceData) ([]in
 	}
 	template := make(map[string]interface{})
 	template["spec"] = podSpec
-	template["metadata"] = flattenMetadata(in.Template.ObjectMeta, d, "spec.0.template.0.")
+	template["metadata"] = flattenMetadata(in.Template.ObjectMeta, d, meta, "spec.0.template.0.")
 	att["template"] = []interface{}{template}
 
 	return []interface{}{att}, nil
@@ -48,6 +48,9 @@ func flattenDaemonSetStrategyRollingUpdate(in *appsv1.RollingUpdateDaemonSet) []
 	if in.MaxUnavailable != nil {
 		att["max_unavailable"] = in.MaxUnavailable.String()
 	}
+	if in.MaxSurge != nil {
+		att["max_surge"] = in.MaxSurge.String()
+	}
 	return []interface{}{att}
 }
 
@@ -109,5 +112,9 @@ func expandRollingUpdateDaemonSet(p []interface{}) *appsv1.RollingUpdateDaemonSe
 		val := intstr.Parse(v)
 		obj.MaxUnavailable = &val
 	}
+	if v, ok := in["max_surge"].(string); ok && v != "" {
+		val := intstr.Parse(v)
+		obj.MaxSurge = &val
+	}
 	return &obj
 }
@@ -9,7 +9,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
-func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]interface{}, error) {
+func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
 	att := make(map[string]interface{})
 	att["min_ready_seconds"] = in.MinReadySeconds
 
@@ -37,7 +37,7 @@ func flattenDeploymentSpec(in appsv1.DeploymentSpec, d *schema.ResourceData) ([]
 	}
 	template := make(map[string]interface{})
 	template["spec"] = podSpec
-	template["metadata"] = flattenMetadata(in.Template.ObjectMeta, d, "spec.0.template.0.")
+	template["metadata"] = flattenMetadata(in.Template.ObjectMeta, d, meta, "spec.0.template.0.")
 	att["template"] = []interface{}{template}
 
 	return []interface{}{att}, nil
@@ -76,7 +76,9 @@ func expandDeploymentSpec(deployment []interface{}) (*appsv1.DeploymentSpec, err
 
 	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
 	obj.Paused = in["paused"].(bool)
-	obj.ProgressDeadlineSeconds = ptrToInt32(int32(in["progress_deadline_seconds"].(int)))
+	if v, ok := in["progress_deadline_seconds"].(int); ok && v > 0 {
+		obj.ProgressDeadlineSeconds = ptrToInt32(int32(v))
+	}
 	if v, ok := in["replicas"].(string); ok && v != "" {
 		i, err := strconv.ParseInt(v, 10, 32)
 		if err != nil {
@@ -134,7 +136,9 @@ func expandDeploymentStrategy(l []interface{}) appsv1.DeploymentStrategy {
 	if v, ok := in["type"].(string); ok {
 		obj.Type = appsv1.DeploymentStrategyType(v)
 	}
-	if v, ok := in["rolling_update"].([]interface{}); ok && len(v) > 0 {
+	// The API rejects rolling update parameters for the Recreate strategy,
+	// they are left in state from before the strategy was switched.
+	if v, ok := in["rolling_update"].([]interface{}); ok && len(v) > 0 && obj.Type != appsv1.RecreateDeploymentStrategyType {
 		obj.RollingUpdate = expandRollingUpdateDeployment(v)
 	}
 	return obj
@@ -0,0 +1,111 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestExpandDeploymentStrategy(t *testing.T) {
+	percent := intstr.FromString("25%")
+	zero := intstr.FromInt(0)
+	rollingUpdate := []interface{}{
+		map[string]interface{}{
+			"max_surge":       "25%",
+			"max_unavailable": "0",
+		},
+	}
+	cases := []struct {
+		Name     string
+		Input    []interface{}
+		Expected appsv1.DeploymentStrategy
+	}{
+		{
+			Name:  "unset",
+			Input: []interface{}{},
+			Expected: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+			},
+		},
+		{
+			Name: "rolling update",
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":           "RollingUpdate",
+					"rolling_update": rollingUpdate,
+				},
+			},
+			Expected: appsv1.DeploymentStrategy{
+				Type: appsv1.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDeployment{
+					MaxSurge:       &percent,
+					MaxUnavailable: &zero,
+				},
+			},
+		},
+		{
+			Name: "recreate",
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":           "Recreate",
+					"rolling_update": rollingUpdate,
+				},
+			},
+			Expected: appsv1.DeploymentStrategy{
+				Type: appsv1.RecreateDeploymentStrategyType,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			got := expandDeploymentStrategy(tc.Input)
+			if diff := cmp.Diff(tc.Expected, got); diff != "" {
+				t.Fatalf("Unexpected strategy (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenDeploymentStrategyRollingUpdate(t *testing.T) {
+	percent := intstr.FromString("25%")
+	zero := intstr.FromInt(0)
+	got := flattenDeploymentStrategyRollingUpdate(&appsv1.RollingUpdateDeployment{
+		MaxSurge:       &percent,
+		MaxUnavailable: &zero,
+	})
+	expected := []interface{}{
+		map[string]interface{}{
+			"max_surge":       "25%",
+			"max_unavailable": "0",
+		},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Fatalf("Unexpected rolling update (-want +got):\n%s", diff)
+	}
+}
+
+func TestSuppressRollingUpdateForRecreate(t *testing.T) {
+	for strategy, expected := range map[string]bool{
+		"Recreate":      true,
+		"RollingUpdate": false,
+	} {
+		d := resourceKubernetesDeployment().TestResourceData()
+		err := d.Set("spec", []interface{}{
+			map[string]interface{}{
+				"strategy": []interface{}{
+					map[string]interface{}{"type": strategy},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := suppressRollingUpdateForRecreate("spec.0.strategy.0.rolling_update.0.max_surge", "25%", "1", d)
+		if got != expected {
+			t.Errorf("Expected rolling update diff to be suppressed for %s: %t, got %t", strategy, expected, got)
+		}
+	}
+}
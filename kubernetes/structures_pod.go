@@ -5,7 +5,9 @@ import (
 	"log"
 	"regexp"
 	"strconv"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -81,6 +83,8 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	att["host_ipc"] = in.HostIPC
 	att["host_network"] = in.HostNetwork
 	att["host_pid"] = in.HostPID
+	// HostUsers is left out by servers without user namespace support.
+	att["host_users"] = in.HostUsers == nil || *in.HostUsers
 
 	if in.Hostname != "" {
 		att["hostname"] = in.Hostname
@@ -93,6 +97,11 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if len(in.NodeSelector) > 0 {
 		att["node_selector"] = in.NodeSelector
 	}
+	if in.OS != nil {
+		att["os"] = []interface{}{map[string]interface{}{
+			"name": string(in.OS.Name),
+		}}
+	}
 	if in.PriorityClassName != "" {
 		att["priority_class_name"] = in.PriorityClassName
 	}
@@ -100,6 +109,12 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 		att["restart_policy"] = in.RestartPolicy
 	}
 
+	if len(in.ResourceClaims) > 0 {
+		att["resource_claims"] = flattenPodResourceClaims(in.ResourceClaims)
+	}
+
+	att["scheduling_gate"] = flattenPodSchedulingGates(in.SchedulingGates)
+
 	if in.SecurityContext != nil {
 		att["security_context"] = flattenPodSecurityContext(in.SecurityContext)
 	}
@@ -107,6 +122,7 @@ func flattenPodSpec(in v1.PodSpec) ([]interface{}, error) {
 	if in.ServiceAccountName != "" {
 		att["service_account_name"] = in.ServiceAccountName
 	}
+	att["set_hostname_as_fqdn"] = in.SetHostnameAsFQDN != nil && *in.SetHostnameAsFQDN
 	if in.ShareProcessNamespace != nil {
 		att["share_process_namespace"] = *in.ShareProcessNamespace
 	}
@@ -273,11 +289,26 @@ func flattenSysctls(sysctls []v1.Sysctl) []interface{} {
 	return att
 }
 
+// isBuiltInToleration reports whether the toleration looks like one added by
+// the DefaultTolerationSeconds admission plugin or the DaemonSet controller.
+// These only tolerate a taint of the node lifecycle with the Exists operator,
+// either forever or for the default 300 seconds, so tolerations of the same
+// taints with other settings, such as a toleration_seconds of 0, are kept.
+func isBuiltInToleration(t v1.Toleration) bool {
+	if _, ok := builtInTolerations[t.Key]; !ok {
+		return false
+	}
+	if t.Operator != v1.TolerationOpExists || t.Value != "" {
+		return false
+	}
+	return t.TolerationSeconds == nil || *t.TolerationSeconds == 300
+}
+
 func flattenTolerations(tolerations []v1.Toleration) []interface{} {
 	att := []interface{}{}
 	for _, v := range tolerations {
 		// The API Server may automatically add several Tolerations to pods, strip these to avoid TF diff.
-		if _, ok := builtInTolerations[v.Key]; ok {
+		if isBuiltInToleration(v) {
 			log.Printf("[INFO] ignoring toleration with key: %s", v.Key)
 			continue
 		}
@@ -312,7 +343,7 @@ func flattenTopologySpreadConstraints(tsc []v1.TopologySpreadConstraint) []inter
 			obj["topology_key"] = v.TopologyKey
 		}
 		if v.MaxSkew != 0 {
-			obj["max_skew"] = v.MaxSkew
+			obj["max_skew"] = int(v.MaxSkew)
 		}
 		if v.WhenUnsatisfiable != "" {
 			obj["when_unsatisfiable"] = string(v.WhenUnsatisfiable)
@@ -320,6 +351,15 @@ func flattenTopologySpreadConstraints(tsc []v1.TopologySpreadConstraint) []inter
 		if v.LabelSelector != nil {
 			obj["label_selector"] = flattenLabelSelector(v.LabelSelector)
 		}
+		if v.MinDomains != nil {
+			obj["min_domains"] = int(*v.MinDomains)
+		}
+		if v.NodeAffinityPolicy != nil {
+			obj["node_affinity_policy"] = string(*v.NodeAffinityPolicy)
+		}
+		if v.NodeTaintsPolicy != nil {
+			obj["node_taints_policy"] = string(*v.NodeTaintsPolicy)
+		}
 		att = append(att, obj)
 	}
 	return att
@@ -607,6 +647,9 @@ func flattenConfigMapProjection(in *v1.ConfigMapProjection) []interface{} {
 		}
 		att["items"] = items
 	}
+	if in.Optional != nil {
+		att["optional"] = *in.Optional
+	}
 	return []interface{}{att}
 }
 
@@ -624,7 +667,7 @@ func flattenServiceAccountTokenProjection(in *v1.ServiceAccountTokenProjection)
 		att["audience"] = in.Audience
 	}
 	if in.ExpirationSeconds != nil {
-		att["expiration_seconds"] = in.ExpirationSeconds
+		att["expiration_seconds"] = int(*in.ExpirationSeconds)
 	}
 	if in.Path != "" {
 		att["path"] = in.Path
@@ -642,6 +685,122 @@ func flattenReadinessGates(in []v1.PodReadinessGate) ([]interface{}, error) {
 	return att, nil
 }
 
+func flattenPodSchedulingGates(in []v1.PodSchedulingGate) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		att[i] = map[string]interface{}{
+			"name": v.Name,
+		}
+	}
+	return att
+}
+
+func flattenPodResourceClaims(in []v1.PodResourceClaim) []interface{} {
+	att := make([]interface{}, len(in))
+	for i, v := range in {
+		source := map[string]interface{}{}
+		if v.Source.ResourceClaimName != nil {
+			source["resource_claim_name"] = *v.Source.ResourceClaimName
+		}
+		if v.Source.ResourceClaimTemplateName != nil {
+			source["resource_claim_template_name"] = *v.Source.ResourceClaimTemplateName
+		}
+		att[i] = map[string]interface{}{
+			"name":   v.Name,
+			"source": []interface{}{source},
+		}
+	}
+	return att
+}
+
+// keepDroppedResourceClaims copies the resource claims of the pod spec at
+// path in the flattened value of attr from state when the cluster returned
+// none, as it does when the DynamicResourceAllocation feature gate is
+// disabled. State then records that the claims were sent, so they do not show
+// up as a diff on every plan, and a warning is returned for each of them.
+func keepDroppedResourceClaims(d *schema.ResourceData, attr string, value []interface{}, path string) diag.Diagnostics {
+	spec, ok := flattenedPodSpecAt(value, path)
+	if !ok {
+		return nil
+	}
+	key := attr + "." + path
+
+	var dropped []string
+	if claims, ok := d.Get(key + ".resource_claims").([]interface{}); ok && len(claims) > 0 {
+		if v, ok := spec["resource_claims"].([]interface{}); !ok || len(v) == 0 {
+			spec["resource_claims"] = claims
+			dropped = append(dropped, key+".resource_claims")
+		}
+	}
+	for _, kind := range []string{"container", "init_container"} {
+		prior := map[string][]interface{}{}
+		containers, _ := d.Get(key + "." + kind).([]interface{})
+		for i, c := range containers {
+			ctr, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if claims, ok := d.Get(fmt.Sprintf("%s.%s.%d.resources.0.claims", key, kind, i)).([]interface{}); ok && len(claims) > 0 {
+				prior[ctr["name"].(string)] = claims
+			}
+		}
+		flattened, _ := spec[kind].([]interface{})
+		for i, c := range flattened {
+			ctr, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			claims, ok := prior[ctr["name"].(string)]
+			if !ok {
+				continue
+			}
+			res, _ := ctr["resources"].([]interface{})
+			if len(res) == 0 || res[0] == nil {
+				res = []interface{}{map[string]interface{}{}}
+				ctr["resources"] = res
+			}
+			r := res[0].(map[string]interface{})
+			if v, ok := r["claims"].([]interface{}); ok && len(v) > 0 {
+				continue
+			}
+			r["claims"] = claims
+			dropped = append(dropped, fmt.Sprintf("%s.%s.%d.resources.0.claims", key, kind, i))
+		}
+	}
+
+	var diags diag.Diagnostics
+	for _, k := range dropped {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Resource claims not set by the cluster",
+			Detail:   fmt.Sprintf("The cluster did not return the resource claims of %s at %s, they are kept as configured. Dynamic Resource Allocation may be disabled in the cluster.", d.Id(), k),
+		})
+	}
+	return diags
+}
+
+// flattenedPodSpecAt returns the pod spec at path, a dotted list of keys and
+// indexes, in a flattened value.
+func flattenedPodSpecAt(value []interface{}, path string) (map[string]interface{}, bool) {
+	var cur interface{} = value
+	for _, p := range strings.Split(path, ".") {
+		switch v := cur.(type) {
+		case []interface{}:
+			i, err := strconv.Atoi(p)
+			if err != nil || i >= len(v) {
+				return nil, false
+			}
+			cur = v[i]
+		case map[string]interface{}:
+			cur = v[p]
+		default:
+			return nil, false
+		}
+	}
+	spec, ok := cur.(map[string]interface{})
+	return spec, ok
+}
+
 // Expanders
 
 func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
@@ -707,8 +866,8 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.EnableServiceLinks = ptrToBool(v)
 	}
 
-	if v, ok := in["host_aliases"].([]interface{}); ok && len(v) > 0 {
-		hs, err := expandHostaliases(v)
+	if v, ok := in["host_aliases"].(*schema.Set); ok && v.Len() > 0 {
+		hs, err := expandHostaliases(v.List())
 		if err != nil {
 			return obj, err
 		}
@@ -727,6 +886,12 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.HostPID = v.(bool)
 	}
 
+	// Only a non-default value is sent, so the spec stays the same on servers
+	// which do not know the field.
+	if v, ok := in["host_users"].(bool); ok && !v {
+		obj.HostUsers = ptrToBool(v)
+	}
+
 	if v, ok := in["hostname"]; ok {
 		obj.Hostname = v.(string)
 	}
@@ -750,6 +915,12 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.NodeSelector = nodeSelectors
 	}
 
+	if v, ok := in["os"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		obj.OS = &v1.PodOS{
+			Name: v1.OSName(v[0].(map[string]interface{})["name"].(string)),
+		}
+	}
+
 	if v, ok := in["priority_class_name"].(string); ok {
 		obj.PriorityClassName = v
 	}
@@ -758,6 +929,14 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.RestartPolicy = v1.RestartPolicy(v)
 	}
 
+	if v, ok := in["resource_claims"].([]interface{}); ok && len(v) > 0 {
+		obj.ResourceClaims = expandPodResourceClaims(v)
+	}
+
+	if v, ok := in["scheduling_gate"].([]interface{}); ok && len(v) > 0 {
+		obj.SchedulingGates = expandPodSchedulingGates(v)
+	}
+
 	if v, ok := in["security_context"].([]interface{}); ok && len(v) > 0 {
 		ctx, err := expandPodSecurityContext(v)
 		if err != nil {
@@ -770,6 +949,10 @@ func expandPodSpec(p []interface{}) (*v1.PodSpec, error) {
 		obj.ServiceAccountName = v
 	}
 
+	if v, ok := in["set_hostname_as_fqdn"].(bool); ok && v {
+		obj.SetHostnameAsFQDN = ptrToBool(v)
+	}
+
 	if v, ok := in["share_process_namespace"]; ok {
 		obj.ShareProcessNamespace = ptrToBool(v.(bool))
 	}
@@ -846,7 +1029,9 @@ func expandDNSConfigOptions(options []interface{}) ([]v1.PodDNSConfigOption, err
 		if v, ok := in["name"].(string); ok {
 			opt.Name = v
 		}
-		if v, ok := in["value"].(string); ok {
+		// Options such as use-vc have no value, an empty value would be
+		// written to resolv.conf as "use-vc:".
+		if v, ok := in["value"].(string); ok && v != "" {
 			opt.Value = ptrToString(v)
 		}
 		opts[i] = opt
@@ -886,7 +1071,11 @@ func expandPodSecurityContext(l []interface{}) (*v1.PodSecurityContext, error) {
 		obj.RunAsUser = ptrToInt64(int64(i))
 	}
 	if v, ok := in["seccomp_profile"].([]interface{}); ok && len(v) > 0 {
-		obj.SeccompProfile = expandSeccompProfile(v)
+		sp, err := expandSeccompProfile(v)
+		if err != nil {
+			return obj, err
+		}
+		obj.SeccompProfile = sp
 	}
 	if v, ok := in["se_linux_options"].([]interface{}); ok && len(v) > 0 {
 		obj.SELinuxOptions = expandSeLinuxOptions(v)
@@ -919,21 +1108,25 @@ func expandSysctls(l []interface{}) []v1.Sysctl {
 	return sysctls
 }
 
-func expandSeccompProfile(l []interface{}) *v1.SeccompProfile {
+func expandSeccompProfile(l []interface{}) (*v1.SeccompProfile, error) {
 	if len(l) == 0 || l[0] == nil {
-		return &v1.SeccompProfile{}
+		return &v1.SeccompProfile{}, nil
 	}
 	in := l[0].(map[string]interface{})
 	obj := &v1.SeccompProfile{}
 	if v, ok := in["type"].(string); ok {
 		obj.Type = v1.SeccompProfileType(v)
-		if v == "Localhost" {
-			if lp, ok := in["localhost_profile"].(string); ok {
-				obj.LocalhostProfile = &lp
-			}
+	}
+	lp, _ := in["localhost_profile"].(string)
+	if obj.Type == v1.SeccompProfileTypeLocalhost {
+		if lp == "" {
+			return nil, fmt.Errorf("seccomp_profile: localhost_profile must be set when type is %q", v1.SeccompProfileTypeLocalhost)
 		}
+		obj.LocalhostProfile = &lp
+	} else if lp != "" {
+		return nil, fmt.Errorf("seccomp_profile: localhost_profile can only be set when type is %q", v1.SeccompProfileTypeLocalhost)
 	}
-	return obj
+	return obj, nil
 }
 
 func expandSeLinuxOptions(l []interface{}) *v1.SELinuxOptions {
@@ -1347,6 +1540,12 @@ func expandTolerations(tolerations []interface{}) ([]*v1.Toleration, error) {
 		if value, ok := m["value"]; ok {
 			ts[i].Value = value.(string)
 		}
+		if ts[i].Operator == v1.TolerationOpExists && ts[i].Value != "" {
+			return nil, fmt.Errorf("toleration value must be empty when operator is %q, got %q", v1.TolerationOpExists, ts[i].Value)
+		}
+		if ts[i].Key == "" && ts[i].Operator == v1.TolerationOpEqual {
+			return nil, fmt.Errorf("toleration key can only be empty when operator is %q, which tolerates all taints", v1.TolerationOpExists)
+		}
 	}
 	return ts, nil
 }
@@ -1376,6 +1575,19 @@ func expandTopologySpreadConstraints(tsc []interface{}) ([]*v1.TopologySpreadCon
 			ts[i].MaxSkew = int32(value)
 		}
 
+		if value, ok := m["min_domains"].(int); ok && value > 0 {
+			ts[i].MinDomains = ptrToInt32(int32(value))
+		}
+
+		if value, ok := m["node_affinity_policy"].(string); ok && value != "" {
+			policy := v1.NodeInclusionPolicy(value)
+			ts[i].NodeAffinityPolicy = &policy
+		}
+
+		if value, ok := m["node_taints_policy"].(string); ok && value != "" {
+			policy := v1.NodeInclusionPolicy(value)
+			ts[i].NodeTaintsPolicy = &policy
+		}
 	}
 	return ts, nil
 }
@@ -1509,6 +1721,38 @@ func expandReadinessGates(gates []interface{}) ([]v1.PodReadinessGate, error) {
 	return cs, nil
 }
 
+func expandPodSchedulingGates(gates []interface{}) []v1.PodSchedulingGate {
+	cs := make([]v1.PodSchedulingGate, 0, len(gates))
+	for _, c := range gates {
+		if gate, ok := c.(map[string]interface{}); ok {
+			cs = append(cs, v1.PodSchedulingGate{Name: gate["name"].(string)})
+		}
+	}
+	return cs
+}
+
+func expandPodResourceClaims(claims []interface{}) []v1.PodResourceClaim {
+	cs := make([]v1.PodResourceClaim, 0, len(claims))
+	for _, c := range claims {
+		claim, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rc := v1.PodResourceClaim{Name: claim["name"].(string)}
+		if s, ok := claim["source"].([]interface{}); ok && len(s) > 0 && s[0] != nil {
+			source := s[0].(map[string]interface{})
+			if v, ok := source["resource_claim_name"].(string); ok && v != "" {
+				rc.Source.ResourceClaimName = ptrToString(v)
+			}
+			if v, ok := source["resource_claim_template_name"].(string); ok && v != "" {
+				rc.Source.ResourceClaimTemplateName = ptrToString(v)
+			}
+		}
+		cs = append(cs, rc)
+	}
+	return cs
+}
+
 func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOperations, error) {
 	ops := make([]PatchOperation, 0)
 
@@ -1533,5 +1777,26 @@ func patchPodSpec(pathPrefix, prefix string, d *schema.ResourceData) (PatchOpera
 		}
 
 	}
+
+	if d.HasChange(prefix + "scheduling_gate") {
+		o, n := d.GetChange(prefix + "scheduling_gate")
+		gates := expandPodSchedulingGates(n.([]interface{}))
+		switch {
+		case len(o.([]interface{})) == 0:
+			ops = append(ops, &AddOperation{
+				Path:  pathPrefix + "/schedulingGates",
+				Value: gates,
+			})
+		case len(gates) == 0:
+			ops = append(ops, &RemoveOperation{
+				Path: pathPrefix + "/schedulingGates",
+			})
+		default:
+			ops = append(ops, &ReplaceOperation{
+				Path:  pathPrefix + "/schedulingGates",
+				Value: gates,
+			})
+		}
+	}
 	return ops, nil
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 )
@@ -64,6 +65,47 @@ func TestFlattenTolerations(t *testing.T) {
 				},
 			},
 		},
+		{
+			[]v1.Toleration{
+				{
+					Operator: "Exists",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"operator": "Exists",
+				},
+			},
+		},
+		{
+			[]v1.Toleration{
+				{
+					Key:               v1.TaintNodeNotReady,
+					Operator:          "Exists",
+					Effect:            "NoExecute",
+					TolerationSeconds: ptrToInt64(300),
+				},
+				{
+					Key:               v1.TaintNodeUnreachable,
+					Operator:          "Exists",
+					Effect:            "NoExecute",
+					TolerationSeconds: ptrToInt64(0),
+				},
+				{
+					Key:      v1.TaintNodeDiskPressure,
+					Operator: "Exists",
+					Effect:   "NoSchedule",
+				},
+			},
+			[]interface{}{
+				map[string]interface{}{
+					"key":                v1.TaintNodeUnreachable,
+					"operator":           "Exists",
+					"effect":             "NoExecute",
+					"toleration_seconds": "0",
+				},
+			},
+		},
 		{
 			[]v1.Toleration{},
 			[]interface{}{},
@@ -134,6 +176,24 @@ func TestExpandTolerations(t *testing.T) {
 				},
 			},
 		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"key":                "",
+					"operator":           "Exists",
+					"effect":             "NoExecute",
+					"toleration_seconds": "0",
+					"value":              "",
+				},
+			},
+			[]*v1.Toleration{
+				{
+					Operator:          "Exists",
+					Effect:            "NoExecute",
+					TolerationSeconds: ptrToInt64(0),
+				},
+			},
+		},
 		{
 			[]interface{}{},
 			[]*v1.Toleration{},
@@ -152,6 +212,28 @@ func TestExpandTolerations(t *testing.T) {
 	}
 }
 
+func TestExpandTolerations_invalid(t *testing.T) {
+	cases := map[string]map[string]interface{}{
+		"value with exists": {
+			"key":      "example.com/dedicated",
+			"operator": "Exists",
+			"value":    "true",
+		},
+		"empty key with equal": {
+			"key":      "",
+			"operator": "Equal",
+			"value":    "true",
+		},
+	}
+	for name, input := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := expandTolerations([]interface{}{input}); err == nil {
+				t.Fatal("Expected an error")
+			}
+		})
+	}
+}
+
 func TestFlattenSecretVolumeSource(t *testing.T) {
 	cases := []struct {
 		Input          *v1.SecretVolumeSource
@@ -522,12 +604,21 @@ func TestExpandThenFlatten_projected_volume(t *testing.T) {
 					{
 						ConfigMap: &v1.ConfigMapProjection{
 							LocalObjectReference: v1.LocalObjectReference{Name: "config-2"},
+							Optional:             ptrToBool(true),
 						},
 					},
 					{
 						DownwardAPI: &v1.DownwardAPIProjection{
 							Items: []v1.DownwardAPIVolumeFile{
 								{Path: "path-1"},
+								{
+									Path: "cpu-limit",
+									ResourceFieldRef: &v1.ResourceFieldSelector{
+										ContainerName: "main",
+										Resource:      "limits.cpu",
+										Divisor:       resource.MustParse("1m"),
+									},
+								},
 							},
 						},
 					},
@@ -536,6 +627,13 @@ func TestExpandThenFlatten_projected_volume(t *testing.T) {
 							Audience: "audience-1",
 						},
 					},
+					{
+						ServiceAccountToken: &v1.ServiceAccountTokenProjection{
+							Audience:          "vault",
+							ExpirationSeconds: ptrToInt64(7200),
+							Path:              "vault-token",
+						},
+					},
 				},
 			},
 		},
@@ -694,3 +792,353 @@ func TestFlattenCSIVolumeSource(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandThenFlatten_topology_spread_constraint(t *testing.T) {
+	honor := v1.NodeInclusionPolicyHonor
+	ignore := v1.NodeInclusionPolicyIgnore
+	cases := []struct {
+		Input          []interface{}
+		ExpectedOutput []*v1.TopologySpreadConstraint
+	}{
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"max_skew":           1,
+					"topology_key":       "topology.kubernetes.io/zone",
+					"when_unsatisfiable": "ScheduleAnyway",
+				},
+			},
+			[]*v1.TopologySpreadConstraint{
+				{
+					MaxSkew:           1,
+					TopologyKey:       "topology.kubernetes.io/zone",
+					WhenUnsatisfiable: v1.ScheduleAnyway,
+				},
+			},
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{
+					"max_skew":             2,
+					"topology_key":         "topology.kubernetes.io/zone",
+					"when_unsatisfiable":   "DoNotSchedule",
+					"min_domains":          3,
+					"node_affinity_policy": "Honor",
+					"node_taints_policy":   "Ignore",
+				},
+			},
+			[]*v1.TopologySpreadConstraint{
+				{
+					MaxSkew:            2,
+					TopologyKey:        "topology.kubernetes.io/zone",
+					WhenUnsatisfiable:  v1.DoNotSchedule,
+					MinDomains:         ptrToInt32(3),
+					NodeAffinityPolicy: &honor,
+					NodeTaintsPolicy:   &ignore,
+				},
+			},
+		},
+	}
+	for _, tc := range cases {
+		expanded, err := expandTopologySpreadConstraints(tc.Input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expanded, tc.ExpectedOutput) {
+			t.Fatalf("Unexpected output from topology spread constraint expander.\nExpected: %#v\nGiven:    %#v",
+				tc.ExpectedOutput, expanded)
+		}
+		tsc := make([]v1.TopologySpreadConstraint, len(expanded))
+		for i, c := range expanded {
+			tsc[i] = *c
+		}
+		flattened := flattenTopologySpreadConstraints(tsc)
+		if !reflect.DeepEqual(flattened, tc.Input) {
+			t.Fatalf("Unexpected output from topology spread constraint flattener.\nExpected: %#v\nGiven:    %#v",
+				tc.Input, flattened)
+		}
+	}
+}
+
+func TestExpandSeccompProfile(t *testing.T) {
+	cases := map[string]struct {
+		Input          []interface{}
+		ExpectedOutput *v1.SeccompProfile
+		ExpectError    bool
+	}{
+		"runtime default": {
+			Input: []interface{}{map[string]interface{}{
+				"type":              "RuntimeDefault",
+				"localhost_profile": "",
+			}},
+			ExpectedOutput: &v1.SeccompProfile{Type: v1.SeccompProfileTypeRuntimeDefault},
+		},
+		"localhost": {
+			Input: []interface{}{map[string]interface{}{
+				"type":              "Localhost",
+				"localhost_profile": "profiles/audit.json",
+			}},
+			ExpectedOutput: &v1.SeccompProfile{
+				Type:             v1.SeccompProfileTypeLocalhost,
+				LocalhostProfile: ptrToString("profiles/audit.json"),
+			},
+		},
+		"localhost without profile": {
+			Input: []interface{}{map[string]interface{}{
+				"type":              "Localhost",
+				"localhost_profile": "",
+			}},
+			ExpectError: true,
+		},
+		"profile without localhost": {
+			Input: []interface{}{map[string]interface{}{
+				"type":              "RuntimeDefault",
+				"localhost_profile": "profiles/audit.json",
+			}},
+			ExpectError: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			output, err := expandSeccompProfile(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("Expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(output, tc.ExpectedOutput) {
+				t.Fatalf("Unexpected output from expander.\nExpected: %#v\nGiven:    %#v", tc.ExpectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestExpandThenFlatten_podSpec_hostAndScheduling(t *testing.T) {
+	cases := map[string]struct {
+		Input          map[string]interface{}
+		ExpectedOutput v1.PodSpec
+	}{
+		"defaults": {
+			Input: map[string]interface{}{
+				"host_users":           true,
+				"set_hostname_as_fqdn": false,
+				"os":                   []interface{}{},
+				"scheduling_gate":      []interface{}{},
+			},
+			ExpectedOutput: v1.PodSpec{},
+		},
+		"all set": {
+			Input: map[string]interface{}{
+				"host_users":           false,
+				"set_hostname_as_fqdn": true,
+				"os": []interface{}{map[string]interface{}{
+					"name": "linux",
+				}},
+				"scheduling_gate": []interface{}{
+					map[string]interface{}{"name": "example.com/quota"},
+					map[string]interface{}{"name": "example.com/approval"},
+				},
+			},
+			ExpectedOutput: v1.PodSpec{
+				HostUsers:         ptrToBool(false),
+				SetHostnameAsFQDN: ptrToBool(true),
+				OS:                &v1.PodOS{Name: v1.Linux},
+				SchedulingGates: []v1.PodSchedulingGate{
+					{Name: "example.com/quota"},
+					{Name: "example.com/approval"},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			expanded, err := expandPodSpec([]interface{}{tc.Input})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.ExpectedOutput.HostUsers, expanded.HostUsers); diff != "" {
+				t.Fatalf("unexpected host users (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.ExpectedOutput.SetHostnameAsFQDN, expanded.SetHostnameAsFQDN); diff != "" {
+				t.Fatalf("unexpected set hostname as FQDN (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.ExpectedOutput.OS, expanded.OS); diff != "" {
+				t.Fatalf("unexpected OS (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.ExpectedOutput.SchedulingGates, expanded.SchedulingGates); diff != "" {
+				t.Fatalf("unexpected scheduling gates (-want +got):\n%s", diff)
+			}
+
+			flattened, err := flattenPodSpec(*expanded)
+			if err != nil {
+				t.Fatal(err)
+			}
+			att := flattened[0].(map[string]interface{})
+			for k, v := range tc.Input {
+				if k == "os" && len(v.([]interface{})) == 0 {
+					if _, ok := att[k]; ok {
+						t.Fatalf("expected %s to be unset, got %#v", k, att[k])
+					}
+					continue
+				}
+				if diff := cmp.Diff(v, att[k]); diff != "" {
+					t.Fatalf("unexpected %s (-want +got):\n%s", k, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestExpandThenFlatten_resource_claims(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"name": "gpu",
+			"source": []interface{}{map[string]interface{}{
+				"resource_claim_template_name": "gpu-template",
+			}},
+		},
+		map[string]interface{}{
+			"name": "shared",
+			"source": []interface{}{map[string]interface{}{
+				"resource_claim_name": "shared-gpu",
+			}},
+		},
+	}
+	expected := []v1.PodResourceClaim{
+		{Name: "gpu", Source: v1.ClaimSource{ResourceClaimTemplateName: ptrToString("gpu-template")}},
+		{Name: "shared", Source: v1.ClaimSource{ResourceClaimName: ptrToString("shared-gpu")}},
+	}
+
+	expanded := expandPodResourceClaims(input)
+	if diff := cmp.Diff(expected, expanded); diff != "" {
+		t.Fatalf("unexpected resource claims (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(input, flattenPodResourceClaims(expanded)); diff != "" {
+		t.Fatalf("unexpected flattened resource claims (-want +got):\n%s", diff)
+	}
+
+	resources := []interface{}{map[string]interface{}{
+		"claims": []interface{}{map[string]interface{}{"name": "gpu"}},
+	}}
+	requirements, err := expandContainerResourceRequirements(resources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]v1.ResourceClaim{{Name: "gpu"}}, requirements.Claims); diff != "" {
+		t.Fatalf("unexpected container claims (-want +got):\n%s", diff)
+	}
+	flattened, err := flattenContainerResourceRequirements(*requirements)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(resources, flattened); diff != "" {
+		t.Fatalf("unexpected flattened container resources (-want +got):\n%s", diff)
+	}
+}
+
+func TestKeepDroppedResourceClaims(t *testing.T) {
+	claims := []interface{}{map[string]interface{}{
+		"name": "gpu",
+		"source": []interface{}{map[string]interface{}{
+			"resource_claim_template_name": "gpu-template",
+		}},
+	}}
+	containerClaims := []interface{}{map[string]interface{}{"name": "gpu"}}
+	d := schema.TestResourceDataRaw(t, resourceKubernetesPod().Schema, map[string]interface{}{
+		"metadata": []interface{}{map[string]interface{}{"name": "test"}},
+		"spec": []interface{}{map[string]interface{}{
+			"resource_claims": claims,
+			"container": []interface{}{
+				map[string]interface{}{
+					"name":      "app",
+					"image":     "app",
+					"resources": []interface{}{map[string]interface{}{"claims": containerClaims}},
+				},
+				map[string]interface{}{
+					"name":  "sidecar",
+					"image": "sidecar",
+				},
+			},
+		}},
+	})
+	d.SetId("default/test")
+
+	// The cluster returned the pod without any resource claims.
+	flattened, err := flattenPodSpec(v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "sidecar", Image: "sidecar"},
+			{Name: "app", Image: "app"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	diags := keepDroppedResourceClaims(d, "spec", flattened, "0")
+	if len(diags) != 2 || diags.HasError() {
+		t.Fatalf("Expected two warnings, got %#v", diags)
+	}
+
+	spec := flattened[0].(map[string]interface{})
+	// State holds the zero value of the source left unset.
+	claims[0].(map[string]interface{})["source"].([]interface{})[0].(map[string]interface{})["resource_claim_name"] = ""
+	if diff := cmp.Diff(claims, spec["resource_claims"]); diff != "" {
+		t.Errorf("unexpected resource claims (-want +got):\n%s", diff)
+	}
+	containers := spec["container"].([]interface{})
+	app := containers[1].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})
+	if diff := cmp.Diff(containerClaims, app["claims"]); diff != "" {
+		t.Errorf("unexpected container claims (-want +got):\n%s", diff)
+	}
+	sidecar := containers[0].(map[string]interface{})["resources"].([]interface{})[0].(map[string]interface{})
+	if _, ok := sidecar["claims"]; ok {
+		t.Errorf("Expected no claims for the sidecar container, got %#v", sidecar["claims"])
+	}
+
+	// Claims returned by the cluster are left untouched.
+	flattened, err = flattenPodSpec(v1.PodSpec{
+		ResourceClaims: []v1.PodResourceClaim{{Name: "other", Source: v1.ClaimSource{ResourceClaimName: ptrToString("other")}}},
+		Containers:     []v1.Container{{Name: "app", Image: "app", Resources: v1.ResourceRequirements{Claims: []v1.ResourceClaim{{Name: "other"}}}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diags := keepDroppedResourceClaims(d, "spec", flattened, "0"); len(diags) != 0 {
+		t.Errorf("Expected no warnings, got %#v", diags)
+	}
+	if got := flattened[0].(map[string]interface{})["resource_claims"].([]interface{}); got[0].(map[string]interface{})["name"] != "other" {
+		t.Errorf("Expected the claims of the cluster to be kept, got %#v", got)
+	}
+}
+
+func TestExpandDNSConfigOptions(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{"name": "ndots", "value": "2"},
+		map[string]interface{}{"name": "use-vc", "value": ""},
+	}
+	expected := []v1.PodDNSConfigOption{
+		{Name: "ndots", Value: ptrToString("2")},
+		{Name: "use-vc"},
+	}
+	output, err := expandDNSConfigOptions(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(expected, output); diff != "" {
+		t.Fatalf("unexpected options (-want +got):\n%s", diff)
+	}
+	flattened, err := flattenPodDNSConfigOptions(output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]interface{}{
+		map[string]interface{}{"name": "ndots", "value": "2"},
+		map[string]interface{}{"name": "use-vc"},
+	}, flattened); diff != "" {
+		t.Fatalf("unexpected flattened options (-want +got):\n%s", diff)
+	}
+}
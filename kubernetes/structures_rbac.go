@@ -88,8 +88,10 @@ func expandClusterRoleAggregationRule(in []interface{}) *api.AggregationRule {
 	m := in[0].(map[string]interface{})
 
 	if v, ok := m["cluster_role_selectors"].([]interface{}); ok && len(v) > 0 {
-		crs := make([]metav1.LabelSelector, 0)
-		crs = append(crs, *expandLabelSelector(v))
+		crs := make([]metav1.LabelSelector, 0, len(v))
+		for _, selector := range v {
+			crs = append(crs, *expandLabelSelector([]interface{}{selector}))
+		}
 		ref.ClusterRoleSelectors = crs
 	}
 
@@ -142,9 +144,11 @@ func flattenClusterRoleAggregationRule(in *api.AggregationRule) []interface{} {
 	att := make(map[string]interface{})
 
 	if len(in.ClusterRoleSelectors) > 0 {
+		selectors := make([]interface{}, 0, len(in.ClusterRoleSelectors))
 		for _, crs := range in.ClusterRoleSelectors {
-			att["cluster_role_selectors"] = flattenLabelSelector(&crs)
+			selectors = append(selectors, flattenLabelSelector(&crs)...)
 		}
+		att["cluster_role_selectors"] = selectors
 	}
 
 	return []interface{}{att}
@@ -220,12 +224,22 @@ func patchRbacRule(d *schema.ResourceData) PatchOperations {
 }
 
 func patchRbacAggregationRule(d *schema.ResourceData) PatchOperations {
-	_, n := d.GetChange("aggregation_rule")
-	//oldrules := expandClusterRoleRules(o.([]interface{}))
-	newAggRule := expandClusterRoleAggregationRule(n.([]interface{}))
+	o, n := d.GetChange("aggregation_rule")
 	ops := make([]PatchOperation, 0)
 
-	ops = append(ops, &ReplaceOperation{
+	// An empty aggregation rule would still make the controller manage the
+	// rules, so the field is removed when the block is.
+	if len(n.([]interface{})) == 0 {
+		if len(o.([]interface{})) > 0 {
+			ops = append(ops, &RemoveOperation{
+				Path: "/aggregationRule",
+			})
+		}
+		return ops
+	}
+
+	newAggRule := expandClusterRoleAggregationRule(n.([]interface{}))
+	ops = append(ops, &AddOperation{
 		Path:  "/aggregationRule",
 		Value: newAggRule,
 	})
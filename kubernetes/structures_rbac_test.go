@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	api "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExpandClusterRoleAggregationRule(t *testing.T) {
+	in := []interface{}{map[string]interface{}{
+		"cluster_role_selectors": []interface{}{
+			map[string]interface{}{
+				"match_labels": map[string]interface{}{"rbac.example.com/aggregate-to-view": "true"},
+			},
+			map[string]interface{}{
+				"match_expressions": []interface{}{map[string]interface{}{
+					"key":      "rbac.example.com/aggregate-to-edit",
+					"operator": "Exists",
+					"values":   schema.NewSet(schema.HashString, []interface{}{}),
+				}},
+			},
+		},
+	}}
+	expected := &api.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-view": "true"}},
+			{
+				MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "rbac.example.com/aggregate-to-edit", Operator: metav1.LabelSelectorOpExists, Values: []string{}},
+				},
+			},
+		},
+	}
+
+	out := expandClusterRoleAggregationRule(in)
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Fatalf("Unexpected aggregation rule: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenClusterRoleAggregationRule(t *testing.T) {
+	in := &api.AggregationRule{
+		ClusterRoleSelectors: []metav1.LabelSelector{
+			{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-view": "true"}},
+			{MatchLabels: map[string]string{"rbac.example.com/aggregate-to-edit": "true"}},
+		},
+	}
+	expected := []interface{}{map[string]interface{}{
+		"cluster_role_selectors": []interface{}{
+			map[string]interface{}{"match_labels": map[string]string{"rbac.example.com/aggregate-to-view": "true"}},
+			map[string]interface{}{"match_labels": map[string]string{"rbac.example.com/aggregate-to-edit": "true"}},
+		},
+	}}
+
+	out := flattenClusterRoleAggregationRule(in)
+	if diff := cmp.Diff(expected, out); diff != "" {
+		t.Fatalf("Unexpected aggregation rule: mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,63 @@
+package kubernetes
+
+import (
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func flattenReplicaSetSpec(in appsv1.ReplicaSetSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
+	att := make(map[string]interface{})
+	att["min_ready_seconds"] = in.MinReadySeconds
+
+	if in.Replicas != nil {
+		att["replicas"] = strconv.Itoa(int(*in.Replicas))
+	}
+
+	if in.Selector != nil {
+		att["selector"] = flattenLabelSelector(in.Selector)
+	}
+
+	podSpec, err := flattenPodSpec(in.Template.Spec)
+	if err != nil {
+		return nil, err
+	}
+	template := make(map[string]interface{})
+	template["spec"] = podSpec
+	template["metadata"] = flattenMetadata(in.Template.ObjectMeta, d, meta, "spec.0.template.0.")
+	att["template"] = []interface{}{template}
+
+	return []interface{}{att}, nil
+}
+
+func expandReplicaSetSpec(replicaSet []interface{}) (*appsv1.ReplicaSetSpec, error) {
+	obj := &appsv1.ReplicaSetSpec{}
+
+	if len(replicaSet) == 0 || replicaSet[0] == nil {
+		return obj, nil
+	}
+
+	in := replicaSet[0].(map[string]interface{})
+
+	obj.MinReadySeconds = int32(in["min_ready_seconds"].(int))
+	if v, ok := in["replicas"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 32)
+		if err != nil {
+			return obj, err
+		}
+		obj.Replicas = ptrToInt32(int32(i))
+	}
+
+	if v, ok := in["selector"].([]interface{}); ok && len(v) > 0 {
+		obj.Selector = expandLabelSelector(v)
+	}
+
+	template, err := expandPodTemplate(in["template"].([]interface{}))
+	if err != nil {
+		return obj, err
+	}
+	obj.Template = *template
+
+	return obj, nil
+}
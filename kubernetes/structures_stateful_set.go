@@ -20,6 +20,14 @@ func expandStatefulSetSpec(s []interface{}) (*v1.StatefulSetSpec, error) {
 	}
 	in := s[0].(map[string]interface{})
 
+	if v, ok := in["ordinals"].([]interface{}); ok && len(v) > 0 {
+		obj.Ordinals = expandStatefulSetSpecOrdinals(v)
+	}
+
+	if v, ok := in["persistent_volume_claim_retention_policy"].([]interface{}); ok && len(v) > 0 {
+		obj.PersistentVolumeClaimRetentionPolicy = expandStatefulSetSpecPersistentVolumeClaimRetentionPolicy(v)
+	}
+
 	if v, ok := in["pod_management_policy"].(string); ok {
 		obj.PodManagementPolicy = v1.PodManagementPolicyType(v)
 	}
@@ -108,9 +116,52 @@ func expandStatefulSetSpecUpdateStrategy(s []interface{}) (*v1.StatefulSetUpdate
 	return ust, nil
 }
 
-func flattenStatefulSetSpec(spec v1.StatefulSetSpec, d *schema.ResourceData) ([]interface{}, error) {
+func expandStatefulSetSpecOrdinals(s []interface{}) *v1.StatefulSetOrdinals {
+	obj := &v1.StatefulSetOrdinals{}
+	if len(s) == 0 || s[0] == nil {
+		return obj
+	}
+	in := s[0].(map[string]interface{})
+
+	if v, ok := in["start"].(int); ok {
+		obj.Start = int32(v)
+	}
+	return obj
+}
+
+func expandStatefulSetSpecPersistentVolumeClaimRetentionPolicy(s []interface{}) *v1.StatefulSetPersistentVolumeClaimRetentionPolicy {
+	obj := &v1.StatefulSetPersistentVolumeClaimRetentionPolicy{}
+	if len(s) == 0 || s[0] == nil {
+		return obj
+	}
+	in := s[0].(map[string]interface{})
+
+	if v, ok := in["when_deleted"].(string); ok {
+		obj.WhenDeleted = v1.PersistentVolumeClaimRetentionPolicyType(v)
+	}
+	if v, ok := in["when_scaled"].(string); ok {
+		obj.WhenScaled = v1.PersistentVolumeClaimRetentionPolicyType(v)
+	}
+	return obj
+}
+
+func flattenStatefulSetSpec(spec v1.StatefulSetSpec, d *schema.ResourceData, meta interface{}) ([]interface{}, error) {
 	att := make(map[string]interface{})
 
+	// Clusters with the StatefulSetStartOrdinal or StatefulSetAutoDeletePVC
+	// feature gates disabled drop these fields, keep what is configured in
+	// that case to avoid a perpetual diff.
+	if spec.Ordinals != nil {
+		att["ordinals"] = flattenStatefulSetSpecOrdinals(*spec.Ordinals)
+	} else if v, ok := d.Get("spec.0.ordinals").([]interface{}); ok {
+		att["ordinals"] = v
+	}
+	if spec.PersistentVolumeClaimRetentionPolicy != nil {
+		att["persistent_volume_claim_retention_policy"] = flattenStatefulSetSpecPersistentVolumeClaimRetentionPolicy(*spec.PersistentVolumeClaimRetentionPolicy)
+	} else if v, ok := d.Get("spec.0.persistent_volume_claim_retention_policy").([]interface{}); ok {
+		att["persistent_volume_claim_retention_policy"] = v
+	}
+
 	if spec.PodManagementPolicy != "" {
 		att["pod_management_policy"] = spec.PodManagementPolicy
 	}
@@ -126,12 +177,12 @@ func flattenStatefulSetSpec(spec v1.StatefulSetSpec, d *schema.ResourceData) ([]
 	if spec.ServiceName != "" {
 		att["service_name"] = spec.ServiceName
 	}
-	template, err := flattenPodTemplateSpec(spec.Template, d)
+	template, err := flattenPodTemplateSpec(spec.Template, d, meta)
 	if err != nil {
 		return []interface{}{att}, err
 	}
 	att["template"] = template
-	att["volume_claim_template"] = flattenPersistentVolumeClaim(spec.VolumeClaimTemplates, d)
+	att["volume_claim_template"] = flattenPersistentVolumeClaim(spec.VolumeClaimTemplates, d, meta)
 
 	// Only write update_strategy to state if the user has defined it,
 	// otherwise we get a perpetual diff.
@@ -143,14 +194,14 @@ func flattenStatefulSetSpec(spec v1.StatefulSetSpec, d *schema.ResourceData) ([]
 	return []interface{}{att}, nil
 }
 
-func flattenPodTemplateSpec(t corev1.PodTemplateSpec, d *schema.ResourceData, prefix ...string) ([]interface{}, error) {
+func flattenPodTemplateSpec(t corev1.PodTemplateSpec, d *schema.ResourceData, meta interface{}, prefix ...string) ([]interface{}, error) {
 	template := make(map[string]interface{})
 
 	metaPrefix := "spec.0.template.0."
 	if len(prefix) > 0 {
 		metaPrefix = prefix[0]
 	}
-	template["metadata"] = flattenMetadata(t.ObjectMeta, d, metaPrefix)
+	template["metadata"] = flattenMetadata(t.ObjectMeta, d, meta, metaPrefix)
 	spec, err := flattenPodSpec(t.Spec)
 	if err != nil {
 		return []interface{}{template}, err
@@ -160,18 +211,31 @@ func flattenPodTemplateSpec(t corev1.PodTemplateSpec, d *schema.ResourceData, pr
 	return []interface{}{template}, nil
 }
 
-func flattenPersistentVolumeClaim(in []corev1.PersistentVolumeClaim, d *schema.ResourceData) []interface{} {
+func flattenPersistentVolumeClaim(in []corev1.PersistentVolumeClaim, d *schema.ResourceData, meta interface{}) []interface{} {
 	pvcs := make([]interface{}, 0, len(in))
 
 	for i, pvc := range in {
 		p := make(map[string]interface{})
-		p["metadata"] = flattenMetadata(pvc.ObjectMeta, d, fmt.Sprintf("spec.0.volume_claim_template.%d.", i))
+		p["metadata"] = flattenMetadata(pvc.ObjectMeta, d, meta, fmt.Sprintf("spec.0.volume_claim_template.%d.", i))
 		p["spec"] = flattenPersistentVolumeClaimSpec(pvc.Spec)
 		pvcs = append(pvcs, p)
 	}
 	return pvcs
 }
 
+func flattenStatefulSetSpecOrdinals(o v1.StatefulSetOrdinals) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"start": int(o.Start),
+	}}
+}
+
+func flattenStatefulSetSpecPersistentVolumeClaimRetentionPolicy(p v1.StatefulSetPersistentVolumeClaimRetentionPolicy) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"when_deleted": string(p.WhenDeleted),
+		"when_scaled":  string(p.WhenScaled),
+	}}
+}
+
 func flattenStatefulSetSpecUpdateStrategy(s v1.StatefulSetUpdateStrategy) []interface{} {
 	att := make(map[string]interface{})
 
@@ -191,7 +255,9 @@ func flattenStatefulSetSpecUpdateStrategy(s v1.StatefulSetUpdateStrategy) []inte
 func patchStatefulSetSpec(d *schema.ResourceData) (PatchOperations, error) {
 	ops := PatchOperations{}
 
-	if d.HasChange("spec.0.replicas") {
+	// The replica count is left alone when it is not configured, as it may be
+	// set by a HorizontalPodAutoscaler.
+	if d.HasChange("spec.0.replicas") && isConfigured(d, "spec", "replicas") {
 		log.Printf("[TRACE] StatefulSet.Spec.Replicas has changes")
 		if v, ok := d.Get("spec.0.replicas").(string); ok && v != "" {
 			vv, err := strconv.Atoi(v)
@@ -211,12 +277,27 @@ func patchStatefulSetSpec(d *schema.ResourceData) (PatchOperations, error) {
 		if err != nil {
 			return ops, err
 		}
+		orderContainerEnvAsConfigured(d, "spec.0.template.0.spec.0", &template.Spec)
 		ops = append(ops, &ReplaceOperation{
 			Path:  "/spec/template",
 			Value: template,
 		})
 	}
 
+	if d.HasChange("spec.0.ordinals") {
+		log.Printf("[TRACE] StatefulSet.Spec.Ordinals has changes")
+		ops = append(ops, patchStatefulSetSpecBlock("spec.0.ordinals", "/spec/ordinals", d, func(v []interface{}) interface{} {
+			return expandStatefulSetSpecOrdinals(v)
+		})...)
+	}
+
+	if d.HasChange("spec.0.persistent_volume_claim_retention_policy") {
+		log.Printf("[TRACE] StatefulSet.Spec.PersistentVolumeClaimRetentionPolicy has changes")
+		ops = append(ops, patchStatefulSetSpecBlock("spec.0.persistent_volume_claim_retention_policy", "/spec/persistentVolumeClaimRetentionPolicy", d, func(v []interface{}) interface{} {
+			return expandStatefulSetSpecPersistentVolumeClaimRetentionPolicy(v)
+		})...)
+	}
+
 	if d.HasChange("spec.0.update_strategy") {
 		log.Printf("[TRACE] StatefulSet.Spec.UpdateStrategy has changes")
 		u, err := patchUpdateStrategy("spec.0.update_strategy.0.", "/spec/updateStrategy/", d)
@@ -228,6 +309,20 @@ func patchStatefulSetSpec(d *schema.ResourceData) (PatchOperations, error) {
 	return ops, nil
 }
 
+// patchStatefulSetSpecBlock sets or removes an optional single nested block
+// of the StatefulSet spec. An add operation is used to set the block since
+// the field may be missing on the server even when it is set in the state.
+func patchStatefulSetSpecBlock(key, path string, d *schema.ResourceData, expand func([]interface{}) interface{}) PatchOperations {
+	o, n := d.GetChange(key)
+	if len(n.([]interface{})) > 0 {
+		return PatchOperations{&AddOperation{Path: path, Value: expand(n.([]interface{}))}}
+	}
+	if len(o.([]interface{})) > 0 {
+		return PatchOperations{&RemoveOperation{Path: path}}
+	}
+	return PatchOperations{}
+}
+
 func patchUpdateStrategy(keyPrefix, pathPrefix string, d *schema.ResourceData) (PatchOperations, error) {
 	ops := PatchOperations{}
 
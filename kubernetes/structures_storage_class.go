@@ -25,20 +25,16 @@ func flattenStorageClass(in storageapi.StorageClass) map[string]interface{} {
 }
 
 func expandStorageClassAllowedTopologies(l []interface{}) []v1.TopologySelectorTerm {
-	if len(l) == 0 || l[0] == nil {
-		return []v1.TopologySelectorTerm{}
-	}
-
-	in := l[0].(map[string]interface{})
-	topologies := make([]v1.TopologySelectorTerm, 0)
-	obj := v1.TopologySelectorTerm{}
-
-	if v, ok := in["match_label_expressions"].([]interface{}); ok && len(v) > 0 {
-		obj.MatchLabelExpressions = expandStorageClassMatchLabelExpressions(v)
+	topologies := make([]v1.TopologySelectorTerm, 0, len(l))
+	for _, t := range l {
+		obj := v1.TopologySelectorTerm{}
+		if in, ok := t.(map[string]interface{}); ok {
+			if v, ok := in["match_label_expressions"].([]interface{}); ok && len(v) > 0 {
+				obj.MatchLabelExpressions = expandStorageClassMatchLabelExpressions(v)
+			}
+		}
+		topologies = append(topologies, obj)
 	}
-
-	topologies = append(topologies, obj)
-
 	return topologies
 }
 
@@ -58,13 +54,15 @@ func expandStorageClassMatchLabelExpressions(l []interface{}) []v1.TopologySelec
 }
 
 func flattenStorageClassAllowedTopologies(in []v1.TopologySelectorTerm) []interface{} {
-	att := make(map[string]interface{})
-	for _, n := range in {
+	att := make([]interface{}, len(in))
+	for i, n := range in {
+		m := make(map[string]interface{})
 		if len(n.MatchLabelExpressions) > 0 {
-			att["match_label_expressions"] = flattenStorageClassMatchLabelExpressions(n.MatchLabelExpressions)
+			m["match_label_expressions"] = flattenStorageClassMatchLabelExpressions(n.MatchLabelExpressions)
 		}
+		att[i] = m
 	}
-	return []interface{}{att}
+	return att
 }
 
 func flattenStorageClassMatchLabelExpressions(in []v1.TopologySelectorLabelRequirement) []interface{} {
@@ -77,3 +75,15 @@ func flattenStorageClassMatchLabelExpressions(in []v1.TopologySelectorLabelRequi
 	}
 	return att
 }
+
+// filterStorageClassParameters returns the parameters of the storage class which are tracked in the
+// state, so that parameters added by the provisioner do not cause a perpetual diff.
+func filterStorageClassParameters(in map[string]string, tracked map[string]interface{}) map[string]string {
+	out := make(map[string]string)
+	for k, v := range in {
+		if _, ok := tracked[k]; ok {
+			out[k] = v
+		}
+	}
+	return out
+}
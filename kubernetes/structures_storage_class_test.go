@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestExpandThenFlatten_storage_class_allowed_topologies(t *testing.T) {
+	in := []v1.TopologySelectorTerm{
+		{
+			MatchLabelExpressions: []v1.TopologySelectorLabelRequirement{
+				{Key: "topology.kubernetes.io/zone", Values: []string{"zone-a"}},
+			},
+		},
+		{
+			MatchLabelExpressions: []v1.TopologySelectorLabelRequirement{
+				{Key: "topology.kubernetes.io/zone", Values: []string{"zone-b"}},
+				{Key: "topology.kubernetes.io/region", Values: []string{"region-1", "region-2"}},
+			},
+		},
+	}
+
+	flattened := flattenStorageClassAllowedTopologies(in)
+	if len(flattened) != 2 {
+		t.Fatalf("Expected each topology selector term to be flattened separately, got %d terms", len(flattened))
+	}
+	out := expandStorageClassAllowedTopologies(flattened)
+	if diff := cmp.Diff(in, out, cmp.Transformer("sortValues", func(in []string) *schema.Set {
+		return newStringSet(schema.HashString, in)
+	}), cmp.Comparer(func(a, b *schema.Set) bool { return a.Equal(b) })); diff != "" {
+		t.Fatalf("Unexpected allowed topologies round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFilterStorageClassParameters(t *testing.T) {
+	cases := map[string]struct {
+		In       map[string]string
+		Tracked  map[string]interface{}
+		Expected map[string]string
+	}{
+		"provisioner added parameters are ignored": {
+			In:       map[string]string{"type": "gp3", "csi.storage.k8s.io/fstype": "ext4"},
+			Tracked:  map[string]interface{}{"type": "gp3"},
+			Expected: map[string]string{"type": "gp3"},
+		},
+		"changed values are kept": {
+			In:       map[string]string{"type": "gp2"},
+			Tracked:  map[string]interface{}{"type": "gp3"},
+			Expected: map[string]string{"type": "gp2"},
+		},
+		"removed parameters are dropped": {
+			In:       map[string]string{},
+			Tracked:  map[string]interface{}{"type": "gp3"},
+			Expected: map[string]string{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := filterStorageClassParameters(tc.In, tc.Tracked)
+			if diff := cmp.Diff(tc.Expected, out); diff != "" {
+				t.Fatalf("Unexpected parameters: mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,12 @@ package kubernetes
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
+
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 func TestIsInternalKey(t *testing.T) {
@@ -31,3 +36,161 @@ func TestIsInternalKey(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveKeys(t *testing.T) {
+	ignore := []*regexp.Regexp{
+		regexp.MustCompile(`^linkerd\.io/`),
+		regexp.MustCompile(`^kustomize\.toolkit\.fluxcd\.io/`),
+	}
+	in := map[string]string{
+		"app":                                "web",
+		"linkerd.io/control-plane-ns":        "linkerd",
+		"linkerd.io/proxy-version":           "stable",
+		"kustomize.toolkit.fluxcd.io/name":   "apps",
+		"example.com/linkerd.io/not-ignored": "true",
+	}
+	config := map[string]interface{}{
+		"app":                      "web",
+		"linkerd.io/proxy-version": "stable",
+	}
+	expected := map[string]string{
+		"app":                                "web",
+		"linkerd.io/proxy-version":           "stable",
+		"example.com/linkerd.io/not-ignored": "true",
+	}
+
+	out := removeKeys(in, config, ignore)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, out)
+	}
+}
+
+func TestExpandThenFlattenResourceList(t *testing.T) {
+	in := map[string]interface{}{
+		"cpu":               "0.5",
+		"memory":            "1024Mi",
+		"ephemeral-storage": "1e3",
+		"pods":              10,
+		"requests.storage":  "1000m",
+	}
+	expected := map[string]string{
+		"cpu":    "500m",
+		"memory": "1Gi",
+		// The exponent notation is canonical on its own.
+		"ephemeral-storage": "1e3",
+		"pods":              "10",
+		"requests.storage":  "1",
+	}
+
+	l, err := expandMapToResourceList(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := flattenResourceList(*l)
+	if !reflect.DeepEqual(out, expected) {
+		t.Fatalf("Expected resource list to be flattened in canonical form %#v, got %#v", expected, out)
+	}
+	for k, v := range out {
+		if !equivalentResourceQuantities(v, fmt.Sprint(in[k])) {
+			t.Fatalf("Expected flattened %s %q to be equivalent to %v", k, v, in[k])
+		}
+	}
+}
+
+func TestFlattenResourceQuotaScopeSelector(t *testing.T) {
+	in := &api.ScopeSelector{
+		MatchExpressions: []api.ScopedResourceSelectorRequirement{
+			{
+				ScopeName: api.ResourceQuotaScopePriorityClass,
+				Operator:  api.ScopeSelectorOpIn,
+				Values:    []string{"high"},
+			},
+			{
+				ScopeName: api.ResourceQuotaScopeTerminating,
+				Operator:  api.ScopeSelectorOpExists,
+			},
+		},
+	}
+
+	out := flattenResourceQuotaScopeSelector(in)
+	expressions := out[0].(map[string]interface{})["match_expression"].([]interface{})
+	if len(expressions) != 2 {
+		t.Fatalf("Expected 2 match expressions, got %d", len(expressions))
+	}
+	expanded := expandResourceQuotaScopeSelector(out)
+	if !reflect.DeepEqual(in, expanded) {
+		t.Fatalf("Expected scope selector %#v to round trip, got %#v", in, expanded)
+	}
+}
+
+func TestFlattenLimitRangeItems(t *testing.T) {
+	max := api.ResourceList{
+		api.ResourceCPU:    resource.MustParse("1"),
+		api.ResourceMemory: resource.MustParse("1Gi"),
+	}
+	// Container limits as returned by the API server after defaulting.
+	in := []api.LimitRangeItem{
+		{
+			Type:    api.LimitTypeContainer,
+			Max:     max,
+			Default: api.ResourceList{api.ResourceCPU: resource.MustParse("500m"), api.ResourceMemory: resource.MustParse("1Gi")},
+			DefaultRequest: api.ResourceList{
+				api.ResourceCPU:    resource.MustParse("500m"),
+				api.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+		{
+			Type: api.LimitTypePod,
+			Max:  max,
+		},
+	}
+
+	cases := map[string]struct {
+		Prior                  []interface{}
+		ExpectedDefault        map[string]string
+		ExpectedDefaultRequest map[string]string
+	}{
+		"import keeps server defaults": {
+			Prior:                  nil,
+			ExpectedDefault:        map[string]string{"cpu": "500m", "memory": "1Gi"},
+			ExpectedDefaultRequest: map[string]string{"cpu": "500m", "memory": "1Gi"},
+		},
+		"unset maps keep server defaults": {
+			Prior: []interface{}{map[string]interface{}{
+				"type":            "Container",
+				"default":         map[string]interface{}{},
+				"default_request": map[string]interface{}{},
+			}},
+			ExpectedDefault:        map[string]string{"cpu": "500m", "memory": "1Gi"},
+			ExpectedDefaultRequest: map[string]string{"cpu": "500m", "memory": "1Gi"},
+		},
+		"partially set maps drop server defaults": {
+			Prior: []interface{}{map[string]interface{}{
+				"type":            "Container",
+				"default":         map[string]interface{}{"cpu": "0.5"},
+				"default_request": map[string]interface{}{"memory": "1024Mi"},
+			}},
+			ExpectedDefault:        map[string]string{"cpu": "500m"},
+			ExpectedDefaultRequest: map[string]string{"memory": "1Gi"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			out := flattenLimitRangeItems(in, tc.Prior)
+			if len(out) != len(in) {
+				t.Fatalf("Expected %d limits, got %d", len(in), len(out))
+			}
+			container := out[0].(map[string]interface{})
+			if !reflect.DeepEqual(container["default"], tc.ExpectedDefault) {
+				t.Fatalf("Expected default %#v, got %#v", tc.ExpectedDefault, container["default"])
+			}
+			if !reflect.DeepEqual(container["default_request"], tc.ExpectedDefaultRequest) {
+				t.Fatalf("Expected default_request %#v, got %#v", tc.ExpectedDefaultRequest, container["default_request"])
+			}
+			if pod := out[1].(map[string]interface{}); pod["type"] != "Pod" {
+				t.Fatalf("Expected limits to keep their order, got %#v", out)
+			}
+		})
+	}
+}
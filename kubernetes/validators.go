@@ -1,12 +1,16 @@
 package kubernetes
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	apiValidation "k8s.io/apimachinery/pkg/api/validation"
 	utilValidation "k8s.io/apimachinery/pkg/util/validation"
@@ -68,6 +72,14 @@ func validateName(value interface{}, key string) (ws []string, es []error) {
 	return
 }
 
+func validateLabelValue(value interface{}, key string) (ws []string, es []error) {
+	v := value.(string)
+	for _, msg := range utilValidation.IsValidLabelValue(v) {
+		es = append(es, fmt.Errorf("%s %s", key, msg))
+	}
+	return
+}
+
 func validateGenerateName(value interface{}, key string) (ws []string, es []error) {
 	v := value.(string)
 
@@ -80,22 +92,46 @@ func validateGenerateName(value interface{}, key string) (ws []string, es []erro
 	return
 }
 
-func validateLabels(value interface{}, key string) (ws []string, es []error) {
-	m := value.(map[string]interface{})
+func validateLabels(value interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	m, ok := value.(map[string]interface{})
+	if !ok {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Expected labels to be a map of strings",
+			AttributePath: path,
+		}}
+	}
 	for k, v := range m {
+		keyPath := path.IndexString(k)
 		for _, msg := range utilValidation.IsQualifiedName(k) {
-			es = append(es, fmt.Errorf("%s (%q) %s", key, k, msg))
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid label key %q", k),
+				Detail:        msg,
+				AttributePath: keyPath,
+			})
 		}
 		val, isString := v.(string)
 		if !isString {
-			es = append(es, fmt.Errorf("%s.%s (%#v): Expected value to be string", key, k, v))
-			return
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid value for label %q", k),
+				Detail:        fmt.Sprintf("Expected value to be string, got %#v", v),
+				AttributePath: keyPath,
+			})
+			continue
 		}
 		for _, msg := range utilValidation.IsValidLabelValue(val) {
-			es = append(es, fmt.Errorf("%s (%q) %s", key, val, msg))
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid value %q for label %q", val, k),
+				Detail:        msg,
+				AttributePath: keyPath,
+			})
 		}
 	}
-	return
+	return diags
 }
 
 func validatePortNum(value interface{}, key string) (ws []string, es []error) {
@@ -290,3 +326,52 @@ func validateTypeStringNullableIntOrPercent(v interface{}, key string) (ws []str
 
 	return
 }
+
+// podSpecCustomizeDiff validates the pod spec at key, such as
+// "spec.0.template.0.spec", where the validation of a single attribute is not
+// enough: the DNS settings, and the Pod Security Standard of the namespace
+// when validate_pod_security is enabled.
+func podSpecCustomizeDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if err := validatePodSpecDNS(d, key); err != nil {
+			return err
+		}
+		return validatePodSecurityDiff(ctx, d, meta, key)
+	}
+}
+
+// validatePodSpecDNS checks that dns_policy None comes with a nameserver and
+// that the ndots option is a number, skipping values not known until apply.
+func validatePodSpecDNS(d *schema.ResourceDiff, key string) error {
+	prefix := key + ".0."
+	if !d.NewValueKnown(prefix+"dns_policy") || !d.NewValueKnown(prefix+"dns_config") {
+		return nil
+	}
+	dnsConfig, _ := d.Get(prefix + "dns_config").([]interface{})
+	if d.Get(prefix+"dns_policy").(string) == string(v1.DNSNone) {
+		nameservers := 0
+		if len(dnsConfig) > 0 && dnsConfig[0] != nil {
+			nameservers = len(dnsConfig[0].(map[string]interface{})["nameservers"].([]interface{}))
+		}
+		if nameservers == 0 {
+			return fmt.Errorf("%sdns_config: at least one nameserver is required when dns_policy is %q", prefix, v1.DNSNone)
+		}
+	}
+	if len(dnsConfig) == 0 || dnsConfig[0] == nil {
+		return nil
+	}
+	for i, o := range dnsConfig[0].(map[string]interface{})["option"].([]interface{}) {
+		option, ok := o.(map[string]interface{})
+		if !ok || option["name"] != "ndots" {
+			continue
+		}
+		valueKey := fmt.Sprintf("%sdns_config.0.option.%d.value", prefix, i)
+		if !d.NewValueKnown(valueKey) {
+			continue
+		}
+		if n, err := strconv.Atoi(option["value"].(string)); err != nil || n < 0 {
+			return fmt.Errorf("%s: the value of the ndots option must be a non-negative integer, got %q", valueKey, option["value"])
+		}
+	}
+	return nil
+}
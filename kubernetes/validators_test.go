@@ -1,7 +1,10 @@
 package kubernetes
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func TestValidateModeBits(t *testing.T) {
@@ -161,3 +164,86 @@ func TestValidateTypeStringNullableIntOrPercent(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateLabels(t *testing.T) {
+	path := cty.GetAttrPath("metadata").IndexInt(0).GetAttr("labels")
+	validCases := []map[string]interface{}{
+		{},
+		{"app": "nginx"},
+		{"app.kubernetes.io/name": "nginx"},
+		{"empty": ""},
+	}
+	for _, data := range validCases {
+		diags := validateLabels(data, path)
+		if diags.HasError() {
+			t.Fatalf("Expected %#v to be valid: %#v", data, diags)
+		}
+	}
+
+	invalidCases := []struct {
+		labels map[string]interface{}
+		key    string
+	}{
+		{map[string]interface{}{"Example.COM/app": "nginx"}, "Example.COM/app"},
+		{map[string]interface{}{"bad key": "nginx"}, "bad key"},
+		{map[string]interface{}{"app": "-nginx"}, "app"},
+		{map[string]interface{}{"app": strings.Repeat("a", 64)}, "app"},
+		{map[string]interface{}{"app": 10}, "app"},
+	}
+	for _, tc := range invalidCases {
+		diags := validateLabels(tc.labels, path)
+		if !diags.HasError() {
+			t.Fatalf("Expected %#v to be invalid", tc.labels)
+		}
+		expectedPath := path.IndexString(tc.key)
+		for _, d := range diags {
+			if !d.AttributePath.Equals(expectedPath) {
+				t.Fatalf("Expected diagnostic for %#v to point at %#v, got %#v", tc.labels, expectedPath, d.AttributePath)
+			}
+		}
+	}
+}
+
+func TestValidateCronExpression(t *testing.T) {
+	validCases := []string{
+		"0 * * * *", "*/5 1-3 * * MON-FRI", "@hourly", "@every 1h30m",
+	}
+	for _, schedule := range validCases {
+		_, es := validateCronExpression()(schedule, "schedule")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", schedule, es)
+		}
+	}
+
+	invalidCases := []string{
+		"", "* * * *", "61 * * * *", "@fortnightly", "CRON_TZ=Europe/Berlin 0 * * * *", "TZ=UTC 0 * * * *",
+	}
+	for _, schedule := range invalidCases {
+		_, es := validateCronExpression()(schedule, "schedule")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", schedule)
+		}
+	}
+}
+
+func TestValidateCronJobTimeZone(t *testing.T) {
+	validCases := []string{
+		"UTC", "Etc/UTC", "Europe/Berlin", "America/Argentina/Buenos_Aires",
+	}
+	for _, tz := range validCases {
+		_, es := validateCronJobTimeZone(tz, "time_zone")
+		if len(es) > 0 {
+			t.Fatalf("Expected %q to be valid: %#v", tz, es)
+		}
+	}
+
+	invalidCases := []string{
+		"Local", "local", "Europe/Atlantis", "+01:00",
+	}
+	for _, tz := range invalidCases {
+		_, es := validateCronJobTimeZone(tz, "time_zone")
+		if len(es) == 0 {
+			t.Fatalf("Expected %q to be invalid", tz)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+)
+
+// The CSI snapshot API is served by CRDs installed with the external
+// snapshot controller and its Go types live in a separate module, so the
+// resources are managed through the dynamic client using the minimal types
+// below.
+const volumeSnapshotV1GroupVersion = "snapshot.storage.k8s.io/v1"
+
+var (
+	volumeSnapshotClassV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "snapshot.storage.k8s.io",
+		Version:  "v1",
+		Resource: "volumesnapshotclasses",
+	}
+	volumeSnapshotV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "snapshot.storage.k8s.io",
+		Version:  "v1",
+		Resource: "volumesnapshots",
+	}
+	volumeSnapshotContentV1Resource = apimachineryschema.GroupVersionResource{
+		Group:    "snapshot.storage.k8s.io",
+		Version:  "v1",
+		Resource: "volumesnapshotcontents",
+	}
+)
+
+var volumeSnapshotDeletionPolicies = []string{"Delete", "Retain"}
+
+// errVolumeSnapshotAPINotInstalled is returned when the cluster does not
+// serve the snapshot resource a configuration refers to.
+type errVolumeSnapshotAPINotInstalled struct {
+	resource string
+}
+
+func (e *errVolumeSnapshotAPINotInstalled) Error() string {
+	return fmt.Sprintf("Volume snapshot API not installed: the cluster does not serve %s in %s. Install the CSI external snapshotter CRDs and controller (https://github.com/kubernetes-csi/external-snapshotter#usage) before creating this resource.", e.resource, volumeSnapshotV1GroupVersion)
+}
+
+func checkVolumeSnapshotV1Installed(conn *kubernetes.Clientset, resource string) error {
+	resources, err := conn.Discovery().ServerResourcesForGroupVersion(volumeSnapshotV1GroupVersion)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &errVolumeSnapshotAPINotInstalled{resource: resource}
+		}
+		return err
+	}
+	for _, r := range resources.APIResources {
+		if r.Name == resource {
+			return nil
+		}
+	}
+	return &errVolumeSnapshotAPINotInstalled{resource: resource}
+}
+
+type volumeSnapshotClassV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Driver         string            `json:"driver"`
+	Parameters     map[string]string `json:"parameters,omitempty"`
+	DeletionPolicy string            `json:"deletionPolicy"`
+}
+
+type volumeSnapshotV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   volumeSnapshotV1Spec    `json:"spec"`
+	Status *volumeSnapshotV1Status `json:"status,omitempty"`
+}
+
+type volumeSnapshotV1Spec struct {
+	Source                  volumeSnapshotV1Source `json:"source"`
+	VolumeSnapshotClassName *string                `json:"volumeSnapshotClassName,omitempty"`
+}
+
+type volumeSnapshotV1Source struct {
+	PersistentVolumeClaimName *string `json:"persistentVolumeClaimName,omitempty"`
+	VolumeSnapshotContentName *string `json:"volumeSnapshotContentName,omitempty"`
+}
+
+type volumeSnapshotV1Status struct {
+	BoundVolumeSnapshotContentName *string              `json:"boundVolumeSnapshotContentName,omitempty"`
+	CreationTime                   *metav1.Time         `json:"creationTime,omitempty"`
+	ReadyToUse                     *bool                `json:"readyToUse,omitempty"`
+	RestoreSize                    *string              `json:"restoreSize,omitempty"`
+	Error                          *volumeSnapshotError `json:"error,omitempty"`
+}
+
+type volumeSnapshotError struct {
+	Time    *metav1.Time `json:"time,omitempty"`
+	Message *string      `json:"message,omitempty"`
+}
+
+type volumeSnapshotContentV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   volumeSnapshotContentV1Spec    `json:"spec"`
+	Status *volumeSnapshotContentV1Status `json:"status,omitempty"`
+}
+
+type volumeSnapshotContentV1Spec struct {
+	VolumeSnapshotRef       volumeSnapshotContentV1Reference `json:"volumeSnapshotRef"`
+	DeletionPolicy          string                           `json:"deletionPolicy"`
+	Driver                  string                           `json:"driver"`
+	VolumeSnapshotClassName *string                          `json:"volumeSnapshotClassName,omitempty"`
+	Source                  volumeSnapshotContentV1Source    `json:"source"`
+	SourceVolumeMode        *string                          `json:"sourceVolumeMode,omitempty"`
+}
+
+// volumeSnapshotContentV1Reference is the subset of an ObjectReference the
+// snapshot API needs. The snapshot controller fills in the other fields once
+// the content is bound.
+type volumeSnapshotContentV1Reference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+type volumeSnapshotContentV1Source struct {
+	VolumeHandle   *string `json:"volumeHandle,omitempty"`
+	SnapshotHandle *string `json:"snapshotHandle,omitempty"`
+}
+
+type volumeSnapshotContentV1Status struct {
+	SnapshotHandle *string              `json:"snapshotHandle,omitempty"`
+	CreationTime   *int64               `json:"creationTime,omitempty"`
+	RestoreSize    *int64               `json:"restoreSize,omitempty"`
+	ReadyToUse     *bool                `json:"readyToUse,omitempty"`
+	Error          *volumeSnapshotError `json:"error,omitempty"`
+}
+
+func volumeSnapshotErrorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The last error encountered while creating the snapshot, if any. It is cleared once the snapshot is ready.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"message": {
+					Type:        schema.TypeString,
+					Description: "The error message reported by the snapshot controller or the CSI driver.",
+					Computed:    true,
+				},
+				"time": {
+					Type:        schema.TypeString,
+					Description: "The time the error was encountered, in RFC3339 format.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,112 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestVolumeSnapshotV1UnstructuredRoundTrip(t *testing.T) {
+	snapshot := volumeSnapshotV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: volumeSnapshotV1GroupVersion,
+			Kind:       "VolumeSnapshot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example",
+			Namespace: "default",
+		},
+		Spec: expandVolumeSnapshotV1Spec([]interface{}{map[string]interface{}{
+			"volume_snapshot_class_name": "csi-hostpath",
+			"source": []interface{}{map[string]interface{}{
+				"persistent_volume_claim_name": "data",
+				"volume_snapshot_content_name": "",
+			}},
+		}}),
+		Status: &volumeSnapshotV1Status{ReadyToUse: ptrToBool(true)},
+	}
+
+	u, err := toUnstructuredObject(&snapshot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := u.Object["status"]; ok {
+		t.Fatal("Expected the status to be dropped from the object sent to the API server")
+	}
+	name, _, _ := unstructured.NestedString(u.Object, "spec", "source", "persistentVolumeClaimName")
+	if name != "data" {
+		t.Fatalf("Unexpected source claim name %q", name)
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "source", "volumeSnapshotContentName"); ok {
+		t.Fatal("Expected the unset source to be omitted")
+	}
+
+	out := volumeSnapshotV1{}
+	if err := fromUnstructuredObject(u, &out); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(snapshot.Spec, out.Spec); diff != "" {
+		t.Fatalf("Unexpected volume snapshot spec round trip: mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestFlattenVolumeSnapshotContentV1(t *testing.T) {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": volumeSnapshotV1GroupVersion,
+		"kind":       "VolumeSnapshotContent",
+		"metadata":   map[string]interface{}{"name": "example"},
+		"spec": map[string]interface{}{
+			"deletionPolicy": "Retain",
+			"driver":         "hostpath.csi.k8s.io",
+			"source":         map[string]interface{}{"snapshotHandle": "handle"},
+			// The snapshot controller adds the other reference fields once bound.
+			"volumeSnapshotRef": map[string]interface{}{
+				"apiVersion": volumeSnapshotV1GroupVersion,
+				"kind":       "VolumeSnapshot",
+				"name":       "example",
+				"namespace":  "default",
+				"uid":        "3a1e5d0e-1b1c-4f4e-9f3a-8e3c9d9f4b1a",
+			},
+		},
+		"status": map[string]interface{}{
+			"creationTime":   int64(1700000000000000000),
+			"readyToUse":     true,
+			"restoreSize":    int64(1073741824),
+			"snapshotHandle": "handle",
+		},
+	}}
+
+	content := volumeSnapshotContentV1{}
+	if err := fromUnstructuredObject(u, &content); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedSpec := []interface{}{map[string]interface{}{
+		"driver":          "hostpath.csi.k8s.io",
+		"deletion_policy": "Retain",
+		"volume_snapshot_ref": []interface{}{map[string]interface{}{
+			"name":      "example",
+			"namespace": "default",
+		}},
+		"source": []interface{}{map[string]interface{}{
+			"snapshot_handle": "handle",
+		}},
+	}}
+	if diff := cmp.Diff(expectedSpec, flattenVolumeSnapshotContentV1Spec(content.Spec)); diff != "" {
+		t.Fatalf("Unexpected volume snapshot content spec: mismatch (-want +got):\n%s", diff)
+	}
+
+	expectedStatus := []interface{}{map[string]interface{}{
+		"snapshot_handle": "handle",
+		"creation_time":   time.Unix(0, 1700000000000000000).UTC().Format(time.RFC3339),
+		"ready_to_use":    true,
+		"restore_size":    1073741824,
+		"error":           []interface{}{},
+	}}
+	if diff := cmp.Diff(expectedStatus, flattenVolumeSnapshotContentV1Status(content.Status)); diff != "" {
+		t.Fatalf("Unexpected volume snapshot content status: mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	tfmux "github.com/hashicorp/terraform-plugin-mux"
+
+	"github.com/hashicorp/terraform-provider-kubernetes/kubernetes"
+	kubernetesalphaprovider "github.com/hashicorp/terraform-provider-kubernetes/manifest/provider"
+)
+
+// TestMuxServer checks that both providers can be served together, which
+// requires their provider configuration schemas to be identical.
+func TestMuxServer(t *testing.T) {
+	_, err := tfmux.NewSchemaServerFactory(context.Background(), kubernetes.Provider().GRPCProvider, kubernetesalphaprovider.Provider())
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
@@ -129,6 +130,12 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		computedFields[atp.String()] = atp
 	}
 
+	ignoredFields, ifDiags := getIgnoredFields(plannedStateVal)
+	if len(ifDiags) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, ifDiags...)
+		return resp, nil
+	}
+
 	c, err := s.getDynamicClient()
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics,
@@ -210,6 +217,35 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			return resp, nil
 		}
 
+		// Ignored fields were removed from the plan. Apply their values from
+		// "manifest", on update they are replaced by the current values below.
+		obj, err = tftypes.Transform(obj, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+			if !isIgnoredField(ignoredFields, ap) {
+				return v, nil
+			}
+			ppMan, restPath, err := tftypes.WalkAttributePath(plannedStateVal["manifest"], ap)
+			if err != nil {
+				if len(restPath.Steps()) > 0 {
+					// attribute not in manifest
+					return tftypes.NewValue(v.Type(), nil), nil
+				}
+				return v, ap.NewError(err)
+			}
+			nv, err := morph.ValueToType(ppMan.(tftypes.Value), v.Type(), tftypes.NewAttributePath())
+			if err != nil {
+				return v, ap.NewError(err)
+			}
+			return nv, nil
+		})
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to backfill ignored values in proposed value",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+
 		nullObj := morph.UnknownToNull(obj)
 		s.logger.Trace("[ApplyResourceChange][Apply]", "[UnknownToNull]", dump(nullObj))
 
@@ -299,6 +335,23 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			rs = c.Resource(gvr)
 		}
 
+		// Keep the current values of the ignored fields on update
+		if !applyPriorState.IsNull() && len(ignoredFields) > 0 {
+			current, err := rs.Get(ctx, rname, metav1.GetOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				resp.Diagnostics = append(resp.Diagnostics,
+					&tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  fmt.Sprintf("Failed to read the ignored fields of resource %q", rnn),
+						Detail:   err.Error(),
+					})
+				return resp, nil
+			}
+			if err == nil {
+				copyIgnoredFields(uo.Object, current.Object, ignoredFields)
+			}
+		}
+
 		// Check the resource does not exist if this is a create operation
 		if applyPriorState.IsNull() {
 			_, err := rs.Get(ctx, rname, metav1.GetOptions{})
@@ -365,18 +418,8 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		)
 		if err != nil {
 			s.logger.Error("[ApplyResourceChange][Apply]", "API error", dump(err), "API response", dump(result))
-			if apierrors.IsConflict(err) {
-				resp.Diagnostics = append(resp.Diagnostics,
-					&tfprotov5.Diagnostic{
-						Severity: tfprotov5.DiagnosticSeverityError,
-						Summary:  fmt.Sprintf(`There was a field manager conflict when trying to apply the manifest for %q`, rnn),
-						Detail: fmt.Sprintf(
-							"The API returned the following conflict: %q\n\n"+
-								"You can override this conflict by setting \"force_conflicts\" to true in the \"field_manager\" block.",
-							err.Error(),
-						),
-					},
-				)
+			if status := apierrors.APIStatus(nil); apierrors.IsConflict(err) && errors.As(err, &status) {
+				resp.Diagnostics = append(resp.Diagnostics, APIConflictErrorToDiagnostics(status.Status(), rnn, fieldManagerName)...)
 			} else if status := apierrors.APIStatus(nil); errors.As(err, &status) {
 				resp.Diagnostics = append(resp.Diagnostics, APIStatusErrorToDiagnostics(status.Status())...)
 			} else {
@@ -402,21 +445,33 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		}
 		s.logger.Trace("[ApplyResourceChange][Apply]", "[payload.ToTFValue]", dump(newResObject))
 
+		newResObject, err = nullIgnoredFields(newResObject, ignoredFields)
+		if err != nil {
+			return resp, err
+		}
+
 		wt, _, err := s.TFTypeFromOpenAPI(ctx, gvk, true)
 		if err != nil {
 			return resp, fmt.Errorf("failed to determine resource type ID: %s", err)
 		}
 
 		wf, ok := plannedStateVal["wait_for"]
+		if w, found := plannedStateVal["wait"]; found && !w.IsNull() && w.IsKnown() {
+			var waitBlocks []tftypes.Value
+			w.As(&waitBlocks)
+			if len(waitBlocks) > 0 {
+				wf, ok = waitBlocks[0], true
+			}
+		}
 		if ok {
 			err = s.waitForCompletion(ctxDeadline, wf, rs, rname, wt, th)
 			if err != nil {
-				if err == context.DeadlineExceeded {
+				if errors.Is(err, context.DeadlineExceeded) {
 					resp.Diagnostics = append(resp.Diagnostics,
 						&tfprotov5.Diagnostic{
 							Severity: tfprotov5.DiagnosticSeverityError,
 							Summary:  "Operation timed out",
-							Detail:   "Terraform timed out waiting on the operation to complete",
+							Detail:   fmt.Sprintf("Terraform timed out waiting on the operation to complete: %s", err),
 						})
 				} else {
 					resp.Diagnostics = append(resp.Diagnostics,
@@ -430,6 +485,19 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 			}
 		}
 
+		if isCustomResourceDefinition(gvk) {
+			err = s.waitForCRDEstablished(ctxDeadline, rs, rname)
+			if err != nil {
+				resp.Diagnostics = append(resp.Diagnostics,
+					&tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Error waiting for CustomResourceDefinition to be established",
+						Detail:   fmt.Sprintf("CustomResourceDefinition %q was applied but is not established: %s", rname, err),
+					})
+				return resp, nil
+			}
+		}
+
 		compObj, err := morph.DeepUnknown(tsch, newResObject, tftypes.NewAttributePath())
 		if err != nil {
 			return resp, err
@@ -500,8 +568,14 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		ctxDeadline, cancel := context.WithDeadline(ctx, deadline)
 		defer cancel()
 
-		err = rs.Delete(ctxDeadline, rname, metav1.DeleteOptions{})
+		deleteOptions, waitForDelete := s.getDeleteOptions(priorStateVal)
+		err = rs.Delete(ctxDeadline, rname, deleteOptions)
 		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// the resource is already gone
+				resp.NewState = req.PlannedState
+				return resp, nil
+			}
 			rn := types.NamespacedName{Namespace: rnamespace, Name: rname}.String()
 			resp.Diagnostics = append(resp.Diagnostics,
 				&tfprotov5.Diagnostic{
@@ -513,22 +587,33 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 		}
 
 		// wait for delete
-		for {
+		var finalizers []string
+		for waitForDelete {
 			if time.Now().After(deadline) {
+				detail := "Deletion timed out. This can happen when there is a finalizer on a resource. You may need to delete this resource manually with kubectl."
+				if len(finalizers) > 0 {
+					detail = fmt.Sprintf("Deletion timed out. The resource still has the following finalizers: %s. "+
+						"The controllers responsible for them may be unavailable. You may need to delete this resource manually with kubectl.",
+						strings.Join(finalizers, ", "))
+				}
 				resp.Diagnostics = append(resp.Diagnostics,
 					&tfprotov5.Diagnostic{
 						Severity: tfprotov5.DiagnosticSeverityError,
 						Summary:  fmt.Sprintf("Timed out when waiting for resource %q to be deleted", rname),
-						Detail:   "Deletion timed out. This can happen when there is a finalizer on a resource. You may need to delete this resource manually with kubectl.",
+						Detail:   detail,
 					})
 				return resp, nil
 			}
-			_, err := rs.Get(ctxDeadline, rname, metav1.GetOptions{})
+			ro, err := rs.Get(ctxDeadline, rname, metav1.GetOptions{})
 			if err != nil {
-				if apierrors.IsNotFound(err) {
+				if apierrors.IsNotFound(err) || apierrors.IsGone(err) {
 					s.logger.Trace("[ApplyResourceChange][Delete]", "Resource is deleted")
 					break
 				}
+				if ctxDeadline.Err() != nil {
+					// the deadline expired during the request, report it on the next pass
+					continue
+				}
 				resp.Diagnostics = append(resp.Diagnostics,
 					&tfprotov5.Diagnostic{
 						Severity: tfprotov5.DiagnosticSeverityError,
@@ -537,6 +622,7 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 					})
 				return resp, nil
 			}
+			finalizers = ro.GetFinalizers()
 			time.Sleep(1 * time.Second) // lintignore:R018
 		}
 
@@ -546,6 +632,33 @@ func (s *RawProviderServer) ApplyResourceChange(ctx context.Context, req *tfprot
 	return resp, nil
 }
 
+// getDeleteOptions returns the options for the delete call, and whether
+// to wait for the resource to be removed
+func (s *RawProviderServer) getDeleteOptions(v map[string]tftypes.Value) (metav1.DeleteOptions, bool) {
+	opts := metav1.DeleteOptions{}
+	wait := true
+	if !v["delete_options"].IsNull() && v["delete_options"].IsKnown() {
+		var deleteOptionsBlock []tftypes.Value
+		v["delete_options"].As(&deleteOptionsBlock)
+		if len(deleteOptionsBlock) > 0 {
+			var d map[string]tftypes.Value
+			deleteOptionsBlock[0].As(&d)
+			if pp, ok := d["propagation_policy"]; ok && !pp.IsNull() && pp.IsKnown() {
+				var policy string
+				pp.As(&policy)
+				if policy != "" {
+					p := metav1.DeletionPropagation(policy)
+					opts.PropagationPolicy = &p
+				}
+			}
+			if w, ok := d["wait"]; ok && !w.IsNull() && w.IsKnown() {
+				w.As(&wait)
+			}
+		}
+	}
+	return opts, wait
+}
+
 func (s *RawProviderServer) getTimeouts(v map[string]tftypes.Value) map[string]string {
 	timeouts := map[string]string{
 		"create": defaultCreateTimeout,
@@ -6,8 +6,10 @@ import (
 	"net/http"
 	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/execplugin"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/openapi"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -26,6 +28,54 @@ const (
 	OAPIFoundry string = "OPENAPIFOUNDRY"
 )
 
+// getHTTPClient returns the HTTP client shared by the Kubernetes clients
+func (ps *RawProviderServer) getHTTPClient() (*http.Client, error) {
+	if ps.httpClient != nil {
+		return ps.httpClient, nil
+	}
+	if ps.clientConfig == nil {
+		return nil, fmt.Errorf("cannot create HTTP client: no client config")
+	}
+	httpClient, err := execplugin.HTTPClientFor(ps.clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	if err := ps.checkServerVersion(httpClient); err != nil {
+		return nil, err
+	}
+	ps.httpClient = httpClient
+	return httpClient, nil
+}
+
+// checkServerVersion fails when the API server does not satisfy the
+// expect_kubernetes_version constraint. A failed lookup does not fail the
+// check, so the error of the request which needed the client is reported
+// instead.
+func (ps *RawProviderServer) checkServerVersion(httpClient *http.Client) error {
+	if len(ps.expectedVersion) == 0 {
+		return nil
+	}
+	dc, err := discovery.NewDiscoveryClientForConfigAndClient(ps.clientConfig, httpClient)
+	if err != nil {
+		return err
+	}
+	info, err := dc.ServerVersion()
+	if err != nil {
+		ps.logger.Warn("[checkServerVersion]", "Skipping the expect_kubernetes_version check:", err)
+		return nil
+	}
+	v, err := gversion.NewVersion(info.GitVersion)
+	if err != nil {
+		return fmt.Errorf("failed to parse server version %q: %s", info.GitVersion, err)
+	}
+	// Versions of managed clusters carry a pre-release suffix, e.g.
+	// v1.27.3-eks-a5565ad, which never satisfies a constraint without one.
+	if !ps.expectedVersion.Check(v.Core()) {
+		return fmt.Errorf("Cluster reports %s which does not satisfy %s, see expect_kubernetes_version", info.GitVersion, ps.expectedVersion)
+	}
+	return nil
+}
+
 // getDynamicClient returns a configured unstructured (dynamic) client instance
 func (ps *RawProviderServer) getDynamicClient() (dynamic.Interface, error) {
 	if ps.dynamicClient != nil {
@@ -34,7 +84,11 @@ func (ps *RawProviderServer) getDynamicClient() (dynamic.Interface, error) {
 	if ps.clientConfig == nil {
 		return nil, fmt.Errorf("cannot create dynamic client: no client config")
 	}
-	dynClient, err := dynamic.NewForConfig(ps.clientConfig)
+	httpClient, err := ps.getHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	dynClient, err := dynamic.NewForConfigAndClient(ps.clientConfig, httpClient)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +104,11 @@ func (ps *RawProviderServer) getDiscoveryClient() (discovery.DiscoveryInterface,
 	if ps.clientConfig == nil {
 		return nil, fmt.Errorf("cannot create discovery client: no client config")
 	}
-	discoClient, err := discovery.NewDiscoveryClientForConfig(ps.clientConfig)
+	httpClient, err := ps.getHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	discoClient, err := discovery.NewDiscoveryClientForConfigAndClient(ps.clientConfig, httpClient)
 	if err != nil {
 		return nil, err
 	}
@@ -79,6 +137,15 @@ func (ps *RawProviderServer) getRestMapper() (meta.RESTMapper, error) {
 	return ps.restMapper, nil
 }
 
+// resetRestMapper drops the discovery information cached by the RESTMapper,
+// so kinds added since it was built, e.g. by a CustomResourceDefinition, are
+// resolved on the next lookup.
+func (ps *RawProviderServer) resetRestMapper() {
+	if m, ok := ps.restMapper.(meta.ResettableRESTMapper); ok {
+		m.Reset()
+	}
+}
+
 // getRestClient returns a raw REST client instance
 func (ps *RawProviderServer) getRestClient() (rest.Interface, error) {
 	if ps.restClient != nil {
@@ -87,7 +154,11 @@ func (ps *RawProviderServer) getRestClient() (rest.Interface, error) {
 	if ps.clientConfig == nil {
 		return nil, fmt.Errorf("cannot create REST client: no client config")
 	}
-	restClient, err := rest.UnversionedRESTClientFor(ps.clientConfig)
+	httpClient, err := ps.getHTTPClient()
+	if err != nil {
+		return nil, err
+	}
+	restClient, err := rest.UnversionedRESTClientForConfigAndClient(ps.clientConfig, httpClient)
 	if err != nil {
 		return nil, err
 	}
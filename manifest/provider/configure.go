@@ -5,14 +5,18 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/apilogging"
 	"github.com/mitchellh/go-homedir"
 	"golang.org/x/mod/semver"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -22,6 +26,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/transport"
 )
 
 const minTFVersion string = "v0.14.8"
@@ -486,6 +491,43 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		overrides.AuthInfo.Token = token
 	}
 
+	var tokenFile string
+	if !providerConfig["token_file"].IsNull() && providerConfig["token_file"].IsKnown() {
+		err = providerConfig["token_file"].As(&tokenFile)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'token_file' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if tokenFileEnv, ok := os.LookupEnv("KUBE_TOKEN_FILE"); ok && tokenFileEnv != "" {
+		tokenFile = tokenFileEnv
+	}
+	if len(tokenFile) > 0 {
+		if len(overrides.AuthInfo.Token) > 0 {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid attribute in provider configuration",
+				Detail:   "'token_file' conflicts with 'token', only one of them can be set",
+			})
+			return response, nil
+		}
+		tokenFileAbs, err := homedir.Expand(tokenFile)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid attribute in provider configuration",
+				Detail:   fmt.Sprintf("'token_file' refers to an invalid path: %q: %v", tokenFile, err),
+			})
+			return response, nil
+		}
+		overrides.AuthInfo.TokenFile = tokenFileAbs
+	}
+
 	var proxyURL string
 	if !providerConfig["proxy_url"].IsNull() && providerConfig["proxy_url"].IsKnown() {
 		err = providerConfig["proxy_url"].As(&proxyURL)
@@ -504,6 +546,56 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		overrides.ClusterDefaults.ProxyURL = proxyURL
 	}
 
+	var tlsServerName string
+	if !providerConfig["tls_server_name"].IsNull() && providerConfig["tls_server_name"].IsKnown() {
+		err = providerConfig["tls_server_name"].As(&tlsServerName)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'tls_server_name' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if tlsServerNameEnv, ok := os.LookupEnv("KUBE_TLS_SERVER_NAME"); ok && tlsServerNameEnv != "" {
+		tlsServerName = tlsServerNameEnv
+	}
+	overrides.ClusterInfo.TLSServerName = tlsServerName
+
+	var timeoutStr string
+	if !providerConfig["timeout"].IsNull() && providerConfig["timeout"].IsKnown() {
+		err = providerConfig["timeout"].As(&timeoutStr)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'timeout' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if timeoutEnv, ok := os.LookupEnv("KUBE_TIMEOUT"); ok && timeoutEnv != "" {
+		timeoutStr = timeoutEnv
+	}
+	var timeout time.Duration
+	if len(timeoutStr) > 0 {
+		timeout, err = time.ParseDuration(timeoutStr)
+		if err == nil && timeout < 0 {
+			err = errors.New("must not be negative")
+		}
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid attribute in provider configuration",
+				Detail:   fmt.Sprintf("'timeout' is not a valid duration: %q: %v", timeoutStr, err),
+			})
+			return response, nil
+		}
+	}
+
 	if !providerConfig["exec"].IsNull() && providerConfig["exec"].IsKnown() {
 		var execBlock []tftypes.Value
 		err = providerConfig["exec"].As(&execBlock)
@@ -616,10 +708,242 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 					})
 				}
 			}
+			if !execObj["interactive_mode"].IsNull() && execObj["interactive_mode"].IsKnown() {
+				var mode string
+				err = execObj["interactive_mode"].As(&mode)
+				if err != nil {
+					// invalid attribute type - this shouldn't happen, bail out for now
+					response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider configuration: failed to assert type of 'interactive_mode' value",
+						Detail:   err.Error(),
+					})
+					return response, nil
+				}
+				switch clientcmdapi.ExecInteractiveMode(mode) {
+				case clientcmdapi.NeverExecInteractiveMode, clientcmdapi.IfAvailableExecInteractiveMode, clientcmdapi.AlwaysExecInteractiveMode:
+					execCfg.InteractiveMode = clientcmdapi.ExecInteractiveMode(mode)
+				default:
+					response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityInvalid,
+						Summary:  "Invalid attribute in provider configuration",
+						Detail:   fmt.Sprintf("'interactive_mode' must be one of Never, IfAvailable or Always, got %q", mode),
+					})
+					return response, nil
+				}
+			}
+			if !execObj["install_hint"].IsNull() && execObj["install_hint"].IsKnown() {
+				err = execObj["install_hint"].As(&execCfg.InstallHint)
+				if err != nil {
+					// invalid attribute type - this shouldn't happen, bail out for now
+					response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider configuration: failed to assert type of 'install_hint' value",
+						Detail:   err.Error(),
+					})
+					return response, nil
+				}
+			}
+			if !execObj["provide_cluster_info"].IsNull() && execObj["provide_cluster_info"].IsKnown() {
+				err = execObj["provide_cluster_info"].As(&execCfg.ProvideClusterInfo)
+				if err != nil {
+					// invalid attribute type - this shouldn't happen, bail out for now
+					response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+						Severity: tfprotov5.DiagnosticSeverityError,
+						Summary:  "Provider configuration: failed to assert type of 'provide_cluster_info' value",
+						Detail:   err.Error(),
+					})
+					return response, nil
+				}
+			}
 			overrides.AuthInfo.Exec = &execCfg
 		}
 	}
 
+	// Handle 'impersonate' block
+	//
+	var impersonate rest.ImpersonationConfig
+	if !providerConfig["impersonate"].IsNull() && providerConfig["impersonate"].IsFullyKnown() {
+		impersonate, err = expandImpersonateBlock(providerConfig["impersonate"])
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to extract 'impersonate' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	// the environment is only used when the block is not set, since a block cannot have a default
+	if impersonate.UserName == "" {
+		impersonate.UserName = os.Getenv("KUBE_AS")
+		impersonate.UID = os.Getenv("KUBE_AS_UID")
+		if groups := os.Getenv("KUBE_AS_GROUPS"); groups != "" {
+			impersonate.Groups = strings.Split(groups, ",")
+		}
+	}
+
+	// Handle 'qps', 'burst' and 'disable_client_rate_limiting' attributes
+	//
+	qps := 50.0
+	if !providerConfig["qps"].IsNull() && providerConfig["qps"].IsKnown() {
+		var v big.Float
+		err = providerConfig["qps"].As(&v)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'qps' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+		qps, _ = v.Float64()
+	}
+	if qpsEnv, ok := os.LookupEnv("KUBE_QPS"); ok && qpsEnv != "" {
+		v, err := strconv.ParseFloat(qpsEnv, 64)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid provider configuration",
+				Detail:   "Environment variable KUBE_QPS contains invalid value: " + err.Error(),
+			})
+		} else {
+			qps = v
+		}
+	}
+	if qps < 0.1 {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityInvalid,
+			Summary:  "Invalid attribute in provider configuration",
+			Detail:   fmt.Sprintf("'qps' must be at least 0.1, got %v", qps),
+		})
+	}
+	burst := int64(100)
+	if !providerConfig["burst"].IsNull() && providerConfig["burst"].IsKnown() {
+		var v big.Float
+		err = providerConfig["burst"].As(&v)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'burst' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+		burst, _ = v.Int64()
+	}
+	if burstEnv, ok := os.LookupEnv("KUBE_BURST"); ok && burstEnv != "" {
+		v, err := strconv.ParseInt(burstEnv, 10, 32)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid provider configuration",
+				Detail:   "Environment variable KUBE_BURST contains invalid value: " + err.Error(),
+			})
+		} else {
+			burst = v
+		}
+	}
+	if burst < 1 {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityInvalid,
+			Summary:  "Invalid attribute in provider configuration",
+			Detail:   fmt.Sprintf("'burst' must be at least 1, got %d", burst),
+		})
+	}
+	var disableRateLimiting bool
+	if !providerConfig["disable_client_rate_limiting"].IsNull() && providerConfig["disable_client_rate_limiting"].IsKnown() {
+		err = providerConfig["disable_client_rate_limiting"].As(&disableRateLimiting)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'disable_client_rate_limiting' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if disableEnv, ok := os.LookupEnv("KUBE_DISABLE_CLIENT_RATE_LIMITING"); ok && disableEnv != "" {
+		v, err := strconv.ParseBool(disableEnv)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid provider configuration",
+				Detail:   "Environment variable KUBE_DISABLE_CLIENT_RATE_LIMITING contains invalid value: " + err.Error(),
+			})
+		} else {
+			disableRateLimiting = v
+		}
+	}
+
+	if len(diags) > 0 {
+		response.Diagnostics = diags
+		return response, nil
+	}
+
+	// Handle 'log_api_requests' attribute
+	//
+	var logAPIRequests bool
+	if !providerConfig["log_api_requests"].IsNull() && providerConfig["log_api_requests"].IsKnown() {
+		err = providerConfig["log_api_requests"].As(&logAPIRequests)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'log_api_requests' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if logAPIRequestsEnv, ok := os.LookupEnv("KUBE_LOG_API_REQUESTS"); ok && logAPIRequestsEnv != "" {
+		v, err := strconv.ParseBool(logAPIRequestsEnv)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid provider configuration",
+				Detail:   "Environment variable KUBE_LOG_API_REQUESTS contains invalid value: " + err.Error(),
+			})
+			return response, nil
+		}
+		logAPIRequests = v
+	}
+
+	// Handle 'expect_kubernetes_version' attribute
+	//
+	var expectVersion string
+	if !providerConfig["expect_kubernetes_version"].IsNull() && providerConfig["expect_kubernetes_version"].IsKnown() {
+		err = providerConfig["expect_kubernetes_version"].As(&expectVersion)
+		if err != nil {
+			// invalid attribute type - this shouldn't happen, bail out for now
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Provider configuration: failed to assert type of 'expect_kubernetes_version' value",
+				Detail:   err.Error(),
+			})
+			return response, nil
+		}
+	}
+	if expectVersionEnv, ok := os.LookupEnv("KUBE_EXPECT_KUBERNETES_VERSION"); ok && expectVersionEnv != "" {
+		expectVersion = expectVersionEnv
+	}
+	s.expectedVersion = nil
+	if len(expectVersion) > 0 {
+		s.expectedVersion, err = gversion.NewConstraint(expectVersion)
+		if err != nil {
+			response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityInvalid,
+				Summary:  "Invalid attribute in provider configuration",
+				Detail:   fmt.Sprintf("'expect_kubernetes_version' is not a valid version constraint: %q: %v", expectVersion, err),
+			})
+			return response, nil
+		}
+	}
+
 	cc := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loader, overrides)
 	clientConfig, err := cc.ClientConfig()
 	if err != nil {
@@ -636,8 +960,44 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 		return response, nil
 	}
 
+	// The in-cluster configuration only takes the host, token and CA from the
+	// overrides, so the transport settings and the impersonation are applied
+	// to the result as well.
+	if impersonate.UserName != "" {
+		clientConfig.Impersonate = impersonate
+	}
+	if tlsServerName != "" {
+		clientConfig.TLSClientConfig.ServerName = tlsServerName
+	}
+	if timeout > 0 {
+		clientConfig.Timeout = timeout
+	}
+
+	if clientConfig.BearerTokenFile != "" {
+		// client-go only reloads a token file once a minute. Reading it through
+		// a resettable token source also reloads it after an unauthorized
+		// response, so applies outliving a rotated token recover immediately.
+		ts := transport.NewCachedFileTokenSource(clientConfig.BearerTokenFile)
+		clientConfig.Wrap(transport.ResettableTokenSourceWrapTransport(ts))
+		clientConfig.BearerToken = ""
+		clientConfig.BearerTokenFile = ""
+	}
+
+	// The clients of the kubernetes_manifest resource are rate limited like the
+	// ones of the other resources.
+	if disableRateLimiting {
+		clientConfig.QPS = -1
+		clientConfig.Burst = 0
+		s.logger.Trace("[Configure]", "Client-side rate limiting of Kubernetes API requests is disabled")
+	} else {
+		clientConfig.QPS = float32(qps)
+		clientConfig.Burst = int(burst)
+		s.logger.Trace("[Configure]", "Rate limiting Kubernetes API requests", "qps", clientConfig.QPS, "burst", clientConfig.Burst)
+	}
+
+	clientConfig.Wrap(apilogging.NewTransport(logAPIRequests))
 	if s.logger.IsTrace() {
-		clientConfig.WrapTransport = loggingTransport
+		clientConfig.Wrap(loggingTransport)
 	}
 
 	codec := runtime.NoopEncoder{Decoder: scheme.Codecs.UniversalDecoder()}
@@ -649,6 +1009,83 @@ func (s *RawProviderServer) ConfigureProvider(ctx context.Context, req *tfprotov
 	return response, nil
 }
 
+// expandImpersonateBlock extracts the impersonation settings from the
+// 'impersonate' block, which holds at most one element.
+func expandImpersonateBlock(v tftypes.Value) (rest.ImpersonationConfig, error) {
+	c := rest.ImpersonationConfig{}
+	var block []tftypes.Value
+	if err := v.As(&block); err != nil {
+		return c, err
+	}
+	if len(block) == 0 {
+		return c, nil
+	}
+	var obj map[string]tftypes.Value
+	if err := block[0].As(&obj); err != nil {
+		return c, err
+	}
+	if !obj["user_name"].IsNull() {
+		if err := obj["user_name"].As(&c.UserName); err != nil {
+			return c, err
+		}
+	}
+	if !obj["uid"].IsNull() {
+		if err := obj["uid"].As(&c.UID); err != nil {
+			return c, err
+		}
+	}
+	groups, err := stringListValue(obj["groups"])
+	if err != nil {
+		return c, err
+	}
+	c.Groups = groups
+	if !obj["extra"].IsNull() {
+		var extras []tftypes.Value
+		if err := obj["extra"].As(&extras); err != nil {
+			return c, err
+		}
+		for _, e := range extras {
+			var extra map[string]tftypes.Value
+			if err := e.As(&extra); err != nil {
+				return c, err
+			}
+			var key string
+			if err := extra["key"].As(&key); err != nil {
+				return c, err
+			}
+			values, err := stringListValue(extra["values"])
+			if err != nil {
+				return c, err
+			}
+			if c.Extra == nil {
+				c.Extra = map[string][]string{}
+			}
+			c.Extra[key] = append(c.Extra[key], values...)
+		}
+	}
+	return c, nil
+}
+
+// stringListValue converts a list of strings, a null list is empty.
+func stringListValue(v tftypes.Value) ([]string, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(elems))
+	for _, e := range elems {
+		var s string
+		if err := e.As(&s); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, nil
+}
+
 func (s *RawProviderServer) canExecute() (resp []*tfprotov5.Diagnostic) {
 	if !s.providerEnabled {
 		resp = append(resp, &tfprotov5.Diagnostic{
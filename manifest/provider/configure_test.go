@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// unsetKubeEnv clears the KUBE_ environment variables read by the provider
+// for the duration of the test.
+func unsetKubeEnv(t *testing.T) {
+	for _, ev := range []string{"KUBE_CONFIG_PATH", "KUBE_CONFIG_PATHS", "KUBE_HOST", "KUBE_INSECURE", "KUBE_TOKEN", "KUBE_TOKEN_FILE",
+		"KUBE_QPS", "KUBE_BURST", "KUBE_DISABLE_CLIENT_RATE_LIMITING", "KUBE_AS", "KUBE_AS_GROUPS", "KUBE_AS_UID",
+		"KUBE_EXPECT_KUBERNETES_VERSION", "KUBE_TLS_SERVER_NAME", "KUBE_TIMEOUT"} {
+		t.Setenv(ev, "")
+	}
+}
+
+// configureTestProvider configures a provider server with the given
+// attributes, the others are null.
+func configureTestProvider(t *testing.T, attrs map[string]tftypes.Value) (*RawProviderServer, []*tfprotov5.Diagnostic) {
+	cfgType := GetObjectTypeFromSchema(GetProviderConfigSchema()).(tftypes.Object)
+	vals := map[string]tftypes.Value{}
+	for name, typ := range cfgType.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			vals[name] = v
+			continue
+		}
+		vals[name] = tftypes.NewValue(typ, nil)
+	}
+	cfg, err := tfprotov5.NewDynamicValue(cfgType, tftypes.NewValue(cfgType, vals))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := &RawProviderServer{logger: hclog.NewNullLogger()}
+	resp, err := s.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{
+		TerraformVersion: "1.5.0",
+		Config:           &cfg,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s, resp.Diagnostics
+}
+
+func TestConfigureProvider_rateLimiting(t *testing.T) {
+	samples := map[string]struct {
+		attrs         map[string]tftypes.Value
+		expectedQPS   float32
+		expectedBurst int
+		expectedError bool
+	}{
+		"defaults": {
+			attrs:         map[string]tftypes.Value{},
+			expectedQPS:   50,
+			expectedBurst: 100,
+		},
+		"configured": {
+			attrs: map[string]tftypes.Value{
+				"qps":   tftypes.NewValue(tftypes.Number, big.NewFloat(20)),
+				"burst": tftypes.NewValue(tftypes.Number, big.NewFloat(40)),
+			},
+			expectedQPS:   20,
+			expectedBurst: 40,
+		},
+		"disabled": {
+			attrs: map[string]tftypes.Value{
+				"qps":                          tftypes.NewValue(tftypes.Number, big.NewFloat(20)),
+				"disable_client_rate_limiting": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectedQPS:   -1,
+			expectedBurst: 0,
+		},
+		"invalid burst": {
+			attrs: map[string]tftypes.Value{
+				"burst": tftypes.NewValue(tftypes.Number, big.NewFloat(0)),
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			unsetKubeEnv(t)
+			s.attrs["host"] = tftypes.NewValue(tftypes.String, "https://127.0.0.1")
+			ps, diags := configureTestProvider(t, s.attrs)
+			if s.expectedError {
+				if len(diags) == 0 {
+					t.Fatal("Expected a diagnostic")
+				}
+				return
+			}
+			if len(diags) > 0 {
+				t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+			}
+			if ps.clientConfig.QPS != s.expectedQPS || ps.clientConfig.Burst != s.expectedBurst {
+				t.Errorf("Expected %v QPS with a burst of %d, got %v QPS with a burst of %d",
+					s.expectedQPS, s.expectedBurst, ps.clientConfig.QPS, ps.clientConfig.Burst)
+			}
+		})
+	}
+}
+
+func TestConfigureProvider_impersonate(t *testing.T) {
+	cfgType := GetObjectTypeFromSchema(GetProviderConfigSchema()).(tftypes.Object)
+	blockType := cfgType.AttributeTypes["impersonate"].(tftypes.List)
+	objType := blockType.ElementType.(tftypes.Object)
+	extraType := objType.AttributeTypes["extra"].(tftypes.List)
+	stringList := func(s ...string) tftypes.Value {
+		vals := []tftypes.Value{}
+		for _, v := range s {
+			vals = append(vals, tftypes.NewValue(tftypes.String, v))
+		}
+		return tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, vals)
+	}
+
+	unsetKubeEnv(t)
+	ps, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"host": tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+		"impersonate": tftypes.NewValue(blockType, []tftypes.Value{
+			tftypes.NewValue(objType, map[string]tftypes.Value{
+				"user_name": tftypes.NewValue(tftypes.String, "jane"),
+				"groups":    stringList("developers"),
+				"uid":       tftypes.NewValue(tftypes.String, nil),
+				"extra": tftypes.NewValue(extraType, []tftypes.Value{
+					tftypes.NewValue(extraType.ElementType, map[string]tftypes.Value{
+						"key":    tftypes.NewValue(tftypes.String, "scopes"),
+						"values": stringList("view", "edit"),
+					}),
+				}),
+			}),
+		}),
+	})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+	}
+	expected := rest.ImpersonationConfig{
+		UserName: "jane",
+		Groups:   []string{"developers"},
+		Extra:    map[string][]string{"scopes": {"view", "edit"}},
+	}
+	if diff := cmp.Diff(expected, ps.clientConfig.Impersonate); diff != "" {
+		t.Errorf("Unexpected impersonation (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfigureProvider_impersonateEnv(t *testing.T) {
+	unsetKubeEnv(t)
+	t.Setenv("KUBE_AS", "jane")
+	t.Setenv("KUBE_AS_GROUPS", "developers,admins")
+
+	ps, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"host": tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+	})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+	}
+	expected := rest.ImpersonationConfig{
+		UserName: "jane",
+		Groups:   []string{"developers", "admins"},
+	}
+	if diff := cmp.Diff(expected, ps.clientConfig.Impersonate); diff != "" {
+		t.Errorf("Unexpected impersonation (-want +got):\n%s", diff)
+	}
+}
+
+// recordingRoundTripper records the Authorization headers of the requests and
+// answers them as unauthorized.
+type recordingRoundTripper struct {
+	authorization []string
+}
+
+func (rt *recordingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.authorization = append(rt.authorization, req.Header.Get("Authorization"))
+	return &http.Response{StatusCode: http.StatusUnauthorized, Body: http.NoBody, Request: req}, nil
+}
+
+func TestConfigureProvider_tokenFileRotation(t *testing.T) {
+	unsetKubeEnv(t)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("first-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	ps, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"host":       tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+		"insecure":   tftypes.NewValue(tftypes.Bool, true),
+		"token_file": tftypes.NewValue(tftypes.String, tokenFile),
+	})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+	}
+
+	recorder := &recordingRoundTripper{}
+	rt, err := rest.HTTPWrappersForConfig(ps.clientConfig, recorder)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodGet, "https://127.0.0.1/api", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// An unauthorized response to a request made after the token was read
+	// drops the cached token, so the next request reads the rewritten file.
+	for i := 0; i < 2; i++ {
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(tokenFile, []byte("second-token"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"Bearer first-token", "Bearer first-token", "Bearer second-token"}
+	if diff := cmp.Diff(expected, recorder.authorization); diff != "" {
+		t.Fatalf("Unexpected Authorization headers (-want +got):\n%s", diff)
+	}
+}
+
+func TestConfigureProvider_exec(t *testing.T) {
+	unsetKubeEnv(t)
+	cfgType := GetObjectTypeFromSchema(GetProviderConfigSchema()).(tftypes.Object)
+	blockType := cfgType.AttributeTypes["exec"].(tftypes.List)
+	objType := blockType.ElementType.(tftypes.Object)
+
+	ps, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"host": tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+		"exec": tftypes.NewValue(blockType, []tftypes.Value{
+			tftypes.NewValue(objType, map[string]tftypes.Value{
+				"api_version":          tftypes.NewValue(tftypes.String, "client.authentication.k8s.io/v1"),
+				"command":              tftypes.NewValue(tftypes.String, "credential-plugin"),
+				"args":                 tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"env":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"interactive_mode":     tftypes.NewValue(tftypes.String, "Never"),
+				"install_hint":         tftypes.NewValue(tftypes.String, "Install the plugin"),
+				"provide_cluster_info": tftypes.NewValue(tftypes.Bool, true),
+			}),
+		}),
+	})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+	}
+	ec := ps.clientConfig.ExecProvider
+	if ec == nil {
+		t.Fatal("Expected an exec provider")
+	}
+	if ec.InteractiveMode != clientcmdapi.NeverExecInteractiveMode {
+		t.Errorf("Expected interactive mode %q, got %q", clientcmdapi.NeverExecInteractiveMode, ec.InteractiveMode)
+	}
+	if ec.InstallHint != "Install the plugin" {
+		t.Errorf("Expected install hint %q, got %q", "Install the plugin", ec.InstallHint)
+	}
+	if !ec.ProvideClusterInfo {
+		t.Error("Expected the cluster information to be provided")
+	}
+}
+
+func TestConfigureProvider_expectKubernetesVersion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"major":"1","minor":"27","gitVersion":"v1.27.3-eks-a5565ad"}`))
+	}))
+	defer server.Close()
+
+	samples := map[string]struct {
+		constraint    string
+		expectedError string
+	}{
+		"satisfied":   {constraint: ">= 1.26"},
+		"unsatisfied": {constraint: ">= 1.28", expectedError: "Cluster reports v1.27.3-eks-a5565ad which does not satisfy >= 1.28"},
+	}
+	for name, s := range samples {
+		t.Run(name, func(t *testing.T) {
+			unsetKubeEnv(t)
+			ps, diags := configureTestProvider(t, map[string]tftypes.Value{
+				"host":                      tftypes.NewValue(tftypes.String, server.URL),
+				"expect_kubernetes_version": tftypes.NewValue(tftypes.String, s.constraint),
+			})
+			if len(diags) > 0 {
+				t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+			}
+			_, err := ps.getDynamicClient()
+			if s.expectedError == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), s.expectedError) {
+				t.Fatalf("Expected error %q, got %v", s.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestConfigureProvider_invalidExpectKubernetesVersion(t *testing.T) {
+	unsetKubeEnv(t)
+	_, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"host":                      tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+		"expect_kubernetes_version": tftypes.NewValue(tftypes.String, "not a version"),
+	})
+	if len(diags) == 0 {
+		t.Fatal("Expected a diagnostic")
+	}
+}
+
+func TestConfigureProvider_transport(t *testing.T) {
+	unsetKubeEnv(t)
+	ps, diags := configureTestProvider(t, map[string]tftypes.Value{
+		"host":            tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+		"tls_server_name": tftypes.NewValue(tftypes.String, "kubernetes.default.svc"),
+		"timeout":         tftypes.NewValue(tftypes.String, "30s"),
+	})
+	if len(diags) > 0 {
+		t.Fatalf("Unexpected diagnostics: %s", diags[0].Detail)
+	}
+	if ps.clientConfig.TLSClientConfig.ServerName != "kubernetes.default.svc" {
+		t.Errorf("Expected server name %q, got %q", "kubernetes.default.svc", ps.clientConfig.TLSClientConfig.ServerName)
+	}
+	if ps.clientConfig.Timeout != 30*time.Second {
+		t.Errorf("Expected a timeout of 30s, got %s", ps.clientConfig.Timeout)
+	}
+
+	_, diags = configureTestProvider(t, map[string]tftypes.Value{
+		"host":    tftypes.NewValue(tftypes.String, "https://127.0.0.1"),
+		"timeout": tftypes.NewValue(tftypes.String, "-1s"),
+	})
+	if len(diags) == 0 {
+		t.Fatal("Expected a diagnostic for a negative timeout")
+	}
+}
@@ -14,8 +14,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
 )
 
+// dataSourceListPageSize is the number of objects requested per page when
+// listing objects for the kubernetes_resources data source.
+const dataSourceListPageSize = 500
+
 // ReadDataSource function
 func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
 	s.logger.Trace("[ReadDataSource][Request]\n%s\n", dump(*req))
@@ -115,6 +120,10 @@ func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.R
 		return resp, nil
 	}
 
+	if req.TypeName == "kubernetes_resources" {
+		return s.readResourcesDataSource(ctx, resp, rt, config, rcl, ns, objectType, th)
+	}
+
 	var metadataBlock []tftypes.Value
 	dsConfig["metadata"].As(&metadataBlock)
 
@@ -137,7 +146,7 @@ func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.R
 	}
 	if err != nil {
 		if apierrors.IsNotFound(err) {
-			return resp, nil
+			return s.setDataSourceState(resp, rt, config, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}), false)
 		}
 		d := tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -168,8 +177,14 @@ func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.R
 		})
 		return resp, nil
 	}
+	return s.setDataSourceState(resp, rt, config, morph.UnknownToNull(nobj), true)
+}
+
+// setDataSourceState sets the state of the kubernetes_resource data source to
+// its configuration along with the object read from the API server.
+func (s *RawProviderServer) setDataSourceState(resp *tfprotov5.ReadDataSourceResponse, rt tftypes.Type, config tftypes.Value, object tftypes.Value, found bool) (*tfprotov5.ReadDataSourceResponse, error) {
 	rawState := make(map[string]tftypes.Value)
-	err = config.As(&rawState)
+	err := config.As(&rawState)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
 			Severity: tfprotov5.DiagnosticSeverityError,
@@ -178,7 +193,109 @@ func (s *RawProviderServer) ReadDataSource(ctx context.Context, req *tfprotov5.R
 		})
 		return resp, nil
 	}
-	rawState["object"] = morph.UnknownToNull(nobj)
+	rawState["object"] = object
+	rawState["found"] = tftypes.NewValue(tftypes.Bool, found)
+
+	v := tftypes.NewValue(rt, rawState)
+	state, err := tfprotov5.NewDynamicValue(v.Type(), v)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to save resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+	resp.State = &state
+	return resp, nil
+}
+
+// readResourcesDataSource lists all objects of the configured kind, following
+// continue tokens until the API server has returned the complete list.
+func (s *RawProviderServer) readResourcesDataSource(ctx context.Context, resp *tfprotov5.ReadDataSourceResponse, rt tftypes.Type, config tftypes.Value, rcl dynamic.NamespaceableResourceInterface, namespaced bool, objectType tftypes.Type, th map[string]string) (*tfprotov5.ReadDataSourceResponse, error) {
+	rawState := make(map[string]tftypes.Value)
+	err := config.As(&rawState)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to save resource state",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
+
+	var namespace, labelSelector, fieldSelector string
+	rawState["namespace"].As(&namespace)
+	rawState["label_selector"].As(&labelSelector)
+	rawState["field_selector"].As(&fieldSelector)
+
+	var lister dynamic.ResourceInterface = rcl
+	if namespaced {
+		lister = rcl.Namespace(namespace)
+	} else if namespace != "" {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Invalid namespace for cluster scoped resource",
+			Detail:    "The requested kind is not namespaced, remove the namespace attribute.",
+			Attribute: tftypes.NewAttributePath().WithAttributeName("namespace"),
+		})
+		return resp, nil
+	}
+
+	var items []unstructured.Unstructured
+	opts := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: fieldSelector,
+		Limit:         dataSourceListPageSize,
+	}
+	for {
+		list, err := lister.List(ctx, opts)
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to list data source",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+		items = append(items, list.Items...)
+		opts.Continue = list.GetContinue()
+		if opts.Continue == "" {
+			break
+		}
+	}
+
+	objects := make([]tftypes.Value, 0, len(items))
+	objectTypes := make([]tftypes.Type, 0, len(items))
+	names := make([]tftypes.Value, 0, len(items))
+	for i := range items {
+		name := items[i].GetName()
+		fo := RemoveServerSideFields(items[i].Object)
+		nobj, err := payload.ToTFValue(fo, objectType, th, tftypes.NewAttributePath())
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Failed to convert API response for %q to Terraform value type", name),
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+		nobj, err = morph.DeepUnknown(objectType, nobj, tftypes.NewAttributePath())
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  "Failed to save resource state",
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+		nobj = morph.UnknownToNull(nobj)
+		objects = append(objects, nobj)
+		objectTypes = append(objectTypes, nobj.Type())
+		names = append(names, tftypes.NewValue(tftypes.String, name))
+	}
+	rawState["objects"] = tftypes.NewValue(tftypes.Tuple{ElementTypes: objectTypes}, objects)
+	rawState["names"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, names)
 
 	v := tftypes.NewValue(rt, rawState)
 	state, err := tfprotov5.NewDynamicValue(v.Type(), v)
@@ -201,6 +318,9 @@ func getGVR(apiVersion, kind string, m meta.RESTMapper) (schema.GroupVersionReso
 	}
 	mapping, err := m.RESTMapping(gv.WithKind(kind).GroupKind(), gv.Version)
 	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return schema.GroupVersionResource{}, fmt.Errorf("the API server does not serve kind %q in %q, check the api_version and kind and that any required CustomResourceDefinition is installed: %w", kind, apiVersion, err)
+		}
 		return schema.GroupVersionResource{}, err
 	}
 	return mapping.Resource, err
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestGetGVR(t *testing.T) {
+	gv := schema.GroupVersion{Group: "example.com", Version: "v1"}
+	rm := meta.NewDefaultRESTMapper([]schema.GroupVersion{gv})
+	rm.Add(gv.WithKind("Widget"), meta.RESTScopeNamespace)
+
+	gvr, err := getGVR("example.com/v1", "Widget", rm)
+	if err != nil {
+		t.Fatalf("Expected Widget to be mapped: %s", err)
+	}
+	if gvr.Resource != "widgets" {
+		t.Errorf("Expected resource widgets, got %q", gvr.Resource)
+	}
+
+	_, err = getGVR("example.com/v1", "Gadget", rm)
+	if err == nil {
+		t.Fatal("Expected an error for an unknown kind")
+	}
+	if !meta.IsNoMatchError(err) {
+		t.Errorf("Expected the no match error to be wrapped, got %#v", err)
+	}
+	if !strings.Contains(err.Error(), `does not serve kind "Gadget" in "example.com/v1"`) {
+		t.Errorf("Expected error to name the unknown kind, got %q", err)
+	}
+}
+
+func TestGetDataSourceTypeResources(t *testing.T) {
+	rt, err := GetDataSourceType("kubernetes_resources")
+	if err != nil {
+		t.Fatal(err)
+	}
+	attrs := rt.(tftypes.Object).AttributeTypes
+	for _, name := range []string{"api_version", "kind", "namespace", "label_selector", "field_selector", "objects", "names"} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("Expected attribute %q in data source type %s", name, rt)
+		}
+	}
+}
+
+func TestSetDataSourceStateNotFound(t *testing.T) {
+	rt, err := GetDataSourceType("kubernetes_resource")
+	if err != nil {
+		t.Fatal(err)
+	}
+	metadataType := rt.(tftypes.Object).AttributeTypes["metadata"]
+	config := tftypes.NewValue(rt, map[string]tftypes.Value{
+		"api_version": tftypes.NewValue(tftypes.String, "v1"),
+		"kind":        tftypes.NewValue(tftypes.String, "ConfigMap"),
+		"object":      tftypes.NewValue(tftypes.DynamicPseudoType, nil),
+		"found":       tftypes.NewValue(tftypes.Bool, nil),
+		"metadata": tftypes.NewValue(metadataType, []tftypes.Value{
+			tftypes.NewValue(metadataType.(tftypes.List).ElementType, map[string]tftypes.Value{
+				"name":      tftypes.NewValue(tftypes.String, "missing"),
+				"namespace": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	})
+
+	s := &RawProviderServer{}
+	resp, err := s.setDataSourceState(&tfprotov5.ReadDataSourceResponse{}, rt, config, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	state, err := resp.State.Unmarshal(rt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := state.As(&attrs); err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	if err := attrs["found"].As(&found); err != nil {
+		t.Fatal(err)
+	}
+	if found {
+		t.Error("Expected found to be false")
+	}
+	if attrs["object"].IsNull() || !attrs["object"].Type().Is(tftypes.Object{}) {
+		t.Errorf("Expected object to be an empty object, got %s", attrs["object"])
+	}
+}
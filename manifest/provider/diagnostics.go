@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-provider-kubernetes/internal/ssa"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -32,3 +33,31 @@ func APIStatusErrorToDiagnostics(s metav1.Status) []*tfprotov5.Diagnostic {
 	}
 	return diags
 }
+
+// APIConflictErrorToDiagnostics converts a server-side apply conflict into Terraform Diagnostics,
+// with one diagnostic for each conflicting field naming the manager which owns it
+func APIConflictErrorToDiagnostics(s metav1.Status, resourceName string, fieldManager string) []*tfprotov5.Diagnostic {
+	var diags []*tfprotov5.Diagnostic
+	for _, c := range ssa.FieldConflicts(s) {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("There was a field manager conflict when trying to apply the manifest for %q", resourceName),
+			Detail: fmt.Sprintf("The field %q is managed by %s, so it cannot be changed by field manager %q.\n\n"+
+				"You can override this conflict by setting \"force_conflicts\" to true in the \"field_manager\" block, "+
+				"or by setting the \"name\" in the \"field_manager\" block to the manager which owns the field.",
+				c.Field, c.Owner(), fieldManager),
+		})
+	}
+	if len(diags) == 0 {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  fmt.Sprintf("There was a field manager conflict when trying to apply the manifest for %q", resourceName),
+			Detail: fmt.Sprintf(
+				"The API returned the following conflict: %q\n\n"+
+					"You can override this conflict by setting \"force_conflicts\" to true in the \"field_manager\" block.",
+				s.Message,
+			),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAPIConflictErrorToDiagnostics(t *testing.T) {
+	status := metav1.Status{
+		Status:  metav1.StatusFailure,
+		Reason:  metav1.StatusReasonConflict,
+		Message: "Apply failed with 2 conflicts",
+		Details: &metav1.StatusDetails{
+			Name: "test",
+			Kind: "configmaps",
+			Causes: []metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "kubectl-edit" using v1`,
+					Field:   ".data.foo",
+				},
+				{
+					Type:    metav1.CauseTypeFieldManagerConflict,
+					Message: `conflict with "helm"`,
+					Field:   ".metadata.labels.app",
+				},
+			},
+		},
+	}
+
+	diags := APIConflictErrorToDiagnostics(status, "test", "Terraform")
+	if len(diags) != 2 {
+		t.Fatalf("Expected 2 diagnostics, got %d", len(diags))
+	}
+	expected := []string{
+		`The field ".data.foo" is managed by field manager "kubectl-edit"`,
+		`The field ".metadata.labels.app" is managed by field manager "helm"`,
+	}
+	for i, d := range diags {
+		if !strings.Contains(d.Detail, expected[i]) {
+			t.Errorf("Expected diagnostic %d to contain %q, got %q", i, expected[i], d.Detail)
+		}
+	}
+
+	status.Details.Causes = nil
+	diags = APIConflictErrorToDiagnostics(status, "test", "Terraform")
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "Apply failed with 2 conflicts") {
+		t.Fatalf("Expected a single diagnostic with the API message, got %#v", diags)
+	}
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// IgnoredField is a path of the ignore_fields attribute. Steps which are nil
+// stand for the [*] wildcard and match any element of a list or map.
+type IgnoredField struct {
+	path  string
+	steps []tftypes.AttributePathStep
+}
+
+// ParseIgnoredField parses a field path in dot/square bracket notation, in
+// which the [*] wildcard can be used in place of an index
+func ParseIgnoredField(fieldPath string) (IgnoredField, error) {
+	atp, err := FieldPathToTftypesPath(strings.ReplaceAll(fieldPath, "[*]", `["*"]`))
+	if err != nil {
+		return IgnoredField{}, err
+	}
+	steps := atp.Steps()
+	for i, s := range steps {
+		if s.Equal(tftypes.ElementKeyString("*")) {
+			steps[i] = nil
+		}
+	}
+	return IgnoredField{path: fieldPath, steps: steps}, nil
+}
+
+// Matches reports whether the attribute path is matched by the field path
+func (f IgnoredField) Matches(ap *tftypes.AttributePath) bool {
+	steps := ap.Steps()
+	if len(steps) != len(f.steps) {
+		return false
+	}
+	for i, s := range f.steps {
+		if s == nil {
+			switch steps[i].(type) {
+			case tftypes.ElementKeyInt, tftypes.ElementKeyString, tftypes.ElementKeyValue:
+				continue
+			}
+			return false
+		}
+		if s.Equal(steps[i]) {
+			continue
+		}
+		// map keys can be written with either notation
+		k1, ok1 := stepKey(s)
+		k2, ok2 := stepKey(steps[i])
+		if !ok1 || !ok2 || k1 != k2 {
+			return false
+		}
+	}
+	return true
+}
+
+func stepKey(s tftypes.AttributePathStep) (string, bool) {
+	switch k := s.(type) {
+	case tftypes.AttributeName:
+		return string(k), true
+	case tftypes.ElementKeyString:
+		return string(k), true
+	}
+	return "", false
+}
+
+// getIgnoredFields extracts the ignore_fields configuration of the resource
+func getIgnoredFields(v map[string]tftypes.Value) ([]IgnoredField, []*tfprotov5.Diagnostic) {
+	var fields []IgnoredField
+	var diags []*tfprotov5.Diagnostic
+	ifVal, ok := v["ignore_fields"]
+	if !ok || ifVal.IsNull() || !ifVal.IsKnown() {
+		return fields, diags
+	}
+	var elems []tftypes.Value
+	ifVal.As(&elems)
+	for _, e := range elems {
+		if e.IsNull() || !e.IsKnown() {
+			continue
+		}
+		var vs string
+		e.As(&vs)
+		f, err := ParseIgnoredField(vs)
+		if err != nil {
+			diags = append(diags, &tfprotov5.Diagnostic{
+				Severity:  tfprotov5.DiagnosticSeverityError,
+				Summary:   "[ignore_fields] cannot parse field path element: " + vs,
+				Detail:    err.Error(),
+				Attribute: tftypes.NewAttributePath().WithAttributeName("ignore_fields"),
+			})
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields, diags
+}
+
+// isIgnoredField reports whether the attribute path is matched by any of the ignored fields
+func isIgnoredField(fields []IgnoredField, ap *tftypes.AttributePath) bool {
+	for _, f := range fields {
+		if f.Matches(ap) {
+			return true
+		}
+	}
+	return false
+}
+
+// nullIgnoredFields sets the values of the ignored fields to null, so they
+// never differ between the state and the plan
+func nullIgnoredFields(v tftypes.Value, fields []IgnoredField) (tftypes.Value, error) {
+	if len(fields) == 0 {
+		return v, nil
+	}
+	return tftypes.Transform(v, func(ap *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		if isIgnoredField(fields, ap) {
+			return tftypes.NewValue(v.Type(), nil), nil
+		}
+		return v, nil
+	})
+}
+
+// typeHasIgnoredField reports whether the field path can be resolved
+// against the type, without requiring a value.
+func typeHasIgnoredField(t tftypes.Type, f IgnoredField) bool {
+	for _, step := range f.steps {
+		if t.Is(tftypes.DynamicPseudoType) {
+			return true
+		}
+		if step != nil {
+			var ok bool
+			t, ok = attributePathStepType(t, step)
+			if !ok {
+				return false
+			}
+			continue
+		}
+		switch tt := t.(type) {
+		case tftypes.List:
+			t = tt.ElementType
+		case tftypes.Set:
+			t = tt.ElementType
+		case tftypes.Map:
+			t = tt.ElementType
+		case tftypes.Tuple:
+			if len(tt.ElementTypes) == 0 {
+				return false
+			}
+			t = tt.ElementTypes[0]
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// copyIgnoredFields replaces the values of the ignored fields in the payload
+// dst with the ones of the object src, removing the fields src does not have.
+// Elements of lists are only matched by position, and never added or removed.
+func copyIgnoredFields(dst, src map[string]interface{}, fields []IgnoredField) {
+	for _, f := range fields {
+		copyIgnoredValue(dst, src, f.steps)
+	}
+}
+
+func copyIgnoredValue(dst, src interface{}, steps []tftypes.AttributePathStep) interface{} {
+	if len(steps) == 0 {
+		return src
+	}
+	switch d := dst.(type) {
+	case map[string]interface{}:
+		s, _ := src.(map[string]interface{})
+		for _, k := range ignoredMapKeys(d, s, steps[0]) {
+			v := copyIgnoredValue(d[k], s[k], steps[1:])
+			if v == nil {
+				delete(d, k)
+			} else {
+				d[k] = v
+			}
+		}
+		return d
+	case []interface{}:
+		s, _ := src.([]interface{})
+		for i := range d {
+			if k, ok := steps[0].(tftypes.ElementKeyInt); ok && int(k) != i {
+				continue
+			}
+			var sv interface{}
+			if i < len(s) {
+				sv = s[i]
+			}
+			if v := copyIgnoredValue(d[i], sv, steps[1:]); v != nil {
+				d[i] = v
+			}
+		}
+		return d
+	case nil:
+		// the payload does not have the parent of the field
+		s, ok := src.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		m := map[string]interface{}{}
+		for _, k := range ignoredMapKeys(m, s, steps[0]) {
+			if v := copyIgnoredValue(nil, s[k], steps[1:]); v != nil {
+				m[k] = v
+			}
+		}
+		if len(m) == 0 {
+			return nil
+		}
+		return m
+	}
+	return dst
+}
+
+// ignoredMapKeys returns the keys of dst and src matched by the step
+func ignoredMapKeys(dst, src map[string]interface{}, step tftypes.AttributePathStep) []string {
+	if step != nil {
+		k, ok := stepKey(step)
+		if !ok {
+			return nil
+		}
+		return []string{k}
+	}
+	var keys []string
+	for k := range dst {
+		keys = append(keys, k)
+	}
+	for k := range src {
+		if _, ok := dst[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	return keys
+}
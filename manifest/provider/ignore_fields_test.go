@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIgnoredFieldMatches(t *testing.T) {
+	samples := []struct {
+		field    string
+		path     *tftypes.AttributePath
+		expected bool
+	}{
+		{
+			"spec.replicas",
+			tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("replicas"),
+			true,
+		},
+		{
+			"spec.containers[*].image",
+			tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("containers").WithElementKeyInt(2).WithAttributeName("image"),
+			true,
+		},
+		{
+			"spec.containers[1].image",
+			tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("containers").WithElementKeyInt(2).WithAttributeName("image"),
+			false,
+		},
+		{
+			"metadata.annotations.owner",
+			tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("annotations").WithElementKeyString("owner"),
+			true,
+		},
+		{
+			"metadata.annotations[\"example.com/owner\"]",
+			tftypes.NewAttributePath().WithAttributeName("metadata").WithAttributeName("annotations").WithElementKeyString("example.com/owner"),
+			true,
+		},
+		{
+			"spec[*]",
+			tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("replicas"),
+			false,
+		},
+		{
+			"spec.containers[*].image",
+			tftypes.NewAttributePath().WithAttributeName("spec").WithAttributeName("containers"),
+			false,
+		},
+	}
+
+	for _, s := range samples {
+		f, err := ParseIgnoredField(s.field)
+		if err != nil {
+			t.Fatalf("Failed to parse field path %q: %s", s.field, err)
+		}
+		if f.Matches(s.path) != s.expected {
+			t.Errorf("Expected field path %q to match %s: %t", s.field, s.path, s.expected)
+		}
+	}
+}
+
+func TestTypeHasIgnoredField(t *testing.T) {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"metadata": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"annotations": tftypes.Map{ElementType: tftypes.String},
+				},
+			},
+			"spec": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"replicas": tftypes.Number,
+					"containers": tftypes.List{ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"image": tftypes.String,
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	samples := map[string]bool{
+		"spec.replicas":             true,
+		"spec.containers[*].image":  true,
+		"spec.containers[0].image":  true,
+		"metadata.annotations[*]":   true,
+		"spec.containers[*].images": false,
+		"spec[*]":                   false,
+		"spec.replicas[*]":          false,
+	}
+
+	for fp, expected := range samples {
+		f, err := ParseIgnoredField(fp)
+		if err != nil {
+			t.Fatalf("Failed to parse field path %q: %s", fp, err)
+		}
+		if typeHasIgnoredField(objectType, f) != expected {
+			t.Errorf("Expected field path %q to be valid: %t", fp, expected)
+		}
+	}
+}
+
+func TestCopyIgnoredFields(t *testing.T) {
+	dst := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"replicas": 3,
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:1"},
+				map[string]interface{}{"name": "sidecar", "image": "sidecar:1"},
+			},
+		},
+		"metadata": map[string]interface{}{
+			"name": "example",
+		},
+	}
+	src := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:2"},
+			},
+		},
+		"metadata": map[string]interface{}{
+			"name":        "example",
+			"annotations": map[string]interface{}{"injected": "true"},
+		},
+	}
+	var fields []IgnoredField
+	for _, fp := range []string{"spec.replicas", "spec.containers[*].image", "metadata.annotations[*]"} {
+		f, err := ParseIgnoredField(fp)
+		if err != nil {
+			t.Fatalf("Failed to parse field path %q: %s", fp, err)
+		}
+		fields = append(fields, f)
+	}
+
+	copyIgnoredFields(dst, src, fields)
+
+	expected := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "app", "image": "app:2"},
+				map[string]interface{}{"name": "sidecar"},
+			},
+		},
+		"metadata": map[string]interface{}{
+			"name":        "example",
+			"annotations": map[string]interface{}{"injected": "true"},
+		},
+	}
+	if !reflect.DeepEqual(expected, dst) {
+		t.Errorf("Unexpected payload:\nexpected: %#v\ngot: %#v", expected, dst)
+	}
+}
+
+func TestNullIgnoredFields(t *testing.T) {
+	containerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"image": tftypes.String}}
+	specType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"replicas":   tftypes.Number,
+		"containers": tftypes.List{ElementType: containerType},
+	}}
+	in := tftypes.NewValue(specType, map[string]tftypes.Value{
+		"replicas": tftypes.NewValue(tftypes.Number, 3),
+		"containers": tftypes.NewValue(tftypes.List{ElementType: containerType}, []tftypes.Value{
+			tftypes.NewValue(containerType, map[string]tftypes.Value{"image": tftypes.NewValue(tftypes.String, "app:1")}),
+		}),
+	})
+	f, err := ParseIgnoredField("containers[*].image")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := nullIgnoredFields(in, []IgnoredField{f})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := tftypes.NewValue(specType, map[string]tftypes.Value{
+		"replicas": tftypes.NewValue(tftypes.Number, 3),
+		"containers": tftypes.NewValue(tftypes.List{ElementType: containerType}, []tftypes.Value{
+			tftypes.NewValue(containerType, map[string]tftypes.Value{"image": tftypes.NewValue(tftypes.String, nil)}),
+		}),
+	})
+	if !out.Equal(expected) {
+		t.Errorf("Unexpected value:\nexpected: %s\ngot: %s", expected, out)
+	}
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"strings"
@@ -11,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/payload"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v4/value"
 )
 
 // ImportResourceState function
@@ -35,6 +39,7 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 			Summary:  "Failed to parse import ID",
 			Detail:   err.Error(),
 		})
+		return resp, nil
 	}
 	s.logger.Trace("[ImportResourceState]", "[ID]", gvk, name, namespace)
 	rt, err := GetResourceType(req.TypeName)
@@ -64,6 +69,19 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		})
 		return resp, nil
 	}
+	if gvk.Version == "" {
+		// the shorthand ID format has no version, use the one preferred by the API server
+		mapping, err := rm.RESTMapping(gvk.GroupKind())
+		if err != nil {
+			resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+				Severity: tfprotov5.DiagnosticSeverityError,
+				Summary:  fmt.Sprintf("Failed to find a version for kind %q", gvk.GroupKind().String()),
+				Detail:   err.Error(),
+			})
+			return resp, nil
+		}
+		gvk = mapping.GroupVersionKind
+	}
 	ns, err := IsResourceNamespaced(gvk, rm)
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -73,6 +91,11 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		})
 		return resp, nil
 	}
+	if !ns {
+		namespace = ""
+	} else if namespace == "" {
+		namespace = "default"
+	}
 
 	io := unstructured.Unstructured{}
 	io.SetKind(gvk.Kind)
@@ -117,6 +140,11 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		return resp, nil
 	}
 
+	// The manifest only holds the fields set by the clients which manage the
+	// object, so that a configuration which matches what they set produces an
+	// empty plan after the import. The defaults of the API server are in the
+	// object alone.
+	mo := managedObject(ro)
 	fo := RemoveServerSideFields(ro.UnstructuredContent())
 	nobj, err := payload.ToTFValue(fo, objectType, th, tftypes.NewAttributePath())
 	if err != nil {
@@ -127,6 +155,15 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		})
 		return resp, nil
 	}
+	nman, err := payload.ToTFValue(RemoveServerSideFields(mo), tftypes.DynamicPseudoType, th, tftypes.NewAttributePath())
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Failed to convert unstructured to manifest value",
+			Detail:   err.Error(),
+		})
+		return resp, nil
+	}
 	nobj, err = morph.DeepUnknown(objectType, nobj, tftypes.NewAttributePath())
 	if err != nil {
 		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
@@ -140,16 +177,22 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 
 	newState := make(map[string]tftypes.Value)
 	wftype := rt.(tftypes.Object).AttributeTypes["wait_for"]
+	waitType := rt.(tftypes.Object).AttributeTypes["wait"]
 	timeoutsType := rt.(tftypes.Object).AttributeTypes["timeouts"]
 	fmType := rt.(tftypes.Object).AttributeTypes["field_manager"]
+	doType := rt.(tftypes.Object).AttributeTypes["delete_options"]
 	cmpType := rt.(tftypes.Object).AttributeTypes["computed_fields"]
+	ignType := rt.(tftypes.Object).AttributeTypes["ignore_fields"]
 
-	newState["manifest"] = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{}}, nil)
+	newState["manifest"] = nman
 	newState["object"] = morph.UnknownToNull(nobj)
 	newState["wait_for"] = tftypes.NewValue(wftype, nil)
+	newState["wait"] = tftypes.NewValue(waitType, nil)
 	newState["timeouts"] = tftypes.NewValue(timeoutsType, nil)
 	newState["field_manager"] = tftypes.NewValue(fmType, nil)
+	newState["delete_options"] = tftypes.NewValue(doType, nil)
 	newState["computed_fields"] = tftypes.NewValue(cmpType, nil)
+	newState["ignore_fields"] = tftypes.NewValue(ignType, nil)
 
 	nsVal := tftypes.NewValue(rt, newState)
 
@@ -166,11 +209,6 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 		TypeName: req.TypeName,
 		State:    &impState,
 	})
-	resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
-		Severity: tfprotov5.DiagnosticSeverityWarning,
-		Summary:  "Apply needed after 'import'",
-		Detail:   "Please run apply after a successful import to realign the resource state to the configuration in Terraform.",
-	})
 	return resp, nil
 }
 
@@ -186,7 +224,15 @@ func (s *RawProviderServer) ImportResourceState(ctx context.Context, req *tfprot
 //
 // Example: "apiVersion=v1,kind=Secret,namespace=default,name=default-token-qgm6s"
 //
+// The shorthand format "<kind>[.<group>]/[<namespace>/]<name>" is also accepted, in which
+// case the version is left empty for the caller to resolve.
+//
+// Example: "Deployment.apps/default/nginx"
 func parseImportID(id string) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+	if strings.Contains(id, "/") && !strings.Contains(id, "=") {
+		return parseShorthandImportID(id)
+	}
+
 	tokens := map[string]string{
 		"apiVersion": "",
 		"kind":       "",
@@ -222,3 +268,135 @@ func parseImportID(id string) (gvk schema.GroupVersionKind, name string, namespa
 
 	return
 }
+
+func parseShorthandImportID(id string) (gvk schema.GroupVersionKind, name string, namespace string, err error) {
+	parts := strings.Split(id, "/")
+	for _, p := range parts {
+		if p == "" {
+			parts = nil
+			break
+		}
+	}
+	switch len(parts) {
+	case 2:
+		name = parts[1]
+	case 3:
+		namespace = parts[1]
+		name = parts[2]
+	default:
+		err = fmt.Errorf("invalid format for import ID [%s]\nExpected format is: apiVersion=<value>,kind=<value>,name=<value>[,namespace=<value>] or <kind>[.<group>]/[<namespace>/]<name>", id)
+		return
+	}
+	kg := strings.SplitN(parts[0], ".", 2)
+	gvk.Kind = kg[0]
+	if len(kg) == 2 {
+		gvk.Group = kg[1]
+	}
+	return
+}
+
+// managedObject returns the fields of the object which are owned by one of
+// its field managers, leaving out the fields defaulted by the API server,
+// which no manager owns, and the status written through the status
+// subresource. The identity of the object is always kept. Objects without
+// managed fields are returned whole.
+func managedObject(obj *unstructured.Unstructured) map[string]interface{} {
+	managed := &fieldpath.Set{}
+	for _, e := range obj.GetManagedFields() {
+		if e.Subresource != "" || e.FieldsV1 == nil {
+			continue
+		}
+		fs := &fieldpath.Set{}
+		if err := fs.FromJSON(bytes.NewReader(e.FieldsV1.Raw)); err != nil {
+			continue
+		}
+		managed = managed.Union(fs)
+	}
+	if managed.Empty() {
+		return obj.DeepCopy().Object
+	}
+
+	out, _ := managedValue(obj.Object, managed).(map[string]interface{})
+	if out == nil {
+		out = map[string]interface{}{}
+	}
+	out["apiVersion"] = obj.GetAPIVersion()
+	out["kind"] = obj.GetKind()
+	meta, _ := out["metadata"].(map[string]interface{})
+	if meta == nil {
+		meta = map[string]interface{}{}
+		out["metadata"] = meta
+	}
+	meta["name"] = obj.GetName()
+	if ns := obj.GetNamespace(); ns != "" {
+		meta["namespace"] = ns
+	}
+	return out
+}
+
+// managedValue returns the parts of v which are in the field set.
+func managedValue(v interface{}, set *fieldpath.Set) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k, cv := range t {
+			pe := fieldpath.PathElement{FieldName: &k}
+			if child, ok := set.Children.Get(pe); ok {
+				out[k] = managedValue(cv, child)
+			} else if set.Members.Has(pe) {
+				out[k] = runtime.DeepCopyJSONValue(cv)
+			}
+		}
+		return out
+	case []interface{}:
+		out := []interface{}{}
+		for i, item := range t {
+			// Items of keyed lists are members of the set besides having
+			// children for their fields, of which only the owned ones are
+			// kept.
+			var child *fieldpath.Set
+			set.Children.Iterate(func(pe fieldpath.PathElement) {
+				if child == nil && listItemMatches(pe, i, item) {
+					child, _ = set.Children.Get(pe)
+				}
+			})
+			member := false
+			set.Members.Iterate(func(pe fieldpath.PathElement) {
+				member = member || listItemMatches(pe, i, item)
+			})
+			switch {
+			case child != nil:
+				out = append(out, managedValue(item, child))
+			case member:
+				out = append(out, runtime.DeepCopyJSONValue(item))
+			}
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// listItemMatches reports whether the path element selects the item at index
+// i of a list.
+func listItemMatches(pe fieldpath.PathElement, i int, item interface{}) bool {
+	switch {
+	case pe.Index != nil:
+		return *pe.Index == i
+	case pe.Value != nil:
+		return value.Equals(*pe.Value, value.NewValueInterface(item))
+	case pe.Key != nil:
+		m, ok := item.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for _, f := range *pe.Key {
+			fv, ok := m[f.Name]
+			if !ok || !value.Equals(f.Value, value.NewValueInterface(fv)) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
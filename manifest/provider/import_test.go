@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
@@ -36,6 +39,31 @@ func TestParseImportID(t *testing.T) {
 			Namespace: "foo",
 			Err:       nil,
 		},
+		{
+			ID:        "ConfigMap/foo/bar",
+			GVK:       schema.GroupVersionKind{Group: "", Version: "", Kind: "ConfigMap"},
+			Name:      "bar",
+			Namespace: "foo",
+			Err:       nil,
+		},
+		{
+			ID:        "Deployment.apps/foo/bar",
+			GVK:       schema.GroupVersionKind{Group: "apps", Version: "", Kind: "Deployment"},
+			Name:      "bar",
+			Namespace: "foo",
+			Err:       nil,
+		},
+		{
+			ID:        "ClusterRole.rbac.authorization.k8s.io/test",
+			GVK:       schema.GroupVersionKind{Group: "rbac.authorization.k8s.io", Version: "", Kind: "ClusterRole"},
+			Name:      "test",
+			Namespace: "",
+			Err:       nil,
+		},
+		{
+			ID:  "ConfigMap/foo/bar/baz",
+			Err: fmt.Errorf("invalid format for import ID [%s]\nExpected format is: apiVersion=<value>,kind=<value>,name=<value>[,namespace=<value>] or <kind>[.<group>]/[<namespace>/]<name>", "ConfigMap/foo/bar/baz"),
+		},
 		{
 			ID:  "foobar",
 			Err: fmt.Errorf("invalid format for import ID [%s]\nExpected format is: apiVersion=<value>,kind=<value>,name=<value>[,namespace=<value>]", "foobar"),
@@ -63,3 +91,88 @@ func TestParseImportID(t *testing.T) {
 		}
 	}
 }
+
+func TestManagedObject(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":              "nginx",
+			"namespace":         "default",
+			"uid":               "5fd5f1a6",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+			"labels":            map[string]interface{}{"app": "nginx"},
+			"annotations":       map[string]interface{}{"deployment.kubernetes.io/revision": "1"},
+		},
+		"spec": map[string]interface{}{
+			"replicas":             int64(2),
+			"revisionHistoryLimit": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"dnsPolicy": "ClusterFirst",
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":                     "nginx",
+							"image":                    "nginx:1.25",
+							"terminationMessagePath":   "/dev/termination-log",
+							"terminationMessagePolicy": "File",
+							"ports": []interface{}{
+								map[string]interface{}{"containerPort": int64(80), "protocol": "TCP"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(2)},
+	}}
+	obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:    "kubectl",
+			Operation:  metav1.ManagedFieldsOperationApply,
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:app":{}}},"f:spec":{"f:replicas":{},"f:template":{"f:spec":{"f:containers":{"k:{\"name\":\"nginx\"}":{".":{},"f:image":{},"f:name":{},"f:ports":{"k:{\"containerPort\":80,\"protocol\":\"TCP\"}":{".":{},"f:containerPort":{}}}}}}}}}`)},
+		},
+		{
+			Manager:     "kube-controller-manager",
+			Operation:   metav1.ManagedFieldsOperationUpdate,
+			Subresource: "status",
+			FieldsType:  "FieldsV1",
+			FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{"f:replicas":{}}}`)},
+		},
+	})
+
+	expected := map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+			"labels":    map[string]interface{}{"app": "nginx"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(2),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{
+							"name":  "nginx",
+							"image": "nginx:1.25",
+							"ports": []interface{}{
+								map[string]interface{}{"containerPort": int64(80)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, managedObject(obj)); diff != "" {
+		t.Errorf("unexpected managed object (-want +got):\n%s", diff)
+	}
+
+	obj.SetManagedFields(nil)
+	if diff := cmp.Diff(obj.Object, managedObject(obj)); diff != "" {
+		t.Errorf("Expected an object without managed fields to be returned whole (-want +got):\n%s", diff)
+	}
+}
@@ -155,7 +155,8 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	computedFields := make(map[string]*tftypes.AttributePath)
 	var atp *tftypes.AttributePath
 	cfVal, ok := proposedVal["computed_fields"]
-	if ok && !cfVal.IsNull() && cfVal.IsKnown() {
+	userComputedFields := ok && !cfVal.IsNull() && cfVal.IsKnown()
+	if userComputedFields {
 		var cf []tftypes.Value
 		cfVal.As(&cf)
 		for _, v := range cf {
@@ -186,6 +187,12 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		computedFields[atp.String()] = atp
 	}
 
+	ignoredFields, ifDiags := getIgnoredFields(proposedVal)
+	if len(ifDiags) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, ifDiags...)
+		return resp, nil
+	}
+
 	// Decode prior resource state
 	priorState, err := req.PriorState.Unmarshal(rt)
 	if err != nil {
@@ -282,7 +289,8 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		return resp, fmt.Errorf("failed to determine resource type ID: %s", err)
 	}
 
-	if !objectType.Is(tftypes.Object{}) {
+	structural := objectType.Is(tftypes.Object{})
+	if !structural {
 		// non-structural resources have no schema so we just use the
 		// type information we can get from the config
 		objectType = ppMan.Type()
@@ -322,6 +330,44 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 	so := objectType.(tftypes.Object)
 	s.logger.Debug("[PlanUpdateResource]", "OAPI type", dump(so))
 
+	// Catch typos in computed_fields early, using the type from the OpenAPI spec
+	if userComputedFields && structural {
+		invalid := false
+		for k, p := range computedFields {
+			if !typeHasAttributePath(objectType, p) {
+				invalid = true
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "Invalid computed_fields path",
+					Detail:    fmt.Sprintf("The field path %s does not exist in the schema of %s", k, gvk.String()),
+					Attribute: tftypes.NewAttributePath().WithAttributeName("computed_fields"),
+				})
+			}
+		}
+		if invalid {
+			return resp, nil
+		}
+	}
+
+	// Catch typos in ignore_fields early, the same way
+	if structural {
+		invalid := false
+		for _, f := range ignoredFields {
+			if !typeHasIgnoredField(objectType, f) {
+				invalid = true
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   "Invalid ignore_fields path",
+					Detail:    fmt.Sprintf("The field path %s does not exist in the schema of %s", f.path, gvk.String()),
+					Attribute: tftypes.NewAttributePath().WithAttributeName("ignore_fields"),
+				})
+			}
+		}
+		if invalid {
+			return resp, nil
+		}
+	}
+
 	// Transform the input manifest to adhere to the type model from the OpenAPI spec
 	morphedManifest, err := morph.ValueToType(ppMan, objectType, tftypes.NewAttributePath())
 	if err != nil {
@@ -453,6 +499,19 @@ func (s *RawProviderServer) PlanResourceChange(ctx context.Context, req *tfproto
 		proposedVal["object"] = updatedObj
 	}
 
+	// Ignored fields are never part of the state, so they do not cause changes
+	ignObj, err := nullIgnoredFields(proposedVal["object"], ignoredFields)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   "Failed to remove ignored fields from proposed state",
+			Detail:    err.Error(),
+			Attribute: tftypes.NewAttributePath().WithAttributeName("object"),
+		})
+		return resp, nil
+	}
+	proposedVal["object"] = ignObj
+
 	propStateVal := tftypes.NewValue(proposedState.Type(), proposedVal)
 	s.logger.Trace("[PlanResourceChange]", "new planned state", dump(propStateVal))
 
@@ -481,3 +540,57 @@ func getAttributeValue(v tftypes.Value, path string) (tftypes.Value, error) {
 	}
 	return vv.(tftypes.Value), nil
 }
+
+// typeHasAttributePath reports whether the attribute path can be resolved
+// against the type, without requiring a value.
+func typeHasAttributePath(t tftypes.Type, p *tftypes.AttributePath) bool {
+	for _, step := range p.Steps() {
+		if t.Is(tftypes.DynamicPseudoType) {
+			return true
+		}
+		var ok bool
+		t, ok = attributePathStepType(t, step)
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// attributePathStepType returns the type of the element or attribute the
+// step of an attribute path resolves to
+func attributePathStepType(t tftypes.Type, step tftypes.AttributePathStep) (tftypes.Type, bool) {
+	switch tt := t.(type) {
+	case tftypes.Object:
+		name, ok := step.(tftypes.AttributeName)
+		if !ok {
+			return nil, false
+		}
+		at, ok := tt.AttributeTypes[string(name)]
+		if !ok {
+			return nil, false
+		}
+		return at, true
+	case tftypes.Map:
+		switch step.(type) {
+		case tftypes.ElementKeyString, tftypes.AttributeName:
+		default:
+			return nil, false
+		}
+		return tt.ElementType, true
+	case tftypes.List:
+		if _, ok := step.(tftypes.ElementKeyInt); !ok {
+			return nil, false
+		}
+		return tt.ElementType, true
+	case tftypes.Set:
+		return tt.ElementType, true
+	case tftypes.Tuple:
+		i, ok := step.(tftypes.ElementKeyInt)
+		if !ok || int(i) < 0 || int(i) >= len(tt.ElementTypes) {
+			return nil, false
+		}
+		return tt.ElementTypes[i], true
+	}
+	return nil, false
+}
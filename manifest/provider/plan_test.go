@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTypeHasAttributePath(t *testing.T) {
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"metadata": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"annotations": tftypes.Map{ElementType: tftypes.String},
+					"labels":      tftypes.Map{ElementType: tftypes.String},
+				},
+			},
+			"spec": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"replicas": tftypes.Number,
+					"ports": tftypes.List{ElementType: tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"port": tftypes.Number,
+						},
+					}},
+					"extra": tftypes.DynamicPseudoType,
+				},
+			},
+		},
+	}
+
+	samples := map[string]bool{
+		"metadata.annotations":             true,
+		"metadata.labels[\"app\"]":         true,
+		"spec.replicas":                    true,
+		"spec.ports[0].port":               true,
+		"spec.extra.anything.goes":         true,
+		"spec.replica":                     false,
+		"metadata.annotation":              false,
+		"spec.ports.port":                  false,
+		"spec.replicas.value":              false,
+		"status.readyReplicas":             false,
+		"spec.ports[0].targetPort":         false,
+		"metadata.labels[\"app\"].invalid": false,
+	}
+
+	for fp, expected := range samples {
+		p, err := FieldPathToTftypesPath(fp)
+		if err != nil {
+			t.Fatalf("Failed to parse field path %q: %s", fp, err)
+		}
+		if typeHasAttributePath(objectType, p) != expected {
+			t.Errorf("Expected field path %q to be valid: %t", fp, expected)
+		}
+	}
+}
@@ -16,19 +16,26 @@ func GetObjectTypeFromSchema(schema *tfprotov5.Schema) tftypes.Type {
 	}
 
 	for _, b := range schema.Block.BlockTypes {
-		attrs := map[string]tftypes.Type{}
-		for _, att := range b.Block.Attributes {
-			attrs[att.Name] = att.Type
-		}
-		bm[b.TypeName] = tftypes.List{
-			ElementType: tftypes.Object{AttributeTypes: attrs},
-		}
-		// TODO handle repeated blocks
+		bm[b.TypeName] = getBlockType(b)
 	}
 
 	return tftypes.Object{AttributeTypes: bm}
 }
 
+// getBlockType returns the tftypes.Type of a nested block, including any blocks nested within it
+func getBlockType(b *tfprotov5.SchemaNestedBlock) tftypes.Type {
+	attrs := map[string]tftypes.Type{}
+	for _, att := range b.Block.Attributes {
+		attrs[att.Name] = att.Type
+	}
+	for _, nb := range b.Block.BlockTypes {
+		attrs[nb.TypeName] = getBlockType(nb)
+	}
+	return tftypes.List{
+		ElementType: tftypes.Object{AttributeTypes: attrs},
+	}
+}
+
 // GetResourceType returns the tftypes.Type of a resource of type 'name'
 func GetResourceType(name string) (tftypes.Type, error) {
 	sch := GetProviderResourceSchema()
@@ -92,6 +99,75 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 							},
 						},
 					},
+					{
+						TypeName: "wait",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						MinItems: 0,
+						MaxItems: 1,
+						Block: &tfprotov5.SchemaBlock{
+							Description: "Configure waiter options.",
+							BlockTypes: []*tfprotov5.SchemaNestedBlock{
+								{
+									TypeName: "condition",
+									Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+									MinItems: 0,
+									Block: &tfprotov5.SchemaBlock{
+										Attributes: []*tfprotov5.SchemaAttribute{
+											{
+												Name:        "type",
+												Type:        tftypes.String,
+												Required:    true,
+												Description: "The type of condition.",
+											},
+											{
+												Name:        "status",
+												Type:        tftypes.String,
+												Optional:    true,
+												Description: "The condition status. Defaults to `True`.",
+											},
+										},
+									},
+								},
+							},
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "rollout",
+									Type:        tftypes.Bool,
+									Optional:    true,
+									Description: "Wait for rollout to complete on resources that support `kubectl rollout status`.",
+								},
+								{
+									Name:        "fields",
+									Type:        tftypes.Map{ElementType: tftypes.String},
+									Optional:    true,
+									Description: "A map of paths to fields to wait for a specific field value.",
+								},
+							},
+						},
+					},
+					{
+						TypeName: "delete_options",
+						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+						MinItems: 0,
+						MaxItems: 1,
+						Block: &tfprotov5.SchemaBlock{
+							Description: "Configure options used when deleting the resource.",
+							Attributes: []*tfprotov5.SchemaAttribute{
+								{
+									Name:        "propagation_policy",
+									Type:        tftypes.String,
+									Optional:    true,
+									Description: "Whether and how garbage collection will be performed for dependents. One of \"Orphan\", \"Background\" or \"Foreground\".",
+								},
+								{
+									Name:        "wait",
+									Type:        tftypes.Bool,
+									Optional:    true,
+									Description: "Wait until the resource is removed from the API server before completing the delete. Defaults to true.",
+								},
+							},
+						},
+					},
 					{
 						TypeName: "field_manager",
 						Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
@@ -152,6 +228,12 @@ func GetProviderResourceSchema() map[string]*tfprotov5.Schema {
 						Description: "List of manifest fields whose values can be altered by the API server during 'apply'. Defaults to: [\"metadata.annotations\", \"metadata.labels\"]",
 						Optional:    true,
 					},
+					{
+						Name:        "ignore_fields",
+						Type:        tftypes.List{ElementType: tftypes.String},
+						Description: "List of object fields whose values are left to other controllers. Their values are only applied on create and never cause a plan change. Use '[*]' to match every element of a list.",
+						Optional:    true,
+					},
 				},
 			},
 		},
@@ -184,6 +266,12 @@ func GetProviderDataSourceSchema() map[string]*tfprotov5.Schema {
 						Computed:    true,
 						Description: "The response from the API server.",
 					},
+					{
+						Name:        "found",
+						Type:        tftypes.Bool,
+						Computed:    true,
+						Description: "Whether the resource exists. When false, object is empty.",
+					},
 				},
 				BlockTypes: []*tfprotov5.SchemaNestedBlock{
 					{
@@ -212,5 +300,54 @@ func GetProviderDataSourceSchema() map[string]*tfprotov5.Schema {
 				},
 			},
 		},
+		"kubernetes_resources": {
+			Version: 1,
+			Block: &tfprotov5.SchemaBlock{
+				Attributes: []*tfprotov5.SchemaAttribute{
+					{
+						Name:        "api_version",
+						Type:        tftypes.String,
+						Required:    true,
+						Description: "The resource apiVersion.",
+					},
+					{
+						Name:        "kind",
+						Type:        tftypes.String,
+						Required:    true,
+						Description: "The resource kind.",
+					},
+					{
+						Name:        "namespace",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "The namespace to list resources in. When omitted, resources are listed across all namespaces.",
+					},
+					{
+						Name:        "label_selector",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "A selector to restrict the list of returned objects by their labels.",
+					},
+					{
+						Name:        "field_selector",
+						Type:        tftypes.String,
+						Optional:    true,
+						Description: "A selector to restrict the list of returned objects by their fields.",
+					},
+					{
+						Name:        "objects",
+						Type:        tftypes.DynamicPseudoType,
+						Computed:    true,
+						Description: "The list of objects returned by the API server.",
+					},
+					{
+						Name:        "names",
+						Type:        tftypes.List{ElementType: tftypes.String},
+						Computed:    true,
+						Description: "The names of the returned objects.",
+					},
+				},
+			},
+		},
 	}
 }
@@ -153,6 +153,17 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 				DescriptionKind: 0,
 				Deprecated:      false,
 			},
+			{
+				Name:            "token_file",
+				Type:            tftypes.String,
+				Description:     "Path to a file containing the token to authenticate a service account. The file is read again when it changes, e.g. for a rotated projected service account token.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
 			{
 				Name:            "proxy_url",
 				Type:            tftypes.String,
@@ -164,6 +175,127 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 				DescriptionKind: 0,
 				Deprecated:      false,
 			},
+			{
+				Name:            "tls_server_name",
+				Type:            tftypes.String,
+				Description:     "Server name to use for SNI and to verify the TLS certificate of the Kubernetes API, instead of the hostname of host.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "timeout",
+				Type:            tftypes.String,
+				Description:     "Timeout of a single request to the Kubernetes API, as a duration such as `30s`. Defaults to no timeout.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "qps",
+				Type:            tftypes.Number,
+				Description:     "Maximum number of requests per second sent to the Kubernetes API by the client. Defaults to 50.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "burst",
+				Type:            tftypes.Number,
+				Description:     "Maximum burst of requests sent to the Kubernetes API by the client above qps. Defaults to 100.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "disable_client_rate_limiting",
+				Type:            tftypes.Bool,
+				Description:     "Do not throttle requests on the client side and ignore qps and burst, e.g. for clusters protected by API Priority and Fairness.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "log_api_requests",
+				Type:            tftypes.Bool,
+				Description:     "Log the bodies of API requests and responses at TRACE level, with the data of secrets redacted.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "ignore_annotations",
+				Type:            tftypes.List{ElementType: tftypes.String},
+				Description:     "List of Kubernetes metadata annotations to ignore across all resources handled by this provider for situations where external systems are managing certain resource annotations. Each item is a regular expression.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "ignore_labels",
+				Type:            tftypes.List{ElementType: tftypes.String},
+				Description:     "List of Kubernetes metadata labels to ignore across all resources handled by this provider for situations where external systems are managing certain resource labels. Each item is a regular expression.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "server_side_apply",
+				Type:            tftypes.Bool,
+				Description:     "Use server-side apply for the resources which support it, instead of updating them with JSON patches. Can be overridden per resource.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "validate_pod_security",
+				Type:            tftypes.Bool,
+				Description:     "Check during plan that the pods of workload resources satisfy the Pod Security Standard enforced on their namespace by the `pod-security.kubernetes.io/enforce` label. The check is skipped when the cluster cannot be reached.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
+			{
+				Name:            "expect_kubernetes_version",
+				Type:            tftypes.String,
+				Description:     "A version constraint, such as `>= 1.26`, the API server has to satisfy. It is checked before the first request to the cluster.",
+				Required:        false,
+				Optional:        true,
+				Computed:        false,
+				Sensitive:       false,
+				DescriptionKind: 0,
+				Deprecated:      false,
+			},
 		},
 		BlockTypes: []*tfprotov5.SchemaNestedBlock{
 			{
@@ -176,6 +308,7 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 						{
 							Name:            "api_version",
 							Type:            tftypes.String,
+							Description:     "API version of the ExecCredential exchanged with the plugin. Valid values are `client.authentication.k8s.io/v1` and `client.authentication.k8s.io/v1beta1`.",
 							Required:        true,
 							Optional:        false,
 							Computed:        false,
@@ -213,6 +346,116 @@ func GetProviderConfigSchema() *tfprotov5.Schema {
 							DescriptionKind: 0,
 							Deprecated:      false,
 						},
+						{
+							Name:            "interactive_mode",
+							Type:            tftypes.String,
+							Description:     "Whether the plugin uses standard input. Valid values are `Never`, `IfAvailable` and `Always`.",
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "install_hint",
+							Type:            tftypes.String,
+							Description:     "Help text shown when the command cannot be found.",
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "provide_cluster_info",
+							Type:            tftypes.Bool,
+							Description:     "Pass the information about the cluster to the plugin in the KUBERNETES_EXEC_INFO environment variable.",
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+					},
+				},
+			},
+			{
+				TypeName: "impersonate",
+				Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+				MinItems: 0,
+				MaxItems: 1,
+				Block: &tfprotov5.SchemaBlock{
+					Description: "Make all requests as the given user instead of the authenticated one. Can be set with the KUBE_AS, KUBE_AS_GROUPS and KUBE_AS_UID environment variables.",
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:            "user_name",
+							Type:            tftypes.String,
+							Description:     "The user name to impersonate.",
+							Required:        true,
+							Optional:        false,
+							Computed:        false,
+							Sensitive:       false,
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "groups",
+							Type:            tftypes.List{ElementType: tftypes.String},
+							Description:     "The groups to impersonate.",
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+						{
+							Name:            "uid",
+							Type:            tftypes.String,
+							Description:     "The UID to impersonate.",
+							Required:        false,
+							Optional:        true,
+							Computed:        false,
+							Sensitive:       false,
+							DescriptionKind: 0,
+							Deprecated:      false,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "extra",
+							Nesting:  tfprotov5.SchemaNestedBlockNestingModeList,
+							MinItems: 0,
+							MaxItems: 0,
+							Block: &tfprotov5.SchemaBlock{
+								Description: "Extra information to impersonate.",
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:            "key",
+										Type:            tftypes.String,
+										Required:        true,
+										Optional:        false,
+										Computed:        false,
+										Sensitive:       false,
+										DescriptionKind: 0,
+										Deprecated:      false,
+									},
+									{
+										Name:            "values",
+										Type:            tftypes.List{ElementType: tftypes.String},
+										Required:        true,
+										Optional:        false,
+										Computed:        false,
+										Sensitive:       false,
+										DescriptionKind: 0,
+										Deprecated:      false,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -170,6 +170,15 @@ func (s *RawProviderServer) ReadResource(ctx context.Context, req *tfprotov5.Rea
 	if err != nil {
 		return resp, err
 	}
+	ignoredFields, ifDiags := getIgnoredFields(rawState)
+	if len(ifDiags) > 0 {
+		resp.Diagnostics = append(resp.Diagnostics, ifDiags...)
+		return resp, nil
+	}
+	nobj, err = nullIgnoredFields(nobj, ignoredFields)
+	if err != nil {
+		return resp, err
+	}
 	rawState["object"] = morph.UnknownToNull(nobj)
 
 	nsVal := tftypes.NewValue(currentState.Type(), rawState)
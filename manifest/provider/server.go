@@ -2,8 +2,10 @@ package provider
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/hashicorp/go-hclog"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-provider-kubernetes/manifest/openapi"
 	"google.golang.org/grpc/codes"
@@ -27,6 +29,7 @@ type RawProviderServer struct {
 	// such as instances of the Kubernetes clients, configuration options needed at runtime.
 	logger          hclog.Logger
 	clientConfig    *rest.Config
+	httpClient      *http.Client
 	dynamicClient   dynamic.Interface
 	discoveryClient discovery.DiscoveryInterface
 	restMapper      meta.RESTMapper
@@ -35,6 +38,9 @@ type RawProviderServer struct {
 
 	providerEnabled bool
 	hostTFVersion   string
+
+	// expectedVersion is the expect_kubernetes_version constraint, if any.
+	expectedVersion gversion.Constraints
 }
 
 func dump(v interface{}) hclog.Format {
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // ValidateResourceTypeConfig function
@@ -102,6 +103,48 @@ func (s *RawProviderServer) ValidateResourceTypeConfig(ctx context.Context, req
 		}
 	}
 
+	// validate wait and wait_for
+	waitPath := tftypes.NewAttributePath().WithAttributeName("wait")
+	if w, ok := configVal["wait"]; ok && !w.IsNull() && w.IsKnown() {
+		var waitBlocks []tftypes.Value
+		w.As(&waitBlocks)
+		if len(waitBlocks) > 0 {
+			if wf, ok := configVal["wait_for"]; ok && !wf.IsNull() {
+				resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityError,
+					Summary:   `Invalid wait configuration`,
+					Detail:    `You may only set one of "wait" and "wait_for".`,
+					Attribute: waitPath,
+				})
+			}
+			resp.Diagnostics = append(resp.Diagnostics, validateWaitBlock(waitBlocks[0], waitPath.WithElementKeyInt(0))...)
+		}
+	}
+
+	// validate delete_options block
+	if d, ok := configVal["delete_options"]; ok && !d.IsNull() && d.IsKnown() {
+		var deleteOptionsBlocks []tftypes.Value
+		d.As(&deleteOptionsBlocks)
+		if len(deleteOptionsBlocks) > 0 {
+			var do map[string]tftypes.Value
+			deleteOptionsBlocks[0].As(&do)
+			if pp, ok := do["propagation_policy"]; ok && !pp.IsNull() && pp.IsKnown() {
+				var policy string
+				pp.As(&policy)
+				switch metav1.DeletionPropagation(policy) {
+				case metav1.DeletePropagationOrphan, metav1.DeletePropagationBackground, metav1.DeletePropagationForeground:
+				default:
+					resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+						Severity:  tfprotov5.DiagnosticSeverityError,
+						Summary:   `Invalid propagation_policy`,
+						Detail:    fmt.Sprintf(`%q is not a valid propagation policy. Must be one of "Orphan", "Background" or "Foreground".`, policy),
+						Attribute: tftypes.NewAttributePath().WithAttributeName("delete_options").WithElementKeyInt(0).WithAttributeName("propagation_policy"),
+					})
+				}
+			}
+		}
+	}
+
 	// validate timeouts block
 	timeouts := s.getTimeouts(configVal)
 	path := tftypes.NewAttributePath().WithAttributeName("timeouts")
@@ -196,3 +239,42 @@ func (s *RawProviderServer) validateResourceOnline(manifest *tftypes.Value) (dia
 	}
 	return
 }
+
+// validateWaitBlock checks that a wait block sets exactly one kind of waiter
+func validateWaitBlock(waitBlock tftypes.Value, path *tftypes.AttributePath) []*tfprotov5.Diagnostic {
+	var waitVal map[string]tftypes.Value
+	err := waitBlock.As(&waitVal)
+	if err != nil {
+		return nil
+	}
+
+	set := 0
+	if v, ok := waitVal["rollout"]; ok && !v.IsNull() {
+		var rollout bool
+		if v.IsKnown() {
+			v.As(&rollout)
+		}
+		if rollout || !v.IsKnown() {
+			set++
+		}
+	}
+	if v, ok := waitVal["condition"]; ok && !v.IsNull() && v.IsKnown() {
+		var conditions []tftypes.Value
+		v.As(&conditions)
+		if len(conditions) > 0 {
+			set++
+		}
+	}
+	if v, ok := waitVal["fields"]; ok && !v.IsNull() {
+		set++
+	}
+	if set > 1 {
+		return []*tfprotov5.Diagnostic{{
+			Severity:  tfprotov5.DiagnosticSeverityError,
+			Summary:   `Invalid wait configuration`,
+			Detail:    `You may only set one of "rollout", "condition" or "fields" in the "wait" block.`,
+			Attribute: path,
+		}}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"regexp"
@@ -16,7 +17,11 @@ import (
 	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
+	"k8s.io/kubectl/pkg/polymorphichelpers"
 )
 
 func (s *RawProviderServer) waitForCompletion(ctx context.Context, waitForBlock tftypes.Value, rs dynamic.ResourceInterface, rname string, rtype tftypes.Type, th map[string]string) error {
@@ -31,11 +36,55 @@ func (s *RawProviderServer) waitForCompletion(ctx context.Context, waitForBlock
 	return waiter.Wait(ctx)
 }
 
+// waitForCRDEstablished blocks until a CustomResourceDefinition reports the
+// Established condition, then resets the cached RESTMapper so resources of
+// the kinds it defines can be resolved by the resources applied after it.
+func (s *RawProviderServer) waitForCRDEstablished(ctx context.Context, rs dynamic.ResourceInterface, rname string) error {
+	w := &ConditionsWaiter{
+		rs,
+		rname,
+		[]ConditionMatcher{{conditionType: "Established", status: "True"}},
+		s.logger,
+	}
+	if err := w.Wait(ctx); err != nil {
+		return err
+	}
+	s.resetRestMapper()
+	return nil
+}
+
+const (
+	waiterInitialInterval = 1 * time.Second
+	waiterMaxInterval     = 30 * time.Second
+)
+
 // Waiter is a simple interface to implement a blocking wait operation
 type Waiter interface {
 	Wait(context.Context) error
 }
 
+// WaiterTimeoutError is returned by a Waiter when its context expires before
+// the object reaches the desired state. It carries the last observed status.
+type WaiterTimeoutError struct {
+	LastStatus interface{}
+}
+
+func (e *WaiterTimeoutError) Error() string {
+	if e.LastStatus == nil {
+		return "timed out waiting for the resource, no status was observed"
+	}
+	status, err := json.MarshalIndent(e.LastStatus, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("timed out waiting for the resource, last observed status: %v", e.LastStatus)
+	}
+	return fmt.Sprintf("timed out waiting for the resource, last observed status:\n%s", status)
+}
+
+// Unwrap allows callers to match the error against context.DeadlineExceeded
+func (e *WaiterTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
 // NewResourceWaiter constructs an appropriate Waiter using the supplied waitForBlock configuration
 func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string, resourceType tftypes.Type, th map[string]string, waitForBlock tftypes.Value, hl hclog.Logger) (Waiter, error) {
 	var waitForBlockVal map[string]tftypes.Value
@@ -44,6 +93,45 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 		return nil, err
 	}
 
+	if v, ok := waitForBlockVal["rollout"]; ok && !v.IsNull() && v.IsKnown() {
+		var rollout bool
+		v.As(&rollout)
+		if rollout {
+			return &RolloutWaiter{
+				resource,
+				resourceName,
+				hl,
+			}, nil
+		}
+	}
+
+	if v, ok := waitForBlockVal["condition"]; ok && !v.IsNull() && v.IsKnown() {
+		var conditionBlocks []tftypes.Value
+		v.As(&conditionBlocks)
+		if len(conditionBlocks) > 0 {
+			var conditions []ConditionMatcher
+			for _, cb := range conditionBlocks {
+				var cm map[string]tftypes.Value
+				err := cb.As(&cm)
+				if err != nil {
+					return nil, err
+				}
+				c := ConditionMatcher{status: "True"}
+				cm["type"].As(&c.conditionType)
+				if s := cm["status"]; !s.IsNull() {
+					s.As(&c.status)
+				}
+				conditions = append(conditions, c)
+			}
+			return &ConditionsWaiter{
+				resource,
+				resourceName,
+				conditions,
+				hl,
+			}, nil
+		}
+	}
+
 	fields, ok := waitForBlockVal["fields"]
 	if !ok || fields.IsNull() || !fields.IsKnown() {
 		return &NoopWaiter{}, nil
@@ -91,6 +179,107 @@ func NewResourceWaiter(resource dynamic.ResourceInterface, resourceName string,
 
 }
 
+// waitForObject blocks until done returns true for the named object. It watches
+// the object starting from the last observed resourceVersion and falls back to
+// polling with exponential back-off whenever the watch cannot be established.
+func waitForObject(ctx context.Context, resource dynamic.ResourceInterface, name string, logger hclog.Logger, done func(*unstructured.Unstructured) (bool, error)) error {
+	var last *unstructured.Unstructured
+	interval := waiterInitialInterval
+	for {
+		if ctx.Err() != nil {
+			return waiterTimeoutError(last)
+		}
+
+		res, err := resource.Get(ctx, name, v1.GetOptions{})
+		if err != nil {
+			if ctx.Err() != nil {
+				return waiterTimeoutError(last)
+			}
+			if errors.IsNotFound(err) || errors.IsGone(err) {
+				return fmt.Errorf("resource was deleted")
+			}
+			return err
+		}
+		last = res
+		ok, err := done(res)
+		if ok || err != nil {
+			return err
+		}
+
+		w, err := resource.Watch(ctx, v1.ListOptions{
+			FieldSelector:   fields.OneTermEqualSelector("metadata.name", name).String(),
+			ResourceVersion: res.GetResourceVersion(),
+		})
+		if err == nil {
+			var obj *unstructured.Unstructured
+			obj, ok, err = watchObject(ctx, w, done)
+			w.Stop()
+			if obj != nil {
+				last = obj
+			}
+			if ok || err != nil {
+				return err
+			}
+			// The watch was closed by the server, start over from a fresh read.
+			interval = waiterInitialInterval
+			continue
+		}
+		logger.Debug("[ApplyResourceChange][Wait] Failed to watch resource, polling instead", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return waiterTimeoutError(last)
+		case <-time.After(interval):
+		}
+		interval *= 2
+		if interval > waiterMaxInterval {
+			interval = waiterMaxInterval
+		}
+	}
+}
+
+// watchObject evaluates done on every change delivered by the watch. It returns
+// the last object it observed once done is satisfied, or the watch ends.
+func watchObject(ctx context.Context, w watch.Interface, done func(*unstructured.Unstructured) (bool, error)) (*unstructured.Unstructured, bool, error) {
+	var last *unstructured.Unstructured
+	for {
+		select {
+		case <-ctx.Done():
+			return last, false, nil
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				return last, false, nil
+			}
+			switch ev.Type {
+			case watch.Deleted:
+				return last, false, fmt.Errorf("resource was deleted")
+			case watch.Added, watch.Modified:
+				obj, ok := ev.Object.(*unstructured.Unstructured)
+				if !ok {
+					continue
+				}
+				last = obj
+				ok, err := done(obj)
+				if ok || err != nil {
+					return last, ok, err
+				}
+			default:
+				// Errors (e.g. an expired resourceVersion) are recovered by
+				// starting over from a fresh read of the object.
+				return last, false, nil
+			}
+		}
+	}
+}
+
+func waiterTimeoutError(last *unstructured.Unstructured) error {
+	e := &WaiterTimeoutError{}
+	if last != nil {
+		e.LastStatus = last.Object["status"]
+	}
+	return e
+}
+
 // FieldMatcher contains a tftypes.AttributePath to a field and a regexp to match on it
 type FieldMatcher struct {
 	path         *tftypes.AttributePath
@@ -111,82 +300,127 @@ type FieldWaiter struct {
 // Wait blocks until all of the FieldMatchers configured evaluate to true
 func (w *FieldWaiter) Wait(ctx context.Context) error {
 	w.logger.Info("[ApplyResourceChange][Wait] Waiting until ready...\n")
-	for {
-		if deadline, ok := ctx.Deadline(); ok {
-			if time.Now().After(deadline) {
-				return context.DeadlineExceeded
-			}
+	return waitForObject(ctx, w.resource, w.resourceName, w.logger, func(res *unstructured.Unstructured) (bool, error) {
+		resObj := res.DeepCopy().Object
+		if meta, ok := resObj["metadata"].(map[string]interface{}); ok {
+			delete(meta, "managedFields")
 		}
 
-		// NOTE The typed API resource is actually returned in the
-		// event object but I haven't yet figured out how to convert it
-		// to a cty.Value.
-		res, err := w.resource.Get(ctx, w.resourceName, v1.GetOptions{})
-		if err != nil {
-			return err
-		}
-		if errors.IsGone(err) {
-			return fmt.Errorf("resource was deleted")
-		}
-		resObj := res.Object
-		meta := resObj["metadata"].(map[string]interface{})
-		delete(meta, "managedFields")
-
 		w.logger.Trace("[ApplyResourceChange][Wait]", "API Response", resObj)
 
 		obj, err := payload.ToTFValue(resObj, w.resourceType, w.typeHints, tftypes.NewAttributePath())
 		if err != nil {
-			return err
+			return false, err
 		}
 
-		done, err := func(obj tftypes.Value) (bool, error) {
-			for _, m := range w.fieldMatchers {
-				vi, rp, err := tftypes.WalkAttributePath(obj, m.path)
-				if err != nil {
-					return false, err
-				}
-				if len(rp.Steps()) > 0 {
-					return false, fmt.Errorf("attribute not present at path '%s'", m.path.String())
-				}
+		for _, m := range w.fieldMatchers {
+			vi, rp, err := tftypes.WalkAttributePath(obj, m.path)
+			if err != nil || len(rp.Steps()) > 0 {
+				// the attribute is not present yet
+				return false, nil
+			}
 
-				var s string
-				v := vi.(tftypes.Value)
-				switch {
-				case v.Type().Is(tftypes.String):
-					v.As(&s)
-				case v.Type().Is(tftypes.Bool):
-					var vb bool
-					v.As(&vb)
-					s = fmt.Sprintf("%t", vb)
-				case v.Type().Is(tftypes.Number):
-					var f big.Float
-					v.As(&f)
-					if f.IsInt() {
-						i, _ := f.Int64()
-						s = fmt.Sprintf("%d", i)
-					} else {
-						i, _ := f.Float64()
-						s = fmt.Sprintf("%f", i)
-					}
-				default:
-					return true, fmt.Errorf("wait_for: cannot match on type %q", v.Type().String())
+			var s string
+			v := vi.(tftypes.Value)
+			switch {
+			case v.Type().Is(tftypes.String):
+				v.As(&s)
+			case v.Type().Is(tftypes.Bool):
+				var vb bool
+				v.As(&vb)
+				s = fmt.Sprintf("%t", vb)
+			case v.Type().Is(tftypes.Number):
+				var f big.Float
+				v.As(&f)
+				if f.IsInt() {
+					i, _ := f.Int64()
+					s = fmt.Sprintf("%d", i)
+				} else {
+					i, _ := f.Float64()
+					s = fmt.Sprintf("%f", i)
 				}
+			default:
+				return true, fmt.Errorf("wait_for: cannot match on type %q", v.Type().String())
+			}
 
-				if !m.valueMatcher.Match([]byte(s)) {
-					return false, nil
-				}
+			if !m.valueMatcher.Match([]byte(s)) {
+				return false, nil
 			}
+		}
 
-			return true, nil
-		}(obj)
+		return true, nil
+	})
+}
 
-		if done {
-			return err
+// ConditionMatcher contains the type and status of a status condition to match on
+type ConditionMatcher struct {
+	conditionType string
+	status        string
+}
+
+// ConditionsWaiter will wait for the specified conditions on
+// the resource to be met
+type ConditionsWaiter struct {
+	resource     dynamic.ResourceInterface
+	resourceName string
+	conditions   []ConditionMatcher
+	logger       hclog.Logger
+}
+
+// Wait blocks until all of the configured conditions are present with the desired status
+func (w *ConditionsWaiter) Wait(ctx context.Context) error {
+	w.logger.Info("[ApplyResourceChange][Wait] Waiting for conditions...\n")
+	return waitForObject(ctx, w.resource, w.resourceName, w.logger, func(res *unstructured.Unstructured) (bool, error) {
+		conditions, _, err := unstructured.NestedSlice(res.Object, "status", "conditions")
+		if err != nil {
+			return false, nil
+		}
+		for _, m := range w.conditions {
+			if !hasCondition(conditions, m) {
+				return false, nil
+			}
 		}
+		return true, nil
+	})
+}
 
-		// TODO: implement with exponential back-off.
-		time.Sleep(1 * time.Second) // lintignore:R018
+func hasCondition(conditions []interface{}, m ConditionMatcher) bool {
+	for _, c := range conditions {
+		cm, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if cm["type"] == m.conditionType && cm["status"] == m.status {
+			return true
+		}
 	}
+	return false
+}
+
+// RolloutWaiter will wait for a resource that has a StatusViewer to
+// finish rolling out
+type RolloutWaiter struct {
+	resource     dynamic.ResourceInterface
+	resourceName string
+	logger       hclog.Logger
+}
+
+// Wait uses StatusViewer to determine if the rollout is done
+func (w *RolloutWaiter) Wait(ctx context.Context) error {
+	w.logger.Info("[ApplyResourceChange][Wait] Waiting for rollout to complete...\n")
+	return waitForObject(ctx, w.resource, w.resourceName, w.logger, func(res *unstructured.Unstructured) (bool, error) {
+		gk := res.GetObjectKind().GroupVersionKind().GroupKind()
+		statusViewer, err := polymorphichelpers.StatusViewerFor(gk)
+		if err != nil {
+			return false, fmt.Errorf("error getting resource status: %v", err)
+		}
+		msg, done, err := statusViewer.Status(res, 0)
+		if err != nil {
+			return false, err
+		}
+		w.logger.Trace("[ApplyResourceChange][Wait]", "rollout status", msg)
+		return done, nil
+	})
 }
 
 // NoopWaiter is a placeholder for when there is nothing to wait on
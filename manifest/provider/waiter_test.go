@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestHasCondition(t *testing.T) {
+	conditions := []interface{}{
+		map[string]interface{}{"type": "Ready", "status": "False"},
+		map[string]interface{}{"type": "Synced", "status": "True"},
+		"not a condition",
+	}
+
+	samples := []struct {
+		matcher  ConditionMatcher
+		expected bool
+	}{
+		{ConditionMatcher{"Synced", "True"}, true},
+		{ConditionMatcher{"Ready", "False"}, true},
+		{ConditionMatcher{"Ready", "True"}, false},
+		{ConditionMatcher{"Missing", "True"}, false},
+	}
+
+	for _, s := range samples {
+		if hasCondition(conditions, s.matcher) != s.expected {
+			t.Errorf("Expected condition %s=%s to be found: %t", s.matcher.conditionType, s.matcher.status, s.expected)
+		}
+	}
+}
+
+func TestNewResourceWaiter_conditionStatus(t *testing.T) {
+	conditionType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"type":   tftypes.String,
+		"status": tftypes.String,
+	}}
+	waitType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"rollout":   tftypes.Bool,
+		"fields":    tftypes.Map{ElementType: tftypes.String},
+		"condition": tftypes.List{ElementType: conditionType},
+	}}
+	wait := tftypes.NewValue(waitType, map[string]tftypes.Value{
+		"rollout": tftypes.NewValue(tftypes.Bool, nil),
+		"fields":  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		"condition": tftypes.NewValue(tftypes.List{ElementType: conditionType}, []tftypes.Value{
+			tftypes.NewValue(conditionType, map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, "Ready"),
+				"status": tftypes.NewValue(tftypes.String, nil),
+			}),
+			tftypes.NewValue(conditionType, map[string]tftypes.Value{
+				"type":   tftypes.NewValue(tftypes.String, "Degraded"),
+				"status": tftypes.NewValue(tftypes.String, "False"),
+			}),
+		}),
+	})
+
+	waiter, err := NewResourceWaiter(nil, "test", waitType, nil, wait, hclog.NewNullLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	cw, ok := waiter.(*ConditionsWaiter)
+	if !ok {
+		t.Fatalf("Expected a conditions waiter, got %T", waiter)
+	}
+	expected := []ConditionMatcher{{"Ready", "True"}, {"Degraded", "False"}}
+	if len(cw.conditions) != len(expected) {
+		t.Fatalf("Expected %d conditions, got %#v", len(expected), cw.conditions)
+	}
+	for i, c := range cw.conditions {
+		if c != expected[i] {
+			t.Errorf("Expected condition %d to be %#v, got %#v", i, expected[i], c)
+		}
+	}
+}
+
+func TestWaiterTimeoutError(t *testing.T) {
+	err := error(&WaiterTimeoutError{
+		LastStatus: map[string]interface{}{"phase": "Pending"},
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Error("Expected the timeout error to match context.DeadlineExceeded")
+	}
+	if !strings.Contains(err.Error(), `"phase": "Pending"`) {
+		t.Errorf("Expected the last observed status to be rendered, got %q", err.Error())
+	}
+}
@@ -72,9 +72,75 @@ func TestDataSourceKubernetesResource_ConfigMap(t *testing.T) {
 	// check the data source
 	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
 		"data.kubernetes_resource.test_config.object.data.TEST": "hello world",
+		"data.kubernetes_resource.test_config.found":            true,
+		"data.kubernetes_resource.missing_config.found":         false,
 	})
+	tfstate.AssertAttributeLen(t, "data.kubernetes_resource.missing_config.object", 0)
 	// check the resource was created with the correct value
 	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
 		"kubernetes_manifest.test_config2.object.data.TEST": "hello world",
 	})
 }
+
+func TestDataSourceKubernetesResources_ConfigMap(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+	namespace := randName()
+
+	k8shelper.CreateNamespace(t, namespace)
+	defer k8shelper.DeleteResource(t, namespace, kubernetes.NewGroupVersionResource("v1", "namespaces"))
+
+	// STEP 1: Create labelled ConfigMaps to list
+	tf := tfhelper.RequireNewWorkingDir(t)
+	tf.SetReattachInfo(reattachInfo)
+	defer func() {
+		tf.RequireDestroy(t)
+		tf.Close()
+		k8shelper.AssertNamespacedResourceDoesNotExist(t, "v1", "configmaps", namespace, name+"-0")
+	}()
+
+	tfvars := TFVARS{
+		"name":      name,
+		"namespace": namespace,
+	}
+	tfconfig := loadTerraformConfig(t, "datasource_resources/step1.tf", tfvars)
+	tf.RequireSetConfig(t, tfconfig)
+	tf.RequireInit(t)
+	tf.RequireApply(t)
+
+	k8shelper.AssertNamespacedResourceExists(t, "v1", "configmaps", namespace, name+"-0")
+
+	// STEP 2: List the ConfigMaps from step 1 using label selectors
+	reattachInfo2, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create additional provider instance: %q", err)
+	}
+	step2 := tfhelper.RequireNewWorkingDir(t)
+	step2.SetReattachInfo(reattachInfo2)
+	defer func() {
+		step2.RequireDestroy(t)
+		step2.Close()
+	}()
+
+	tfconfig = loadTerraformConfig(t, "datasource_resources/step2.tf", tfvars)
+	step2.RequireSetConfig(t, tfconfig)
+	step2.RequireInit(t)
+	step2.RequireApply(t)
+
+	tfstate := tfstatehelper.NewHelper(step2.RequireState(t))
+
+	tfstate.AssertAttributeLen(t, "data.kubernetes_resources.all.objects", 3)
+	tfstate.AssertAttributeLen(t, "data.kubernetes_resources.all.names", 3)
+	tfstate.AssertAttributeLen(t, "data.kubernetes_resources.one.objects", 1)
+	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
+		"data.kubernetes_resources.one.names.0":                 name + "-1",
+		"data.kubernetes_resources.one.objects.0.metadata.name": name + "-1",
+		"data.kubernetes_resources.one.objects.0.data.TEST":     "hello world",
+	})
+}
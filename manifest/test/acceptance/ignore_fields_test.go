@@ -0,0 +1,72 @@
+//go:build acceptance
+// +build acceptance
+
+package acceptance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/provider"
+	"github.com/hashicorp/terraform-provider-kubernetes/manifest/test/helper/kubernetes"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestKubernetesManifest_IgnoreFields(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+	namespace := randName()
+
+	tf := tfhelper.RequireNewWorkingDir(t)
+	tf.SetReattachInfo(reattachInfo)
+	defer func() {
+		tf.RequireDestroy(t)
+		tf.Close()
+		k8shelper.AssertNamespacedResourceDoesNotExist(t, "apps/v1", "deployments", namespace, name)
+	}()
+
+	k8shelper.CreateNamespace(t, namespace)
+	defer k8shelper.DeleteResource(t, namespace, kubernetes.NewGroupVersionResource("v1", "namespaces"))
+
+	tfvars := TFVARS{
+		"namespace": namespace,
+		"name":      name,
+	}
+	tfconfig := loadTerraformConfig(t, "IgnoreFields/ignore_fields.tf", tfvars)
+	tf.RequireSetConfig(t, tfconfig)
+	tf.RequireInit(t)
+	tf.RequireApply(t)
+
+	k8shelper.AssertNamespacedResourceExists(t, "apps/v1", "deployments", namespace, name)
+
+	// scale the deployment like an autoscaler would
+	k8shelper.PatchNamespacedResource(t, "apps/v1", "deployments", namespace, name, map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": 5},
+	})
+
+	tf.RequireCreatePlan(t)
+	plan := tf.RequireSavedPlan(t)
+	for _, rc := range plan.ResourceChanges {
+		if !rc.Change.Actions.NoOp() {
+			t.Errorf("Expected no changes to %s, got %v", rc.Address, rc.Change.Actions)
+		}
+	}
+
+	tf.RequireApply(t)
+
+	deployment := k8shelper.GetNamespacedResource(t, "apps/v1", "deployments", namespace, name)
+	replicas, _, err := unstructured.NestedInt64(deployment.Object, "spec", "replicas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replicas != 5 {
+		t.Errorf("Expected the ignored replicas to be kept at 5, got %d", replicas)
+	}
+}
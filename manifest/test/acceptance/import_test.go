@@ -74,3 +74,55 @@ func TestKubernetesManifest_Import(t *testing.T) {
 		"kubernetes_manifest.test.object.data.fizz":          "buzz",
 	})
 }
+
+func TestKubernetesManifest_ImportClusterScopedShorthand(t *testing.T) {
+	ctx := context.Background()
+
+	reattachInfo, err := provider.ServeTest(ctx, hclog.Default(), t)
+	if err != nil {
+		t.Errorf("Failed to create provider instance: %q", err)
+	}
+
+	name := randName()
+
+	tf := tfhelper.RequireNewWorkingDir(t)
+	tf.SetReattachInfo(reattachInfo)
+	defer func() {
+		tf.RequireDestroy(t)
+		tf.Close()
+		k8shelper.AssertResourceDoesNotExist(t, "v1", "namespaces", name)
+	}()
+
+	k8shelper.CreateNamespace(t, name)
+
+	tfvars := TFVARS{
+		"namespace": name,
+		"name":      name,
+	}
+	tfconfig := loadTerraformConfig(t, "Import/import_namespace.tf", tfvars)
+	tf.RequireSetConfig(t, tfconfig)
+	tf.RequireInit(t)
+
+	// cluster-scoped kinds have no namespace segment and the
+	// version is resolved from the API server
+	importId := fmt.Sprintf("Namespace/%s", name)
+
+	tf.RequireImport(t, "kubernetes_manifest.test", importId)
+	k8shelper.AssertResourceExists(t, "v1", "namespaces", name)
+
+	tfstate := tfstatehelper.NewHelper(tf.RequireState(t))
+	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
+		"kubernetes_manifest.test.object.metadata.name":   name,
+		"kubernetes_manifest.test.manifest.metadata.name": name,
+		"kubernetes_manifest.test.manifest.kind":          "Namespace",
+	})
+	tfstate.AssertAttributeDoesNotExist(t, "kubernetes_manifest.test.manifest.status")
+
+	tf.RequireApply(t)
+
+	tfstate = tfstatehelper.NewHelper(tf.RequireState(t))
+	tfstate.AssertAttributeValues(t, tfstatehelper.AttributeValues{
+		"kubernetes_manifest.test.object.metadata.name":        name,
+		"kubernetes_manifest.test.object.metadata.labels.test": "import",
+	})
+}
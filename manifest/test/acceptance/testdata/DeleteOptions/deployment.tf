@@ -0,0 +1,38 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "apps/v1"
+    kind       = "Deployment"
+    metadata = {
+      name      = var.name
+      namespace = var.namespace
+    }
+    spec = {
+      replicas = 1
+      selector = {
+        matchLabels = {
+          app = var.name
+        }
+      }
+      template = {
+        metadata = {
+          labels = {
+            app = var.name
+          }
+        }
+        spec = {
+          containers = [
+            {
+              name  = "nginx"
+              image = "nginx:1.19"
+            }
+          ]
+        }
+      }
+    }
+  }
+
+  delete_options {
+    propagation_policy = "Foreground"
+    wait               = true
+  }
+}
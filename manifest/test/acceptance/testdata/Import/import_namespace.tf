@@ -0,0 +1,12 @@
+resource "kubernetes_manifest" "test" {
+  manifest = {
+    apiVersion = "v1"
+    kind       = "Namespace"
+    metadata = {
+      name = var.name
+      labels = {
+        test = "import"
+      }
+    }
+  }
+}
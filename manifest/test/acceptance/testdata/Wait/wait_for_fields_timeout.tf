@@ -0,0 +1,26 @@
+resource "kubernetes_manifest" "test" {
+
+  manifest = {
+    apiVersion = "v1"
+    kind       = "ConfigMap"
+
+    metadata = {
+      name      = var.name
+      namespace = var.namespace
+    }
+
+    data = {
+      foo = "bar"
+    }
+  }
+
+  wait {
+    fields = {
+      "data.foo" = "^never$"
+    }
+  }
+
+  timeouts {
+    create = "10s"
+  }
+}
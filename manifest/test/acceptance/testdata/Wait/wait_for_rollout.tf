@@ -0,0 +1,56 @@
+resource "kubernetes_manifest" "test" {
+
+  manifest = {
+    apiVersion = "apps/v1"
+    kind       = "Deployment"
+
+    metadata = {
+      name      = var.name
+      namespace = var.namespace
+    }
+
+    spec = {
+      replicas = 2
+
+      selector = {
+        matchLabels = {
+          app = "nginx"
+        }
+      }
+
+      template = {
+        metadata = {
+          labels = {
+            app = "nginx"
+          }
+        }
+
+        spec = {
+          containers = [
+            {
+              name  = "nginx"
+              image = "nginx:1.19"
+
+              readinessProbe = {
+                initialDelaySeconds = 10
+
+                httpGet = {
+                  path = "/"
+                  port = 80
+                }
+              }
+            }
+          ]
+        }
+      }
+    }
+  }
+
+  wait {
+    rollout = true
+  }
+
+  timeouts {
+    create = "3m"
+  }
+}
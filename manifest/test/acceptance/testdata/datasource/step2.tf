@@ -20,3 +20,12 @@ resource "kubernetes_manifest" "test_config2" {
     }
   }
 }
+
+data "kubernetes_resource" "missing_config" {
+  api_version = "v1"
+  kind = "ConfigMap"
+  metadata {
+    name = "${var.name}-missing"
+    namespace = var.namespace
+  }
+}
@@ -0,0 +1,19 @@
+resource "kubernetes_manifest" "test_config" {
+  count = 3
+
+  manifest = {
+    "apiVersion" = "v1"
+    "kind"       = "ConfigMap"
+    "metadata" = {
+      "name"      = "${var.name}-${count.index}"
+      "namespace" = var.namespace
+      "labels" = {
+        "test"  = var.name
+        "index" = tostring(count.index)
+      }
+    }
+    "data" = {
+      "TEST" = "hello world"
+    }
+  }
+}
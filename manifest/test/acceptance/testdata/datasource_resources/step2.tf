@@ -0,0 +1,13 @@
+data "kubernetes_resources" "all" {
+  api_version    = "v1"
+  kind           = "ConfigMap"
+  namespace      = var.namespace
+  label_selector = "test=${var.name}"
+}
+
+data "kubernetes_resources" "one" {
+  api_version    = "v1"
+  kind           = "ConfigMap"
+  namespace      = var.namespace
+  label_selector = "test=${var.name},index=1"
+}
@@ -14,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/version"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
@@ -136,6 +137,33 @@ func (k *Helper) DeleteNamespacedResource(t *testing.T, name string, namespace s
 	}
 }
 
+// PatchNamespacedResource applies a JSON merge patch to a namespaced resource
+func (k *Helper) PatchNamespacedResource(t *testing.T, gv, resource, namespace, name string, patch map[string]interface{}) {
+	t.Helper()
+
+	data, err := json.Marshal(patch)
+	if err != nil {
+		t.Fatalf("Failed to marshal patch for resource \"%s/%s\": %v", namespace, name, err)
+	}
+	gvr := NewGroupVersionResource(gv, resource)
+	_, err = k.dynClient.Resource(gvr).Namespace(namespace).Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		t.Fatalf("Failed to patch resource \"%s/%s\": %v", namespace, name, err)
+	}
+}
+
+// GetNamespacedResource returns a namespaced resource, failing the current test if it cannot be read
+func (k *Helper) GetNamespacedResource(t *testing.T, gv, resource, namespace, name string) *unstructured.Unstructured {
+	t.Helper()
+
+	gvr := NewGroupVersionResource(gv, resource)
+	res, err := k.dynClient.Resource(gvr).Namespace(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Failed to get resource \"%s/%s\": %v", namespace, name, err)
+	}
+	return res
+}
+
 func NewGroupVersionResource(gv, resource string) schema.GroupVersionResource {
 	gvr, _ := schema.ParseGroupVersion(gv)
 	return gvr.WithResource(resource)